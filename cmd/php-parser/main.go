@@ -23,6 +23,8 @@ import (
 	"github.com/z7zmey/php-parser/pkg/parser"
 	"github.com/z7zmey/php-parser/pkg/version"
 	"github.com/z7zmey/php-parser/pkg/visitor/dumper"
+	"github.com/z7zmey/php-parser/pkg/visitor/json"
+	"github.com/z7zmey/php-parser/pkg/visitor/minver"
 	"github.com/z7zmey/php-parser/pkg/visitor/nsresolver"
 	"github.com/z7zmey/php-parser/pkg/visitor/printer"
 	"github.com/z7zmey/php-parser/pkg/visitor/traverser"
@@ -32,11 +34,17 @@ var wg sync.WaitGroup
 var phpVersion *version.Version
 var profiler string
 var dump *bool
+var dumpJSON *bool
 var showResolvedNs *bool
 var printBack *bool
 var printPath *bool
 var printErrors *bool
 var printExecTime *bool
+var minVersion *bool
+
+// minUsage is the construct requiring the highest version across the files
+var minUsage *minver.Usage
+var minUsagePath string
 
 type file struct {
 	path    string
@@ -59,11 +67,18 @@ func main() {
 	printPath = flag.Bool("p", false, "print filepath")
 	printErrors = flag.Bool("e", false, "print errors")
 	dump = flag.Bool("d", false, "dump")
+	dumpJSON = flag.Bool("json", false, "dump AST as JSON")
+	minVersion = flag.Bool("minver", false, "print the minimum php version the files require")
 	flag.StringVar(&profiler, "prof", "", "start profiler: [cpu, mem, trace]")
 	flag.StringVar(&phpVer, "phpver", "7.4", "php version")
 
 	flag.Parse()
 
+	// the newest grammar accepts the constructs of every version
+	if *minVersion && !isFlagSet("phpver") {
+		phpVer = "8.3"
+	}
+
 	var err error
 	phpVersion, err = version.New(phpVer)
 	if err != nil {
@@ -106,6 +121,10 @@ func main() {
 	close(fileCh)
 	close(resultCh)
 
+	if *minVersion {
+		printMinVersion()
+	}
+
 	elapsed := time.Since(start)
 	if *printExecTime {
 		log.Printf("took: %s", elapsed)
@@ -137,19 +156,17 @@ func parserWorker(fileCh <-chan *file, r chan<- result) {
 			return
 		}
 
-		var parserErrors []*errors.Error
-		rootNode, err := parser.Parse(f.content, conf.Config{
+		rootNode, warnings, err := parser.ParseWithWarnings(f.content, conf.Config{
 			Version: phpVersion,
-			ErrorHandlerFunc: func(e *errors.Error) {
-				parserErrors = append(parserErrors, e)
-			},
 		})
-		if err != nil {
+
+		parserErrors, ok := err.(errors.ErrorList)
+		if err != nil && !ok {
 			fmt.Println("Error:" + err.Error())
 			os.Exit(1)
 		}
 
-		r <- result{path: f.path, rootNode: rootNode, errors: parserErrors}
+		r <- result{path: f.path, rootNode: rootNode, errors: append(parserErrors, warnings...)}
 	}
 }
 
@@ -185,20 +202,59 @@ func printerWorker(r <-chan result) {
 
 		if *showResolvedNs {
 			v := nsresolver.NewNamespaceResolver()
-			traverser.NewTraverser(v).Traverse(res.rootNode)
+			traverser.NewNodeTraverser(v).Traverse(res.rootNode)
 			for _, n := range v.ResolvedNames {
 				_, _ = io.WriteString(os.Stderr, "===> "+n+"\n")
 			}
 		}
 
+		if *minVersion && res.rootNode != nil {
+			d := minver.NewDetector()
+			traverser.NewNodeTraverser(d).Traverse(res.rootNode)
+
+			min := d.Min()
+			if min != nil {
+				_, _ = io.WriteString(os.Stdout, res.path+": PHP "+min.Feature.Since().String()+", "+min.Feature.String()+" at line "+strconv.Itoa(min.Position.StartLine)+"\n")
+			}
+
+			if min != nil && (minUsage == nil || minUsage.Feature.Since().Less(min.Feature.Since())) {
+				minUsage, minUsagePath = min, res.path
+			}
+		}
+
 		if *dump == true {
 			dumper.NewDumper(os.Stdout).WithPositions().WithTokens().Dump(res.rootNode)
 		}
 
+		if *dumpJSON {
+			err := json.NewEncoder(os.Stdout).WithIndent("", "  ").Encode(res.rootNode)
+			checkErr(err)
+		}
+
 		wg.Done()
 	}
 }
 
+func printMinVersion() {
+	if minUsage == nil {
+		fmt.Println("minimum version: no version dependent constructs found")
+		return
+	}
+
+	fmt.Printf("minimum version: PHP %s, %s in %s at line %d\n", minUsage.Feature.Since(), minUsage.Feature, minUsagePath, minUsage.Position.StartLine)
+}
+
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+
+	return set
+}
+
 func checkErr(err error) {
 	if err != nil {
 		log.Fatal(err)
@@ -0,0 +1,336 @@
+// Command astgen generates the field tables of the ast nodes
+// and the node constructors of the builder package
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+type field struct {
+	name string
+	kind string
+}
+
+type node struct {
+	name   string
+	fields []field
+}
+
+var kinds = map[string]string{
+	"*position.Position": "FieldPosition",
+	"*token.Token":       "FieldToken",
+	"[]*token.Token":     "FieldTokenList",
+	"Vertex":             "FieldNode",
+	"[]Vertex":           "FieldNodeList",
+	"[]byte":             "FieldValue",
+}
+
+func main() {
+	input := flag.String("input", "node.go", "file with the node declarations")
+	output := flag.String("output", "node_fields.go", "output file name")
+	builder := flag.Bool("builder", false, "generate the node constructors of the builder package")
+	flag.Parse()
+
+	nodes, err := parseNodes(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	gen := generate
+	if *builder {
+		gen = generateBuilder
+	}
+
+	src, err := gen(nodes)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(*output, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseNodes(path string) ([]node, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []node
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+
+			n := node{name: ts.Name.Name}
+			for _, fl := range st.Fields.List {
+				typ := exprString(fset, fl.Type)
+				kind, ok := kinds[typ]
+				if !ok {
+					return nil, fmt.Errorf("%s: unsupported field type %s", fset.Position(fl.Pos()), typ)
+				}
+
+				for _, name := range fl.Names {
+					n.fields = append(n.fields, field{name.Name, kind})
+				}
+			}
+
+			nodes = append(nodes, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func exprString(fset *token.FileSet, e ast.Expr) string {
+	var buf bytes.Buffer
+	_ = format.Node(&buf, fset, e)
+	return buf.String()
+}
+
+func generate(nodes []node) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by \"astgen %s\"; DO NOT EDIT.\n\n", strings.Join(os.Args[1:], " "))
+	fmt.Fprintf(&buf, "package ast\n\n")
+
+	fmt.Fprintf(&buf, "var (\n")
+	for _, n := range nodes {
+		fmt.Fprintf(&buf, "fields%s = []Field{\n", n.name)
+		for _, f := range n.fields {
+			fmt.Fprintf(&buf, "{Name: %q, Kind: %s},\n", f.name, f.kind)
+		}
+		fmt.Fprintf(&buf, "}\n")
+	}
+	fmt.Fprintf(&buf, ")\n\n")
+
+	fmt.Fprintf(&buf, "// Fields returns the fields of the node type in the declaration order\n")
+	fmt.Fprintf(&buf, "func Fields(n Vertex) []Field {\n")
+	fmt.Fprintf(&buf, "switch n.(type) {\n")
+	for _, n := range nodes {
+		fmt.Fprintf(&buf, "case *%s:\nreturn fields%s\n", n.name, n.name)
+	}
+	fmt.Fprintf(&buf, "}\n\nreturn nil\n}\n\n")
+
+	fmt.Fprintf(&buf, "// Children returns the child nodes in the declaration order of their fields,\n")
+	fmt.Fprintf(&buf, "// nil nodes are omitted\n")
+	fmt.Fprintf(&buf, "func Children(n Vertex) []Child {\n")
+	fmt.Fprintf(&buf, "var c []Child\n\n")
+	fmt.Fprintf(&buf, "switch n := n.(type) {\n")
+	for _, n := range nodes {
+		fmt.Fprintf(&buf, "case *%s:\n", n.name)
+		for _, f := range n.fields {
+			switch f.kind {
+			case "FieldNode":
+				fmt.Fprintf(&buf, "c = appendChild(c, %q, n.%s)\n", f.name, f.name)
+			case "FieldNodeList":
+				fmt.Fprintf(&buf, "c = appendChildren(c, %q, n.%s)\n", f.name, f.name)
+			}
+		}
+	}
+	fmt.Fprintf(&buf, "}\n\nreturn c\n}\n\n")
+
+	generateKinds(&buf, nodes)
+	generateClone(&buf, nodes)
+	generateEqual(&buf, nodes)
+
+	return format.Source(buf.Bytes())
+}
+
+func generateKinds(buf *bytes.Buffer, nodes []node) {
+	fmt.Fprintf(buf, "// Kind returns the name of the node type\n")
+	fmt.Fprintf(buf, "func Kind(n Vertex) string {\n")
+	fmt.Fprintf(buf, "switch n.(type) {\n")
+	for _, n := range nodes {
+		fmt.Fprintf(buf, "case *%s:\nreturn %q\n", n.name, n.name)
+	}
+	fmt.Fprintf(buf, "}\n\nreturn \"\"\n}\n\n")
+
+	fmt.Fprintf(buf, "// NewNode returns an empty node of the kind, or nil if the kind is unknown\n")
+	fmt.Fprintf(buf, "func NewNode(kind string) Vertex {\n")
+	fmt.Fprintf(buf, "switch kind {\n")
+	for _, n := range nodes {
+		fmt.Fprintf(buf, "case %q:\nreturn &%s{}\n", n.name, n.name)
+	}
+	fmt.Fprintf(buf, "}\n\nreturn nil\n}\n\n")
+}
+
+var cloneFuncs = map[string]string{
+	"FieldPosition":  "position",
+	"FieldToken":     "token",
+	"FieldTokenList": "tokens",
+	"FieldNode":      "node",
+	"FieldNodeList":  "nodes",
+	"FieldValue":     "bytes",
+}
+
+func generateClone(buf *bytes.Buffer, nodes []node) {
+	fmt.Fprintf(buf, "func cloneNode(c *cloner, n Vertex) Vertex {\n")
+	fmt.Fprintf(buf, "switch n := n.(type) {\n")
+	for _, n := range nodes {
+		fmt.Fprintf(buf, "case *%s:\n", n.name)
+		fmt.Fprintf(buf, "x := *n\n")
+		for _, f := range n.fields {
+			fmt.Fprintf(buf, "x.%s = c.%s(n.%s)\n", f.name, cloneFuncs[f.kind], f.name)
+		}
+		fmt.Fprintf(buf, "return &x\n")
+	}
+	fmt.Fprintf(buf, "}\n\nreturn nil\n}\n\n")
+}
+
+// names lists the nodes compared case-insensitively with the IgnoreNameCase option
+// if they are held by the name fields
+var names = map[string]bool{
+	"Identifier": true,
+	"NamePart":   true,
+}
+
+// nameFields lists the fields holding the class, function and method names and the types,
+// PHP compares them case-insensitively unlike the variable, property and constant names
+var nameFields = map[string]bool{
+	"Attribute.Name":                   true,
+	"ExprArrowFunction.ReturnType":     true,
+	"ExprClosure.ReturnType":           true,
+	"ExprFunctionCall.Function":        true,
+	"ExprInstanceOf.Class":             true,
+	"ExprMethodCall.Method":            true,
+	"ExprNew.Class":                    true,
+	"ExprNullsafeMethodCall.Method":    true,
+	"ExprClassConstFetch.Class":        true,
+	"ExprStaticCall.Class":             true,
+	"ExprStaticCall.Call":              true,
+	"ExprStaticPropertyFetch.Class":    true,
+	"Intersection.Types":               true,
+	"Nullable.Expr":                    true,
+	"Parameter.Type":                   true,
+	"StmtCatch.Types":                  true,
+	"StmtClass.Name":                   true,
+	"StmtClass.Extends":                true,
+	"StmtClass.Implements":             true,
+	"StmtClassConstList.Type":          true,
+	"StmtClassMethod.Name":             true,
+	"StmtClassMethod.ReturnType":       true,
+	"StmtEnum.Name":                    true,
+	"StmtEnum.Type":                    true,
+	"StmtEnum.Implements":              true,
+	"StmtFunction.Name":                true,
+	"StmtFunction.ReturnType":          true,
+	"StmtGroupUseList.Prefix":          true,
+	"StmtInterface.Name":               true,
+	"StmtInterface.Extends":            true,
+	"StmtNamespace.Name":               true,
+	"StmtPropertyList.Type":            true,
+	"StmtTrait.Name":                   true,
+	"StmtTraitUse.Traits":              true,
+	"StmtTraitUseAlias.Trait":          true,
+	"StmtTraitUseAlias.Method":         true,
+	"StmtTraitUseAlias.Alias":          true,
+	"StmtTraitUsePrecedence.Trait":     true,
+	"StmtTraitUsePrecedence.Method":    true,
+	"StmtTraitUsePrecedence.Insteadof": true,
+	"StmtUse.Use":                      true,
+	"StmtUse.Alias":                    true,
+	"Union.Types":                      true,
+}
+
+func generateEqual(buf *bytes.Buffer, nodes []node) {
+	fmt.Fprintf(buf, "func equalNode(e *equality, a, b Vertex) bool {\n")
+	fmt.Fprintf(buf, "switch a := a.(type) {\n")
+	for _, n := range nodes {
+		fmt.Fprintf(buf, "case *%s:\n", n.name)
+		fmt.Fprintf(buf, "b, ok := b.(*%s)\n", n.name)
+		fmt.Fprintf(buf, "if !ok {\nreturn false\n}\n")
+
+		fold := "false"
+		if names[n.name] {
+			fold = "e.fold"
+		}
+
+		var conds []string
+		for _, f := range n.fields {
+			switch {
+			case f.kind == "FieldToken" || f.kind == "FieldValue":
+				conds = append(conds, fmt.Sprintf("e.%s(a.%s, b.%s, %s)", cloneFuncs[f.kind], f.name, f.name, fold))
+			case f.kind == "FieldNode" && nameFields[n.name+"."+f.name]:
+				conds = append(conds, fmt.Sprintf("e.name(a.%s, b.%s)", f.name, f.name))
+			case f.kind == "FieldNodeList" && nameFields[n.name+"."+f.name]:
+				conds = append(conds, fmt.Sprintf("e.names(a.%s, b.%s)", f.name, f.name))
+			default:
+				conds = append(conds, fmt.Sprintf("e.%s(a.%s, b.%s)", cloneFuncs[f.kind], f.name, f.name))
+			}
+		}
+		fmt.Fprintf(buf, "return %s\n", strings.Join(conds, " &&\n"))
+	}
+	fmt.Fprintf(buf, "}\n\nreturn false\n}\n")
+}
+
+var builderTypes = map[string]string{
+	"FieldNode":     "ast.Vertex",
+	"FieldNodeList": "[]ast.Vertex",
+	"FieldValue":    "string",
+}
+
+// paramNames renames the fields that are go keywords
+var paramNames = map[string]string{
+	"const": "constant",
+	"else":  "elseStmt",
+	"type":  "typ",
+	"var":   "variable",
+}
+
+func generateBuilder(nodes []node) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by \"astgen %s\"; DO NOT EDIT.\n\n", strings.Join(os.Args[1:], " "))
+	fmt.Fprintf(&buf, "package builder\n\n")
+	fmt.Fprintf(&buf, "import \"github.com/z7zmey/php-parser/pkg/ast\"\n\n")
+
+	for _, n := range nodes {
+		var params, values []string
+		for _, f := range n.fields {
+			typ, ok := builderTypes[f.kind]
+			if !ok {
+				continue
+			}
+
+			param := strings.ToLower(f.name[:1]) + f.name[1:]
+			if name, ok := paramNames[param]; ok {
+				param = name
+			}
+
+			params = append(params, param+" "+typ)
+
+			if f.kind == "FieldValue" {
+				param = "[]byte(" + param + ")"
+			}
+			values = append(values, f.name+": "+param+",")
+		}
+
+		fmt.Fprintf(&buf, "// New%s returns the %s node without the tokens\n", n.name, n.name)
+		fmt.Fprintf(&buf, "func New%s(%s) *ast.%s {\n", n.name, strings.Join(params, ", "), n.name)
+		fmt.Fprintf(&buf, "return &ast.%s{\n%s\n}\n}\n\n", n.name, strings.Join(values, "\n"))
+	}
+
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,77 @@
+// Package indentation indents the source lines and the formatted subtrees
+package indentation
+
+import (
+	"bytes"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+// Line returns the indentation of the source line containing the offset
+func Line(src []byte, offset int) []byte {
+	start := bytes.LastIndexByte(src[:offset], '\n') + 1
+
+	end := start
+	for end < offset && (src[end] == ' ' || src[end] == '\t') {
+		end++
+	}
+
+	return src[start:end]
+}
+
+// Reindent adds the indentation after every line break in the whitespace
+// and the doc comments of the subtree formatted at the zero indentation level,
+// the blank lines are left empty
+func Reindent(n ast.Vertex, indent []byte) {
+	if len(indent) == 0 {
+		return
+	}
+
+	for _, t := range ast.Tokens(n) {
+		reindent(t.FreeFloating, indent)
+	}
+
+	for _, c := range ast.Children(n) {
+		Reindent(c.Node, indent)
+	}
+}
+
+func reindent(ff []*token.Token, indent []byte) {
+	for i, t := range ff {
+		if t.ID != token.T_WHITESPACE && t.ID != token.T_DOC_COMMENT {
+			continue
+		}
+
+		var v []byte
+		for j, b := range t.Value {
+			v = append(v, b)
+			if b == '\n' && !blank(ff[i:], t.Value[j+1:]) {
+				v = append(v, indent...)
+			}
+		}
+		t.Value = v
+	}
+}
+
+// blank reports whether the line starting with rest of the first token
+// ends with the line break before the end of the free floating tokens
+func blank(ff []*token.Token, rest []byte) bool {
+	for i, t := range ff {
+		if i > 0 {
+			rest = t.Value
+		}
+
+		for _, b := range rest {
+			switch b {
+			case ' ', '\t', '\r':
+			case '\n':
+				return true
+			default:
+				return false
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,19 @@
+package php5
+
+import (
+	"github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+// requires reports an error if the php version does not support the feature used at the position
+func (p *Parser) requires(f version.Feature, pos *position.Position) {
+	if p.phpVersion == nil || p.phpVersion.Supports(f) || p.errHandlerFunc == nil {
+		return
+	}
+
+	e := errors.NewError(f.Requirement(), pos)
+	e.Column = pos.StartCol
+
+	p.errHandlerFunc(e)
+}
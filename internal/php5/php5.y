@@ -7,6 +7,7 @@ import (
     "github.com/z7zmey/php-parser/pkg/ast"
     "github.com/z7zmey/php-parser/pkg/errors"
     "github.com/z7zmey/php-parser/pkg/token"
+    "github.com/z7zmey/php-parser/pkg/version"
 )
 
 %}
@@ -155,6 +156,11 @@ import (
 %token <token> T_IS_NOT_EQUAL
 %token <token> T_IS_SMALLER_OR_EQUAL
 %token <token> T_IS_GREATER_OR_EQUAL
+
+// the fragment tokens keep their pkg/token numbers following the php8 tokens
+%token <token> T_FRAGMENT_EXPR 57490
+%token <token> T_FRAGMENT_STMTS 57491
+%token <token> T_FRAGMENT_CLASS_MEMBER 57492
 %token <token> '"'
 %token <token> '`'
 %token <token> '{'
@@ -273,6 +279,27 @@ start:
                     EndTkn: yylex.(*Parser).currentToken,
                 }
             }
+    |   T_FRAGMENT_EXPR expr
+            {
+                yylex.(*Parser).rootNode = &ast.Root{
+                    Position: yylex.(*Parser).builder.NewNodePosition($2),
+                    Stmts:  []ast.Vertex{$2},
+                }
+            }
+    |   T_FRAGMENT_STMTS top_statement_list
+            {
+                yylex.(*Parser).rootNode = &ast.Root{
+                    Position: yylex.(*Parser).builder.NewNodeListPosition($2),
+                    Stmts:  $2,
+                }
+            }
+    |   T_FRAGMENT_CLASS_MEMBER class_statement
+            {
+                yylex.(*Parser).rootNode = &ast.Root{
+                    Position: yylex.(*Parser).builder.NewNodePosition($2),
+                    Stmts:  []ast.Vertex{$2},
+                }
+            }
 ;
 
 top_statement_list:
@@ -281,6 +308,8 @@ top_statement_list:
                 if $2 != nil {
                     $$ = append($1, $2)
                 }
+
+                yylex.(*Parser).topStmts = $$
             }
     |   /* empty */
             {
@@ -319,8 +348,7 @@ namespace_name:
 top_statement:
         error
             {
-                // error
-                $$ = nil
+                $$ = yylex.(*Parser).recovery.NewBadStmt()
             }
     |   statement
             {
@@ -346,6 +374,8 @@ top_statement:
             }
     |   T_NAMESPACE namespace_name ';'
             {
+                yylex.(*Parser).requires(version.FeatureNamespace, $1.Position)
+
                 $$ = &ast.StmtNamespace{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $3),
                     NsTkn: $1,
@@ -359,6 +389,8 @@ top_statement:
             }
     |   T_NAMESPACE namespace_name '{' top_statement_list '}'
             {
+                yylex.(*Parser).requires(version.FeatureNamespace, $1.Position)
+
                 $$ = &ast.StmtNamespace{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $5),
                     NsTkn: $1,
@@ -374,6 +406,8 @@ top_statement:
             }
     |   T_NAMESPACE '{' top_statement_list '}'
             {
+                yylex.(*Parser).requires(version.FeatureNamespace, $1.Position)
+
                 $$ = &ast.StmtNamespace{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $4),
                     NsTkn:                $1,
@@ -384,6 +418,8 @@ top_statement:
             }
     |   T_USE use_declarations ';'
             {
+                yylex.(*Parser).requires(version.FeatureNamespace, $1.Position)
+
                 $$ = &ast.StmtUseList{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $3),
                     UseTkn:          $1,
@@ -394,6 +430,8 @@ top_statement:
             }
     |   T_USE T_FUNCTION use_function_declarations ';'
             {
+                yylex.(*Parser).requires(version.FeatureUseFunction, $2.Position)
+
                 $$ = &ast.StmtUseList{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $4),
                     UseTkn: $1,
@@ -409,6 +447,8 @@ top_statement:
             }
     |   T_USE T_CONST use_const_declarations ';'
             {
+                yylex.(*Parser).requires(version.FeatureUseFunction, $2.Position)
+
                 $$ = &ast.StmtUseList{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $4),
                     UseTkn: $1,
@@ -718,8 +758,7 @@ inner_statement_list:
 inner_statement:
         error
             {
-                // error
-                $$ = nil
+                $$ = yylex.(*Parser).recovery.NewBadStmt()
             }
     |   statement
             {
@@ -1088,6 +1127,8 @@ unticked_statement:
             }
     |   T_GOTO T_STRING ';'
             {
+                yylex.(*Parser).requires(version.FeatureGoto, $1.Position)
+
                 $$ = &ast.StmtGoto{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $3),
                     GotoTkn: $1,
@@ -1137,6 +1178,8 @@ finally_statement:
             }
     |   T_FINALLY '{' inner_statement_list '}'
             {
+                yylex.(*Parser).requires(version.FeatureFinally, $1.Position)
+
                 $$ = &ast.StmtFinally{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $4),
                     FinallyTkn:           $1,
@@ -1248,6 +1291,8 @@ is_variadic:
             }
     |   T_ELLIPSIS
             {
+                yylex.(*Parser).requires(version.FeatureVariadic, $1.Position)
+
                 $$ = $1
             }
 ;
@@ -1376,6 +1421,8 @@ class_entry_type:
             }
     |   T_TRAIT
             {
+                yylex.(*Parser).requires(version.FeatureTrait, $1.Position)
+
                 $$ = &ast.StmtTrait{
                     Position: yylex.(*Parser).builder.NewTokenPosition($1),
                     TraitTkn: $1,
@@ -1911,6 +1958,8 @@ optional_class_type:
             }
     |   T_CALLABLE
             {
+                yylex.(*Parser).requires(version.FeatureCallableType, $1.Position)
+
                 $$ = &ast.Identifier{
                     Position: yylex.(*Parser).builder.NewTokenPosition($1),
                     IdentifierTkn: $1,
@@ -2000,12 +2049,18 @@ function_call_parameter:
             }
     |   T_ELLIPSIS expr
             {
+                yylex.(*Parser).requires(version.FeatureArgumentUnpacking, $1.Position)
+
                 $$ = &ast.Argument{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $2),
                     VariadicTkn: $1,
                     Expr:        $2,
                 }
             }
+    |   error
+            {
+                $$ = yylex.(*Parser).recovery.NewBadExpr()
+            }
 ;
 
 global_var_list:
@@ -2193,11 +2248,17 @@ class_statement:
                     Stmt:                $8,
                 }
             }
+    |   error
+            {
+                $$ = yylex.(*Parser).recovery.NewBadStmt()
+            }
 ;
 
 trait_use_statement:
         T_USE trait_list trait_adaptations
             {
+                yylex.(*Parser).requires(version.FeatureTrait, $1.Position)
+
                 traitUse := &ast.StmtTraitUse{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $3),
                     UseTkn:        $1,
@@ -2860,6 +2921,8 @@ expr_without_variable:
             }
     |   variable T_POW_EQUAL expr
             {
+                yylex.(*Parser).requires(version.FeatureExponentiation, $2.Position)
+
                 $$ = &ast.ExprAssignPow{
                     Position: yylex.(*Parser).builder.NewNodesPosition($1, $3),
                     Var:      $1,
@@ -3081,6 +3144,8 @@ expr_without_variable:
             }
     |   expr T_POW expr
             {
+                yylex.(*Parser).requires(version.FeatureExponentiation, $2.Position)
+
                 $$ = &ast.ExprBinaryPow{
                     Position: yylex.(*Parser).builder.NewNodesPosition($1, $3),
                     Left:  $1,
@@ -3291,6 +3356,8 @@ expr_without_variable:
             }
     |   expr '?' ':' expr
             {
+                yylex.(*Parser).requires(version.FeatureShortTernary, $2.Position)
+
                 $$ = &ast.ExprTernary{
                     Position: yylex.(*Parser).builder.NewNodesPosition($1, $4),
                     Cond:        $1,
@@ -3415,6 +3482,8 @@ expr_without_variable:
             }
     |   T_YIELD
             {
+                yylex.(*Parser).requires(version.FeatureGenerator, $1.Position)
+
                 $$ = &ast.ExprYield{
                     Position: yylex.(*Parser).builder.NewTokenPosition($1),
                     YieldTkn: $1,
@@ -3422,6 +3491,8 @@ expr_without_variable:
             }
     |   function is_reference '(' parameter_list ')' lexical_vars '{' inner_statement_list '}'
             {
+                yylex.(*Parser).requires(version.FeatureClosure, $1.Position)
+
                 closure := $6.(*ast.ExprClosure)
 
                 closure.Position             = yylex.(*Parser).builder.NewTokensPosition($1, $9)
@@ -3439,6 +3510,8 @@ expr_without_variable:
             }
     |   T_STATIC function is_reference '(' parameter_list ')' lexical_vars '{' inner_statement_list '}'
             {
+                yylex.(*Parser).requires(version.FeatureClosure, $2.Position)
+
                 closure := $7.(*ast.ExprClosure)
                 
                 closure.Position             = yylex.(*Parser).builder.NewTokensPosition($1, $10)
@@ -3460,6 +3533,8 @@ expr_without_variable:
 yield_expr:
         T_YIELD expr_without_variable
             {
+                yylex.(*Parser).requires(version.FeatureGenerator, $1.Position)
+
                 $$ = &ast.ExprYield{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $2),
                     YieldTkn: $1,
@@ -3468,6 +3543,8 @@ yield_expr:
             }
     |   T_YIELD variable
             {
+                yylex.(*Parser).requires(version.FeatureGenerator, $1.Position)
+
                 $$ = &ast.ExprYield{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $2),
                     YieldTkn: $1,
@@ -3476,6 +3553,8 @@ yield_expr:
             }
     |   T_YIELD expr T_DOUBLE_ARROW expr_without_variable
             {
+                yylex.(*Parser).requires(version.FeatureGenerator, $1.Position)
+
                 $$ = &ast.ExprYield{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $4),
                     YieldTkn:       $1,
@@ -3486,6 +3565,8 @@ yield_expr:
             }
     |   T_YIELD expr T_DOUBLE_ARROW variable
             {
+                yylex.(*Parser).requires(version.FeatureGenerator, $1.Position)
+
                 $$ = &ast.ExprYield{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $4),
                     YieldTkn:       $1,
@@ -3557,6 +3638,8 @@ combined_scalar:
             }
     |   '[' array_pair_list ']'
             {
+                yylex.(*Parser).requires(version.FeatureShortArray, $1.Position)
+
                 $$ = &ast.ExprArray{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $3),
                     OpenBracketTkn:  $1,
@@ -4180,6 +4263,8 @@ static_scalar_value:
             }
     |   '[' static_array_pair_list ']'
             {
+                yylex.(*Parser).requires(version.FeatureShortArray, $1.Position)
+
                 $$ = &ast.ExprArray{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $3),
                     OpenBracketTkn:  $1,
@@ -4246,6 +4331,8 @@ static_operation:
             }
     |   static_scalar_value T_POW static_scalar_value
             {
+                yylex.(*Parser).requires(version.FeatureExponentiation, $2.Position)
+
                 $$ = &ast.ExprBinaryPow{
                     Position: yylex.(*Parser).builder.NewNodesPosition($1, $3),
                     Left:  $1,
@@ -4460,6 +4547,8 @@ static_operation:
             }
     |   static_scalar_value '?' ':' static_scalar_value
             {
+                yylex.(*Parser).requires(version.FeatureShortTernary, $2.Position)
+
                 $$ = &ast.ExprTernary{
                     Position: yylex.(*Parser).builder.NewNodesPosition($1, $4),
                     Cond:        $1,
@@ -0,0 +1,46 @@
+package php7
+
+import (
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+// requires reports an error if the php version does not support the feature used at the position
+func (p *Parser) requires(f version.Feature, pos *position.Position) {
+	if p.phpVersion == nil || p.phpVersion.Supports(f) || p.errHandlerFunc == nil {
+		return
+	}
+
+	e := errors.NewError(f.Requirement(), pos)
+	e.Column = pos.StartCol
+
+	p.errHandlerFunc(e)
+}
+
+// checkList reports the features used by the items of the list assignment
+func (p *Parser) checkList(items []ast.Vertex) {
+	for _, item := range items {
+		item, ok := item.(*ast.ExprArrayItem)
+		if !ok {
+			continue
+		}
+
+		if item.Key != nil {
+			p.requires(version.FeatureKeyedList, item.Key.GetPosition())
+		}
+
+		if item.AmpersandTkn != nil {
+			p.requires(version.FeatureListReference, item.AmpersandTkn.Position)
+		}
+
+		switch v := item.Val.(type) {
+		case *ast.ExprList:
+			p.checkList(v.Items)
+		case *ast.ExprArray:
+			p.requires(version.FeatureShortList, v.OpenBracketTkn.Position)
+			p.checkList(v.Items)
+		}
+	}
+}
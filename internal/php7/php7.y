@@ -6,6 +6,7 @@ import (
 
     "github.com/z7zmey/php-parser/pkg/ast"
     "github.com/z7zmey/php-parser/pkg/token"
+    "github.com/z7zmey/php-parser/pkg/version"
 )
 
 %}
@@ -154,6 +155,11 @@ import (
 %token <token> T_IS_NOT_EQUAL
 %token <token> T_IS_SMALLER_OR_EQUAL
 %token <token> T_IS_GREATER_OR_EQUAL
+
+// the fragment tokens keep their pkg/token numbers following the php8 tokens
+%token <token> T_FRAGMENT_EXPR 57490
+%token <token> T_FRAGMENT_STMTS 57491
+%token <token> T_FRAGMENT_CLASS_MEMBER 57492
 %token <token> '"'
 %token <token> '`'
 %token <token> '{'
@@ -289,6 +295,27 @@ start:
                     EndTkn: yylex.(*Parser).currentToken,
                 }
             }
+    |   T_FRAGMENT_EXPR expr
+            {
+                yylex.(*Parser).rootNode = &ast.Root{
+                    Position: yylex.(*Parser).builder.NewNodePosition($2),
+                    Stmts:  []ast.Vertex{$2},
+                }
+            }
+    |   T_FRAGMENT_STMTS top_statement_list
+            {
+                yylex.(*Parser).rootNode = &ast.Root{
+                    Position: yylex.(*Parser).builder.NewNodeListPosition($2),
+                    Stmts:  $2,
+                }
+            }
+    |   T_FRAGMENT_CLASS_MEMBER class_statement
+            {
+                yylex.(*Parser).rootNode = &ast.Root{
+                    Position: yylex.(*Parser).builder.NewNodePosition($2),
+                    Stmts:  []ast.Vertex{$2},
+                }
+            }
 ;
 
 reserved_non_modifiers:
@@ -326,6 +353,8 @@ top_statement_list:
                 if $2 != nil {
                     $$ = append($1, $2)
                 }
+
+                yylex.(*Parser).topStmts = $$
             }
     |   /* empty */
             {
@@ -394,8 +423,7 @@ name:
 top_statement:
         error
             {
-                // error
-                $$ = nil
+                $$ = yylex.(*Parser).recovery.NewBadStmt()
             }
     |   statement
             {
@@ -541,6 +569,10 @@ use_type:
 group_use_declaration:
         namespace_name T_NS_SEPARATOR '{' unprefixed_use_declarations possible_comma '}'
             {
+                if $5 != nil {
+                    yylex.(*Parser).requires(version.FeatureGroupUseTrailingComma, $5.Position)
+                }
+
                 if $5 != nil {
                     $4.(*ParserSeparatedList).SeparatorTkns = append($4.(*ParserSeparatedList).SeparatorTkns, $5)
                 }
@@ -561,6 +593,10 @@ group_use_declaration:
             }
     |   T_NS_SEPARATOR namespace_name T_NS_SEPARATOR '{' unprefixed_use_declarations possible_comma '}'
             {
+                if $6 != nil {
+                    yylex.(*Parser).requires(version.FeatureGroupUseTrailingComma, $6.Position)
+                }
+
                 if $6 != nil {
                     $5.(*ParserSeparatedList).SeparatorTkns = append($5.(*ParserSeparatedList).SeparatorTkns, $6)
                 }
@@ -585,6 +621,10 @@ group_use_declaration:
 mixed_group_use_declaration:
         namespace_name T_NS_SEPARATOR '{' inline_use_declarations possible_comma '}'
             {
+                if $5 != nil {
+                    yylex.(*Parser).requires(version.FeatureGroupUseTrailingComma, $5.Position)
+                }
+
                 if $5 != nil {
                     $4.(*ParserSeparatedList).SeparatorTkns = append($4.(*ParserSeparatedList).SeparatorTkns, $5)
                 }
@@ -605,6 +645,10 @@ mixed_group_use_declaration:
             }
     |   T_NS_SEPARATOR namespace_name T_NS_SEPARATOR '{' inline_use_declarations possible_comma '}'
             {
+                if $6 != nil {
+                    yylex.(*Parser).requires(version.FeatureGroupUseTrailingComma, $6.Position)
+                }
+
                 if $6 != nil {
                     $5.(*ParserSeparatedList).SeparatorTkns = append($5.(*ParserSeparatedList).SeparatorTkns, $6)
                 }
@@ -778,8 +822,7 @@ inner_statement_list:
 inner_statement:
         error
             {
-                // error
-                $$ = nil
+                $$ = yylex.(*Parser).recovery.NewBadStmt()
             }
     |   statement
             {
@@ -949,6 +992,10 @@ statement:
             }
     |   T_UNSET '(' unset_variables possible_comma ')' ';'
             {
+                if $4 != nil {
+                    yylex.(*Parser).requires(version.FeatureCallTrailingComma, $4.Position)
+                }
+
                 $3.(*ast.StmtUnset).UnsetTkn = $1
                 $3.(*ast.StmtUnset).OpenParenthesisTkn = $2
                 if $4 != nil {
@@ -1106,6 +1153,8 @@ catch_name_list:
             }
     |   catch_name_list '|' name
             {
+                yylex.(*Parser).requires(version.FeatureMultiCatch, $2.Position)
+
                 $1.(*ast.StmtCatch).SeparatorTkns = append($1.(*ast.StmtCatch).SeparatorTkns, $2)
                 $1.(*ast.StmtCatch).Types = append($1.(*ast.StmtCatch).Types, $3)
 
@@ -1396,6 +1445,8 @@ foreach_variable:
             }
     |   T_LIST '(' array_pair_list ')'
             {
+                yylex.(*Parser).checkList($3.(*ParserSeparatedList).Items)
+
                 $$ = &ast.ExprList{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $4),
                     ListTkn:         $1,
@@ -1407,6 +1458,9 @@ foreach_variable:
             }
     |   '[' array_pair_list ']'
             {
+                yylex.(*Parser).requires(version.FeatureShortList, $1.Position)
+                yylex.(*Parser).checkList($2.(*ParserSeparatedList).Items)
+
                 $$ = &ast.ExprList{
                     Position: yylex.(*Parser).builder.NewTokensPosition($1, $3),
                     OpenBracketTkn:  $1,
@@ -1802,6 +1856,8 @@ type_expr:
             }
     |   '?' type
             {
+                yylex.(*Parser).requires(version.FeatureNullableType, $1.Position)
+
                 $$ = &ast.Nullable{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $2),
                     QuestionTkn: $1,
@@ -1858,6 +1914,10 @@ argument_list:
             }
     |   '(' non_empty_argument_list possible_comma ')'
             {
+                if $3 != nil {
+                    yylex.(*Parser).requires(version.FeatureCallTrailingComma, $3.Position)
+                }
+
                 argumentList := $2.(*ArgumentList)
                 argumentList.Position = yylex.(*Parser).builder.NewTokensPosition($1, $4)
                 argumentList.OpenParenthesisTkn = $1
@@ -1902,6 +1962,10 @@ argument:
                     Expr:        $2,
                 }
             }
+    |   error
+            {
+                $$ = yylex.(*Parser).recovery.NewBadExpr()
+            }
 ;
 
 global_var_list:
@@ -1991,6 +2055,10 @@ class_statement_list:
 class_statement:
         variable_modifiers optional_type property_list ';'
             {
+                if $2 != nil {
+                    yylex.(*Parser).requires(version.FeatureTypedProperty, $2.GetPosition())
+                }
+
                 $$ = &ast.StmtPropertyList{
                     Position: yylex.(*Parser).builder.NewNodeListTokenPosition($1, $4),
                     Modifiers:     $1,
@@ -2002,6 +2070,10 @@ class_statement:
             }
     |   method_modifiers T_CONST class_const_list ';'
             {
+                if len($1) > 0 {
+                    yylex.(*Parser).requires(version.FeatureClassConstVisibility, $1[0].GetPosition())
+                }
+
                 $$ = &ast.StmtClassConstList{
                     Position: yylex.(*Parser).builder.NewOptionalListTokensPosition($1, $2, $4),
                     Modifiers:     $1,
@@ -2057,6 +2129,10 @@ class_statement:
                     Stmt:                $10,
                 }
             }
+    |   error
+            {
+                $$ = yylex.(*Parser).recovery.NewBadStmt()
+            }
 ;
 
 name_list:
@@ -2562,6 +2638,8 @@ new_expr:
 expr_without_variable:
         T_LIST '(' array_pair_list ')' '=' expr
             {
+                yylex.(*Parser).checkList($3.(*ParserSeparatedList).Items)
+
                 $$ = &ast.ExprAssign{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $6),
                     Var: &ast.ExprList{
@@ -2578,6 +2656,9 @@ expr_without_variable:
             }
     |   '[' array_pair_list ']' '=' expr
             {
+                yylex.(*Parser).requires(version.FeatureShortList, $1.Position)
+                yylex.(*Parser).checkList($2.(*ParserSeparatedList).Items)
+
                 $$ = &ast.ExprAssign{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $5),
                     Var: &ast.ExprList{
@@ -2728,6 +2809,8 @@ expr_without_variable:
             }
     |   variable T_COALESCE_EQUAL expr
             {
+                yylex.(*Parser).requires(version.FeatureNullCoalescingAssignment, $2.Position)
+
                 $$ = &ast.ExprAssignCoalesce{
                     Position: yylex.(*Parser).builder.NewNodesPosition($1, $3),
                     Var:      $1,
@@ -3265,6 +3348,8 @@ inline_function:
             }
     |   T_FN returns_ref '(' parameter_list ')' return_type backup_doc_comment T_DOUBLE_ARROW expr
             {
+                yylex.(*Parser).requires(version.FeatureArrowFunction, $1.Position)
+
                 $$ = &ast.ExprArrowFunction{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $9),
                     FnTkn:               $1,
@@ -4102,6 +4187,8 @@ array_pair:
             }
     |   T_ELLIPSIS expr
             {
+                yylex.(*Parser).requires(version.FeatureArraySpread, $1.Position)
+
                 $$ = &ast.ExprArrayItem{
                     Position: yylex.(*Parser).builder.NewTokenNodePosition($1, $2),
                     EllipsisTkn: $1,
@@ -4337,6 +4424,10 @@ encaps_var_offset:
 internal_functions_in_yacc:
         T_ISSET '(' isset_variables possible_comma ')'
             {
+                if $4 != nil {
+                    yylex.(*Parser).requires(version.FeatureCallTrailingComma, $4.Position)
+                }
+
                 if $4 != nil {
                     $3.(*ParserSeparatedList).SeparatorTkns = append($3.(*ParserSeparatedList).SeparatorTkns, $4)
                 }
@@ -0,0 +1,55 @@
+package php8
+
+import (
+	"bytes"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+// requires reports an error if the php version does not support the feature used at the position
+func (p *Parser) requires(f version.Feature, pos *position.Position) {
+	if p.phpVersion == nil || p.phpVersion.Supports(f) || p.errHandlerFunc == nil {
+		return
+	}
+
+	e := errors.NewError(f.Requirement(), pos)
+	e.Column = pos.StartCol
+
+	p.errHandlerFunc(e)
+}
+
+// checkType reports the true type and the null and false types used outside of the union
+func (p *Parser) checkType(n ast.Vertex, standalone bool) {
+	name, ok := n.(*ast.Name)
+	if !ok || len(name.Parts) != 1 {
+		return
+	}
+
+	value := name.Parts[0].(*ast.NamePart).Value
+	switch {
+	case bytes.EqualFold(value, []byte("true")):
+		p.requires(version.FeatureStandaloneType, name.Position)
+	case standalone && (bytes.EqualFold(value, []byte("null")) || bytes.EqualFold(value, []byte("false"))):
+		p.requires(version.FeatureStandaloneType, name.Position)
+	}
+}
+
+// checkInitializer reports the new expressions in the initializer of the parameter,
+// the static variable, the property or the constant
+func (p *Parser) checkInitializer(n ast.Vertex) {
+	if n == nil || p.phpVersion == nil || p.phpVersion.Supports(version.FeatureNewInInitializer) {
+		return
+	}
+
+	if e, ok := n.(*ast.ExprNew); ok {
+		p.requires(version.FeatureNewInInitializer, e.Position)
+		return
+	}
+
+	for _, c := range ast.Children(n) {
+		p.checkInitializer(c.Node)
+	}
+}
@@ -0,0 +1,192 @@
+package php8
+
+import (
+	"github.com/z7zmey/php-parser/internal/position"
+	"github.com/z7zmey/php-parser/internal/recovery"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+// Lexer is the token source of the Parser
+type Lexer interface {
+	Lex() *token.Token
+	TokenLocation(t *token.Token) (int, int)
+}
+
+// Parser structure
+type Parser struct {
+	Lexer          Lexer
+	currentToken   *token.Token
+	rootNode       ast.Vertex
+	phpVersion     *version.Version
+	errHandlerFunc func(*errors.Error)
+	builder        *position.Builder
+	recovery       *recovery.Recovery
+	topStmts       []ast.Vertex
+	fragment       token.ID
+}
+
+// NewParser creates and returns new Parser
+func NewParser(lexer Lexer, config conf.Config) *Parser {
+	return &Parser{
+		Lexer:          lexer,
+		phpVersion:     config.Version,
+		errHandlerFunc: config.ErrorHandlerFunc,
+		builder:        position.NewBuilder(),
+	}
+}
+
+func (p *Parser) Lex(lval *yySymType) int {
+	if p.fragment != 0 {
+		id := p.fragment
+		p.fragment = 0
+		lval.token = nil
+
+		return int(id)
+	}
+
+	t := p.Lexer.Lex()
+
+	p.currentToken = t
+	p.recovery.AddToken(t)
+	lval.token = t
+
+	return int(t.ID)
+}
+
+func (p *Parser) Error(msg string) {
+	if p.errHandlerFunc == nil {
+		return
+	}
+
+	p.errHandlerFunc(errors.NewError(msg, p.currentToken.Position))
+}
+
+// syntaxError reports unexpected lookahead token in the given parser state
+func (p *Parser) syntaxError(state, lookahead int) {
+	if p.errHandlerFunc == nil {
+		return
+	}
+
+	e := errors.NewSyntaxError(yyErrorMessage(state, lookahead), p.currentToken, expectedTokens(state))
+	e.Line, e.Column = p.Lexer.TokenLocation(p.currentToken)
+
+	p.errHandlerFunc(e)
+}
+
+// Parse the php8 Parser entrypoint
+func (p *Parser) Parse() int {
+	p.rootNode = nil
+	p.topStmts = nil
+	p.recovery = recovery.NewRecovery(p.builder)
+
+	r := yyParse(p)
+
+	aborted := p.rootNode == nil
+	if aborted {
+		p.rootNode = p.partialRootNode()
+	}
+
+	// the fragment start rule may be reduced before the eof token is read
+	if root := p.rootNode.(*ast.Root); root.EndTkn == nil {
+		p.currentToken.Value = nil
+		root.EndTkn = p.currentToken
+	}
+
+	p.recovery.Resolve(p.rootNode.(*ast.Root), aborted)
+
+	return r
+}
+
+// ParseFragment parses the fragment selected by the start token:
+// token.T_FRAGMENT_EXPR, token.T_FRAGMENT_STMTS or token.T_FRAGMENT_CLASS_MEMBER
+func (p *Parser) ParseFragment(start token.ID) int {
+	p.fragment = start
+
+	return p.Parse()
+}
+
+// partialRootNode builds root node from top statements parsed before the parser gave up
+func (p *Parser) partialRootNode() *ast.Root {
+	for p.currentToken == nil || p.currentToken.ID != 0 {
+		p.currentToken = p.Lexer.Lex()
+		p.recovery.AddToken(p.currentToken)
+	}
+
+	p.currentToken.Value = nil
+
+	return &ast.Root{
+		Stmts:  p.topStmts,
+		EndTkn: p.currentToken,
+	}
+}
+
+// GetRootNode returns root node
+func (p *Parser) GetRootNode() ast.Vertex {
+	return p.rootNode
+}
+
+// helpers
+
+func lastNode(nn []ast.Vertex) ast.Vertex {
+	if len(nn) == 0 {
+		return nil
+	}
+	return nn[len(nn)-1]
+}
+
+// tokenIDs maps goyacc token numbers to token.ID
+var tokenIDs = func() []token.ID {
+	ids := make([]token.ID, len(yyToknames)+1)
+
+	for id, tok := range yyTok1 {
+		ids[tok] = token.ID(id)
+	}
+
+	for i, tok := range yyTok2 {
+		ids[tok] = token.ID(yyPrivate + i)
+	}
+
+	for i := 0; yyTok3[i] != 0; i += 2 {
+		ids[yyTok3[i+1]] = token.ID(yyTok3[i])
+	}
+
+	return ids
+}()
+
+// expectedTokens returns tokens that can be shifted or reduced in the given parser state
+func expectedTokens(state int) []token.ID {
+	const tokStart = 4
+
+	var expected []token.ID
+
+	base := int(yyPact[state])
+	for tok := tokStart; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
+			expected = append(expected, tokenIDs[tok])
+		}
+	}
+
+	if yyDef[state] != -2 {
+		return expected
+	}
+
+	i := 0
+	for yyExca[i] != -1 || int(yyExca[i+1]) != state {
+		i += 2
+	}
+
+	for i += 2; yyExca[i] >= 0; i += 2 {
+		tok := int(yyExca[i])
+		if tok < tokStart || yyExca[i+1] == 0 {
+			continue
+		}
+
+		expected = append(expected, tokenIDs[tok])
+	}
+
+	return expected
+}
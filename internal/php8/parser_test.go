@@ -0,0 +1,15274 @@
+package php8_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/internal/php8"
+	"github.com/z7zmey/php-parser/internal/scanner"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+func TestPhp8AttributeGroups(t *testing.T) {
+	src := `<?php
+#[A, B(1, name: 'x'),]
+#[\C\D]
+final class Foo {
+    #[E] public int $a;
+    #[F] const X = 1;
+    #[G] public function m(#[H] $p) {}
+}
+#[I] function f() {}
+#[J] interface Bar {}
+#[K] trait Baz {}
+`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     2,
+			EndLine:       11,
+			StartPos:      6,
+			EndPos:        202,
+			StartCol:      1,
+			EndCol:        18,
+			StartColUTF16: 1,
+			EndColUTF16:   18,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtClass{
+				Position: &position.Position{
+					StartLine:     2,
+					EndLine:       8,
+					StartPos:      6,
+					EndPos:        141,
+					StartCol:      1,
+					EndCol:        2,
+					StartColUTF16: 1,
+					EndColUTF16:   2,
+				},
+				AttrGroups: []ast.Vertex{
+					&ast.AttributeGroup{
+						Position: &position.Position{
+							StartLine:     2,
+							EndLine:       2,
+							StartPos:      6,
+							EndPos:        28,
+							StartCol:      1,
+							EndCol:        23,
+							StartColUTF16: 1,
+							EndColUTF16:   23,
+						},
+						OpenAttributeTkn: &token.Token{
+							ID:    token.T_ATTRIBUTE,
+							Value: []byte("#["),
+							Position: &position.Position{
+								StartLine:     2,
+								EndLine:       2,
+								StartPos:      6,
+								EndPos:        8,
+								StartCol:      1,
+								EndCol:        3,
+								StartColUTF16: 1,
+								EndColUTF16:   3,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_OPEN_TAG,
+									Value: []byte("<?php"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      0,
+										EndPos:        5,
+										StartCol:      1,
+										EndCol:        6,
+										StartColUTF16: 1,
+										EndColUTF16:   6,
+									},
+								},
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte("\n"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      5,
+										EndPos:        6,
+										StartCol:      6,
+										EndCol:        7,
+										StartColUTF16: 6,
+										EndColUTF16:   7,
+									},
+								},
+							},
+						},
+						Attrs: []ast.Vertex{
+							&ast.Attribute{
+								Position: &position.Position{
+									StartLine:     2,
+									EndLine:       2,
+									StartPos:      8,
+									EndPos:        9,
+									StartCol:      3,
+									EndCol:        4,
+									StartColUTF16: 3,
+									EndColUTF16:   4,
+								},
+								Name: &ast.Name{
+									Position: &position.Position{
+										StartLine:     2,
+										EndLine:       2,
+										StartPos:      8,
+										EndPos:        9,
+										StartCol:      3,
+										EndCol:        4,
+										StartColUTF16: 3,
+										EndColUTF16:   4,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     2,
+												EndLine:       2,
+												StartPos:      8,
+												EndPos:        9,
+												StartCol:      3,
+												EndCol:        4,
+												StartColUTF16: 3,
+												EndColUTF16:   4,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("A"),
+												Position: &position.Position{
+													StartLine:     2,
+													EndLine:       2,
+													StartPos:      8,
+													EndPos:        9,
+													StartCol:      3,
+													EndCol:        4,
+													StartColUTF16: 3,
+													EndColUTF16:   4,
+												},
+											},
+											Value: []byte("A"),
+										},
+									},
+								},
+							},
+							&ast.Attribute{
+								Position: &position.Position{
+									StartLine:     2,
+									EndLine:       2,
+									StartPos:      11,
+									EndPos:        26,
+									StartCol:      6,
+									EndCol:        21,
+									StartColUTF16: 6,
+									EndColUTF16:   21,
+								},
+								Name: &ast.Name{
+									Position: &position.Position{
+										StartLine:     2,
+										EndLine:       2,
+										StartPos:      11,
+										EndPos:        12,
+										StartCol:      6,
+										EndCol:        7,
+										StartColUTF16: 6,
+										EndColUTF16:   7,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     2,
+												EndLine:       2,
+												StartPos:      11,
+												EndPos:        12,
+												StartCol:      6,
+												EndCol:        7,
+												StartColUTF16: 6,
+												EndColUTF16:   7,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("B"),
+												Position: &position.Position{
+													StartLine:     2,
+													EndLine:       2,
+													StartPos:      11,
+													EndPos:        12,
+													StartCol:      6,
+													EndCol:        7,
+													StartColUTF16: 6,
+													EndColUTF16:   7,
+												},
+												FreeFloating: []*token.Token{
+													{
+														ID:    token.T_WHITESPACE,
+														Value: []byte(" "),
+														Position: &position.Position{
+															StartLine:     2,
+															EndLine:       2,
+															StartPos:      10,
+															EndPos:        11,
+															StartCol:      5,
+															EndCol:        6,
+															StartColUTF16: 5,
+															EndColUTF16:   6,
+														},
+													},
+												},
+											},
+											Value: []byte("B"),
+										},
+									},
+								},
+								OpenParenthesisTkn: &token.Token{
+									ID:    token.ID(40),
+									Value: []byte("("),
+									Position: &position.Position{
+										StartLine:     2,
+										EndLine:       2,
+										StartPos:      12,
+										EndPos:        13,
+										StartCol:      7,
+										EndCol:        8,
+										StartColUTF16: 7,
+										EndColUTF16:   8,
+									},
+								},
+								Args: []ast.Vertex{
+									&ast.Argument{
+										Position: &position.Position{
+											StartLine:     2,
+											EndLine:       2,
+											StartPos:      13,
+											EndPos:        14,
+											StartCol:      8,
+											EndCol:        9,
+											StartColUTF16: 8,
+											EndColUTF16:   9,
+										},
+										Expr: &ast.ScalarLnumber{
+											Position: &position.Position{
+												StartLine:     2,
+												EndLine:       2,
+												StartPos:      13,
+												EndPos:        14,
+												StartCol:      8,
+												EndCol:        9,
+												StartColUTF16: 8,
+												EndColUTF16:   9,
+											},
+											NumberTkn: &token.Token{
+												ID:    token.T_LNUMBER,
+												Value: []byte("1"),
+												Position: &position.Position{
+													StartLine:     2,
+													EndLine:       2,
+													StartPos:      13,
+													EndPos:        14,
+													StartCol:      8,
+													EndCol:        9,
+													StartColUTF16: 8,
+													EndColUTF16:   9,
+												},
+											},
+											Value: []byte("1"),
+										},
+									},
+									&ast.Argument{
+										Position: &position.Position{
+											StartLine:     2,
+											EndLine:       2,
+											StartPos:      16,
+											EndPos:        25,
+											StartCol:      11,
+											EndCol:        20,
+											StartColUTF16: 11,
+											EndColUTF16:   20,
+										},
+										Name: &ast.Identifier{
+											Position: &position.Position{
+												StartLine:     2,
+												EndLine:       2,
+												StartPos:      16,
+												EndPos:        20,
+												StartCol:      11,
+												EndCol:        15,
+												StartColUTF16: 11,
+												EndColUTF16:   15,
+											},
+											IdentifierTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("name"),
+												Position: &position.Position{
+													StartLine:     2,
+													EndLine:       2,
+													StartPos:      16,
+													EndPos:        20,
+													StartCol:      11,
+													EndCol:        15,
+													StartColUTF16: 11,
+													EndColUTF16:   15,
+												},
+												FreeFloating: []*token.Token{
+													{
+														ID:    token.T_WHITESPACE,
+														Value: []byte(" "),
+														Position: &position.Position{
+															StartLine:     2,
+															EndLine:       2,
+															StartPos:      15,
+															EndPos:        16,
+															StartCol:      10,
+															EndCol:        11,
+															StartColUTF16: 10,
+															EndColUTF16:   11,
+														},
+													},
+												},
+											},
+											Value: []byte("name"),
+										},
+										ColonTkn: &token.Token{
+											ID:    token.ID(58),
+											Value: []byte(":"),
+											Position: &position.Position{
+												StartLine:     2,
+												EndLine:       2,
+												StartPos:      20,
+												EndPos:        21,
+												StartCol:      15,
+												EndCol:        16,
+												StartColUTF16: 15,
+												EndColUTF16:   16,
+											},
+										},
+										Expr: &ast.ScalarString{
+											Position: &position.Position{
+												StartLine:     2,
+												EndLine:       2,
+												StartPos:      22,
+												EndPos:        25,
+												StartCol:      17,
+												EndCol:        20,
+												StartColUTF16: 17,
+												EndColUTF16:   20,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_CONSTANT_ENCAPSED_STRING,
+												Value: []byte("'x'"),
+												Position: &position.Position{
+													StartLine:     2,
+													EndLine:       2,
+													StartPos:      22,
+													EndPos:        25,
+													StartCol:      17,
+													EndCol:        20,
+													StartColUTF16: 17,
+													EndColUTF16:   20,
+												},
+												FreeFloating: []*token.Token{
+													{
+														ID:    token.T_WHITESPACE,
+														Value: []byte(" "),
+														Position: &position.Position{
+															StartLine:     2,
+															EndLine:       2,
+															StartPos:      21,
+															EndPos:        22,
+															StartCol:      16,
+															EndCol:        17,
+															StartColUTF16: 16,
+															EndColUTF16:   17,
+														},
+													},
+												},
+											},
+											Value: []byte("'x'"),
+										},
+									},
+								},
+								SeparatorTkns: []*token.Token{
+									{
+										ID:    token.ID(44),
+										Value: []byte(","),
+										Position: &position.Position{
+											StartLine:     2,
+											EndLine:       2,
+											StartPos:      14,
+											EndPos:        15,
+											StartCol:      9,
+											EndCol:        10,
+											StartColUTF16: 9,
+											EndColUTF16:   10,
+										},
+									},
+								},
+								CloseParenthesisTkn: &token.Token{
+									ID:    token.ID(41),
+									Value: []byte(")"),
+									Position: &position.Position{
+										StartLine:     2,
+										EndLine:       2,
+										StartPos:      25,
+										EndPos:        26,
+										StartCol:      20,
+										EndCol:        21,
+										StartColUTF16: 20,
+										EndColUTF16:   21,
+									},
+								},
+							},
+						},
+						SeparatorTkns: []*token.Token{
+							{
+								ID:    token.ID(44),
+								Value: []byte(","),
+								Position: &position.Position{
+									StartLine:     2,
+									EndLine:       2,
+									StartPos:      9,
+									EndPos:        10,
+									StartCol:      4,
+									EndCol:        5,
+									StartColUTF16: 4,
+									EndColUTF16:   5,
+								},
+							},
+							{
+								ID:    token.ID(44),
+								Value: []byte(","),
+								Position: &position.Position{
+									StartLine:     2,
+									EndLine:       2,
+									StartPos:      26,
+									EndPos:        27,
+									StartCol:      21,
+									EndCol:        22,
+									StartColUTF16: 21,
+									EndColUTF16:   22,
+								},
+							},
+						},
+						CloseAttributeTkn: &token.Token{
+							ID:    token.ID(93),
+							Value: []byte("]"),
+							Position: &position.Position{
+								StartLine:     2,
+								EndLine:       2,
+								StartPos:      27,
+								EndPos:        28,
+								StartCol:      22,
+								EndCol:        23,
+								StartColUTF16: 22,
+								EndColUTF16:   23,
+							},
+						},
+					},
+					&ast.AttributeGroup{
+						Position: &position.Position{
+							StartLine:     3,
+							EndLine:       3,
+							StartPos:      29,
+							EndPos:        36,
+							StartCol:      1,
+							EndCol:        8,
+							StartColUTF16: 1,
+							EndColUTF16:   8,
+						},
+						OpenAttributeTkn: &token.Token{
+							ID:    token.T_ATTRIBUTE,
+							Value: []byte("#["),
+							Position: &position.Position{
+								StartLine:     3,
+								EndLine:       3,
+								StartPos:      29,
+								EndPos:        31,
+								StartCol:      1,
+								EndCol:        3,
+								StartColUTF16: 1,
+								EndColUTF16:   3,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte("\n"),
+									Position: &position.Position{
+										StartLine:     2,
+										EndLine:       2,
+										StartPos:      28,
+										EndPos:        29,
+										StartCol:      23,
+										EndCol:        24,
+										StartColUTF16: 23,
+										EndColUTF16:   24,
+									},
+								},
+							},
+						},
+						Attrs: []ast.Vertex{
+							&ast.Attribute{
+								Position: &position.Position{
+									StartLine:     3,
+									EndLine:       3,
+									StartPos:      31,
+									EndPos:        35,
+									StartCol:      3,
+									EndCol:        7,
+									StartColUTF16: 3,
+									EndColUTF16:   7,
+								},
+								Name: &ast.NameFullyQualified{
+									Position: &position.Position{
+										StartLine:     3,
+										EndLine:       3,
+										StartPos:      31,
+										EndPos:        35,
+										StartCol:      3,
+										EndCol:        7,
+										StartColUTF16: 3,
+										EndColUTF16:   7,
+									},
+									NsSeparatorTkn: &token.Token{
+										ID:    token.T_NS_SEPARATOR,
+										Value: []byte("\\"),
+										Position: &position.Position{
+											StartLine:     3,
+											EndLine:       3,
+											StartPos:      31,
+											EndPos:        32,
+											StartCol:      3,
+											EndCol:        4,
+											StartColUTF16: 3,
+											EndColUTF16:   4,
+										},
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     3,
+												EndLine:       3,
+												StartPos:      32,
+												EndPos:        33,
+												StartCol:      4,
+												EndCol:        5,
+												StartColUTF16: 4,
+												EndColUTF16:   5,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("C"),
+												Position: &position.Position{
+													StartLine:     3,
+													EndLine:       3,
+													StartPos:      32,
+													EndPos:        33,
+													StartCol:      4,
+													EndCol:        5,
+													StartColUTF16: 4,
+													EndColUTF16:   5,
+												},
+											},
+											Value: []byte("C"),
+										},
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     3,
+												EndLine:       3,
+												StartPos:      34,
+												EndPos:        35,
+												StartCol:      6,
+												EndCol:        7,
+												StartColUTF16: 6,
+												EndColUTF16:   7,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("D"),
+												Position: &position.Position{
+													StartLine:     3,
+													EndLine:       3,
+													StartPos:      34,
+													EndPos:        35,
+													StartCol:      6,
+													EndCol:        7,
+													StartColUTF16: 6,
+													EndColUTF16:   7,
+												},
+											},
+											Value: []byte("D"),
+										},
+									},
+									SeparatorTkns: []*token.Token{
+										{
+											ID:    token.T_NS_SEPARATOR,
+											Value: []byte("\\"),
+											Position: &position.Position{
+												StartLine:     3,
+												EndLine:       3,
+												StartPos:      33,
+												EndPos:        34,
+												StartCol:      5,
+												EndCol:        6,
+												StartColUTF16: 5,
+												EndColUTF16:   6,
+											},
+										},
+									},
+								},
+							},
+						},
+						CloseAttributeTkn: &token.Token{
+							ID:    token.ID(93),
+							Value: []byte("]"),
+							Position: &position.Position{
+								StartLine:     3,
+								EndLine:       3,
+								StartPos:      35,
+								EndPos:        36,
+								StartCol:      7,
+								EndCol:        8,
+								StartColUTF16: 7,
+								EndColUTF16:   8,
+							},
+						},
+					},
+				},
+				Modifiers: []ast.Vertex{
+					&ast.Identifier{
+						Position: &position.Position{
+							StartLine:     4,
+							EndLine:       4,
+							StartPos:      37,
+							EndPos:        42,
+							StartCol:      1,
+							EndCol:        6,
+							StartColUTF16: 1,
+							EndColUTF16:   6,
+						},
+						IdentifierTkn: &token.Token{
+							ID:    token.T_FINAL,
+							Value: []byte("final"),
+							Position: &position.Position{
+								StartLine:     4,
+								EndLine:       4,
+								StartPos:      37,
+								EndPos:        42,
+								StartCol:      1,
+								EndCol:        6,
+								StartColUTF16: 1,
+								EndColUTF16:   6,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte("\n"),
+									Position: &position.Position{
+										StartLine:     3,
+										EndLine:       3,
+										StartPos:      36,
+										EndPos:        37,
+										StartCol:      8,
+										EndCol:        9,
+										StartColUTF16: 8,
+										EndColUTF16:   9,
+									},
+								},
+							},
+						},
+						Value: []byte("final"),
+					},
+				},
+				ClassTkn: &token.Token{
+					ID:    token.T_CLASS,
+					Value: []byte("class"),
+					Position: &position.Position{
+						StartLine:     4,
+						EndLine:       4,
+						StartPos:      43,
+						EndPos:        48,
+						StartCol:      7,
+						EndCol:        12,
+						StartColUTF16: 7,
+						EndColUTF16:   12,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     4,
+								EndLine:       4,
+								StartPos:      42,
+								EndPos:        43,
+								StartCol:      6,
+								EndCol:        7,
+								StartColUTF16: 6,
+								EndColUTF16:   7,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     4,
+						EndLine:       4,
+						StartPos:      49,
+						EndPos:        52,
+						StartCol:      13,
+						EndCol:        16,
+						StartColUTF16: 13,
+						EndColUTF16:   16,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("Foo"),
+						Position: &position.Position{
+							StartLine:     4,
+							EndLine:       4,
+							StartPos:      49,
+							EndPos:        52,
+							StartCol:      13,
+							EndCol:        16,
+							StartColUTF16: 13,
+							EndColUTF16:   16,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     4,
+									EndLine:       4,
+									StartPos:      48,
+									EndPos:        49,
+									StartCol:      12,
+									EndCol:        13,
+									StartColUTF16: 12,
+									EndColUTF16:   13,
+								},
+							},
+						},
+					},
+					Value: []byte("Foo"),
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     4,
+						EndLine:       4,
+						StartPos:      53,
+						EndPos:        54,
+						StartCol:      17,
+						EndCol:        18,
+						StartColUTF16: 17,
+						EndColUTF16:   18,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     4,
+								EndLine:       4,
+								StartPos:      52,
+								EndPos:        53,
+								StartCol:      16,
+								EndCol:        17,
+								StartColUTF16: 16,
+								EndColUTF16:   17,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{
+					&ast.StmtPropertyList{
+						Position: &position.Position{
+							StartLine:     5,
+							EndLine:       5,
+							StartPos:      59,
+							EndPos:        78,
+							StartCol:      5,
+							EndCol:        24,
+							StartColUTF16: 5,
+							EndColUTF16:   24,
+						},
+						AttrGroups: []ast.Vertex{
+							&ast.AttributeGroup{
+								Position: &position.Position{
+									StartLine:     5,
+									EndLine:       5,
+									StartPos:      59,
+									EndPos:        63,
+									StartCol:      5,
+									EndCol:        9,
+									StartColUTF16: 5,
+									EndColUTF16:   9,
+								},
+								OpenAttributeTkn: &token.Token{
+									ID:    token.T_ATTRIBUTE,
+									Value: []byte("#["),
+									Position: &position.Position{
+										StartLine:     5,
+										EndLine:       5,
+										StartPos:      59,
+										EndPos:        61,
+										StartCol:      5,
+										EndCol:        7,
+										StartColUTF16: 5,
+										EndColUTF16:   7,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte("\n    "),
+											Position: &position.Position{
+												StartLine:     4,
+												EndLine:       5,
+												StartPos:      54,
+												EndPos:        59,
+												StartCol:      18,
+												EndCol:        5,
+												StartColUTF16: 18,
+												EndColUTF16:   5,
+											},
+										},
+									},
+								},
+								Attrs: []ast.Vertex{
+									&ast.Attribute{
+										Position: &position.Position{
+											StartLine:     5,
+											EndLine:       5,
+											StartPos:      61,
+											EndPos:        62,
+											StartCol:      7,
+											EndCol:        8,
+											StartColUTF16: 7,
+											EndColUTF16:   8,
+										},
+										Name: &ast.Name{
+											Position: &position.Position{
+												StartLine:     5,
+												EndLine:       5,
+												StartPos:      61,
+												EndPos:        62,
+												StartCol:      7,
+												EndCol:        8,
+												StartColUTF16: 7,
+												EndColUTF16:   8,
+											},
+											Parts: []ast.Vertex{
+												&ast.NamePart{
+													Position: &position.Position{
+														StartLine:     5,
+														EndLine:       5,
+														StartPos:      61,
+														EndPos:        62,
+														StartCol:      7,
+														EndCol:        8,
+														StartColUTF16: 7,
+														EndColUTF16:   8,
+													},
+													StringTkn: &token.Token{
+														ID:    token.T_STRING,
+														Value: []byte("E"),
+														Position: &position.Position{
+															StartLine:     5,
+															EndLine:       5,
+															StartPos:      61,
+															EndPos:        62,
+															StartCol:      7,
+															EndCol:        8,
+															StartColUTF16: 7,
+															EndColUTF16:   8,
+														},
+													},
+													Value: []byte("E"),
+												},
+											},
+										},
+									},
+								},
+								CloseAttributeTkn: &token.Token{
+									ID:    token.ID(93),
+									Value: []byte("]"),
+									Position: &position.Position{
+										StartLine:     5,
+										EndLine:       5,
+										StartPos:      62,
+										EndPos:        63,
+										StartCol:      8,
+										EndCol:        9,
+										StartColUTF16: 8,
+										EndColUTF16:   9,
+									},
+								},
+							},
+						},
+						Modifiers: []ast.Vertex{
+							&ast.Identifier{
+								Position: &position.Position{
+									StartLine:     5,
+									EndLine:       5,
+									StartPos:      64,
+									EndPos:        70,
+									StartCol:      10,
+									EndCol:        16,
+									StartColUTF16: 10,
+									EndColUTF16:   16,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_PUBLIC,
+									Value: []byte("public"),
+									Position: &position.Position{
+										StartLine:     5,
+										EndLine:       5,
+										StartPos:      64,
+										EndPos:        70,
+										StartCol:      10,
+										EndCol:        16,
+										StartColUTF16: 10,
+										EndColUTF16:   16,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     5,
+												EndLine:       5,
+												StartPos:      63,
+												EndPos:        64,
+												StartCol:      9,
+												EndCol:        10,
+												StartColUTF16: 9,
+												EndColUTF16:   10,
+											},
+										},
+									},
+								},
+								Value: []byte("public"),
+							},
+						},
+						Type: &ast.Name{
+							Position: &position.Position{
+								StartLine:     5,
+								EndLine:       5,
+								StartPos:      71,
+								EndPos:        74,
+								StartCol:      17,
+								EndCol:        20,
+								StartColUTF16: 17,
+								EndColUTF16:   20,
+							},
+							Parts: []ast.Vertex{
+								&ast.NamePart{
+									Position: &position.Position{
+										StartLine:     5,
+										EndLine:       5,
+										StartPos:      71,
+										EndPos:        74,
+										StartCol:      17,
+										EndCol:        20,
+										StartColUTF16: 17,
+										EndColUTF16:   20,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("int"),
+										Position: &position.Position{
+											StartLine:     5,
+											EndLine:       5,
+											StartPos:      71,
+											EndPos:        74,
+											StartCol:      17,
+											EndCol:        20,
+											StartColUTF16: 17,
+											EndColUTF16:   20,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     5,
+													EndLine:       5,
+													StartPos:      70,
+													EndPos:        71,
+													StartCol:      16,
+													EndCol:        17,
+													StartColUTF16: 16,
+													EndColUTF16:   17,
+												},
+											},
+										},
+									},
+									Value: []byte("int"),
+								},
+							},
+						},
+						Props: []ast.Vertex{
+							&ast.StmtProperty{
+								Position: &position.Position{
+									StartLine:     5,
+									EndLine:       5,
+									StartPos:      75,
+									EndPos:        77,
+									StartCol:      21,
+									EndCol:        23,
+									StartColUTF16: 21,
+									EndColUTF16:   23,
+								},
+								Var: &ast.ExprVariable{
+									Position: &position.Position{
+										StartLine:     5,
+										EndLine:       5,
+										StartPos:      75,
+										EndPos:        77,
+										StartCol:      21,
+										EndCol:        23,
+										StartColUTF16: 21,
+										EndColUTF16:   23,
+									},
+									Name: &ast.Identifier{
+										Position: &position.Position{
+											StartLine:     5,
+											EndLine:       5,
+											StartPos:      75,
+											EndPos:        77,
+											StartCol:      21,
+											EndCol:        23,
+											StartColUTF16: 21,
+											EndColUTF16:   23,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_VARIABLE,
+											Value: []byte("$a"),
+											Position: &position.Position{
+												StartLine:     5,
+												EndLine:       5,
+												StartPos:      75,
+												EndPos:        77,
+												StartCol:      21,
+												EndCol:        23,
+												StartColUTF16: 21,
+												EndColUTF16:   23,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     5,
+														EndLine:       5,
+														StartPos:      74,
+														EndPos:        75,
+														StartCol:      20,
+														EndCol:        21,
+														StartColUTF16: 20,
+														EndColUTF16:   21,
+													},
+												},
+											},
+										},
+										Value: []byte("$a"),
+									},
+								},
+							},
+						},
+						SemiColonTkn: &token.Token{
+							ID:    token.ID(59),
+							Value: []byte(";"),
+							Position: &position.Position{
+								StartLine:     5,
+								EndLine:       5,
+								StartPos:      77,
+								EndPos:        78,
+								StartCol:      23,
+								EndCol:        24,
+								StartColUTF16: 23,
+								EndColUTF16:   24,
+							},
+						},
+					},
+					&ast.StmtClassConstList{
+						Position: &position.Position{
+							StartLine:     6,
+							EndLine:       6,
+							StartPos:      83,
+							EndPos:        100,
+							StartCol:      5,
+							EndCol:        22,
+							StartColUTF16: 5,
+							EndColUTF16:   22,
+						},
+						AttrGroups: []ast.Vertex{
+							&ast.AttributeGroup{
+								Position: &position.Position{
+									StartLine:     6,
+									EndLine:       6,
+									StartPos:      83,
+									EndPos:        87,
+									StartCol:      5,
+									EndCol:        9,
+									StartColUTF16: 5,
+									EndColUTF16:   9,
+								},
+								OpenAttributeTkn: &token.Token{
+									ID:    token.T_ATTRIBUTE,
+									Value: []byte("#["),
+									Position: &position.Position{
+										StartLine:     6,
+										EndLine:       6,
+										StartPos:      83,
+										EndPos:        85,
+										StartCol:      5,
+										EndCol:        7,
+										StartColUTF16: 5,
+										EndColUTF16:   7,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte("\n    "),
+											Position: &position.Position{
+												StartLine:     5,
+												EndLine:       6,
+												StartPos:      78,
+												EndPos:        83,
+												StartCol:      24,
+												EndCol:        5,
+												StartColUTF16: 24,
+												EndColUTF16:   5,
+											},
+										},
+									},
+								},
+								Attrs: []ast.Vertex{
+									&ast.Attribute{
+										Position: &position.Position{
+											StartLine:     6,
+											EndLine:       6,
+											StartPos:      85,
+											EndPos:        86,
+											StartCol:      7,
+											EndCol:        8,
+											StartColUTF16: 7,
+											EndColUTF16:   8,
+										},
+										Name: &ast.Name{
+											Position: &position.Position{
+												StartLine:     6,
+												EndLine:       6,
+												StartPos:      85,
+												EndPos:        86,
+												StartCol:      7,
+												EndCol:        8,
+												StartColUTF16: 7,
+												EndColUTF16:   8,
+											},
+											Parts: []ast.Vertex{
+												&ast.NamePart{
+													Position: &position.Position{
+														StartLine:     6,
+														EndLine:       6,
+														StartPos:      85,
+														EndPos:        86,
+														StartCol:      7,
+														EndCol:        8,
+														StartColUTF16: 7,
+														EndColUTF16:   8,
+													},
+													StringTkn: &token.Token{
+														ID:    token.T_STRING,
+														Value: []byte("F"),
+														Position: &position.Position{
+															StartLine:     6,
+															EndLine:       6,
+															StartPos:      85,
+															EndPos:        86,
+															StartCol:      7,
+															EndCol:        8,
+															StartColUTF16: 7,
+															EndColUTF16:   8,
+														},
+													},
+													Value: []byte("F"),
+												},
+											},
+										},
+									},
+								},
+								CloseAttributeTkn: &token.Token{
+									ID:    token.ID(93),
+									Value: []byte("]"),
+									Position: &position.Position{
+										StartLine:     6,
+										EndLine:       6,
+										StartPos:      86,
+										EndPos:        87,
+										StartCol:      8,
+										EndCol:        9,
+										StartColUTF16: 8,
+										EndColUTF16:   9,
+									},
+								},
+							},
+						},
+						ConstTkn: &token.Token{
+							ID:    token.T_CONST,
+							Value: []byte("const"),
+							Position: &position.Position{
+								StartLine:     6,
+								EndLine:       6,
+								StartPos:      88,
+								EndPos:        93,
+								StartCol:      10,
+								EndCol:        15,
+								StartColUTF16: 10,
+								EndColUTF16:   15,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     6,
+										EndLine:       6,
+										StartPos:      87,
+										EndPos:        88,
+										StartCol:      9,
+										EndCol:        10,
+										StartColUTF16: 9,
+										EndColUTF16:   10,
+									},
+								},
+							},
+						},
+						Consts: []ast.Vertex{
+							&ast.StmtConstant{
+								Position: &position.Position{
+									StartLine:     6,
+									EndLine:       6,
+									StartPos:      94,
+									EndPos:        99,
+									StartCol:      16,
+									EndCol:        21,
+									StartColUTF16: 16,
+									EndColUTF16:   21,
+								},
+								Name: &ast.Identifier{
+									Position: &position.Position{
+										StartLine:     6,
+										EndLine:       6,
+										StartPos:      94,
+										EndPos:        95,
+										StartCol:      16,
+										EndCol:        17,
+										StartColUTF16: 16,
+										EndColUTF16:   17,
+									},
+									IdentifierTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("X"),
+										Position: &position.Position{
+											StartLine:     6,
+											EndLine:       6,
+											StartPos:      94,
+											EndPos:        95,
+											StartCol:      16,
+											EndCol:        17,
+											StartColUTF16: 16,
+											EndColUTF16:   17,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     6,
+													EndLine:       6,
+													StartPos:      93,
+													EndPos:        94,
+													StartCol:      15,
+													EndCol:        16,
+													StartColUTF16: 15,
+													EndColUTF16:   16,
+												},
+											},
+										},
+									},
+									Value: []byte("X"),
+								},
+								EqualTkn: &token.Token{
+									ID:    token.ID(61),
+									Value: []byte("="),
+									Position: &position.Position{
+										StartLine:     6,
+										EndLine:       6,
+										StartPos:      96,
+										EndPos:        97,
+										StartCol:      18,
+										EndCol:        19,
+										StartColUTF16: 18,
+										EndColUTF16:   19,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     6,
+												EndLine:       6,
+												StartPos:      95,
+												EndPos:        96,
+												StartCol:      17,
+												EndCol:        18,
+												StartColUTF16: 17,
+												EndColUTF16:   18,
+											},
+										},
+									},
+								},
+								Expr: &ast.ScalarLnumber{
+									Position: &position.Position{
+										StartLine:     6,
+										EndLine:       6,
+										StartPos:      98,
+										EndPos:        99,
+										StartCol:      20,
+										EndCol:        21,
+										StartColUTF16: 20,
+										EndColUTF16:   21,
+									},
+									NumberTkn: &token.Token{
+										ID:    token.T_LNUMBER,
+										Value: []byte("1"),
+										Position: &position.Position{
+											StartLine:     6,
+											EndLine:       6,
+											StartPos:      98,
+											EndPos:        99,
+											StartCol:      20,
+											EndCol:        21,
+											StartColUTF16: 20,
+											EndColUTF16:   21,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     6,
+													EndLine:       6,
+													StartPos:      97,
+													EndPos:        98,
+													StartCol:      19,
+													EndCol:        20,
+													StartColUTF16: 19,
+													EndColUTF16:   20,
+												},
+											},
+										},
+									},
+									Value: []byte("1"),
+								},
+							},
+						},
+						SemiColonTkn: &token.Token{
+							ID:    token.ID(59),
+							Value: []byte(";"),
+							Position: &position.Position{
+								StartLine:     6,
+								EndLine:       6,
+								StartPos:      99,
+								EndPos:        100,
+								StartCol:      21,
+								EndCol:        22,
+								StartColUTF16: 21,
+								EndColUTF16:   22,
+							},
+						},
+					},
+					&ast.StmtClassMethod{
+						Position: &position.Position{
+							StartLine:     7,
+							EndLine:       7,
+							StartPos:      105,
+							EndPos:        139,
+							StartCol:      5,
+							EndCol:        39,
+							StartColUTF16: 5,
+							EndColUTF16:   39,
+						},
+						AttrGroups: []ast.Vertex{
+							&ast.AttributeGroup{
+								Position: &position.Position{
+									StartLine:     7,
+									EndLine:       7,
+									StartPos:      105,
+									EndPos:        109,
+									StartCol:      5,
+									EndCol:        9,
+									StartColUTF16: 5,
+									EndColUTF16:   9,
+								},
+								OpenAttributeTkn: &token.Token{
+									ID:    token.T_ATTRIBUTE,
+									Value: []byte("#["),
+									Position: &position.Position{
+										StartLine:     7,
+										EndLine:       7,
+										StartPos:      105,
+										EndPos:        107,
+										StartCol:      5,
+										EndCol:        7,
+										StartColUTF16: 5,
+										EndColUTF16:   7,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte("\n    "),
+											Position: &position.Position{
+												StartLine:     6,
+												EndLine:       7,
+												StartPos:      100,
+												EndPos:        105,
+												StartCol:      22,
+												EndCol:        5,
+												StartColUTF16: 22,
+												EndColUTF16:   5,
+											},
+										},
+									},
+								},
+								Attrs: []ast.Vertex{
+									&ast.Attribute{
+										Position: &position.Position{
+											StartLine:     7,
+											EndLine:       7,
+											StartPos:      107,
+											EndPos:        108,
+											StartCol:      7,
+											EndCol:        8,
+											StartColUTF16: 7,
+											EndColUTF16:   8,
+										},
+										Name: &ast.Name{
+											Position: &position.Position{
+												StartLine:     7,
+												EndLine:       7,
+												StartPos:      107,
+												EndPos:        108,
+												StartCol:      7,
+												EndCol:        8,
+												StartColUTF16: 7,
+												EndColUTF16:   8,
+											},
+											Parts: []ast.Vertex{
+												&ast.NamePart{
+													Position: &position.Position{
+														StartLine:     7,
+														EndLine:       7,
+														StartPos:      107,
+														EndPos:        108,
+														StartCol:      7,
+														EndCol:        8,
+														StartColUTF16: 7,
+														EndColUTF16:   8,
+													},
+													StringTkn: &token.Token{
+														ID:    token.T_STRING,
+														Value: []byte("G"),
+														Position: &position.Position{
+															StartLine:     7,
+															EndLine:       7,
+															StartPos:      107,
+															EndPos:        108,
+															StartCol:      7,
+															EndCol:        8,
+															StartColUTF16: 7,
+															EndColUTF16:   8,
+														},
+													},
+													Value: []byte("G"),
+												},
+											},
+										},
+									},
+								},
+								CloseAttributeTkn: &token.Token{
+									ID:    token.ID(93),
+									Value: []byte("]"),
+									Position: &position.Position{
+										StartLine:     7,
+										EndLine:       7,
+										StartPos:      108,
+										EndPos:        109,
+										StartCol:      8,
+										EndCol:        9,
+										StartColUTF16: 8,
+										EndColUTF16:   9,
+									},
+								},
+							},
+						},
+						Modifiers: []ast.Vertex{
+							&ast.Identifier{
+								Position: &position.Position{
+									StartLine:     7,
+									EndLine:       7,
+									StartPos:      110,
+									EndPos:        116,
+									StartCol:      10,
+									EndCol:        16,
+									StartColUTF16: 10,
+									EndColUTF16:   16,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_PUBLIC,
+									Value: []byte("public"),
+									Position: &position.Position{
+										StartLine:     7,
+										EndLine:       7,
+										StartPos:      110,
+										EndPos:        116,
+										StartCol:      10,
+										EndCol:        16,
+										StartColUTF16: 10,
+										EndColUTF16:   16,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     7,
+												EndLine:       7,
+												StartPos:      109,
+												EndPos:        110,
+												StartCol:      9,
+												EndCol:        10,
+												StartColUTF16: 9,
+												EndColUTF16:   10,
+											},
+										},
+									},
+								},
+								Value: []byte("public"),
+							},
+						},
+						FunctionTkn: &token.Token{
+							ID:    token.T_FUNCTION,
+							Value: []byte("function"),
+							Position: &position.Position{
+								StartLine:     7,
+								EndLine:       7,
+								StartPos:      117,
+								EndPos:        125,
+								StartCol:      17,
+								EndCol:        25,
+								StartColUTF16: 17,
+								EndColUTF16:   25,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     7,
+										EndLine:       7,
+										StartPos:      116,
+										EndPos:        117,
+										StartCol:      16,
+										EndCol:        17,
+										StartColUTF16: 16,
+										EndColUTF16:   17,
+									},
+								},
+							},
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     7,
+								EndLine:       7,
+								StartPos:      126,
+								EndPos:        127,
+								StartCol:      26,
+								EndCol:        27,
+								StartColUTF16: 26,
+								EndColUTF16:   27,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_STRING,
+								Value: []byte("m"),
+								Position: &position.Position{
+									StartLine:     7,
+									EndLine:       7,
+									StartPos:      126,
+									EndPos:        127,
+									StartCol:      26,
+									EndCol:        27,
+									StartColUTF16: 26,
+									EndColUTF16:   27,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     7,
+											EndLine:       7,
+											StartPos:      125,
+											EndPos:        126,
+											StartCol:      25,
+											EndCol:        26,
+											StartColUTF16: 25,
+											EndColUTF16:   26,
+										},
+									},
+								},
+							},
+							Value: []byte("m"),
+						},
+						OpenParenthesisTkn: &token.Token{
+							ID:    token.ID(40),
+							Value: []byte("("),
+							Position: &position.Position{
+								StartLine:     7,
+								EndLine:       7,
+								StartPos:      127,
+								EndPos:        128,
+								StartCol:      27,
+								EndCol:        28,
+								StartColUTF16: 27,
+								EndColUTF16:   28,
+							},
+						},
+						Params: []ast.Vertex{
+							&ast.Parameter{
+								Position: &position.Position{
+									StartLine:     7,
+									EndLine:       7,
+									StartPos:      128,
+									EndPos:        135,
+									StartCol:      28,
+									EndCol:        35,
+									StartColUTF16: 28,
+									EndColUTF16:   35,
+								},
+								AttrGroups: []ast.Vertex{
+									&ast.AttributeGroup{
+										Position: &position.Position{
+											StartLine:     7,
+											EndLine:       7,
+											StartPos:      128,
+											EndPos:        132,
+											StartCol:      28,
+											EndCol:        32,
+											StartColUTF16: 28,
+											EndColUTF16:   32,
+										},
+										OpenAttributeTkn: &token.Token{
+											ID:    token.T_ATTRIBUTE,
+											Value: []byte("#["),
+											Position: &position.Position{
+												StartLine:     7,
+												EndLine:       7,
+												StartPos:      128,
+												EndPos:        130,
+												StartCol:      28,
+												EndCol:        30,
+												StartColUTF16: 28,
+												EndColUTF16:   30,
+											},
+											FreeFloating: []*token.Token{},
+										},
+										Attrs: []ast.Vertex{
+											&ast.Attribute{
+												Position: &position.Position{
+													StartLine:     7,
+													EndLine:       7,
+													StartPos:      130,
+													EndPos:        131,
+													StartCol:      30,
+													EndCol:        31,
+													StartColUTF16: 30,
+													EndColUTF16:   31,
+												},
+												Name: &ast.Name{
+													Position: &position.Position{
+														StartLine:     7,
+														EndLine:       7,
+														StartPos:      130,
+														EndPos:        131,
+														StartCol:      30,
+														EndCol:        31,
+														StartColUTF16: 30,
+														EndColUTF16:   31,
+													},
+													Parts: []ast.Vertex{
+														&ast.NamePart{
+															Position: &position.Position{
+																StartLine:     7,
+																EndLine:       7,
+																StartPos:      130,
+																EndPos:        131,
+																StartCol:      30,
+																EndCol:        31,
+																StartColUTF16: 30,
+																EndColUTF16:   31,
+															},
+															StringTkn: &token.Token{
+																ID:    token.T_STRING,
+																Value: []byte("H"),
+																Position: &position.Position{
+																	StartLine:     7,
+																	EndLine:       7,
+																	StartPos:      130,
+																	EndPos:        131,
+																	StartCol:      30,
+																	EndCol:        31,
+																	StartColUTF16: 30,
+																	EndColUTF16:   31,
+																},
+															},
+															Value: []byte("H"),
+														},
+													},
+												},
+											},
+										},
+										CloseAttributeTkn: &token.Token{
+											ID:    token.ID(93),
+											Value: []byte("]"),
+											Position: &position.Position{
+												StartLine:     7,
+												EndLine:       7,
+												StartPos:      131,
+												EndPos:        132,
+												StartCol:      31,
+												EndCol:        32,
+												StartColUTF16: 31,
+												EndColUTF16:   32,
+											},
+										},
+									},
+								},
+								Var: &ast.ExprVariable{
+									Position: &position.Position{
+										StartLine:     7,
+										EndLine:       7,
+										StartPos:      133,
+										EndPos:        135,
+										StartCol:      33,
+										EndCol:        35,
+										StartColUTF16: 33,
+										EndColUTF16:   35,
+									},
+									Name: &ast.Identifier{
+										Position: &position.Position{
+											StartLine:     7,
+											EndLine:       7,
+											StartPos:      133,
+											EndPos:        135,
+											StartCol:      33,
+											EndCol:        35,
+											StartColUTF16: 33,
+											EndColUTF16:   35,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_VARIABLE,
+											Value: []byte("$p"),
+											Position: &position.Position{
+												StartLine:     7,
+												EndLine:       7,
+												StartPos:      133,
+												EndPos:        135,
+												StartCol:      33,
+												EndCol:        35,
+												StartColUTF16: 33,
+												EndColUTF16:   35,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     7,
+														EndLine:       7,
+														StartPos:      132,
+														EndPos:        133,
+														StartCol:      32,
+														EndCol:        33,
+														StartColUTF16: 32,
+														EndColUTF16:   33,
+													},
+												},
+											},
+										},
+										Value: []byte("$p"),
+									},
+								},
+							},
+						},
+						CloseParenthesisTkn: &token.Token{
+							ID:    token.ID(41),
+							Value: []byte(")"),
+							Position: &position.Position{
+								StartLine:     7,
+								EndLine:       7,
+								StartPos:      135,
+								EndPos:        136,
+								StartCol:      35,
+								EndCol:        36,
+								StartColUTF16: 35,
+								EndColUTF16:   36,
+							},
+						},
+						Stmt: &ast.StmtStmtList{
+							Position: &position.Position{
+								StartLine:     7,
+								EndLine:       7,
+								StartPos:      137,
+								EndPos:        139,
+								StartCol:      37,
+								EndCol:        39,
+								StartColUTF16: 37,
+								EndColUTF16:   39,
+							},
+							OpenCurlyBracketTkn: &token.Token{
+								ID:    token.ID(123),
+								Value: []byte("{"),
+								Position: &position.Position{
+									StartLine:     7,
+									EndLine:       7,
+									StartPos:      137,
+									EndPos:        138,
+									StartCol:      37,
+									EndCol:        38,
+									StartColUTF16: 37,
+									EndColUTF16:   38,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     7,
+											EndLine:       7,
+											StartPos:      136,
+											EndPos:        137,
+											StartCol:      36,
+											EndCol:        37,
+											StartColUTF16: 36,
+											EndColUTF16:   37,
+										},
+									},
+								},
+							},
+							Stmts: []ast.Vertex{},
+							CloseCurlyBracketTkn: &token.Token{
+								ID:    token.ID(125),
+								Value: []byte("}"),
+								Position: &position.Position{
+									StartLine:     7,
+									EndLine:       7,
+									StartPos:      138,
+									EndPos:        139,
+									StartCol:      38,
+									EndCol:        39,
+									StartColUTF16: 38,
+									EndColUTF16:   39,
+								},
+							},
+						},
+					},
+				},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     8,
+						EndLine:       8,
+						StartPos:      140,
+						EndPos:        141,
+						StartCol:      1,
+						EndCol:        2,
+						StartColUTF16: 1,
+						EndColUTF16:   2,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte("\n"),
+							Position: &position.Position{
+								StartLine:     7,
+								EndLine:       7,
+								StartPos:      139,
+								EndPos:        140,
+								StartCol:      39,
+								EndCol:        40,
+								StartColUTF16: 39,
+								EndColUTF16:   40,
+							},
+						},
+					},
+				},
+			},
+			&ast.StmtFunction{
+				Position: &position.Position{
+					StartLine:     9,
+					EndLine:       9,
+					StartPos:      142,
+					EndPos:        162,
+					StartCol:      1,
+					EndCol:        21,
+					StartColUTF16: 1,
+					EndColUTF16:   21,
+				},
+				AttrGroups: []ast.Vertex{
+					&ast.AttributeGroup{
+						Position: &position.Position{
+							StartLine:     9,
+							EndLine:       9,
+							StartPos:      142,
+							EndPos:        146,
+							StartCol:      1,
+							EndCol:        5,
+							StartColUTF16: 1,
+							EndColUTF16:   5,
+						},
+						OpenAttributeTkn: &token.Token{
+							ID:    token.T_ATTRIBUTE,
+							Value: []byte("#["),
+							Position: &position.Position{
+								StartLine:     9,
+								EndLine:       9,
+								StartPos:      142,
+								EndPos:        144,
+								StartCol:      1,
+								EndCol:        3,
+								StartColUTF16: 1,
+								EndColUTF16:   3,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte("\n"),
+									Position: &position.Position{
+										StartLine:     8,
+										EndLine:       8,
+										StartPos:      141,
+										EndPos:        142,
+										StartCol:      2,
+										EndCol:        3,
+										StartColUTF16: 2,
+										EndColUTF16:   3,
+									},
+								},
+							},
+						},
+						Attrs: []ast.Vertex{
+							&ast.Attribute{
+								Position: &position.Position{
+									StartLine:     9,
+									EndLine:       9,
+									StartPos:      144,
+									EndPos:        145,
+									StartCol:      3,
+									EndCol:        4,
+									StartColUTF16: 3,
+									EndColUTF16:   4,
+								},
+								Name: &ast.Name{
+									Position: &position.Position{
+										StartLine:     9,
+										EndLine:       9,
+										StartPos:      144,
+										EndPos:        145,
+										StartCol:      3,
+										EndCol:        4,
+										StartColUTF16: 3,
+										EndColUTF16:   4,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     9,
+												EndLine:       9,
+												StartPos:      144,
+												EndPos:        145,
+												StartCol:      3,
+												EndCol:        4,
+												StartColUTF16: 3,
+												EndColUTF16:   4,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("I"),
+												Position: &position.Position{
+													StartLine:     9,
+													EndLine:       9,
+													StartPos:      144,
+													EndPos:        145,
+													StartCol:      3,
+													EndCol:        4,
+													StartColUTF16: 3,
+													EndColUTF16:   4,
+												},
+											},
+											Value: []byte("I"),
+										},
+									},
+								},
+							},
+						},
+						CloseAttributeTkn: &token.Token{
+							ID:    token.ID(93),
+							Value: []byte("]"),
+							Position: &position.Position{
+								StartLine:     9,
+								EndLine:       9,
+								StartPos:      145,
+								EndPos:        146,
+								StartCol:      4,
+								EndCol:        5,
+								StartColUTF16: 4,
+								EndColUTF16:   5,
+							},
+						},
+					},
+				},
+				FunctionTkn: &token.Token{
+					ID:    token.T_FUNCTION,
+					Value: []byte("function"),
+					Position: &position.Position{
+						StartLine:     9,
+						EndLine:       9,
+						StartPos:      147,
+						EndPos:        155,
+						StartCol:      6,
+						EndCol:        14,
+						StartColUTF16: 6,
+						EndColUTF16:   14,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     9,
+								EndLine:       9,
+								StartPos:      146,
+								EndPos:        147,
+								StartCol:      5,
+								EndCol:        6,
+								StartColUTF16: 5,
+								EndColUTF16:   6,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     9,
+						EndLine:       9,
+						StartPos:      156,
+						EndPos:        157,
+						StartCol:      15,
+						EndCol:        16,
+						StartColUTF16: 15,
+						EndColUTF16:   16,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("f"),
+						Position: &position.Position{
+							StartLine:     9,
+							EndLine:       9,
+							StartPos:      156,
+							EndPos:        157,
+							StartCol:      15,
+							EndCol:        16,
+							StartColUTF16: 15,
+							EndColUTF16:   16,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     9,
+									EndLine:       9,
+									StartPos:      155,
+									EndPos:        156,
+									StartCol:      14,
+									EndCol:        15,
+									StartColUTF16: 14,
+									EndColUTF16:   15,
+								},
+							},
+						},
+					},
+					Value: []byte("f"),
+				},
+				OpenParenthesisTkn: &token.Token{
+					ID:    token.ID(40),
+					Value: []byte("("),
+					Position: &position.Position{
+						StartLine:     9,
+						EndLine:       9,
+						StartPos:      157,
+						EndPos:        158,
+						StartCol:      16,
+						EndCol:        17,
+						StartColUTF16: 16,
+						EndColUTF16:   17,
+					},
+				},
+				CloseParenthesisTkn: &token.Token{
+					ID:    token.ID(41),
+					Value: []byte(")"),
+					Position: &position.Position{
+						StartLine:     9,
+						EndLine:       9,
+						StartPos:      158,
+						EndPos:        159,
+						StartCol:      17,
+						EndCol:        18,
+						StartColUTF16: 17,
+						EndColUTF16:   18,
+					},
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     9,
+						EndLine:       9,
+						StartPos:      160,
+						EndPos:        161,
+						StartCol:      19,
+						EndCol:        20,
+						StartColUTF16: 19,
+						EndColUTF16:   20,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     9,
+								EndLine:       9,
+								StartPos:      159,
+								EndPos:        160,
+								StartCol:      18,
+								EndCol:        19,
+								StartColUTF16: 18,
+								EndColUTF16:   19,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     9,
+						EndLine:       9,
+						StartPos:      161,
+						EndPos:        162,
+						StartCol:      20,
+						EndCol:        21,
+						StartColUTF16: 20,
+						EndColUTF16:   21,
+					},
+				},
+			},
+			&ast.StmtInterface{
+				Position: &position.Position{
+					StartLine:     10,
+					EndLine:       10,
+					StartPos:      163,
+					EndPos:        184,
+					StartCol:      1,
+					EndCol:        22,
+					StartColUTF16: 1,
+					EndColUTF16:   22,
+				},
+				AttrGroups: []ast.Vertex{
+					&ast.AttributeGroup{
+						Position: &position.Position{
+							StartLine:     10,
+							EndLine:       10,
+							StartPos:      163,
+							EndPos:        167,
+							StartCol:      1,
+							EndCol:        5,
+							StartColUTF16: 1,
+							EndColUTF16:   5,
+						},
+						OpenAttributeTkn: &token.Token{
+							ID:    token.T_ATTRIBUTE,
+							Value: []byte("#["),
+							Position: &position.Position{
+								StartLine:     10,
+								EndLine:       10,
+								StartPos:      163,
+								EndPos:        165,
+								StartCol:      1,
+								EndCol:        3,
+								StartColUTF16: 1,
+								EndColUTF16:   3,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte("\n"),
+									Position: &position.Position{
+										StartLine:     9,
+										EndLine:       9,
+										StartPos:      162,
+										EndPos:        163,
+										StartCol:      21,
+										EndCol:        22,
+										StartColUTF16: 21,
+										EndColUTF16:   22,
+									},
+								},
+							},
+						},
+						Attrs: []ast.Vertex{
+							&ast.Attribute{
+								Position: &position.Position{
+									StartLine:     10,
+									EndLine:       10,
+									StartPos:      165,
+									EndPos:        166,
+									StartCol:      3,
+									EndCol:        4,
+									StartColUTF16: 3,
+									EndColUTF16:   4,
+								},
+								Name: &ast.Name{
+									Position: &position.Position{
+										StartLine:     10,
+										EndLine:       10,
+										StartPos:      165,
+										EndPos:        166,
+										StartCol:      3,
+										EndCol:        4,
+										StartColUTF16: 3,
+										EndColUTF16:   4,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     10,
+												EndLine:       10,
+												StartPos:      165,
+												EndPos:        166,
+												StartCol:      3,
+												EndCol:        4,
+												StartColUTF16: 3,
+												EndColUTF16:   4,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("J"),
+												Position: &position.Position{
+													StartLine:     10,
+													EndLine:       10,
+													StartPos:      165,
+													EndPos:        166,
+													StartCol:      3,
+													EndCol:        4,
+													StartColUTF16: 3,
+													EndColUTF16:   4,
+												},
+											},
+											Value: []byte("J"),
+										},
+									},
+								},
+							},
+						},
+						CloseAttributeTkn: &token.Token{
+							ID:    token.ID(93),
+							Value: []byte("]"),
+							Position: &position.Position{
+								StartLine:     10,
+								EndLine:       10,
+								StartPos:      166,
+								EndPos:        167,
+								StartCol:      4,
+								EndCol:        5,
+								StartColUTF16: 4,
+								EndColUTF16:   5,
+							},
+						},
+					},
+				},
+				InterfaceTkn: &token.Token{
+					ID:    token.T_INTERFACE,
+					Value: []byte("interface"),
+					Position: &position.Position{
+						StartLine:     10,
+						EndLine:       10,
+						StartPos:      168,
+						EndPos:        177,
+						StartCol:      6,
+						EndCol:        15,
+						StartColUTF16: 6,
+						EndColUTF16:   15,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     10,
+								EndLine:       10,
+								StartPos:      167,
+								EndPos:        168,
+								StartCol:      5,
+								EndCol:        6,
+								StartColUTF16: 5,
+								EndColUTF16:   6,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     10,
+						EndLine:       10,
+						StartPos:      178,
+						EndPos:        181,
+						StartCol:      16,
+						EndCol:        19,
+						StartColUTF16: 16,
+						EndColUTF16:   19,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("Bar"),
+						Position: &position.Position{
+							StartLine:     10,
+							EndLine:       10,
+							StartPos:      178,
+							EndPos:        181,
+							StartCol:      16,
+							EndCol:        19,
+							StartColUTF16: 16,
+							EndColUTF16:   19,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     10,
+									EndLine:       10,
+									StartPos:      177,
+									EndPos:        178,
+									StartCol:      15,
+									EndCol:        16,
+									StartColUTF16: 15,
+									EndColUTF16:   16,
+								},
+							},
+						},
+					},
+					Value: []byte("Bar"),
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     10,
+						EndLine:       10,
+						StartPos:      182,
+						EndPos:        183,
+						StartCol:      20,
+						EndCol:        21,
+						StartColUTF16: 20,
+						EndColUTF16:   21,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     10,
+								EndLine:       10,
+								StartPos:      181,
+								EndPos:        182,
+								StartCol:      19,
+								EndCol:        20,
+								StartColUTF16: 19,
+								EndColUTF16:   20,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     10,
+						EndLine:       10,
+						StartPos:      183,
+						EndPos:        184,
+						StartCol:      21,
+						EndCol:        22,
+						StartColUTF16: 21,
+						EndColUTF16:   22,
+					},
+				},
+			},
+			&ast.StmtTrait{
+				Position: &position.Position{
+					StartLine:     11,
+					EndLine:       11,
+					StartPos:      185,
+					EndPos:        202,
+					StartCol:      1,
+					EndCol:        18,
+					StartColUTF16: 1,
+					EndColUTF16:   18,
+				},
+				AttrGroups: []ast.Vertex{
+					&ast.AttributeGroup{
+						Position: &position.Position{
+							StartLine:     11,
+							EndLine:       11,
+							StartPos:      185,
+							EndPos:        189,
+							StartCol:      1,
+							EndCol:        5,
+							StartColUTF16: 1,
+							EndColUTF16:   5,
+						},
+						OpenAttributeTkn: &token.Token{
+							ID:    token.T_ATTRIBUTE,
+							Value: []byte("#["),
+							Position: &position.Position{
+								StartLine:     11,
+								EndLine:       11,
+								StartPos:      185,
+								EndPos:        187,
+								StartCol:      1,
+								EndCol:        3,
+								StartColUTF16: 1,
+								EndColUTF16:   3,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte("\n"),
+									Position: &position.Position{
+										StartLine:     10,
+										EndLine:       10,
+										StartPos:      184,
+										EndPos:        185,
+										StartCol:      22,
+										EndCol:        23,
+										StartColUTF16: 22,
+										EndColUTF16:   23,
+									},
+								},
+							},
+						},
+						Attrs: []ast.Vertex{
+							&ast.Attribute{
+								Position: &position.Position{
+									StartLine:     11,
+									EndLine:       11,
+									StartPos:      187,
+									EndPos:        188,
+									StartCol:      3,
+									EndCol:        4,
+									StartColUTF16: 3,
+									EndColUTF16:   4,
+								},
+								Name: &ast.Name{
+									Position: &position.Position{
+										StartLine:     11,
+										EndLine:       11,
+										StartPos:      187,
+										EndPos:        188,
+										StartCol:      3,
+										EndCol:        4,
+										StartColUTF16: 3,
+										EndColUTF16:   4,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     11,
+												EndLine:       11,
+												StartPos:      187,
+												EndPos:        188,
+												StartCol:      3,
+												EndCol:        4,
+												StartColUTF16: 3,
+												EndColUTF16:   4,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("K"),
+												Position: &position.Position{
+													StartLine:     11,
+													EndLine:       11,
+													StartPos:      187,
+													EndPos:        188,
+													StartCol:      3,
+													EndCol:        4,
+													StartColUTF16: 3,
+													EndColUTF16:   4,
+												},
+											},
+											Value: []byte("K"),
+										},
+									},
+								},
+							},
+						},
+						CloseAttributeTkn: &token.Token{
+							ID:    token.ID(93),
+							Value: []byte("]"),
+							Position: &position.Position{
+								StartLine:     11,
+								EndLine:       11,
+								StartPos:      188,
+								EndPos:        189,
+								StartCol:      4,
+								EndCol:        5,
+								StartColUTF16: 4,
+								EndColUTF16:   5,
+							},
+						},
+					},
+				},
+				TraitTkn: &token.Token{
+					ID:    token.T_TRAIT,
+					Value: []byte("trait"),
+					Position: &position.Position{
+						StartLine:     11,
+						EndLine:       11,
+						StartPos:      190,
+						EndPos:        195,
+						StartCol:      6,
+						EndCol:        11,
+						StartColUTF16: 6,
+						EndColUTF16:   11,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     11,
+								EndLine:       11,
+								StartPos:      189,
+								EndPos:        190,
+								StartCol:      5,
+								EndCol:        6,
+								StartColUTF16: 5,
+								EndColUTF16:   6,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     11,
+						EndLine:       11,
+						StartPos:      196,
+						EndPos:        199,
+						StartCol:      12,
+						EndCol:        15,
+						StartColUTF16: 12,
+						EndColUTF16:   15,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("Baz"),
+						Position: &position.Position{
+							StartLine:     11,
+							EndLine:       11,
+							StartPos:      196,
+							EndPos:        199,
+							StartCol:      12,
+							EndCol:        15,
+							StartColUTF16: 12,
+							EndColUTF16:   15,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     11,
+									EndLine:       11,
+									StartPos:      195,
+									EndPos:        196,
+									StartCol:      11,
+									EndCol:        12,
+									StartColUTF16: 11,
+									EndColUTF16:   12,
+								},
+							},
+						},
+					},
+					Value: []byte("Baz"),
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     11,
+						EndLine:       11,
+						StartPos:      200,
+						EndPos:        201,
+						StartCol:      16,
+						EndCol:        17,
+						StartColUTF16: 16,
+						EndColUTF16:   17,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     11,
+								EndLine:       11,
+								StartPos:      199,
+								EndPos:        200,
+								StartCol:      15,
+								EndCol:        16,
+								StartColUTF16: 15,
+								EndColUTF16:   16,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     11,
+						EndLine:       11,
+						StartPos:      201,
+						EndPos:        202,
+						StartCol:      17,
+						EndCol:        18,
+						StartColUTF16: 17,
+						EndColUTF16:   18,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{
+			FreeFloating: []*token.Token{
+				{
+					ID:    token.T_WHITESPACE,
+					Value: []byte("\n"),
+					Position: &position.Position{
+						StartLine:     11,
+						EndLine:       11,
+						StartPos:      202,
+						EndPos:        203,
+						StartCol:      18,
+						EndCol:        19,
+						StartColUTF16: 18,
+						EndColUTF16:   19,
+					},
+				},
+			},
+		},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8AttributeExpr(t *testing.T) {
+	src := `<?php #[A] fn() => 1; #[B] static function () {}; new #[C] class {};`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        68,
+			StartCol:      7,
+			EndCol:        69,
+			StartColUTF16: 7,
+			EndColUTF16:   69,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        21,
+					StartCol:      7,
+					EndCol:        22,
+					StartColUTF16: 7,
+					EndColUTF16:   22,
+				},
+				Expr: &ast.ExprArrowFunction{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        20,
+						StartCol:      7,
+						EndCol:        21,
+						StartColUTF16: 7,
+						EndColUTF16:   21,
+					},
+					AttrGroups: []ast.Vertex{
+						&ast.AttributeGroup{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      6,
+								EndPos:        10,
+								StartCol:      7,
+								EndCol:        11,
+								StartColUTF16: 7,
+								EndColUTF16:   11,
+							},
+							OpenAttributeTkn: &token.Token{
+								ID:    token.T_ATTRIBUTE,
+								Value: []byte("#["),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      6,
+									EndPos:        8,
+									StartCol:      7,
+									EndCol:        9,
+									StartColUTF16: 7,
+									EndColUTF16:   9,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_OPEN_TAG,
+										Value: []byte("<?php"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      0,
+											EndPos:        5,
+											StartCol:      1,
+											EndCol:        6,
+											StartColUTF16: 1,
+											EndColUTF16:   6,
+										},
+									},
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      5,
+											EndPos:        6,
+											StartCol:      6,
+											EndCol:        7,
+											StartColUTF16: 6,
+											EndColUTF16:   7,
+										},
+									},
+								},
+							},
+							Attrs: []ast.Vertex{
+								&ast.Attribute{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      8,
+										EndPos:        9,
+										StartCol:      9,
+										EndCol:        10,
+										StartColUTF16: 9,
+										EndColUTF16:   10,
+									},
+									Name: &ast.Name{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      8,
+											EndPos:        9,
+											StartCol:      9,
+											EndCol:        10,
+											StartColUTF16: 9,
+											EndColUTF16:   10,
+										},
+										Parts: []ast.Vertex{
+											&ast.NamePart{
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      8,
+													EndPos:        9,
+													StartCol:      9,
+													EndCol:        10,
+													StartColUTF16: 9,
+													EndColUTF16:   10,
+												},
+												StringTkn: &token.Token{
+													ID:    token.T_STRING,
+													Value: []byte("A"),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      8,
+														EndPos:        9,
+														StartCol:      9,
+														EndCol:        10,
+														StartColUTF16: 9,
+														EndColUTF16:   10,
+													},
+												},
+												Value: []byte("A"),
+											},
+										},
+									},
+								},
+							},
+							CloseAttributeTkn: &token.Token{
+								ID:    token.ID(93),
+								Value: []byte("]"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      9,
+									EndPos:        10,
+									StartCol:      10,
+									EndCol:        11,
+									StartColUTF16: 10,
+									EndColUTF16:   11,
+								},
+							},
+						},
+					},
+					FnTkn: &token.Token{
+						ID:    token.T_FN,
+						Value: []byte("fn"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      11,
+							EndPos:        13,
+							StartCol:      12,
+							EndCol:        14,
+							StartColUTF16: 12,
+							EndColUTF16:   14,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      10,
+									EndPos:        11,
+									StartCol:      11,
+									EndCol:        12,
+									StartColUTF16: 11,
+									EndColUTF16:   12,
+								},
+							},
+						},
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      13,
+							EndPos:        14,
+							StartCol:      14,
+							EndCol:        15,
+							StartColUTF16: 14,
+							EndColUTF16:   15,
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      14,
+							EndPos:        15,
+							StartCol:      15,
+							EndCol:        16,
+							StartColUTF16: 15,
+							EndColUTF16:   16,
+						},
+					},
+					DoubleArrowTkn: &token.Token{
+						ID:    token.T_DOUBLE_ARROW,
+						Value: []byte("=>"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      16,
+							EndPos:        18,
+							StartCol:      17,
+							EndCol:        19,
+							StartColUTF16: 17,
+							EndColUTF16:   19,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      15,
+									EndPos:        16,
+									StartCol:      16,
+									EndCol:        17,
+									StartColUTF16: 16,
+									EndColUTF16:   17,
+								},
+							},
+						},
+					},
+					Expr: &ast.ScalarLnumber{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      19,
+							EndPos:        20,
+							StartCol:      20,
+							EndCol:        21,
+							StartColUTF16: 20,
+							EndColUTF16:   21,
+						},
+						NumberTkn: &token.Token{
+							ID:    token.T_LNUMBER,
+							Value: []byte("1"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      19,
+								EndPos:        20,
+								StartCol:      20,
+								EndCol:        21,
+								StartColUTF16: 20,
+								EndColUTF16:   21,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      18,
+										EndPos:        19,
+										StartCol:      19,
+										EndCol:        20,
+										StartColUTF16: 19,
+										EndColUTF16:   20,
+									},
+								},
+							},
+						},
+						Value: []byte("1"),
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      20,
+						EndPos:        21,
+						StartCol:      21,
+						EndCol:        22,
+						StartColUTF16: 21,
+						EndColUTF16:   22,
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      22,
+					EndPos:        49,
+					StartCol:      23,
+					EndCol:        50,
+					StartColUTF16: 23,
+					EndColUTF16:   50,
+				},
+				Expr: &ast.ExprClosure{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      22,
+						EndPos:        48,
+						StartCol:      23,
+						EndCol:        49,
+						StartColUTF16: 23,
+						EndColUTF16:   49,
+					},
+					AttrGroups: []ast.Vertex{
+						&ast.AttributeGroup{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      22,
+								EndPos:        26,
+								StartCol:      23,
+								EndCol:        27,
+								StartColUTF16: 23,
+								EndColUTF16:   27,
+							},
+							OpenAttributeTkn: &token.Token{
+								ID:    token.T_ATTRIBUTE,
+								Value: []byte("#["),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      22,
+									EndPos:        24,
+									StartCol:      23,
+									EndCol:        25,
+									StartColUTF16: 23,
+									EndColUTF16:   25,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      21,
+											EndPos:        22,
+											StartCol:      22,
+											EndCol:        23,
+											StartColUTF16: 22,
+											EndColUTF16:   23,
+										},
+									},
+								},
+							},
+							Attrs: []ast.Vertex{
+								&ast.Attribute{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      24,
+										EndPos:        25,
+										StartCol:      25,
+										EndCol:        26,
+										StartColUTF16: 25,
+										EndColUTF16:   26,
+									},
+									Name: &ast.Name{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      24,
+											EndPos:        25,
+											StartCol:      25,
+											EndCol:        26,
+											StartColUTF16: 25,
+											EndColUTF16:   26,
+										},
+										Parts: []ast.Vertex{
+											&ast.NamePart{
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      24,
+													EndPos:        25,
+													StartCol:      25,
+													EndCol:        26,
+													StartColUTF16: 25,
+													EndColUTF16:   26,
+												},
+												StringTkn: &token.Token{
+													ID:    token.T_STRING,
+													Value: []byte("B"),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      24,
+														EndPos:        25,
+														StartCol:      25,
+														EndCol:        26,
+														StartColUTF16: 25,
+														EndColUTF16:   26,
+													},
+												},
+												Value: []byte("B"),
+											},
+										},
+									},
+								},
+							},
+							CloseAttributeTkn: &token.Token{
+								ID:    token.ID(93),
+								Value: []byte("]"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      25,
+									EndPos:        26,
+									StartCol:      26,
+									EndCol:        27,
+									StartColUTF16: 26,
+									EndColUTF16:   27,
+								},
+							},
+						},
+					},
+					StaticTkn: &token.Token{
+						ID:    token.T_STATIC,
+						Value: []byte("static"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      27,
+							EndPos:        33,
+							StartCol:      28,
+							EndCol:        34,
+							StartColUTF16: 28,
+							EndColUTF16:   34,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      26,
+									EndPos:        27,
+									StartCol:      27,
+									EndCol:        28,
+									StartColUTF16: 27,
+									EndColUTF16:   28,
+								},
+							},
+						},
+					},
+					FunctionTkn: &token.Token{
+						ID:    token.T_FUNCTION,
+						Value: []byte("function"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      34,
+							EndPos:        42,
+							StartCol:      35,
+							EndCol:        43,
+							StartColUTF16: 35,
+							EndColUTF16:   43,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      33,
+									EndPos:        34,
+									StartCol:      34,
+									EndCol:        35,
+									StartColUTF16: 34,
+									EndColUTF16:   35,
+								},
+							},
+						},
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      43,
+							EndPos:        44,
+							StartCol:      44,
+							EndCol:        45,
+							StartColUTF16: 44,
+							EndColUTF16:   45,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      42,
+									EndPos:        43,
+									StartCol:      43,
+									EndCol:        44,
+									StartColUTF16: 43,
+									EndColUTF16:   44,
+								},
+							},
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      44,
+							EndPos:        45,
+							StartCol:      45,
+							EndCol:        46,
+							StartColUTF16: 45,
+							EndColUTF16:   46,
+						},
+					},
+					OpenCurlyBracketTkn: &token.Token{
+						ID:    token.ID(123),
+						Value: []byte("{"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      46,
+							EndPos:        47,
+							StartCol:      47,
+							EndCol:        48,
+							StartColUTF16: 47,
+							EndColUTF16:   48,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      45,
+									EndPos:        46,
+									StartCol:      46,
+									EndCol:        47,
+									StartColUTF16: 46,
+									EndColUTF16:   47,
+								},
+							},
+						},
+					},
+					Stmts: []ast.Vertex{},
+					CloseCurlyBracketTkn: &token.Token{
+						ID:    token.ID(125),
+						Value: []byte("}"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      47,
+							EndPos:        48,
+							StartCol:      48,
+							EndCol:        49,
+							StartColUTF16: 48,
+							EndColUTF16:   49,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      48,
+						EndPos:        49,
+						StartCol:      49,
+						EndCol:        50,
+						StartColUTF16: 49,
+						EndColUTF16:   50,
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      50,
+					EndPos:        68,
+					StartCol:      51,
+					EndCol:        69,
+					StartColUTF16: 51,
+					EndColUTF16:   69,
+				},
+				Expr: &ast.ExprNew{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      50,
+						EndPos:        67,
+						StartCol:      51,
+						EndCol:        68,
+						StartColUTF16: 51,
+						EndColUTF16:   68,
+					},
+					NewTkn: &token.Token{
+						ID:    token.T_NEW,
+						Value: []byte("new"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      50,
+							EndPos:        53,
+							StartCol:      51,
+							EndCol:        54,
+							StartColUTF16: 51,
+							EndColUTF16:   54,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      49,
+									EndPos:        50,
+									StartCol:      50,
+									EndCol:        51,
+									StartColUTF16: 50,
+									EndColUTF16:   51,
+								},
+							},
+						},
+					},
+					Class: &ast.StmtClass{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      54,
+							EndPos:        67,
+							StartCol:      55,
+							EndCol:        68,
+							StartColUTF16: 55,
+							EndColUTF16:   68,
+						},
+						AttrGroups: []ast.Vertex{
+							&ast.AttributeGroup{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      54,
+									EndPos:        58,
+									StartCol:      55,
+									EndCol:        59,
+									StartColUTF16: 55,
+									EndColUTF16:   59,
+								},
+								OpenAttributeTkn: &token.Token{
+									ID:    token.T_ATTRIBUTE,
+									Value: []byte("#["),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      54,
+										EndPos:        56,
+										StartCol:      55,
+										EndCol:        57,
+										StartColUTF16: 55,
+										EndColUTF16:   57,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      53,
+												EndPos:        54,
+												StartCol:      54,
+												EndCol:        55,
+												StartColUTF16: 54,
+												EndColUTF16:   55,
+											},
+										},
+									},
+								},
+								Attrs: []ast.Vertex{
+									&ast.Attribute{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      56,
+											EndPos:        57,
+											StartCol:      57,
+											EndCol:        58,
+											StartColUTF16: 57,
+											EndColUTF16:   58,
+										},
+										Name: &ast.Name{
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      56,
+												EndPos:        57,
+												StartCol:      57,
+												EndCol:        58,
+												StartColUTF16: 57,
+												EndColUTF16:   58,
+											},
+											Parts: []ast.Vertex{
+												&ast.NamePart{
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      56,
+														EndPos:        57,
+														StartCol:      57,
+														EndCol:        58,
+														StartColUTF16: 57,
+														EndColUTF16:   58,
+													},
+													StringTkn: &token.Token{
+														ID:    token.T_STRING,
+														Value: []byte("C"),
+														Position: &position.Position{
+															StartLine:     1,
+															EndLine:       1,
+															StartPos:      56,
+															EndPos:        57,
+															StartCol:      57,
+															EndCol:        58,
+															StartColUTF16: 57,
+															EndColUTF16:   58,
+														},
+													},
+													Value: []byte("C"),
+												},
+											},
+										},
+									},
+								},
+								CloseAttributeTkn: &token.Token{
+									ID:    token.ID(93),
+									Value: []byte("]"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      57,
+										EndPos:        58,
+										StartCol:      58,
+										EndCol:        59,
+										StartColUTF16: 58,
+										EndColUTF16:   59,
+									},
+								},
+							},
+						},
+						ClassTkn: &token.Token{
+							ID:    token.T_CLASS,
+							Value: []byte("class"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      59,
+								EndPos:        64,
+								StartCol:      60,
+								EndCol:        65,
+								StartColUTF16: 60,
+								EndColUTF16:   65,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      58,
+										EndPos:        59,
+										StartCol:      59,
+										EndCol:        60,
+										StartColUTF16: 59,
+										EndColUTF16:   60,
+									},
+								},
+							},
+						},
+						OpenCurlyBracketTkn: &token.Token{
+							ID:    token.ID(123),
+							Value: []byte("{"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      65,
+								EndPos:        66,
+								StartCol:      66,
+								EndCol:        67,
+								StartColUTF16: 66,
+								EndColUTF16:   67,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      64,
+										EndPos:        65,
+										StartCol:      65,
+										EndCol:        66,
+										StartColUTF16: 65,
+										EndColUTF16:   66,
+									},
+								},
+							},
+						},
+						Stmts: []ast.Vertex{},
+						CloseCurlyBracketTkn: &token.Token{
+							ID:    token.ID(125),
+							Value: []byte("}"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      66,
+								EndPos:        67,
+								StartCol:      67,
+								EndCol:        68,
+								StartColUTF16: 67,
+								EndColUTF16:   68,
+							},
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      67,
+						EndPos:        68,
+						StartCol:      68,
+						EndCol:        69,
+						StartColUTF16: 68,
+						EndColUTF16:   69,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8UnionType(t *testing.T) {
+	src := `<?php function f(int|string $a, ?Foo $b): int|false|null {}`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        59,
+			StartCol:      7,
+			EndCol:        60,
+			StartColUTF16: 7,
+			EndColUTF16:   60,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtFunction{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        59,
+					StartCol:      7,
+					EndCol:        60,
+					StartColUTF16: 7,
+					EndColUTF16:   60,
+				},
+				FunctionTkn: &token.Token{
+					ID:    token.T_FUNCTION,
+					Value: []byte("function"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        14,
+						StartCol:      7,
+						EndCol:        15,
+						StartColUTF16: 7,
+						EndColUTF16:   15,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_OPEN_TAG,
+							Value: []byte("<?php"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      0,
+								EndPos:        5,
+								StartCol:      1,
+								EndCol:        6,
+								StartColUTF16: 1,
+								EndColUTF16:   6,
+							},
+						},
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      5,
+								EndPos:        6,
+								StartCol:      6,
+								EndCol:        7,
+								StartColUTF16: 6,
+								EndColUTF16:   7,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      15,
+						EndPos:        16,
+						StartCol:      16,
+						EndCol:        17,
+						StartColUTF16: 16,
+						EndColUTF16:   17,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("f"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      15,
+							EndPos:        16,
+							StartCol:      16,
+							EndCol:        17,
+							StartColUTF16: 16,
+							EndColUTF16:   17,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      14,
+									EndPos:        15,
+									StartCol:      15,
+									EndCol:        16,
+									StartColUTF16: 15,
+									EndColUTF16:   16,
+								},
+							},
+						},
+					},
+					Value: []byte("f"),
+				},
+				OpenParenthesisTkn: &token.Token{
+					ID:    token.ID(40),
+					Value: []byte("("),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      16,
+						EndPos:        17,
+						StartCol:      17,
+						EndCol:        18,
+						StartColUTF16: 17,
+						EndColUTF16:   18,
+					},
+				},
+				Params: []ast.Vertex{
+					&ast.Parameter{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      17,
+							EndPos:        30,
+							StartCol:      18,
+							EndCol:        31,
+							StartColUTF16: 18,
+							EndColUTF16:   31,
+						},
+						Type: &ast.Union{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      17,
+								EndPos:        27,
+								StartCol:      18,
+								EndCol:        28,
+								StartColUTF16: 18,
+								EndColUTF16:   28,
+							},
+							Types: []ast.Vertex{
+								&ast.Name{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      17,
+										EndPos:        20,
+										StartCol:      18,
+										EndCol:        21,
+										StartColUTF16: 18,
+										EndColUTF16:   21,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      17,
+												EndPos:        20,
+												StartCol:      18,
+												EndCol:        21,
+												StartColUTF16: 18,
+												EndColUTF16:   21,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("int"),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      17,
+													EndPos:        20,
+													StartCol:      18,
+													EndCol:        21,
+													StartColUTF16: 18,
+													EndColUTF16:   21,
+												},
+											},
+											Value: []byte("int"),
+										},
+									},
+								},
+								&ast.Name{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      21,
+										EndPos:        27,
+										StartCol:      22,
+										EndCol:        28,
+										StartColUTF16: 22,
+										EndColUTF16:   28,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      21,
+												EndPos:        27,
+												StartCol:      22,
+												EndCol:        28,
+												StartColUTF16: 22,
+												EndColUTF16:   28,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("string"),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      21,
+													EndPos:        27,
+													StartCol:      22,
+													EndCol:        28,
+													StartColUTF16: 22,
+													EndColUTF16:   28,
+												},
+											},
+											Value: []byte("string"),
+										},
+									},
+								},
+							},
+							SeparatorTkns: []*token.Token{
+								{
+									ID:    token.ID(124),
+									Value: []byte("|"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      20,
+										EndPos:        21,
+										StartCol:      21,
+										EndCol:        22,
+										StartColUTF16: 21,
+										EndColUTF16:   22,
+									},
+								},
+							},
+						},
+						Var: &ast.ExprVariable{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      28,
+								EndPos:        30,
+								StartCol:      29,
+								EndCol:        31,
+								StartColUTF16: 29,
+								EndColUTF16:   31,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      28,
+									EndPos:        30,
+									StartCol:      29,
+									EndCol:        31,
+									StartColUTF16: 29,
+									EndColUTF16:   31,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_VARIABLE,
+									Value: []byte("$a"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      28,
+										EndPos:        30,
+										StartCol:      29,
+										EndCol:        31,
+										StartColUTF16: 29,
+										EndColUTF16:   31,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      27,
+												EndPos:        28,
+												StartCol:      28,
+												EndCol:        29,
+												StartColUTF16: 28,
+												EndColUTF16:   29,
+											},
+										},
+									},
+								},
+								Value: []byte("$a"),
+							},
+						},
+					},
+					&ast.Parameter{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      32,
+							EndPos:        39,
+							StartCol:      33,
+							EndCol:        40,
+							StartColUTF16: 33,
+							EndColUTF16:   40,
+						},
+						Type: &ast.Nullable{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      32,
+								EndPos:        36,
+								StartCol:      33,
+								EndCol:        37,
+								StartColUTF16: 33,
+								EndColUTF16:   37,
+							},
+							QuestionTkn: &token.Token{
+								ID:    token.ID(63),
+								Value: []byte("?"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      32,
+									EndPos:        33,
+									StartCol:      33,
+									EndCol:        34,
+									StartColUTF16: 33,
+									EndColUTF16:   34,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      31,
+											EndPos:        32,
+											StartCol:      32,
+											EndCol:        33,
+											StartColUTF16: 32,
+											EndColUTF16:   33,
+										},
+									},
+								},
+							},
+							Expr: &ast.Name{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      33,
+									EndPos:        36,
+									StartCol:      34,
+									EndCol:        37,
+									StartColUTF16: 34,
+									EndColUTF16:   37,
+								},
+								Parts: []ast.Vertex{
+									&ast.NamePart{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      33,
+											EndPos:        36,
+											StartCol:      34,
+											EndCol:        37,
+											StartColUTF16: 34,
+											EndColUTF16:   37,
+										},
+										StringTkn: &token.Token{
+											ID:    token.T_STRING,
+											Value: []byte("Foo"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      33,
+												EndPos:        36,
+												StartCol:      34,
+												EndCol:        37,
+												StartColUTF16: 34,
+												EndColUTF16:   37,
+											},
+										},
+										Value: []byte("Foo"),
+									},
+								},
+							},
+						},
+						Var: &ast.ExprVariable{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      37,
+								EndPos:        39,
+								StartCol:      38,
+								EndCol:        40,
+								StartColUTF16: 38,
+								EndColUTF16:   40,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      37,
+									EndPos:        39,
+									StartCol:      38,
+									EndCol:        40,
+									StartColUTF16: 38,
+									EndColUTF16:   40,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_VARIABLE,
+									Value: []byte("$b"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      37,
+										EndPos:        39,
+										StartCol:      38,
+										EndCol:        40,
+										StartColUTF16: 38,
+										EndColUTF16:   40,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      36,
+												EndPos:        37,
+												StartCol:      37,
+												EndCol:        38,
+												StartColUTF16: 37,
+												EndColUTF16:   38,
+											},
+										},
+									},
+								},
+								Value: []byte("$b"),
+							},
+						},
+					},
+				},
+				SeparatorTkns: []*token.Token{
+					{
+						ID:    token.ID(44),
+						Value: []byte(","),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      30,
+							EndPos:        31,
+							StartCol:      31,
+							EndCol:        32,
+							StartColUTF16: 31,
+							EndColUTF16:   32,
+						},
+					},
+				},
+				CloseParenthesisTkn: &token.Token{
+					ID:    token.ID(41),
+					Value: []byte(")"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      39,
+						EndPos:        40,
+						StartCol:      40,
+						EndCol:        41,
+						StartColUTF16: 40,
+						EndColUTF16:   41,
+					},
+				},
+				ColonTkn: &token.Token{
+					ID:    token.ID(58),
+					Value: []byte(":"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      40,
+						EndPos:        41,
+						StartCol:      41,
+						EndCol:        42,
+						StartColUTF16: 41,
+						EndColUTF16:   42,
+					},
+				},
+				ReturnType: &ast.Union{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      42,
+						EndPos:        56,
+						StartCol:      43,
+						EndCol:        57,
+						StartColUTF16: 43,
+						EndColUTF16:   57,
+					},
+					Types: []ast.Vertex{
+						&ast.Name{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      42,
+								EndPos:        45,
+								StartCol:      43,
+								EndCol:        46,
+								StartColUTF16: 43,
+								EndColUTF16:   46,
+							},
+							Parts: []ast.Vertex{
+								&ast.NamePart{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      42,
+										EndPos:        45,
+										StartCol:      43,
+										EndCol:        46,
+										StartColUTF16: 43,
+										EndColUTF16:   46,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("int"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      42,
+											EndPos:        45,
+											StartCol:      43,
+											EndCol:        46,
+											StartColUTF16: 43,
+											EndColUTF16:   46,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      41,
+													EndPos:        42,
+													StartCol:      42,
+													EndCol:        43,
+													StartColUTF16: 42,
+													EndColUTF16:   43,
+												},
+											},
+										},
+									},
+									Value: []byte("int"),
+								},
+							},
+						},
+						&ast.Name{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      46,
+								EndPos:        51,
+								StartCol:      47,
+								EndCol:        52,
+								StartColUTF16: 47,
+								EndColUTF16:   52,
+							},
+							Parts: []ast.Vertex{
+								&ast.NamePart{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      46,
+										EndPos:        51,
+										StartCol:      47,
+										EndCol:        52,
+										StartColUTF16: 47,
+										EndColUTF16:   52,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("false"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      46,
+											EndPos:        51,
+											StartCol:      47,
+											EndCol:        52,
+											StartColUTF16: 47,
+											EndColUTF16:   52,
+										},
+									},
+									Value: []byte("false"),
+								},
+							},
+						},
+						&ast.Name{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      52,
+								EndPos:        56,
+								StartCol:      53,
+								EndCol:        57,
+								StartColUTF16: 53,
+								EndColUTF16:   57,
+							},
+							Parts: []ast.Vertex{
+								&ast.NamePart{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      52,
+										EndPos:        56,
+										StartCol:      53,
+										EndCol:        57,
+										StartColUTF16: 53,
+										EndColUTF16:   57,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("null"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      52,
+											EndPos:        56,
+											StartCol:      53,
+											EndCol:        57,
+											StartColUTF16: 53,
+											EndColUTF16:   57,
+										},
+									},
+									Value: []byte("null"),
+								},
+							},
+						},
+					},
+					SeparatorTkns: []*token.Token{
+						{
+							ID:    token.ID(124),
+							Value: []byte("|"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      45,
+								EndPos:        46,
+								StartCol:      46,
+								EndCol:        47,
+								StartColUTF16: 46,
+								EndColUTF16:   47,
+							},
+						},
+						{
+							ID:    token.ID(124),
+							Value: []byte("|"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      51,
+								EndPos:        52,
+								StartCol:      52,
+								EndCol:        53,
+								StartColUTF16: 52,
+								EndColUTF16:   53,
+							},
+						},
+					},
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      57,
+						EndPos:        58,
+						StartCol:      58,
+						EndCol:        59,
+						StartColUTF16: 58,
+						EndColUTF16:   59,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      56,
+								EndPos:        57,
+								StartCol:      57,
+								EndCol:        58,
+								StartColUTF16: 57,
+								EndColUTF16:   58,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      58,
+						EndPos:        59,
+						StartCol:      59,
+						EndCol:        60,
+						StartColUTF16: 59,
+						EndColUTF16:   60,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8StaticMixedReturnType(t *testing.T) {
+	src := `<?php class Foo { public function f(mixed $a): static {} }`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        58,
+			StartCol:      7,
+			EndCol:        59,
+			StartColUTF16: 7,
+			EndColUTF16:   59,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtClass{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        58,
+					StartCol:      7,
+					EndCol:        59,
+					StartColUTF16: 7,
+					EndColUTF16:   59,
+				},
+				ClassTkn: &token.Token{
+					ID:    token.T_CLASS,
+					Value: []byte("class"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        11,
+						StartCol:      7,
+						EndCol:        12,
+						StartColUTF16: 7,
+						EndColUTF16:   12,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_OPEN_TAG,
+							Value: []byte("<?php"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      0,
+								EndPos:        5,
+								StartCol:      1,
+								EndCol:        6,
+								StartColUTF16: 1,
+								EndColUTF16:   6,
+							},
+						},
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      5,
+								EndPos:        6,
+								StartCol:      6,
+								EndCol:        7,
+								StartColUTF16: 6,
+								EndColUTF16:   7,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      12,
+						EndPos:        15,
+						StartCol:      13,
+						EndCol:        16,
+						StartColUTF16: 13,
+						EndColUTF16:   16,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("Foo"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      12,
+							EndPos:        15,
+							StartCol:      13,
+							EndCol:        16,
+							StartColUTF16: 13,
+							EndColUTF16:   16,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      11,
+									EndPos:        12,
+									StartCol:      12,
+									EndCol:        13,
+									StartColUTF16: 12,
+									EndColUTF16:   13,
+								},
+							},
+						},
+					},
+					Value: []byte("Foo"),
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      16,
+						EndPos:        17,
+						StartCol:      17,
+						EndCol:        18,
+						StartColUTF16: 17,
+						EndColUTF16:   18,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      15,
+								EndPos:        16,
+								StartCol:      16,
+								EndCol:        17,
+								StartColUTF16: 16,
+								EndColUTF16:   17,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{
+					&ast.StmtClassMethod{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      18,
+							EndPos:        56,
+							StartCol:      19,
+							EndCol:        57,
+							StartColUTF16: 19,
+							EndColUTF16:   57,
+						},
+						Modifiers: []ast.Vertex{
+							&ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      18,
+									EndPos:        24,
+									StartCol:      19,
+									EndCol:        25,
+									StartColUTF16: 19,
+									EndColUTF16:   25,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_PUBLIC,
+									Value: []byte("public"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      18,
+										EndPos:        24,
+										StartCol:      19,
+										EndCol:        25,
+										StartColUTF16: 19,
+										EndColUTF16:   25,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      17,
+												EndPos:        18,
+												StartCol:      18,
+												EndCol:        19,
+												StartColUTF16: 18,
+												EndColUTF16:   19,
+											},
+										},
+									},
+								},
+								Value: []byte("public"),
+							},
+						},
+						FunctionTkn: &token.Token{
+							ID:    token.T_FUNCTION,
+							Value: []byte("function"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      25,
+								EndPos:        33,
+								StartCol:      26,
+								EndCol:        34,
+								StartColUTF16: 26,
+								EndColUTF16:   34,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      24,
+										EndPos:        25,
+										StartCol:      25,
+										EndCol:        26,
+										StartColUTF16: 25,
+										EndColUTF16:   26,
+									},
+								},
+							},
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      34,
+								EndPos:        35,
+								StartCol:      35,
+								EndCol:        36,
+								StartColUTF16: 35,
+								EndColUTF16:   36,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_STRING,
+								Value: []byte("f"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      34,
+									EndPos:        35,
+									StartCol:      35,
+									EndCol:        36,
+									StartColUTF16: 35,
+									EndColUTF16:   36,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      33,
+											EndPos:        34,
+											StartCol:      34,
+											EndCol:        35,
+											StartColUTF16: 34,
+											EndColUTF16:   35,
+										},
+									},
+								},
+							},
+							Value: []byte("f"),
+						},
+						OpenParenthesisTkn: &token.Token{
+							ID:    token.ID(40),
+							Value: []byte("("),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      35,
+								EndPos:        36,
+								StartCol:      36,
+								EndCol:        37,
+								StartColUTF16: 36,
+								EndColUTF16:   37,
+							},
+						},
+						Params: []ast.Vertex{
+							&ast.Parameter{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      36,
+									EndPos:        44,
+									StartCol:      37,
+									EndCol:        45,
+									StartColUTF16: 37,
+									EndColUTF16:   45,
+								},
+								Type: &ast.Name{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      36,
+										EndPos:        41,
+										StartCol:      37,
+										EndCol:        42,
+										StartColUTF16: 37,
+										EndColUTF16:   42,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      36,
+												EndPos:        41,
+												StartCol:      37,
+												EndCol:        42,
+												StartColUTF16: 37,
+												EndColUTF16:   42,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("mixed"),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      36,
+													EndPos:        41,
+													StartCol:      37,
+													EndCol:        42,
+													StartColUTF16: 37,
+													EndColUTF16:   42,
+												},
+											},
+											Value: []byte("mixed"),
+										},
+									},
+								},
+								Var: &ast.ExprVariable{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      42,
+										EndPos:        44,
+										StartCol:      43,
+										EndCol:        45,
+										StartColUTF16: 43,
+										EndColUTF16:   45,
+									},
+									Name: &ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      42,
+											EndPos:        44,
+											StartCol:      43,
+											EndCol:        45,
+											StartColUTF16: 43,
+											EndColUTF16:   45,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_VARIABLE,
+											Value: []byte("$a"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      42,
+												EndPos:        44,
+												StartCol:      43,
+												EndCol:        45,
+												StartColUTF16: 43,
+												EndColUTF16:   45,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      41,
+														EndPos:        42,
+														StartCol:      42,
+														EndCol:        43,
+														StartColUTF16: 42,
+														EndColUTF16:   43,
+													},
+												},
+											},
+										},
+										Value: []byte("$a"),
+									},
+								},
+							},
+						},
+						CloseParenthesisTkn: &token.Token{
+							ID:    token.ID(41),
+							Value: []byte(")"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      44,
+								EndPos:        45,
+								StartCol:      45,
+								EndCol:        46,
+								StartColUTF16: 45,
+								EndColUTF16:   46,
+							},
+						},
+						ColonTkn: &token.Token{
+							ID:    token.ID(58),
+							Value: []byte(":"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      45,
+								EndPos:        46,
+								StartCol:      46,
+								EndCol:        47,
+								StartColUTF16: 46,
+								EndColUTF16:   47,
+							},
+						},
+						ReturnType: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      47,
+								EndPos:        53,
+								StartCol:      48,
+								EndCol:        54,
+								StartColUTF16: 48,
+								EndColUTF16:   54,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_STATIC,
+								Value: []byte("static"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      47,
+									EndPos:        53,
+									StartCol:      48,
+									EndCol:        54,
+									StartColUTF16: 48,
+									EndColUTF16:   54,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      46,
+											EndPos:        47,
+											StartCol:      47,
+											EndCol:        48,
+											StartColUTF16: 47,
+											EndColUTF16:   48,
+										},
+									},
+								},
+							},
+							Value: []byte("static"),
+						},
+						Stmt: &ast.StmtStmtList{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      54,
+								EndPos:        56,
+								StartCol:      55,
+								EndCol:        57,
+								StartColUTF16: 55,
+								EndColUTF16:   57,
+							},
+							OpenCurlyBracketTkn: &token.Token{
+								ID:    token.ID(123),
+								Value: []byte("{"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      54,
+									EndPos:        55,
+									StartCol:      55,
+									EndCol:        56,
+									StartColUTF16: 55,
+									EndColUTF16:   56,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      53,
+											EndPos:        54,
+											StartCol:      54,
+											EndCol:        55,
+											StartColUTF16: 54,
+											EndColUTF16:   55,
+										},
+									},
+								},
+							},
+							Stmts: []ast.Vertex{},
+							CloseCurlyBracketTkn: &token.Token{
+								ID:    token.ID(125),
+								Value: []byte("}"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      55,
+									EndPos:        56,
+									StartCol:      56,
+									EndCol:        57,
+									StartColUTF16: 56,
+									EndColUTF16:   57,
+								},
+							},
+						},
+					},
+				},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      57,
+						EndPos:        58,
+						StartCol:      58,
+						EndCol:        59,
+						StartColUTF16: 58,
+						EndColUTF16:   59,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      56,
+								EndPos:        57,
+								StartCol:      57,
+								EndCol:        58,
+								StartColUTF16: 57,
+								EndColUTF16:   58,
+							},
+						},
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8ConstructorPromotion(t *testing.T) {
+	src := `<?php class Foo { public function __construct(public int $a, protected $b = 1, private ?Bar $c = null,) {} }`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        108,
+			StartCol:      7,
+			EndCol:        109,
+			StartColUTF16: 7,
+			EndColUTF16:   109,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtClass{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        108,
+					StartCol:      7,
+					EndCol:        109,
+					StartColUTF16: 7,
+					EndColUTF16:   109,
+				},
+				ClassTkn: &token.Token{
+					ID:    token.T_CLASS,
+					Value: []byte("class"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        11,
+						StartCol:      7,
+						EndCol:        12,
+						StartColUTF16: 7,
+						EndColUTF16:   12,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_OPEN_TAG,
+							Value: []byte("<?php"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      0,
+								EndPos:        5,
+								StartCol:      1,
+								EndCol:        6,
+								StartColUTF16: 1,
+								EndColUTF16:   6,
+							},
+						},
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      5,
+								EndPos:        6,
+								StartCol:      6,
+								EndCol:        7,
+								StartColUTF16: 6,
+								EndColUTF16:   7,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      12,
+						EndPos:        15,
+						StartCol:      13,
+						EndCol:        16,
+						StartColUTF16: 13,
+						EndColUTF16:   16,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("Foo"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      12,
+							EndPos:        15,
+							StartCol:      13,
+							EndCol:        16,
+							StartColUTF16: 13,
+							EndColUTF16:   16,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      11,
+									EndPos:        12,
+									StartCol:      12,
+									EndCol:        13,
+									StartColUTF16: 12,
+									EndColUTF16:   13,
+								},
+							},
+						},
+					},
+					Value: []byte("Foo"),
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      16,
+						EndPos:        17,
+						StartCol:      17,
+						EndCol:        18,
+						StartColUTF16: 17,
+						EndColUTF16:   18,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      15,
+								EndPos:        16,
+								StartCol:      16,
+								EndCol:        17,
+								StartColUTF16: 16,
+								EndColUTF16:   17,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{
+					&ast.StmtClassMethod{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      18,
+							EndPos:        106,
+							StartCol:      19,
+							EndCol:        107,
+							StartColUTF16: 19,
+							EndColUTF16:   107,
+						},
+						Modifiers: []ast.Vertex{
+							&ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      18,
+									EndPos:        24,
+									StartCol:      19,
+									EndCol:        25,
+									StartColUTF16: 19,
+									EndColUTF16:   25,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_PUBLIC,
+									Value: []byte("public"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      18,
+										EndPos:        24,
+										StartCol:      19,
+										EndCol:        25,
+										StartColUTF16: 19,
+										EndColUTF16:   25,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      17,
+												EndPos:        18,
+												StartCol:      18,
+												EndCol:        19,
+												StartColUTF16: 18,
+												EndColUTF16:   19,
+											},
+										},
+									},
+								},
+								Value: []byte("public"),
+							},
+						},
+						FunctionTkn: &token.Token{
+							ID:    token.T_FUNCTION,
+							Value: []byte("function"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      25,
+								EndPos:        33,
+								StartCol:      26,
+								EndCol:        34,
+								StartColUTF16: 26,
+								EndColUTF16:   34,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      24,
+										EndPos:        25,
+										StartCol:      25,
+										EndCol:        26,
+										StartColUTF16: 25,
+										EndColUTF16:   26,
+									},
+								},
+							},
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      34,
+								EndPos:        45,
+								StartCol:      35,
+								EndCol:        46,
+								StartColUTF16: 35,
+								EndColUTF16:   46,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_STRING,
+								Value: []byte("__construct"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      34,
+									EndPos:        45,
+									StartCol:      35,
+									EndCol:        46,
+									StartColUTF16: 35,
+									EndColUTF16:   46,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      33,
+											EndPos:        34,
+											StartCol:      34,
+											EndCol:        35,
+											StartColUTF16: 34,
+											EndColUTF16:   35,
+										},
+									},
+								},
+							},
+							Value: []byte("__construct"),
+						},
+						OpenParenthesisTkn: &token.Token{
+							ID:    token.ID(40),
+							Value: []byte("("),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      45,
+								EndPos:        46,
+								StartCol:      46,
+								EndCol:        47,
+								StartColUTF16: 46,
+								EndColUTF16:   47,
+							},
+						},
+						Params: []ast.Vertex{
+							&ast.Parameter{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      46,
+									EndPos:        59,
+									StartCol:      47,
+									EndCol:        60,
+									StartColUTF16: 47,
+									EndColUTF16:   60,
+								},
+								Modifiers: []ast.Vertex{
+									&ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      46,
+											EndPos:        52,
+											StartCol:      47,
+											EndCol:        53,
+											StartColUTF16: 47,
+											EndColUTF16:   53,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_PUBLIC,
+											Value: []byte("public"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      46,
+												EndPos:        52,
+												StartCol:      47,
+												EndCol:        53,
+												StartColUTF16: 47,
+												EndColUTF16:   53,
+											},
+										},
+										Value: []byte("public"),
+									},
+								},
+								Type: &ast.Name{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      53,
+										EndPos:        56,
+										StartCol:      54,
+										EndCol:        57,
+										StartColUTF16: 54,
+										EndColUTF16:   57,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      53,
+												EndPos:        56,
+												StartCol:      54,
+												EndCol:        57,
+												StartColUTF16: 54,
+												EndColUTF16:   57,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("int"),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      53,
+													EndPos:        56,
+													StartCol:      54,
+													EndCol:        57,
+													StartColUTF16: 54,
+													EndColUTF16:   57,
+												},
+												FreeFloating: []*token.Token{
+													{
+														ID:    token.T_WHITESPACE,
+														Value: []byte(" "),
+														Position: &position.Position{
+															StartLine:     1,
+															EndLine:       1,
+															StartPos:      52,
+															EndPos:        53,
+															StartCol:      53,
+															EndCol:        54,
+															StartColUTF16: 53,
+															EndColUTF16:   54,
+														},
+													},
+												},
+											},
+											Value: []byte("int"),
+										},
+									},
+								},
+								Var: &ast.ExprVariable{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      57,
+										EndPos:        59,
+										StartCol:      58,
+										EndCol:        60,
+										StartColUTF16: 58,
+										EndColUTF16:   60,
+									},
+									Name: &ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      57,
+											EndPos:        59,
+											StartCol:      58,
+											EndCol:        60,
+											StartColUTF16: 58,
+											EndColUTF16:   60,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_VARIABLE,
+											Value: []byte("$a"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      57,
+												EndPos:        59,
+												StartCol:      58,
+												EndCol:        60,
+												StartColUTF16: 58,
+												EndColUTF16:   60,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      56,
+														EndPos:        57,
+														StartCol:      57,
+														EndCol:        58,
+														StartColUTF16: 57,
+														EndColUTF16:   58,
+													},
+												},
+											},
+										},
+										Value: []byte("$a"),
+									},
+								},
+							},
+							&ast.Parameter{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      61,
+									EndPos:        77,
+									StartCol:      62,
+									EndCol:        78,
+									StartColUTF16: 62,
+									EndColUTF16:   78,
+								},
+								Modifiers: []ast.Vertex{
+									&ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      61,
+											EndPos:        70,
+											StartCol:      62,
+											EndCol:        71,
+											StartColUTF16: 62,
+											EndColUTF16:   71,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_PROTECTED,
+											Value: []byte("protected"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      61,
+												EndPos:        70,
+												StartCol:      62,
+												EndCol:        71,
+												StartColUTF16: 62,
+												EndColUTF16:   71,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      60,
+														EndPos:        61,
+														StartCol:      61,
+														EndCol:        62,
+														StartColUTF16: 61,
+														EndColUTF16:   62,
+													},
+												},
+											},
+										},
+										Value: []byte("protected"),
+									},
+								},
+								Var: &ast.ExprVariable{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      71,
+										EndPos:        73,
+										StartCol:      72,
+										EndCol:        74,
+										StartColUTF16: 72,
+										EndColUTF16:   74,
+									},
+									Name: &ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      71,
+											EndPos:        73,
+											StartCol:      72,
+											EndCol:        74,
+											StartColUTF16: 72,
+											EndColUTF16:   74,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_VARIABLE,
+											Value: []byte("$b"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      71,
+												EndPos:        73,
+												StartCol:      72,
+												EndCol:        74,
+												StartColUTF16: 72,
+												EndColUTF16:   74,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      70,
+														EndPos:        71,
+														StartCol:      71,
+														EndCol:        72,
+														StartColUTF16: 71,
+														EndColUTF16:   72,
+													},
+												},
+											},
+										},
+										Value: []byte("$b"),
+									},
+								},
+								EqualTkn: &token.Token{
+									ID:    token.ID(61),
+									Value: []byte("="),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      74,
+										EndPos:        75,
+										StartCol:      75,
+										EndCol:        76,
+										StartColUTF16: 75,
+										EndColUTF16:   76,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      73,
+												EndPos:        74,
+												StartCol:      74,
+												EndCol:        75,
+												StartColUTF16: 74,
+												EndColUTF16:   75,
+											},
+										},
+									},
+								},
+								DefaultValue: &ast.ScalarLnumber{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      76,
+										EndPos:        77,
+										StartCol:      77,
+										EndCol:        78,
+										StartColUTF16: 77,
+										EndColUTF16:   78,
+									},
+									NumberTkn: &token.Token{
+										ID:    token.T_LNUMBER,
+										Value: []byte("1"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      76,
+											EndPos:        77,
+											StartCol:      77,
+											EndCol:        78,
+											StartColUTF16: 77,
+											EndColUTF16:   78,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      75,
+													EndPos:        76,
+													StartCol:      76,
+													EndCol:        77,
+													StartColUTF16: 76,
+													EndColUTF16:   77,
+												},
+											},
+										},
+									},
+									Value: []byte("1"),
+								},
+							},
+							&ast.Parameter{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      79,
+									EndPos:        101,
+									StartCol:      80,
+									EndCol:        102,
+									StartColUTF16: 80,
+									EndColUTF16:   102,
+								},
+								Modifiers: []ast.Vertex{
+									&ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      79,
+											EndPos:        86,
+											StartCol:      80,
+											EndCol:        87,
+											StartColUTF16: 80,
+											EndColUTF16:   87,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_PRIVATE,
+											Value: []byte("private"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      79,
+												EndPos:        86,
+												StartCol:      80,
+												EndCol:        87,
+												StartColUTF16: 80,
+												EndColUTF16:   87,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      78,
+														EndPos:        79,
+														StartCol:      79,
+														EndCol:        80,
+														StartColUTF16: 79,
+														EndColUTF16:   80,
+													},
+												},
+											},
+										},
+										Value: []byte("private"),
+									},
+								},
+								Type: &ast.Nullable{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      87,
+										EndPos:        91,
+										StartCol:      88,
+										EndCol:        92,
+										StartColUTF16: 88,
+										EndColUTF16:   92,
+									},
+									QuestionTkn: &token.Token{
+										ID:    token.ID(63),
+										Value: []byte("?"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      87,
+											EndPos:        88,
+											StartCol:      88,
+											EndCol:        89,
+											StartColUTF16: 88,
+											EndColUTF16:   89,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      86,
+													EndPos:        87,
+													StartCol:      87,
+													EndCol:        88,
+													StartColUTF16: 87,
+													EndColUTF16:   88,
+												},
+											},
+										},
+									},
+									Expr: &ast.Name{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      88,
+											EndPos:        91,
+											StartCol:      89,
+											EndCol:        92,
+											StartColUTF16: 89,
+											EndColUTF16:   92,
+										},
+										Parts: []ast.Vertex{
+											&ast.NamePart{
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      88,
+													EndPos:        91,
+													StartCol:      89,
+													EndCol:        92,
+													StartColUTF16: 89,
+													EndColUTF16:   92,
+												},
+												StringTkn: &token.Token{
+													ID:    token.T_STRING,
+													Value: []byte("Bar"),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      88,
+														EndPos:        91,
+														StartCol:      89,
+														EndCol:        92,
+														StartColUTF16: 89,
+														EndColUTF16:   92,
+													},
+												},
+												Value: []byte("Bar"),
+											},
+										},
+									},
+								},
+								Var: &ast.ExprVariable{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      92,
+										EndPos:        94,
+										StartCol:      93,
+										EndCol:        95,
+										StartColUTF16: 93,
+										EndColUTF16:   95,
+									},
+									Name: &ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      92,
+											EndPos:        94,
+											StartCol:      93,
+											EndCol:        95,
+											StartColUTF16: 93,
+											EndColUTF16:   95,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_VARIABLE,
+											Value: []byte("$c"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      92,
+												EndPos:        94,
+												StartCol:      93,
+												EndCol:        95,
+												StartColUTF16: 93,
+												EndColUTF16:   95,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      91,
+														EndPos:        92,
+														StartCol:      92,
+														EndCol:        93,
+														StartColUTF16: 92,
+														EndColUTF16:   93,
+													},
+												},
+											},
+										},
+										Value: []byte("$c"),
+									},
+								},
+								EqualTkn: &token.Token{
+									ID:    token.ID(61),
+									Value: []byte("="),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      95,
+										EndPos:        96,
+										StartCol:      96,
+										EndCol:        97,
+										StartColUTF16: 96,
+										EndColUTF16:   97,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      94,
+												EndPos:        95,
+												StartCol:      95,
+												EndCol:        96,
+												StartColUTF16: 95,
+												EndColUTF16:   96,
+											},
+										},
+									},
+								},
+								DefaultValue: &ast.ExprConstFetch{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      97,
+										EndPos:        101,
+										StartCol:      98,
+										EndCol:        102,
+										StartColUTF16: 98,
+										EndColUTF16:   102,
+									},
+									Const: &ast.Name{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      97,
+											EndPos:        101,
+											StartCol:      98,
+											EndCol:        102,
+											StartColUTF16: 98,
+											EndColUTF16:   102,
+										},
+										Parts: []ast.Vertex{
+											&ast.NamePart{
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      97,
+													EndPos:        101,
+													StartCol:      98,
+													EndCol:        102,
+													StartColUTF16: 98,
+													EndColUTF16:   102,
+												},
+												StringTkn: &token.Token{
+													ID:    token.T_STRING,
+													Value: []byte("null"),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      97,
+														EndPos:        101,
+														StartCol:      98,
+														EndCol:        102,
+														StartColUTF16: 98,
+														EndColUTF16:   102,
+													},
+													FreeFloating: []*token.Token{
+														{
+															ID:    token.T_WHITESPACE,
+															Value: []byte(" "),
+															Position: &position.Position{
+																StartLine:     1,
+																EndLine:       1,
+																StartPos:      96,
+																EndPos:        97,
+																StartCol:      97,
+																EndCol:        98,
+																StartColUTF16: 97,
+																EndColUTF16:   98,
+															},
+														},
+													},
+												},
+												Value: []byte("null"),
+											},
+										},
+									},
+								},
+							},
+						},
+						SeparatorTkns: []*token.Token{
+							{
+								ID:    token.ID(44),
+								Value: []byte(","),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      59,
+									EndPos:        60,
+									StartCol:      60,
+									EndCol:        61,
+									StartColUTF16: 60,
+									EndColUTF16:   61,
+								},
+							},
+							{
+								ID:    token.ID(44),
+								Value: []byte(","),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      77,
+									EndPos:        78,
+									StartCol:      78,
+									EndCol:        79,
+									StartColUTF16: 78,
+									EndColUTF16:   79,
+								},
+							},
+							{
+								ID:    token.ID(44),
+								Value: []byte(","),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      101,
+									EndPos:        102,
+									StartCol:      102,
+									EndCol:        103,
+									StartColUTF16: 102,
+									EndColUTF16:   103,
+								},
+							},
+						},
+						CloseParenthesisTkn: &token.Token{
+							ID:    token.ID(41),
+							Value: []byte(")"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      102,
+								EndPos:        103,
+								StartCol:      103,
+								EndCol:        104,
+								StartColUTF16: 103,
+								EndColUTF16:   104,
+							},
+						},
+						Stmt: &ast.StmtStmtList{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      104,
+								EndPos:        106,
+								StartCol:      105,
+								EndCol:        107,
+								StartColUTF16: 105,
+								EndColUTF16:   107,
+							},
+							OpenCurlyBracketTkn: &token.Token{
+								ID:    token.ID(123),
+								Value: []byte("{"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      104,
+									EndPos:        105,
+									StartCol:      105,
+									EndCol:        106,
+									StartColUTF16: 105,
+									EndColUTF16:   106,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      103,
+											EndPos:        104,
+											StartCol:      104,
+											EndCol:        105,
+											StartColUTF16: 104,
+											EndColUTF16:   105,
+										},
+									},
+								},
+							},
+							Stmts: []ast.Vertex{},
+							CloseCurlyBracketTkn: &token.Token{
+								ID:    token.ID(125),
+								Value: []byte("}"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      105,
+									EndPos:        106,
+									StartCol:      106,
+									EndCol:        107,
+									StartColUTF16: 106,
+									EndColUTF16:   107,
+								},
+							},
+						},
+					},
+				},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      107,
+						EndPos:        108,
+						StartCol:      108,
+						EndCol:        109,
+						StartColUTF16: 108,
+						EndColUTF16:   109,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      106,
+								EndPos:        107,
+								StartCol:      107,
+								EndCol:        108,
+								StartColUTF16: 107,
+								EndColUTF16:   108,
+							},
+						},
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8NamedArguments(t *testing.T) {
+	src := `<?php foo(a: 1, b: $b, ...$c); new Foo(class: 1);`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        49,
+			StartCol:      7,
+			EndCol:        50,
+			StartColUTF16: 7,
+			EndColUTF16:   50,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        30,
+					StartCol:      7,
+					EndCol:        31,
+					StartColUTF16: 7,
+					EndColUTF16:   31,
+				},
+				Expr: &ast.ExprFunctionCall{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        29,
+						StartCol:      7,
+						EndCol:        30,
+						StartColUTF16: 7,
+						EndColUTF16:   30,
+					},
+					Function: &ast.Name{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        9,
+							StartCol:      7,
+							EndCol:        10,
+							StartColUTF16: 7,
+							EndColUTF16:   10,
+						},
+						Parts: []ast.Vertex{
+							&ast.NamePart{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      6,
+									EndPos:        9,
+									StartCol:      7,
+									EndCol:        10,
+									StartColUTF16: 7,
+									EndColUTF16:   10,
+								},
+								StringTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("foo"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      6,
+										EndPos:        9,
+										StartCol:      7,
+										EndCol:        10,
+										StartColUTF16: 7,
+										EndColUTF16:   10,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_OPEN_TAG,
+											Value: []byte("<?php"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      0,
+												EndPos:        5,
+												StartCol:      1,
+												EndCol:        6,
+												StartColUTF16: 1,
+												EndColUTF16:   6,
+											},
+										},
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      5,
+												EndPos:        6,
+												StartCol:      6,
+												EndCol:        7,
+												StartColUTF16: 6,
+												EndColUTF16:   7,
+											},
+										},
+									},
+								},
+								Value: []byte("foo"),
+							},
+						},
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      9,
+							EndPos:        10,
+							StartCol:      10,
+							EndCol:        11,
+							StartColUTF16: 10,
+							EndColUTF16:   11,
+						},
+					},
+					Args: []ast.Vertex{
+						&ast.Argument{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      10,
+								EndPos:        14,
+								StartCol:      11,
+								EndCol:        15,
+								StartColUTF16: 11,
+								EndColUTF16:   15,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      10,
+									EndPos:        11,
+									StartCol:      11,
+									EndCol:        12,
+									StartColUTF16: 11,
+									EndColUTF16:   12,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("a"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      10,
+										EndPos:        11,
+										StartCol:      11,
+										EndCol:        12,
+										StartColUTF16: 11,
+										EndColUTF16:   12,
+									},
+								},
+								Value: []byte("a"),
+							},
+							ColonTkn: &token.Token{
+								ID:    token.ID(58),
+								Value: []byte(":"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      11,
+									EndPos:        12,
+									StartCol:      12,
+									EndCol:        13,
+									StartColUTF16: 12,
+									EndColUTF16:   13,
+								},
+							},
+							Expr: &ast.ScalarLnumber{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      13,
+									EndPos:        14,
+									StartCol:      14,
+									EndCol:        15,
+									StartColUTF16: 14,
+									EndColUTF16:   15,
+								},
+								NumberTkn: &token.Token{
+									ID:    token.T_LNUMBER,
+									Value: []byte("1"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      13,
+										EndPos:        14,
+										StartCol:      14,
+										EndCol:        15,
+										StartColUTF16: 14,
+										EndColUTF16:   15,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      12,
+												EndPos:        13,
+												StartCol:      13,
+												EndCol:        14,
+												StartColUTF16: 13,
+												EndColUTF16:   14,
+											},
+										},
+									},
+								},
+								Value: []byte("1"),
+							},
+						},
+						&ast.Argument{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      16,
+								EndPos:        21,
+								StartCol:      17,
+								EndCol:        22,
+								StartColUTF16: 17,
+								EndColUTF16:   22,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      16,
+									EndPos:        17,
+									StartCol:      17,
+									EndCol:        18,
+									StartColUTF16: 17,
+									EndColUTF16:   18,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("b"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      16,
+										EndPos:        17,
+										StartCol:      17,
+										EndCol:        18,
+										StartColUTF16: 17,
+										EndColUTF16:   18,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      15,
+												EndPos:        16,
+												StartCol:      16,
+												EndCol:        17,
+												StartColUTF16: 16,
+												EndColUTF16:   17,
+											},
+										},
+									},
+								},
+								Value: []byte("b"),
+							},
+							ColonTkn: &token.Token{
+								ID:    token.ID(58),
+								Value: []byte(":"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      17,
+									EndPos:        18,
+									StartCol:      18,
+									EndCol:        19,
+									StartColUTF16: 18,
+									EndColUTF16:   19,
+								},
+							},
+							Expr: &ast.ExprVariable{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      19,
+									EndPos:        21,
+									StartCol:      20,
+									EndCol:        22,
+									StartColUTF16: 20,
+									EndColUTF16:   22,
+								},
+								Name: &ast.Identifier{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      19,
+										EndPos:        21,
+										StartCol:      20,
+										EndCol:        22,
+										StartColUTF16: 20,
+										EndColUTF16:   22,
+									},
+									IdentifierTkn: &token.Token{
+										ID:    token.T_VARIABLE,
+										Value: []byte("$b"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      19,
+											EndPos:        21,
+											StartCol:      20,
+											EndCol:        22,
+											StartColUTF16: 20,
+											EndColUTF16:   22,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      18,
+													EndPos:        19,
+													StartCol:      19,
+													EndCol:        20,
+													StartColUTF16: 19,
+													EndColUTF16:   20,
+												},
+											},
+										},
+									},
+									Value: []byte("$b"),
+								},
+							},
+						},
+						&ast.Argument{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      23,
+								EndPos:        28,
+								StartCol:      24,
+								EndCol:        29,
+								StartColUTF16: 24,
+								EndColUTF16:   29,
+							},
+							VariadicTkn: &token.Token{
+								ID:    token.T_ELLIPSIS,
+								Value: []byte("..."),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      23,
+									EndPos:        26,
+									StartCol:      24,
+									EndCol:        27,
+									StartColUTF16: 24,
+									EndColUTF16:   27,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      22,
+											EndPos:        23,
+											StartCol:      23,
+											EndCol:        24,
+											StartColUTF16: 23,
+											EndColUTF16:   24,
+										},
+									},
+								},
+							},
+							Expr: &ast.ExprVariable{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      26,
+									EndPos:        28,
+									StartCol:      27,
+									EndCol:        29,
+									StartColUTF16: 27,
+									EndColUTF16:   29,
+								},
+								Name: &ast.Identifier{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      26,
+										EndPos:        28,
+										StartCol:      27,
+										EndCol:        29,
+										StartColUTF16: 27,
+										EndColUTF16:   29,
+									},
+									IdentifierTkn: &token.Token{
+										ID:    token.T_VARIABLE,
+										Value: []byte("$c"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      26,
+											EndPos:        28,
+											StartCol:      27,
+											EndCol:        29,
+											StartColUTF16: 27,
+											EndColUTF16:   29,
+										},
+									},
+									Value: []byte("$c"),
+								},
+							},
+						},
+					},
+					SeparatorTkns: []*token.Token{
+						{
+							ID:    token.ID(44),
+							Value: []byte(","),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      14,
+								EndPos:        15,
+								StartCol:      15,
+								EndCol:        16,
+								StartColUTF16: 15,
+								EndColUTF16:   16,
+							},
+						},
+						{
+							ID:    token.ID(44),
+							Value: []byte(","),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      21,
+								EndPos:        22,
+								StartCol:      22,
+								EndCol:        23,
+								StartColUTF16: 22,
+								EndColUTF16:   23,
+							},
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      28,
+							EndPos:        29,
+							StartCol:      29,
+							EndCol:        30,
+							StartColUTF16: 29,
+							EndColUTF16:   30,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      29,
+						EndPos:        30,
+						StartCol:      30,
+						EndCol:        31,
+						StartColUTF16: 30,
+						EndColUTF16:   31,
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      31,
+					EndPos:        49,
+					StartCol:      32,
+					EndCol:        50,
+					StartColUTF16: 32,
+					EndColUTF16:   50,
+				},
+				Expr: &ast.ExprNew{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      31,
+						EndPos:        48,
+						StartCol:      32,
+						EndCol:        49,
+						StartColUTF16: 32,
+						EndColUTF16:   49,
+					},
+					NewTkn: &token.Token{
+						ID:    token.T_NEW,
+						Value: []byte("new"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      31,
+							EndPos:        34,
+							StartCol:      32,
+							EndCol:        35,
+							StartColUTF16: 32,
+							EndColUTF16:   35,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      30,
+									EndPos:        31,
+									StartCol:      31,
+									EndCol:        32,
+									StartColUTF16: 31,
+									EndColUTF16:   32,
+								},
+							},
+						},
+					},
+					Class: &ast.Name{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      35,
+							EndPos:        38,
+							StartCol:      36,
+							EndCol:        39,
+							StartColUTF16: 36,
+							EndColUTF16:   39,
+						},
+						Parts: []ast.Vertex{
+							&ast.NamePart{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      35,
+									EndPos:        38,
+									StartCol:      36,
+									EndCol:        39,
+									StartColUTF16: 36,
+									EndColUTF16:   39,
+								},
+								StringTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("Foo"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      35,
+										EndPos:        38,
+										StartCol:      36,
+										EndCol:        39,
+										StartColUTF16: 36,
+										EndColUTF16:   39,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      34,
+												EndPos:        35,
+												StartCol:      35,
+												EndCol:        36,
+												StartColUTF16: 35,
+												EndColUTF16:   36,
+											},
+										},
+									},
+								},
+								Value: []byte("Foo"),
+							},
+						},
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      38,
+							EndPos:        39,
+							StartCol:      39,
+							EndCol:        40,
+							StartColUTF16: 39,
+							EndColUTF16:   40,
+						},
+					},
+					Args: []ast.Vertex{
+						&ast.Argument{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      39,
+								EndPos:        47,
+								StartCol:      40,
+								EndCol:        48,
+								StartColUTF16: 40,
+								EndColUTF16:   48,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      39,
+									EndPos:        44,
+									StartCol:      40,
+									EndCol:        45,
+									StartColUTF16: 40,
+									EndColUTF16:   45,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_CLASS,
+									Value: []byte("class"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      39,
+										EndPos:        44,
+										StartCol:      40,
+										EndCol:        45,
+										StartColUTF16: 40,
+										EndColUTF16:   45,
+									},
+								},
+								Value: []byte("class"),
+							},
+							ColonTkn: &token.Token{
+								ID:    token.ID(58),
+								Value: []byte(":"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      44,
+									EndPos:        45,
+									StartCol:      45,
+									EndCol:        46,
+									StartColUTF16: 45,
+									EndColUTF16:   46,
+								},
+							},
+							Expr: &ast.ScalarLnumber{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      46,
+									EndPos:        47,
+									StartCol:      47,
+									EndCol:        48,
+									StartColUTF16: 47,
+									EndColUTF16:   48,
+								},
+								NumberTkn: &token.Token{
+									ID:    token.T_LNUMBER,
+									Value: []byte("1"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      46,
+										EndPos:        47,
+										StartCol:      47,
+										EndCol:        48,
+										StartColUTF16: 47,
+										EndColUTF16:   48,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      45,
+												EndPos:        46,
+												StartCol:      46,
+												EndCol:        47,
+												StartColUTF16: 46,
+												EndColUTF16:   47,
+											},
+										},
+									},
+								},
+								Value: []byte("1"),
+							},
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      47,
+							EndPos:        48,
+							StartCol:      48,
+							EndCol:        49,
+							StartColUTF16: 48,
+							EndColUTF16:   49,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      48,
+						EndPos:        49,
+						StartCol:      49,
+						EndCol:        50,
+						StartColUTF16: 49,
+						EndColUTF16:   50,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8NullsafePropertyFetch(t *testing.T) {
+	src := `<?php $a?->b?->c; $a?->{$b};`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        28,
+			StartCol:      7,
+			EndCol:        29,
+			StartColUTF16: 7,
+			EndColUTF16:   29,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        17,
+					StartCol:      7,
+					EndCol:        18,
+					StartColUTF16: 7,
+					EndColUTF16:   18,
+				},
+				Expr: &ast.ExprNullsafePropertyFetch{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        16,
+						StartCol:      7,
+						EndCol:        17,
+						StartColUTF16: 7,
+						EndColUTF16:   17,
+					},
+					Var: &ast.ExprNullsafePropertyFetch{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        12,
+							StartCol:      7,
+							EndCol:        13,
+							StartColUTF16: 7,
+							EndColUTF16:   13,
+						},
+						Var: &ast.ExprVariable{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      6,
+								EndPos:        8,
+								StartCol:      7,
+								EndCol:        9,
+								StartColUTF16: 7,
+								EndColUTF16:   9,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      6,
+									EndPos:        8,
+									StartCol:      7,
+									EndCol:        9,
+									StartColUTF16: 7,
+									EndColUTF16:   9,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_VARIABLE,
+									Value: []byte("$a"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      6,
+										EndPos:        8,
+										StartCol:      7,
+										EndCol:        9,
+										StartColUTF16: 7,
+										EndColUTF16:   9,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_OPEN_TAG,
+											Value: []byte("<?php"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      0,
+												EndPos:        5,
+												StartCol:      1,
+												EndCol:        6,
+												StartColUTF16: 1,
+												EndColUTF16:   6,
+											},
+										},
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      5,
+												EndPos:        6,
+												StartCol:      6,
+												EndCol:        7,
+												StartColUTF16: 6,
+												EndColUTF16:   7,
+											},
+										},
+									},
+								},
+								Value: []byte("$a"),
+							},
+						},
+						ObjectOperatorTkn: &token.Token{
+							ID:    token.T_NULLSAFE_OBJECT_OPERATOR,
+							Value: []byte("?->"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      8,
+								EndPos:        11,
+								StartCol:      9,
+								EndCol:        12,
+								StartColUTF16: 9,
+								EndColUTF16:   12,
+							},
+						},
+						Prop: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      11,
+								EndPos:        12,
+								StartCol:      12,
+								EndCol:        13,
+								StartColUTF16: 12,
+								EndColUTF16:   13,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_STRING,
+								Value: []byte("b"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      11,
+									EndPos:        12,
+									StartCol:      12,
+									EndCol:        13,
+									StartColUTF16: 12,
+									EndColUTF16:   13,
+								},
+							},
+							Value: []byte("b"),
+						},
+					},
+					ObjectOperatorTkn: &token.Token{
+						ID:    token.T_NULLSAFE_OBJECT_OPERATOR,
+						Value: []byte("?->"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      12,
+							EndPos:        15,
+							StartCol:      13,
+							EndCol:        16,
+							StartColUTF16: 13,
+							EndColUTF16:   16,
+						},
+					},
+					Prop: &ast.Identifier{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      15,
+							EndPos:        16,
+							StartCol:      16,
+							EndCol:        17,
+							StartColUTF16: 16,
+							EndColUTF16:   17,
+						},
+						IdentifierTkn: &token.Token{
+							ID:    token.T_STRING,
+							Value: []byte("c"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      15,
+								EndPos:        16,
+								StartCol:      16,
+								EndCol:        17,
+								StartColUTF16: 16,
+								EndColUTF16:   17,
+							},
+						},
+						Value: []byte("c"),
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      16,
+						EndPos:        17,
+						StartCol:      17,
+						EndCol:        18,
+						StartColUTF16: 17,
+						EndColUTF16:   18,
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      18,
+					EndPos:        28,
+					StartCol:      19,
+					EndCol:        29,
+					StartColUTF16: 19,
+					EndColUTF16:   29,
+				},
+				Expr: &ast.ExprNullsafePropertyFetch{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      18,
+						EndPos:        27,
+						StartCol:      19,
+						EndCol:        28,
+						StartColUTF16: 19,
+						EndColUTF16:   28,
+					},
+					Var: &ast.ExprVariable{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      18,
+							EndPos:        20,
+							StartCol:      19,
+							EndCol:        21,
+							StartColUTF16: 19,
+							EndColUTF16:   21,
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      18,
+								EndPos:        20,
+								StartCol:      19,
+								EndCol:        21,
+								StartColUTF16: 19,
+								EndColUTF16:   21,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$a"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      18,
+									EndPos:        20,
+									StartCol:      19,
+									EndCol:        21,
+									StartColUTF16: 19,
+									EndColUTF16:   21,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      17,
+											EndPos:        18,
+											StartCol:      18,
+											EndCol:        19,
+											StartColUTF16: 18,
+											EndColUTF16:   19,
+										},
+									},
+								},
+							},
+							Value: []byte("$a"),
+						},
+					},
+					ObjectOperatorTkn: &token.Token{
+						ID:    token.T_NULLSAFE_OBJECT_OPERATOR,
+						Value: []byte("?->"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      20,
+							EndPos:        23,
+							StartCol:      21,
+							EndCol:        24,
+							StartColUTF16: 21,
+							EndColUTF16:   24,
+						},
+					},
+					OpenCurlyBracketTkn: &token.Token{
+						ID:    token.ID(123),
+						Value: []byte("{"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      23,
+							EndPos:        24,
+							StartCol:      24,
+							EndCol:        25,
+							StartColUTF16: 24,
+							EndColUTF16:   25,
+						},
+					},
+					Prop: &ast.ExprVariable{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      24,
+							EndPos:        26,
+							StartCol:      25,
+							EndCol:        27,
+							StartColUTF16: 25,
+							EndColUTF16:   27,
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      24,
+								EndPos:        26,
+								StartCol:      25,
+								EndCol:        27,
+								StartColUTF16: 25,
+								EndColUTF16:   27,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$b"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      24,
+									EndPos:        26,
+									StartCol:      25,
+									EndCol:        27,
+									StartColUTF16: 25,
+									EndColUTF16:   27,
+								},
+							},
+							Value: []byte("$b"),
+						},
+					},
+					CloseCurlyBracketTkn: &token.Token{
+						ID:    token.ID(125),
+						Value: []byte("}"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      26,
+							EndPos:        27,
+							StartCol:      27,
+							EndCol:        28,
+							StartColUTF16: 27,
+							EndColUTF16:   28,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      27,
+						EndPos:        28,
+						StartCol:      28,
+						EndCol:        29,
+						StartColUTF16: 28,
+						EndColUTF16:   29,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8NullsafeMethodCall(t *testing.T) {
+	src := `<?php $a?->b(1)?->c();`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        22,
+			StartCol:      7,
+			EndCol:        23,
+			StartColUTF16: 7,
+			EndColUTF16:   23,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        22,
+					StartCol:      7,
+					EndCol:        23,
+					StartColUTF16: 7,
+					EndColUTF16:   23,
+				},
+				Expr: &ast.ExprNullsafeMethodCall{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        21,
+						StartCol:      7,
+						EndCol:        22,
+						StartColUTF16: 7,
+						EndColUTF16:   22,
+					},
+					Var: &ast.ExprNullsafeMethodCall{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        15,
+							StartCol:      7,
+							EndCol:        16,
+							StartColUTF16: 7,
+							EndColUTF16:   16,
+						},
+						Var: &ast.ExprVariable{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      6,
+								EndPos:        8,
+								StartCol:      7,
+								EndCol:        9,
+								StartColUTF16: 7,
+								EndColUTF16:   9,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      6,
+									EndPos:        8,
+									StartCol:      7,
+									EndCol:        9,
+									StartColUTF16: 7,
+									EndColUTF16:   9,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_VARIABLE,
+									Value: []byte("$a"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      6,
+										EndPos:        8,
+										StartCol:      7,
+										EndCol:        9,
+										StartColUTF16: 7,
+										EndColUTF16:   9,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_OPEN_TAG,
+											Value: []byte("<?php"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      0,
+												EndPos:        5,
+												StartCol:      1,
+												EndCol:        6,
+												StartColUTF16: 1,
+												EndColUTF16:   6,
+											},
+										},
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      5,
+												EndPos:        6,
+												StartCol:      6,
+												EndCol:        7,
+												StartColUTF16: 6,
+												EndColUTF16:   7,
+											},
+										},
+									},
+								},
+								Value: []byte("$a"),
+							},
+						},
+						ObjectOperatorTkn: &token.Token{
+							ID:    token.T_NULLSAFE_OBJECT_OPERATOR,
+							Value: []byte("?->"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      8,
+								EndPos:        11,
+								StartCol:      9,
+								EndCol:        12,
+								StartColUTF16: 9,
+								EndColUTF16:   12,
+							},
+						},
+						Method: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      11,
+								EndPos:        12,
+								StartCol:      12,
+								EndCol:        13,
+								StartColUTF16: 12,
+								EndColUTF16:   13,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_STRING,
+								Value: []byte("b"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      11,
+									EndPos:        12,
+									StartCol:      12,
+									EndCol:        13,
+									StartColUTF16: 12,
+									EndColUTF16:   13,
+								},
+							},
+							Value: []byte("b"),
+						},
+						OpenParenthesisTkn: &token.Token{
+							ID:    token.ID(40),
+							Value: []byte("("),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      12,
+								EndPos:        13,
+								StartCol:      13,
+								EndCol:        14,
+								StartColUTF16: 13,
+								EndColUTF16:   14,
+							},
+						},
+						Args: []ast.Vertex{
+							&ast.Argument{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      13,
+									EndPos:        14,
+									StartCol:      14,
+									EndCol:        15,
+									StartColUTF16: 14,
+									EndColUTF16:   15,
+								},
+								Expr: &ast.ScalarLnumber{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      13,
+										EndPos:        14,
+										StartCol:      14,
+										EndCol:        15,
+										StartColUTF16: 14,
+										EndColUTF16:   15,
+									},
+									NumberTkn: &token.Token{
+										ID:    token.T_LNUMBER,
+										Value: []byte("1"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      13,
+											EndPos:        14,
+											StartCol:      14,
+											EndCol:        15,
+											StartColUTF16: 14,
+											EndColUTF16:   15,
+										},
+									},
+									Value: []byte("1"),
+								},
+							},
+						},
+						CloseParenthesisTkn: &token.Token{
+							ID:    token.ID(41),
+							Value: []byte(")"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      14,
+								EndPos:        15,
+								StartCol:      15,
+								EndCol:        16,
+								StartColUTF16: 15,
+								EndColUTF16:   16,
+							},
+						},
+					},
+					ObjectOperatorTkn: &token.Token{
+						ID:    token.T_NULLSAFE_OBJECT_OPERATOR,
+						Value: []byte("?->"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      15,
+							EndPos:        18,
+							StartCol:      16,
+							EndCol:        19,
+							StartColUTF16: 16,
+							EndColUTF16:   19,
+						},
+					},
+					Method: &ast.Identifier{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      18,
+							EndPos:        19,
+							StartCol:      19,
+							EndCol:        20,
+							StartColUTF16: 19,
+							EndColUTF16:   20,
+						},
+						IdentifierTkn: &token.Token{
+							ID:    token.T_STRING,
+							Value: []byte("c"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      18,
+								EndPos:        19,
+								StartCol:      19,
+								EndCol:        20,
+								StartColUTF16: 19,
+								EndColUTF16:   20,
+							},
+						},
+						Value: []byte("c"),
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      19,
+							EndPos:        20,
+							StartCol:      20,
+							EndCol:        21,
+							StartColUTF16: 20,
+							EndColUTF16:   21,
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      20,
+							EndPos:        21,
+							StartCol:      21,
+							EndCol:        22,
+							StartColUTF16: 21,
+							EndColUTF16:   22,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      21,
+						EndPos:        22,
+						StartCol:      22,
+						EndCol:        23,
+						StartColUTF16: 22,
+						EndColUTF16:   23,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8Match(t *testing.T) {
+	src := `<?php $a = match ($b) { 1, 2, => 'a', 3 => 'b', default, => 'c', };`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        67,
+			StartCol:      7,
+			EndCol:        68,
+			StartColUTF16: 7,
+			EndColUTF16:   68,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        67,
+					StartCol:      7,
+					EndCol:        68,
+					StartColUTF16: 7,
+					EndColUTF16:   68,
+				},
+				Expr: &ast.ExprAssign{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        66,
+						StartCol:      7,
+						EndCol:        67,
+						StartColUTF16: 7,
+						EndColUTF16:   67,
+					},
+					Var: &ast.ExprVariable{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        8,
+							StartCol:      7,
+							EndCol:        9,
+							StartColUTF16: 7,
+							EndColUTF16:   9,
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      6,
+								EndPos:        8,
+								StartCol:      7,
+								EndCol:        9,
+								StartColUTF16: 7,
+								EndColUTF16:   9,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$a"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      6,
+									EndPos:        8,
+									StartCol:      7,
+									EndCol:        9,
+									StartColUTF16: 7,
+									EndColUTF16:   9,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_OPEN_TAG,
+										Value: []byte("<?php"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      0,
+											EndPos:        5,
+											StartCol:      1,
+											EndCol:        6,
+											StartColUTF16: 1,
+											EndColUTF16:   6,
+										},
+									},
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      5,
+											EndPos:        6,
+											StartCol:      6,
+											EndCol:        7,
+											StartColUTF16: 6,
+											EndColUTF16:   7,
+										},
+									},
+								},
+							},
+							Value: []byte("$a"),
+						},
+					},
+					EqualTkn: &token.Token{
+						ID:    token.ID(61),
+						Value: []byte("="),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      9,
+							EndPos:        10,
+							StartCol:      10,
+							EndCol:        11,
+							StartColUTF16: 10,
+							EndColUTF16:   11,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      8,
+									EndPos:        9,
+									StartCol:      9,
+									EndCol:        10,
+									StartColUTF16: 9,
+									EndColUTF16:   10,
+								},
+							},
+						},
+					},
+					Expr: &ast.ExprMatch{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      11,
+							EndPos:        66,
+							StartCol:      12,
+							EndCol:        67,
+							StartColUTF16: 12,
+							EndColUTF16:   67,
+						},
+						MatchTkn: &token.Token{
+							ID:    token.T_MATCH,
+							Value: []byte("match"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      11,
+								EndPos:        16,
+								StartCol:      12,
+								EndCol:        17,
+								StartColUTF16: 12,
+								EndColUTF16:   17,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      10,
+										EndPos:        11,
+										StartCol:      11,
+										EndCol:        12,
+										StartColUTF16: 11,
+										EndColUTF16:   12,
+									},
+								},
+							},
+						},
+						OpenParenthesisTkn: &token.Token{
+							ID:    token.ID(40),
+							Value: []byte("("),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      17,
+								EndPos:        18,
+								StartCol:      18,
+								EndCol:        19,
+								StartColUTF16: 18,
+								EndColUTF16:   19,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      16,
+										EndPos:        17,
+										StartCol:      17,
+										EndCol:        18,
+										StartColUTF16: 17,
+										EndColUTF16:   18,
+									},
+								},
+							},
+						},
+						Expr: &ast.ExprVariable{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      18,
+								EndPos:        20,
+								StartCol:      19,
+								EndCol:        21,
+								StartColUTF16: 19,
+								EndColUTF16:   21,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      18,
+									EndPos:        20,
+									StartCol:      19,
+									EndCol:        21,
+									StartColUTF16: 19,
+									EndColUTF16:   21,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_VARIABLE,
+									Value: []byte("$b"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      18,
+										EndPos:        20,
+										StartCol:      19,
+										EndCol:        21,
+										StartColUTF16: 19,
+										EndColUTF16:   21,
+									},
+								},
+								Value: []byte("$b"),
+							},
+						},
+						CloseParenthesisTkn: &token.Token{
+							ID:    token.ID(41),
+							Value: []byte(")"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      20,
+								EndPos:        21,
+								StartCol:      21,
+								EndCol:        22,
+								StartColUTF16: 21,
+								EndColUTF16:   22,
+							},
+						},
+						OpenCurlyBracketTkn: &token.Token{
+							ID:    token.ID(123),
+							Value: []byte("{"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      22,
+								EndPos:        23,
+								StartCol:      23,
+								EndCol:        24,
+								StartColUTF16: 23,
+								EndColUTF16:   24,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      21,
+										EndPos:        22,
+										StartCol:      22,
+										EndCol:        23,
+										StartColUTF16: 22,
+										EndColUTF16:   23,
+									},
+								},
+							},
+						},
+						Arms: []ast.Vertex{
+							&ast.MatchArm{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      24,
+									EndPos:        36,
+									StartCol:      25,
+									EndCol:        37,
+									StartColUTF16: 25,
+									EndColUTF16:   37,
+								},
+								Exprs: []ast.Vertex{
+									&ast.ScalarLnumber{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      24,
+											EndPos:        25,
+											StartCol:      25,
+											EndCol:        26,
+											StartColUTF16: 25,
+											EndColUTF16:   26,
+										},
+										NumberTkn: &token.Token{
+											ID:    token.T_LNUMBER,
+											Value: []byte("1"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      24,
+												EndPos:        25,
+												StartCol:      25,
+												EndCol:        26,
+												StartColUTF16: 25,
+												EndColUTF16:   26,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      23,
+														EndPos:        24,
+														StartCol:      24,
+														EndCol:        25,
+														StartColUTF16: 24,
+														EndColUTF16:   25,
+													},
+												},
+											},
+										},
+										Value: []byte("1"),
+									},
+									&ast.ScalarLnumber{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      27,
+											EndPos:        28,
+											StartCol:      28,
+											EndCol:        29,
+											StartColUTF16: 28,
+											EndColUTF16:   29,
+										},
+										NumberTkn: &token.Token{
+											ID:    token.T_LNUMBER,
+											Value: []byte("2"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      27,
+												EndPos:        28,
+												StartCol:      28,
+												EndCol:        29,
+												StartColUTF16: 28,
+												EndColUTF16:   29,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      26,
+														EndPos:        27,
+														StartCol:      27,
+														EndCol:        28,
+														StartColUTF16: 27,
+														EndColUTF16:   28,
+													},
+												},
+											},
+										},
+										Value: []byte("2"),
+									},
+								},
+								SeparatorTkns: []*token.Token{
+									{
+										ID:    token.ID(44),
+										Value: []byte(","),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      25,
+											EndPos:        26,
+											StartCol:      26,
+											EndCol:        27,
+											StartColUTF16: 26,
+											EndColUTF16:   27,
+										},
+									},
+									{
+										ID:    token.ID(44),
+										Value: []byte(","),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      28,
+											EndPos:        29,
+											StartCol:      29,
+											EndCol:        30,
+											StartColUTF16: 29,
+											EndColUTF16:   30,
+										},
+									},
+								},
+								DoubleArrowTkn: &token.Token{
+									ID:    token.T_DOUBLE_ARROW,
+									Value: []byte("=>"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      30,
+										EndPos:        32,
+										StartCol:      31,
+										EndCol:        33,
+										StartColUTF16: 31,
+										EndColUTF16:   33,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      29,
+												EndPos:        30,
+												StartCol:      30,
+												EndCol:        31,
+												StartColUTF16: 30,
+												EndColUTF16:   31,
+											},
+										},
+									},
+								},
+								ReturnExpr: &ast.ScalarString{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      33,
+										EndPos:        36,
+										StartCol:      34,
+										EndCol:        37,
+										StartColUTF16: 34,
+										EndColUTF16:   37,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_CONSTANT_ENCAPSED_STRING,
+										Value: []byte("'a'"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      33,
+											EndPos:        36,
+											StartCol:      34,
+											EndCol:        37,
+											StartColUTF16: 34,
+											EndColUTF16:   37,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      32,
+													EndPos:        33,
+													StartCol:      33,
+													EndCol:        34,
+													StartColUTF16: 33,
+													EndColUTF16:   34,
+												},
+											},
+										},
+									},
+									Value: []byte("'a'"),
+								},
+							},
+							&ast.MatchArm{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      38,
+									EndPos:        46,
+									StartCol:      39,
+									EndCol:        47,
+									StartColUTF16: 39,
+									EndColUTF16:   47,
+								},
+								Exprs: []ast.Vertex{
+									&ast.ScalarLnumber{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      38,
+											EndPos:        39,
+											StartCol:      39,
+											EndCol:        40,
+											StartColUTF16: 39,
+											EndColUTF16:   40,
+										},
+										NumberTkn: &token.Token{
+											ID:    token.T_LNUMBER,
+											Value: []byte("3"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      38,
+												EndPos:        39,
+												StartCol:      39,
+												EndCol:        40,
+												StartColUTF16: 39,
+												EndColUTF16:   40,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      37,
+														EndPos:        38,
+														StartCol:      38,
+														EndCol:        39,
+														StartColUTF16: 38,
+														EndColUTF16:   39,
+													},
+												},
+											},
+										},
+										Value: []byte("3"),
+									},
+								},
+								DoubleArrowTkn: &token.Token{
+									ID:    token.T_DOUBLE_ARROW,
+									Value: []byte("=>"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      40,
+										EndPos:        42,
+										StartCol:      41,
+										EndCol:        43,
+										StartColUTF16: 41,
+										EndColUTF16:   43,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      39,
+												EndPos:        40,
+												StartCol:      40,
+												EndCol:        41,
+												StartColUTF16: 40,
+												EndColUTF16:   41,
+											},
+										},
+									},
+								},
+								ReturnExpr: &ast.ScalarString{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      43,
+										EndPos:        46,
+										StartCol:      44,
+										EndCol:        47,
+										StartColUTF16: 44,
+										EndColUTF16:   47,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_CONSTANT_ENCAPSED_STRING,
+										Value: []byte("'b'"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      43,
+											EndPos:        46,
+											StartCol:      44,
+											EndCol:        47,
+											StartColUTF16: 44,
+											EndColUTF16:   47,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      42,
+													EndPos:        43,
+													StartCol:      43,
+													EndCol:        44,
+													StartColUTF16: 43,
+													EndColUTF16:   44,
+												},
+											},
+										},
+									},
+									Value: []byte("'b'"),
+								},
+							},
+							&ast.MatchArm{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      48,
+									EndPos:        63,
+									StartCol:      49,
+									EndCol:        64,
+									StartColUTF16: 49,
+									EndColUTF16:   64,
+								},
+								DefaultTkn: &token.Token{
+									ID:    token.T_DEFAULT,
+									Value: []byte("default"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      48,
+										EndPos:        55,
+										StartCol:      49,
+										EndCol:        56,
+										StartColUTF16: 49,
+										EndColUTF16:   56,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      47,
+												EndPos:        48,
+												StartCol:      48,
+												EndCol:        49,
+												StartColUTF16: 48,
+												EndColUTF16:   49,
+											},
+										},
+									},
+								},
+								DefaultCommaTkn: &token.Token{
+									ID:    token.ID(44),
+									Value: []byte(","),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      55,
+										EndPos:        56,
+										StartCol:      56,
+										EndCol:        57,
+										StartColUTF16: 56,
+										EndColUTF16:   57,
+									},
+								},
+								DoubleArrowTkn: &token.Token{
+									ID:    token.T_DOUBLE_ARROW,
+									Value: []byte("=>"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      57,
+										EndPos:        59,
+										StartCol:      58,
+										EndCol:        60,
+										StartColUTF16: 58,
+										EndColUTF16:   60,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      56,
+												EndPos:        57,
+												StartCol:      57,
+												EndCol:        58,
+												StartColUTF16: 57,
+												EndColUTF16:   58,
+											},
+										},
+									},
+								},
+								ReturnExpr: &ast.ScalarString{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      60,
+										EndPos:        63,
+										StartCol:      61,
+										EndCol:        64,
+										StartColUTF16: 61,
+										EndColUTF16:   64,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_CONSTANT_ENCAPSED_STRING,
+										Value: []byte("'c'"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      60,
+											EndPos:        63,
+											StartCol:      61,
+											EndCol:        64,
+											StartColUTF16: 61,
+											EndColUTF16:   64,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      59,
+													EndPos:        60,
+													StartCol:      60,
+													EndCol:        61,
+													StartColUTF16: 60,
+													EndColUTF16:   61,
+												},
+											},
+										},
+									},
+									Value: []byte("'c'"),
+								},
+							},
+						},
+						SeparatorTkns: []*token.Token{
+							{
+								ID:    token.ID(44),
+								Value: []byte(","),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      36,
+									EndPos:        37,
+									StartCol:      37,
+									EndCol:        38,
+									StartColUTF16: 37,
+									EndColUTF16:   38,
+								},
+							},
+							{
+								ID:    token.ID(44),
+								Value: []byte(","),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      46,
+									EndPos:        47,
+									StartCol:      47,
+									EndCol:        48,
+									StartColUTF16: 47,
+									EndColUTF16:   48,
+								},
+							},
+							{
+								ID:    token.ID(44),
+								Value: []byte(","),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      63,
+									EndPos:        64,
+									StartCol:      64,
+									EndCol:        65,
+									StartColUTF16: 64,
+									EndColUTF16:   65,
+								},
+							},
+						},
+						CloseCurlyBracketTkn: &token.Token{
+							ID:    token.ID(125),
+							Value: []byte("}"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      65,
+								EndPos:        66,
+								StartCol:      66,
+								EndCol:        67,
+								StartColUTF16: 66,
+								EndColUTF16:   67,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      64,
+										EndPos:        65,
+										StartCol:      65,
+										EndCol:        66,
+										StartColUTF16: 65,
+										EndColUTF16:   66,
+									},
+								},
+							},
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      66,
+						EndPos:        67,
+						StartCol:      67,
+						EndCol:        68,
+						StartColUTF16: 67,
+						EndColUTF16:   68,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8MatchEmpty(t *testing.T) {
+	src := `<?php match ($b) {};`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        20,
+			StartCol:      7,
+			EndCol:        21,
+			StartColUTF16: 7,
+			EndColUTF16:   21,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        20,
+					StartCol:      7,
+					EndCol:        21,
+					StartColUTF16: 7,
+					EndColUTF16:   21,
+				},
+				Expr: &ast.ExprMatch{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        19,
+						StartCol:      7,
+						EndCol:        20,
+						StartColUTF16: 7,
+						EndColUTF16:   20,
+					},
+					MatchTkn: &token.Token{
+						ID:    token.T_MATCH,
+						Value: []byte("match"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        11,
+							StartCol:      7,
+							EndCol:        12,
+							StartColUTF16: 7,
+							EndColUTF16:   12,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_OPEN_TAG,
+								Value: []byte("<?php"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      0,
+									EndPos:        5,
+									StartCol:      1,
+									EndCol:        6,
+									StartColUTF16: 1,
+									EndColUTF16:   6,
+								},
+							},
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      5,
+									EndPos:        6,
+									StartCol:      6,
+									EndCol:        7,
+									StartColUTF16: 6,
+									EndColUTF16:   7,
+								},
+							},
+						},
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      12,
+							EndPos:        13,
+							StartCol:      13,
+							EndCol:        14,
+							StartColUTF16: 13,
+							EndColUTF16:   14,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      11,
+									EndPos:        12,
+									StartCol:      12,
+									EndCol:        13,
+									StartColUTF16: 12,
+									EndColUTF16:   13,
+								},
+							},
+						},
+					},
+					Expr: &ast.ExprVariable{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      13,
+							EndPos:        15,
+							StartCol:      14,
+							EndCol:        16,
+							StartColUTF16: 14,
+							EndColUTF16:   16,
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      13,
+								EndPos:        15,
+								StartCol:      14,
+								EndCol:        16,
+								StartColUTF16: 14,
+								EndColUTF16:   16,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$b"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      13,
+									EndPos:        15,
+									StartCol:      14,
+									EndCol:        16,
+									StartColUTF16: 14,
+									EndColUTF16:   16,
+								},
+							},
+							Value: []byte("$b"),
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      15,
+							EndPos:        16,
+							StartCol:      16,
+							EndCol:        17,
+							StartColUTF16: 16,
+							EndColUTF16:   17,
+						},
+					},
+					OpenCurlyBracketTkn: &token.Token{
+						ID:    token.ID(123),
+						Value: []byte("{"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      17,
+							EndPos:        18,
+							StartCol:      18,
+							EndCol:        19,
+							StartColUTF16: 18,
+							EndColUTF16:   19,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      16,
+									EndPos:        17,
+									StartCol:      17,
+									EndCol:        18,
+									StartColUTF16: 17,
+									EndColUTF16:   18,
+								},
+							},
+						},
+					},
+					CloseCurlyBracketTkn: &token.Token{
+						ID:    token.ID(125),
+						Value: []byte("}"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      18,
+							EndPos:        19,
+							StartCol:      19,
+							EndCol:        20,
+							StartColUTF16: 19,
+							EndColUTF16:   20,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      19,
+						EndPos:        20,
+						StartCol:      20,
+						EndCol:        21,
+						StartColUTF16: 20,
+						EndColUTF16:   21,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8ThrowExpr(t *testing.T) {
+	src := `<?php throw $e; $a ?? throw new E(); fn() => throw $e;`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        54,
+			StartCol:      7,
+			EndCol:        55,
+			StartColUTF16: 7,
+			EndColUTF16:   55,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        15,
+					StartCol:      7,
+					EndCol:        16,
+					StartColUTF16: 7,
+					EndColUTF16:   16,
+				},
+				Expr: &ast.ExprThrow{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        14,
+						StartCol:      7,
+						EndCol:        15,
+						StartColUTF16: 7,
+						EndColUTF16:   15,
+					},
+					ThrowTkn: &token.Token{
+						ID:    token.T_THROW,
+						Value: []byte("throw"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        11,
+							StartCol:      7,
+							EndCol:        12,
+							StartColUTF16: 7,
+							EndColUTF16:   12,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_OPEN_TAG,
+								Value: []byte("<?php"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      0,
+									EndPos:        5,
+									StartCol:      1,
+									EndCol:        6,
+									StartColUTF16: 1,
+									EndColUTF16:   6,
+								},
+							},
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      5,
+									EndPos:        6,
+									StartCol:      6,
+									EndCol:        7,
+									StartColUTF16: 6,
+									EndColUTF16:   7,
+								},
+							},
+						},
+					},
+					Expr: &ast.ExprVariable{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      12,
+							EndPos:        14,
+							StartCol:      13,
+							EndCol:        15,
+							StartColUTF16: 13,
+							EndColUTF16:   15,
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      12,
+								EndPos:        14,
+								StartCol:      13,
+								EndCol:        15,
+								StartColUTF16: 13,
+								EndColUTF16:   15,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$e"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      12,
+									EndPos:        14,
+									StartCol:      13,
+									EndCol:        15,
+									StartColUTF16: 13,
+									EndColUTF16:   15,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      11,
+											EndPos:        12,
+											StartCol:      12,
+											EndCol:        13,
+											StartColUTF16: 12,
+											EndColUTF16:   13,
+										},
+									},
+								},
+							},
+							Value: []byte("$e"),
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      14,
+						EndPos:        15,
+						StartCol:      15,
+						EndCol:        16,
+						StartColUTF16: 15,
+						EndColUTF16:   16,
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      16,
+					EndPos:        36,
+					StartCol:      17,
+					EndCol:        37,
+					StartColUTF16: 17,
+					EndColUTF16:   37,
+				},
+				Expr: &ast.ExprBinaryCoalesce{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      16,
+						EndPos:        35,
+						StartCol:      17,
+						EndCol:        36,
+						StartColUTF16: 17,
+						EndColUTF16:   36,
+					},
+					Left: &ast.ExprVariable{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      16,
+							EndPos:        18,
+							StartCol:      17,
+							EndCol:        19,
+							StartColUTF16: 17,
+							EndColUTF16:   19,
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      16,
+								EndPos:        18,
+								StartCol:      17,
+								EndCol:        19,
+								StartColUTF16: 17,
+								EndColUTF16:   19,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$a"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      16,
+									EndPos:        18,
+									StartCol:      17,
+									EndCol:        19,
+									StartColUTF16: 17,
+									EndColUTF16:   19,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      15,
+											EndPos:        16,
+											StartCol:      16,
+											EndCol:        17,
+											StartColUTF16: 16,
+											EndColUTF16:   17,
+										},
+									},
+								},
+							},
+							Value: []byte("$a"),
+						},
+					},
+					OpTkn: &token.Token{
+						ID:    token.T_COALESCE,
+						Value: []byte("??"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      19,
+							EndPos:        21,
+							StartCol:      20,
+							EndCol:        22,
+							StartColUTF16: 20,
+							EndColUTF16:   22,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      18,
+									EndPos:        19,
+									StartCol:      19,
+									EndCol:        20,
+									StartColUTF16: 19,
+									EndColUTF16:   20,
+								},
+							},
+						},
+					},
+					Right: &ast.ExprThrow{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      22,
+							EndPos:        35,
+							StartCol:      23,
+							EndCol:        36,
+							StartColUTF16: 23,
+							EndColUTF16:   36,
+						},
+						ThrowTkn: &token.Token{
+							ID:    token.T_THROW,
+							Value: []byte("throw"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      22,
+								EndPos:        27,
+								StartCol:      23,
+								EndCol:        28,
+								StartColUTF16: 23,
+								EndColUTF16:   28,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      21,
+										EndPos:        22,
+										StartCol:      22,
+										EndCol:        23,
+										StartColUTF16: 22,
+										EndColUTF16:   23,
+									},
+								},
+							},
+						},
+						Expr: &ast.ExprNew{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      28,
+								EndPos:        35,
+								StartCol:      29,
+								EndCol:        36,
+								StartColUTF16: 29,
+								EndColUTF16:   36,
+							},
+							NewTkn: &token.Token{
+								ID:    token.T_NEW,
+								Value: []byte("new"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      28,
+									EndPos:        31,
+									StartCol:      29,
+									EndCol:        32,
+									StartColUTF16: 29,
+									EndColUTF16:   32,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      27,
+											EndPos:        28,
+											StartCol:      28,
+											EndCol:        29,
+											StartColUTF16: 28,
+											EndColUTF16:   29,
+										},
+									},
+								},
+							},
+							Class: &ast.Name{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      32,
+									EndPos:        33,
+									StartCol:      33,
+									EndCol:        34,
+									StartColUTF16: 33,
+									EndColUTF16:   34,
+								},
+								Parts: []ast.Vertex{
+									&ast.NamePart{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      32,
+											EndPos:        33,
+											StartCol:      33,
+											EndCol:        34,
+											StartColUTF16: 33,
+											EndColUTF16:   34,
+										},
+										StringTkn: &token.Token{
+											ID:    token.T_STRING,
+											Value: []byte("E"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      32,
+												EndPos:        33,
+												StartCol:      33,
+												EndCol:        34,
+												StartColUTF16: 33,
+												EndColUTF16:   34,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      31,
+														EndPos:        32,
+														StartCol:      32,
+														EndCol:        33,
+														StartColUTF16: 32,
+														EndColUTF16:   33,
+													},
+												},
+											},
+										},
+										Value: []byte("E"),
+									},
+								},
+							},
+							OpenParenthesisTkn: &token.Token{
+								ID:    token.ID(40),
+								Value: []byte("("),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      33,
+									EndPos:        34,
+									StartCol:      34,
+									EndCol:        35,
+									StartColUTF16: 34,
+									EndColUTF16:   35,
+								},
+							},
+							CloseParenthesisTkn: &token.Token{
+								ID:    token.ID(41),
+								Value: []byte(")"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      34,
+									EndPos:        35,
+									StartCol:      35,
+									EndCol:        36,
+									StartColUTF16: 35,
+									EndColUTF16:   36,
+								},
+							},
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      35,
+						EndPos:        36,
+						StartCol:      36,
+						EndCol:        37,
+						StartColUTF16: 36,
+						EndColUTF16:   37,
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      37,
+					EndPos:        54,
+					StartCol:      38,
+					EndCol:        55,
+					StartColUTF16: 38,
+					EndColUTF16:   55,
+				},
+				Expr: &ast.ExprArrowFunction{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      37,
+						EndPos:        53,
+						StartCol:      38,
+						EndCol:        54,
+						StartColUTF16: 38,
+						EndColUTF16:   54,
+					},
+					FnTkn: &token.Token{
+						ID:    token.T_FN,
+						Value: []byte("fn"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      37,
+							EndPos:        39,
+							StartCol:      38,
+							EndCol:        40,
+							StartColUTF16: 38,
+							EndColUTF16:   40,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      36,
+									EndPos:        37,
+									StartCol:      37,
+									EndCol:        38,
+									StartColUTF16: 37,
+									EndColUTF16:   38,
+								},
+							},
+						},
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      39,
+							EndPos:        40,
+							StartCol:      40,
+							EndCol:        41,
+							StartColUTF16: 40,
+							EndColUTF16:   41,
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      40,
+							EndPos:        41,
+							StartCol:      41,
+							EndCol:        42,
+							StartColUTF16: 41,
+							EndColUTF16:   42,
+						},
+					},
+					DoubleArrowTkn: &token.Token{
+						ID:    token.T_DOUBLE_ARROW,
+						Value: []byte("=>"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      42,
+							EndPos:        44,
+							StartCol:      43,
+							EndCol:        45,
+							StartColUTF16: 43,
+							EndColUTF16:   45,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      41,
+									EndPos:        42,
+									StartCol:      42,
+									EndCol:        43,
+									StartColUTF16: 42,
+									EndColUTF16:   43,
+								},
+							},
+						},
+					},
+					Expr: &ast.ExprThrow{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      45,
+							EndPos:        53,
+							StartCol:      46,
+							EndCol:        54,
+							StartColUTF16: 46,
+							EndColUTF16:   54,
+						},
+						ThrowTkn: &token.Token{
+							ID:    token.T_THROW,
+							Value: []byte("throw"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      45,
+								EndPos:        50,
+								StartCol:      46,
+								EndCol:        51,
+								StartColUTF16: 46,
+								EndColUTF16:   51,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      44,
+										EndPos:        45,
+										StartCol:      45,
+										EndCol:        46,
+										StartColUTF16: 45,
+										EndColUTF16:   46,
+									},
+								},
+							},
+						},
+						Expr: &ast.ExprVariable{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      51,
+								EndPos:        53,
+								StartCol:      52,
+								EndCol:        54,
+								StartColUTF16: 52,
+								EndColUTF16:   54,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      51,
+									EndPos:        53,
+									StartCol:      52,
+									EndCol:        54,
+									StartColUTF16: 52,
+									EndColUTF16:   54,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_VARIABLE,
+									Value: []byte("$e"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      51,
+										EndPos:        53,
+										StartCol:      52,
+										EndCol:        54,
+										StartColUTF16: 52,
+										EndColUTF16:   54,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      50,
+												EndPos:        51,
+												StartCol:      51,
+												EndCol:        52,
+												StartColUTF16: 51,
+												EndColUTF16:   52,
+											},
+										},
+									},
+								},
+								Value: []byte("$e"),
+							},
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      53,
+						EndPos:        54,
+						StartCol:      54,
+						EndCol:        55,
+						StartColUTF16: 54,
+						EndColUTF16:   55,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8MatchAsIdentifier(t *testing.T) {
+	src := `<?php $a->match; Foo::match(); class Bar { function match() {} }`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        64,
+			StartCol:      7,
+			EndCol:        65,
+			StartColUTF16: 7,
+			EndColUTF16:   65,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        16,
+					StartCol:      7,
+					EndCol:        17,
+					StartColUTF16: 7,
+					EndColUTF16:   17,
+				},
+				Expr: &ast.ExprPropertyFetch{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        15,
+						StartCol:      7,
+						EndCol:        16,
+						StartColUTF16: 7,
+						EndColUTF16:   16,
+					},
+					Var: &ast.ExprVariable{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        8,
+							StartCol:      7,
+							EndCol:        9,
+							StartColUTF16: 7,
+							EndColUTF16:   9,
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      6,
+								EndPos:        8,
+								StartCol:      7,
+								EndCol:        9,
+								StartColUTF16: 7,
+								EndColUTF16:   9,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$a"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      6,
+									EndPos:        8,
+									StartCol:      7,
+									EndCol:        9,
+									StartColUTF16: 7,
+									EndColUTF16:   9,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_OPEN_TAG,
+										Value: []byte("<?php"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      0,
+											EndPos:        5,
+											StartCol:      1,
+											EndCol:        6,
+											StartColUTF16: 1,
+											EndColUTF16:   6,
+										},
+									},
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      5,
+											EndPos:        6,
+											StartCol:      6,
+											EndCol:        7,
+											StartColUTF16: 6,
+											EndColUTF16:   7,
+										},
+									},
+								},
+							},
+							Value: []byte("$a"),
+						},
+					},
+					ObjectOperatorTkn: &token.Token{
+						ID:    token.T_OBJECT_OPERATOR,
+						Value: []byte("->"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      8,
+							EndPos:        10,
+							StartCol:      9,
+							EndCol:        11,
+							StartColUTF16: 9,
+							EndColUTF16:   11,
+						},
+					},
+					Prop: &ast.Identifier{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      10,
+							EndPos:        15,
+							StartCol:      11,
+							EndCol:        16,
+							StartColUTF16: 11,
+							EndColUTF16:   16,
+						},
+						IdentifierTkn: &token.Token{
+							ID:    token.T_STRING,
+							Value: []byte("match"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      10,
+								EndPos:        15,
+								StartCol:      11,
+								EndCol:        16,
+								StartColUTF16: 11,
+								EndColUTF16:   16,
+							},
+						},
+						Value: []byte("match"),
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      15,
+						EndPos:        16,
+						StartCol:      16,
+						EndCol:        17,
+						StartColUTF16: 16,
+						EndColUTF16:   17,
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      17,
+					EndPos:        30,
+					StartCol:      18,
+					EndCol:        31,
+					StartColUTF16: 18,
+					EndColUTF16:   31,
+				},
+				Expr: &ast.ExprStaticCall{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      17,
+						EndPos:        29,
+						StartCol:      18,
+						EndCol:        30,
+						StartColUTF16: 18,
+						EndColUTF16:   30,
+					},
+					Class: &ast.Name{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      17,
+							EndPos:        20,
+							StartCol:      18,
+							EndCol:        21,
+							StartColUTF16: 18,
+							EndColUTF16:   21,
+						},
+						Parts: []ast.Vertex{
+							&ast.NamePart{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      17,
+									EndPos:        20,
+									StartCol:      18,
+									EndCol:        21,
+									StartColUTF16: 18,
+									EndColUTF16:   21,
+								},
+								StringTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("Foo"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      17,
+										EndPos:        20,
+										StartCol:      18,
+										EndCol:        21,
+										StartColUTF16: 18,
+										EndColUTF16:   21,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      16,
+												EndPos:        17,
+												StartCol:      17,
+												EndCol:        18,
+												StartColUTF16: 17,
+												EndColUTF16:   18,
+											},
+										},
+									},
+								},
+								Value: []byte("Foo"),
+							},
+						},
+					},
+					DoubleColonTkn: &token.Token{
+						ID:    token.T_PAAMAYIM_NEKUDOTAYIM,
+						Value: []byte("::"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      20,
+							EndPos:        22,
+							StartCol:      21,
+							EndCol:        23,
+							StartColUTF16: 21,
+							EndColUTF16:   23,
+						},
+					},
+					Call: &ast.Identifier{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      22,
+							EndPos:        27,
+							StartCol:      23,
+							EndCol:        28,
+							StartColUTF16: 23,
+							EndColUTF16:   28,
+						},
+						IdentifierTkn: &token.Token{
+							ID:    token.T_MATCH,
+							Value: []byte("match"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      22,
+								EndPos:        27,
+								StartCol:      23,
+								EndCol:        28,
+								StartColUTF16: 23,
+								EndColUTF16:   28,
+							},
+						},
+						Value: []byte("match"),
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      27,
+							EndPos:        28,
+							StartCol:      28,
+							EndCol:        29,
+							StartColUTF16: 28,
+							EndColUTF16:   29,
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      28,
+							EndPos:        29,
+							StartCol:      29,
+							EndCol:        30,
+							StartColUTF16: 29,
+							EndColUTF16:   30,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      29,
+						EndPos:        30,
+						StartCol:      30,
+						EndCol:        31,
+						StartColUTF16: 30,
+						EndColUTF16:   31,
+					},
+				},
+			},
+			&ast.StmtClass{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      31,
+					EndPos:        64,
+					StartCol:      32,
+					EndCol:        65,
+					StartColUTF16: 32,
+					EndColUTF16:   65,
+				},
+				ClassTkn: &token.Token{
+					ID:    token.T_CLASS,
+					Value: []byte("class"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      31,
+						EndPos:        36,
+						StartCol:      32,
+						EndCol:        37,
+						StartColUTF16: 32,
+						EndColUTF16:   37,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      30,
+								EndPos:        31,
+								StartCol:      31,
+								EndCol:        32,
+								StartColUTF16: 31,
+								EndColUTF16:   32,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      37,
+						EndPos:        40,
+						StartCol:      38,
+						EndCol:        41,
+						StartColUTF16: 38,
+						EndColUTF16:   41,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("Bar"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      37,
+							EndPos:        40,
+							StartCol:      38,
+							EndCol:        41,
+							StartColUTF16: 38,
+							EndColUTF16:   41,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      36,
+									EndPos:        37,
+									StartCol:      37,
+									EndCol:        38,
+									StartColUTF16: 37,
+									EndColUTF16:   38,
+								},
+							},
+						},
+					},
+					Value: []byte("Bar"),
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      41,
+						EndPos:        42,
+						StartCol:      42,
+						EndCol:        43,
+						StartColUTF16: 42,
+						EndColUTF16:   43,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      40,
+								EndPos:        41,
+								StartCol:      41,
+								EndCol:        42,
+								StartColUTF16: 41,
+								EndColUTF16:   42,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{
+					&ast.StmtClassMethod{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      43,
+							EndPos:        62,
+							StartCol:      44,
+							EndCol:        63,
+							StartColUTF16: 44,
+							EndColUTF16:   63,
+						},
+						FunctionTkn: &token.Token{
+							ID:    token.T_FUNCTION,
+							Value: []byte("function"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      43,
+								EndPos:        51,
+								StartCol:      44,
+								EndCol:        52,
+								StartColUTF16: 44,
+								EndColUTF16:   52,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      42,
+										EndPos:        43,
+										StartCol:      43,
+										EndCol:        44,
+										StartColUTF16: 43,
+										EndColUTF16:   44,
+									},
+								},
+							},
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      52,
+								EndPos:        57,
+								StartCol:      53,
+								EndCol:        58,
+								StartColUTF16: 53,
+								EndColUTF16:   58,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_MATCH,
+								Value: []byte("match"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      52,
+									EndPos:        57,
+									StartCol:      53,
+									EndCol:        58,
+									StartColUTF16: 53,
+									EndColUTF16:   58,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      51,
+											EndPos:        52,
+											StartCol:      52,
+											EndCol:        53,
+											StartColUTF16: 52,
+											EndColUTF16:   53,
+										},
+									},
+								},
+							},
+							Value: []byte("match"),
+						},
+						OpenParenthesisTkn: &token.Token{
+							ID:    token.ID(40),
+							Value: []byte("("),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      57,
+								EndPos:        58,
+								StartCol:      58,
+								EndCol:        59,
+								StartColUTF16: 58,
+								EndColUTF16:   59,
+							},
+						},
+						CloseParenthesisTkn: &token.Token{
+							ID:    token.ID(41),
+							Value: []byte(")"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      58,
+								EndPos:        59,
+								StartCol:      59,
+								EndCol:        60,
+								StartColUTF16: 59,
+								EndColUTF16:   60,
+							},
+						},
+						Stmt: &ast.StmtStmtList{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      60,
+								EndPos:        62,
+								StartCol:      61,
+								EndCol:        63,
+								StartColUTF16: 61,
+								EndColUTF16:   63,
+							},
+							OpenCurlyBracketTkn: &token.Token{
+								ID:    token.ID(123),
+								Value: []byte("{"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      60,
+									EndPos:        61,
+									StartCol:      61,
+									EndCol:        62,
+									StartColUTF16: 61,
+									EndColUTF16:   62,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      59,
+											EndPos:        60,
+											StartCol:      60,
+											EndCol:        61,
+											StartColUTF16: 60,
+											EndColUTF16:   61,
+										},
+									},
+								},
+							},
+							Stmts: []ast.Vertex{},
+							CloseCurlyBracketTkn: &token.Token{
+								ID:    token.ID(125),
+								Value: []byte("}"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      61,
+									EndPos:        62,
+									StartCol:      62,
+									EndCol:        63,
+									StartColUTF16: 62,
+									EndColUTF16:   63,
+								},
+							},
+						},
+					},
+				},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      63,
+						EndPos:        64,
+						StartCol:      64,
+						EndCol:        65,
+						StartColUTF16: 64,
+						EndColUTF16:   65,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      62,
+								EndPos:        63,
+								StartCol:      63,
+								EndCol:        64,
+								StartColUTF16: 63,
+								EndColUTF16:   64,
+							},
+						},
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+func TestPhp81Enum(t *testing.T) {
+	src := `<?php enum Suit: string implements I { case Hearts = 'H'; case Spades; const X = self::Hearts; }`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        96,
+			StartCol:      7,
+			EndCol:        97,
+			StartColUTF16: 7,
+			EndColUTF16:   97,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtEnum{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        96,
+					StartCol:      7,
+					EndCol:        97,
+					StartColUTF16: 7,
+					EndColUTF16:   97,
+				},
+				EnumTkn: &token.Token{
+					ID:    token.T_ENUM,
+					Value: []byte("enum"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        10,
+						StartCol:      7,
+						EndCol:        11,
+						StartColUTF16: 7,
+						EndColUTF16:   11,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_OPEN_TAG,
+							Value: []byte("<?php"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      0,
+								EndPos:        5,
+								StartCol:      1,
+								EndCol:        6,
+								StartColUTF16: 1,
+								EndColUTF16:   6,
+							},
+						},
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      5,
+								EndPos:        6,
+								StartCol:      6,
+								EndCol:        7,
+								StartColUTF16: 6,
+								EndColUTF16:   7,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      11,
+						EndPos:        15,
+						StartCol:      12,
+						EndCol:        16,
+						StartColUTF16: 12,
+						EndColUTF16:   16,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("Suit"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      11,
+							EndPos:        15,
+							StartCol:      12,
+							EndCol:        16,
+							StartColUTF16: 12,
+							EndColUTF16:   16,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      10,
+									EndPos:        11,
+									StartCol:      11,
+									EndCol:        12,
+									StartColUTF16: 11,
+									EndColUTF16:   12,
+								},
+							},
+						},
+					},
+					Value: []byte("Suit"),
+				},
+				ColonTkn: &token.Token{
+					ID:    token.ID(58),
+					Value: []byte(":"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      15,
+						EndPos:        16,
+						StartCol:      16,
+						EndCol:        17,
+						StartColUTF16: 16,
+						EndColUTF16:   17,
+					},
+				},
+				Type: &ast.Name{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      17,
+						EndPos:        23,
+						StartCol:      18,
+						EndCol:        24,
+						StartColUTF16: 18,
+						EndColUTF16:   24,
+					},
+					Parts: []ast.Vertex{
+						&ast.NamePart{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      17,
+								EndPos:        23,
+								StartCol:      18,
+								EndCol:        24,
+								StartColUTF16: 18,
+								EndColUTF16:   24,
+							},
+							StringTkn: &token.Token{
+								ID:    token.T_STRING,
+								Value: []byte("string"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      17,
+									EndPos:        23,
+									StartCol:      18,
+									EndCol:        24,
+									StartColUTF16: 18,
+									EndColUTF16:   24,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      16,
+											EndPos:        17,
+											StartCol:      17,
+											EndCol:        18,
+											StartColUTF16: 17,
+											EndColUTF16:   18,
+										},
+									},
+								},
+							},
+							Value: []byte("string"),
+						},
+					},
+				},
+				ImplementsTkn: &token.Token{
+					ID:    token.T_IMPLEMENTS,
+					Value: []byte("implements"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      24,
+						EndPos:        34,
+						StartCol:      25,
+						EndCol:        35,
+						StartColUTF16: 25,
+						EndColUTF16:   35,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      23,
+								EndPos:        24,
+								StartCol:      24,
+								EndCol:        25,
+								StartColUTF16: 24,
+								EndColUTF16:   25,
+							},
+						},
+					},
+				},
+				Implements: []ast.Vertex{
+					&ast.Name{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      35,
+							EndPos:        36,
+							StartCol:      36,
+							EndCol:        37,
+							StartColUTF16: 36,
+							EndColUTF16:   37,
+						},
+						Parts: []ast.Vertex{
+							&ast.NamePart{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      35,
+									EndPos:        36,
+									StartCol:      36,
+									EndCol:        37,
+									StartColUTF16: 36,
+									EndColUTF16:   37,
+								},
+								StringTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("I"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      35,
+										EndPos:        36,
+										StartCol:      36,
+										EndCol:        37,
+										StartColUTF16: 36,
+										EndColUTF16:   37,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      34,
+												EndPos:        35,
+												StartCol:      35,
+												EndCol:        36,
+												StartColUTF16: 35,
+												EndColUTF16:   36,
+											},
+										},
+									},
+								},
+								Value: []byte("I"),
+							},
+						},
+					},
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      37,
+						EndPos:        38,
+						StartCol:      38,
+						EndCol:        39,
+						StartColUTF16: 38,
+						EndColUTF16:   39,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      36,
+								EndPos:        37,
+								StartCol:      37,
+								EndCol:        38,
+								StartColUTF16: 37,
+								EndColUTF16:   38,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{
+					&ast.StmtEnumCase{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      39,
+							EndPos:        57,
+							StartCol:      40,
+							EndCol:        58,
+							StartColUTF16: 40,
+							EndColUTF16:   58,
+						},
+						CaseTkn: &token.Token{
+							ID:    token.T_CASE,
+							Value: []byte("case"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      39,
+								EndPos:        43,
+								StartCol:      40,
+								EndCol:        44,
+								StartColUTF16: 40,
+								EndColUTF16:   44,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      38,
+										EndPos:        39,
+										StartCol:      39,
+										EndCol:        40,
+										StartColUTF16: 39,
+										EndColUTF16:   40,
+									},
+								},
+							},
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      44,
+								EndPos:        50,
+								StartCol:      45,
+								EndCol:        51,
+								StartColUTF16: 45,
+								EndColUTF16:   51,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_STRING,
+								Value: []byte("Hearts"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      44,
+									EndPos:        50,
+									StartCol:      45,
+									EndCol:        51,
+									StartColUTF16: 45,
+									EndColUTF16:   51,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      43,
+											EndPos:        44,
+											StartCol:      44,
+											EndCol:        45,
+											StartColUTF16: 44,
+											EndColUTF16:   45,
+										},
+									},
+								},
+							},
+							Value: []byte("Hearts"),
+						},
+						EqualTkn: &token.Token{
+							ID:    token.ID(61),
+							Value: []byte("="),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      51,
+								EndPos:        52,
+								StartCol:      52,
+								EndCol:        53,
+								StartColUTF16: 52,
+								EndColUTF16:   53,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      50,
+										EndPos:        51,
+										StartCol:      51,
+										EndCol:        52,
+										StartColUTF16: 51,
+										EndColUTF16:   52,
+									},
+								},
+							},
+						},
+						Expr: &ast.ScalarString{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      53,
+								EndPos:        56,
+								StartCol:      54,
+								EndCol:        57,
+								StartColUTF16: 54,
+								EndColUTF16:   57,
+							},
+							StringTkn: &token.Token{
+								ID:    token.T_CONSTANT_ENCAPSED_STRING,
+								Value: []byte("'H'"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      53,
+									EndPos:        56,
+									StartCol:      54,
+									EndCol:        57,
+									StartColUTF16: 54,
+									EndColUTF16:   57,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      52,
+											EndPos:        53,
+											StartCol:      53,
+											EndCol:        54,
+											StartColUTF16: 53,
+											EndColUTF16:   54,
+										},
+									},
+								},
+							},
+							Value: []byte("'H'"),
+						},
+						SemiColonTkn: &token.Token{
+							ID:    token.ID(59),
+							Value: []byte(";"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      56,
+								EndPos:        57,
+								StartCol:      57,
+								EndCol:        58,
+								StartColUTF16: 57,
+								EndColUTF16:   58,
+							},
+						},
+					},
+					&ast.StmtEnumCase{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      58,
+							EndPos:        70,
+							StartCol:      59,
+							EndCol:        71,
+							StartColUTF16: 59,
+							EndColUTF16:   71,
+						},
+						CaseTkn: &token.Token{
+							ID:    token.T_CASE,
+							Value: []byte("case"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      58,
+								EndPos:        62,
+								StartCol:      59,
+								EndCol:        63,
+								StartColUTF16: 59,
+								EndColUTF16:   63,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      57,
+										EndPos:        58,
+										StartCol:      58,
+										EndCol:        59,
+										StartColUTF16: 58,
+										EndColUTF16:   59,
+									},
+								},
+							},
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      63,
+								EndPos:        69,
+								StartCol:      64,
+								EndCol:        70,
+								StartColUTF16: 64,
+								EndColUTF16:   70,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_STRING,
+								Value: []byte("Spades"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      63,
+									EndPos:        69,
+									StartCol:      64,
+									EndCol:        70,
+									StartColUTF16: 64,
+									EndColUTF16:   70,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      62,
+											EndPos:        63,
+											StartCol:      63,
+											EndCol:        64,
+											StartColUTF16: 63,
+											EndColUTF16:   64,
+										},
+									},
+								},
+							},
+							Value: []byte("Spades"),
+						},
+						SemiColonTkn: &token.Token{
+							ID:    token.ID(59),
+							Value: []byte(";"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      69,
+								EndPos:        70,
+								StartCol:      70,
+								EndCol:        71,
+								StartColUTF16: 70,
+								EndColUTF16:   71,
+							},
+						},
+					},
+					&ast.StmtClassConstList{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      71,
+							EndPos:        94,
+							StartCol:      72,
+							EndCol:        95,
+							StartColUTF16: 72,
+							EndColUTF16:   95,
+						},
+						ConstTkn: &token.Token{
+							ID:    token.T_CONST,
+							Value: []byte("const"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      71,
+								EndPos:        76,
+								StartCol:      72,
+								EndCol:        77,
+								StartColUTF16: 72,
+								EndColUTF16:   77,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      70,
+										EndPos:        71,
+										StartCol:      71,
+										EndCol:        72,
+										StartColUTF16: 71,
+										EndColUTF16:   72,
+									},
+								},
+							},
+						},
+						Consts: []ast.Vertex{
+							&ast.StmtConstant{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      77,
+									EndPos:        93,
+									StartCol:      78,
+									EndCol:        94,
+									StartColUTF16: 78,
+									EndColUTF16:   94,
+								},
+								Name: &ast.Identifier{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      77,
+										EndPos:        78,
+										StartCol:      78,
+										EndCol:        79,
+										StartColUTF16: 78,
+										EndColUTF16:   79,
+									},
+									IdentifierTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("X"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      77,
+											EndPos:        78,
+											StartCol:      78,
+											EndCol:        79,
+											StartColUTF16: 78,
+											EndColUTF16:   79,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      76,
+													EndPos:        77,
+													StartCol:      77,
+													EndCol:        78,
+													StartColUTF16: 77,
+													EndColUTF16:   78,
+												},
+											},
+										},
+									},
+									Value: []byte("X"),
+								},
+								EqualTkn: &token.Token{
+									ID:    token.ID(61),
+									Value: []byte("="),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      79,
+										EndPos:        80,
+										StartCol:      80,
+										EndCol:        81,
+										StartColUTF16: 80,
+										EndColUTF16:   81,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      78,
+												EndPos:        79,
+												StartCol:      79,
+												EndCol:        80,
+												StartColUTF16: 79,
+												EndColUTF16:   80,
+											},
+										},
+									},
+								},
+								Expr: &ast.ExprClassConstFetch{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      81,
+										EndPos:        93,
+										StartCol:      82,
+										EndCol:        94,
+										StartColUTF16: 82,
+										EndColUTF16:   94,
+									},
+									Class: &ast.Name{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      81,
+											EndPos:        85,
+											StartCol:      82,
+											EndCol:        86,
+											StartColUTF16: 82,
+											EndColUTF16:   86,
+										},
+										Parts: []ast.Vertex{
+											&ast.NamePart{
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      81,
+													EndPos:        85,
+													StartCol:      82,
+													EndCol:        86,
+													StartColUTF16: 82,
+													EndColUTF16:   86,
+												},
+												StringTkn: &token.Token{
+													ID:    token.T_STRING,
+													Value: []byte("self"),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      81,
+														EndPos:        85,
+														StartCol:      82,
+														EndCol:        86,
+														StartColUTF16: 82,
+														EndColUTF16:   86,
+													},
+													FreeFloating: []*token.Token{
+														{
+															ID:    token.T_WHITESPACE,
+															Value: []byte(" "),
+															Position: &position.Position{
+																StartLine:     1,
+																EndLine:       1,
+																StartPos:      80,
+																EndPos:        81,
+																StartCol:      81,
+																EndCol:        82,
+																StartColUTF16: 81,
+																EndColUTF16:   82,
+															},
+														},
+													},
+												},
+												Value: []byte("self"),
+											},
+										},
+									},
+									DoubleColonTkn: &token.Token{
+										ID:    token.T_PAAMAYIM_NEKUDOTAYIM,
+										Value: []byte("::"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      85,
+											EndPos:        87,
+											StartCol:      86,
+											EndCol:        88,
+											StartColUTF16: 86,
+											EndColUTF16:   88,
+										},
+									},
+									Const: &ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      87,
+											EndPos:        93,
+											StartCol:      88,
+											EndCol:        94,
+											StartColUTF16: 88,
+											EndColUTF16:   94,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_STRING,
+											Value: []byte("Hearts"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      87,
+												EndPos:        93,
+												StartCol:      88,
+												EndCol:        94,
+												StartColUTF16: 88,
+												EndColUTF16:   94,
+											},
+										},
+										Value: []byte("Hearts"),
+									},
+								},
+							},
+						},
+						SemiColonTkn: &token.Token{
+							ID:    token.ID(59),
+							Value: []byte(";"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      93,
+								EndPos:        94,
+								StartCol:      94,
+								EndCol:        95,
+								StartColUTF16: 94,
+								EndColUTF16:   95,
+							},
+						},
+					},
+				},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      95,
+						EndPos:        96,
+						StartCol:      96,
+						EndCol:        97,
+						StartColUTF16: 96,
+						EndColUTF16:   97,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      94,
+								EndPos:        95,
+								StartCol:      95,
+								EndCol:        96,
+								StartColUTF16: 95,
+								EndColUTF16:   96,
+							},
+						},
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 1,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp81EnumAsIdentifier(t *testing.T) {
+	src := `<?php class enum extends Foo {} $a->enum; enum::X;`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        50,
+			StartCol:      7,
+			EndCol:        51,
+			StartColUTF16: 7,
+			EndColUTF16:   51,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtClass{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        31,
+					StartCol:      7,
+					EndCol:        32,
+					StartColUTF16: 7,
+					EndColUTF16:   32,
+				},
+				ClassTkn: &token.Token{
+					ID:    token.T_CLASS,
+					Value: []byte("class"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        11,
+						StartCol:      7,
+						EndCol:        12,
+						StartColUTF16: 7,
+						EndColUTF16:   12,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_OPEN_TAG,
+							Value: []byte("<?php"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      0,
+								EndPos:        5,
+								StartCol:      1,
+								EndCol:        6,
+								StartColUTF16: 1,
+								EndColUTF16:   6,
+							},
+						},
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      5,
+								EndPos:        6,
+								StartCol:      6,
+								EndCol:        7,
+								StartColUTF16: 6,
+								EndColUTF16:   7,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      12,
+						EndPos:        16,
+						StartCol:      13,
+						EndCol:        17,
+						StartColUTF16: 13,
+						EndColUTF16:   17,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("enum"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      12,
+							EndPos:        16,
+							StartCol:      13,
+							EndCol:        17,
+							StartColUTF16: 13,
+							EndColUTF16:   17,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      11,
+									EndPos:        12,
+									StartCol:      12,
+									EndCol:        13,
+									StartColUTF16: 12,
+									EndColUTF16:   13,
+								},
+							},
+						},
+					},
+					Value: []byte("enum"),
+				},
+				ExtendsTkn: &token.Token{
+					ID:    token.T_EXTENDS,
+					Value: []byte("extends"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      17,
+						EndPos:        24,
+						StartCol:      18,
+						EndCol:        25,
+						StartColUTF16: 18,
+						EndColUTF16:   25,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      16,
+								EndPos:        17,
+								StartCol:      17,
+								EndCol:        18,
+								StartColUTF16: 17,
+								EndColUTF16:   18,
+							},
+						},
+					},
+				},
+				Extends: &ast.Name{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      25,
+						EndPos:        28,
+						StartCol:      26,
+						EndCol:        29,
+						StartColUTF16: 26,
+						EndColUTF16:   29,
+					},
+					Parts: []ast.Vertex{
+						&ast.NamePart{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      25,
+								EndPos:        28,
+								StartCol:      26,
+								EndCol:        29,
+								StartColUTF16: 26,
+								EndColUTF16:   29,
+							},
+							StringTkn: &token.Token{
+								ID:    token.T_STRING,
+								Value: []byte("Foo"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      25,
+									EndPos:        28,
+									StartCol:      26,
+									EndCol:        29,
+									StartColUTF16: 26,
+									EndColUTF16:   29,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      24,
+											EndPos:        25,
+											StartCol:      25,
+											EndCol:        26,
+											StartColUTF16: 25,
+											EndColUTF16:   26,
+										},
+									},
+								},
+							},
+							Value: []byte("Foo"),
+						},
+					},
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      29,
+						EndPos:        30,
+						StartCol:      30,
+						EndCol:        31,
+						StartColUTF16: 30,
+						EndColUTF16:   31,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      28,
+								EndPos:        29,
+								StartCol:      29,
+								EndCol:        30,
+								StartColUTF16: 29,
+								EndColUTF16:   30,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      30,
+						EndPos:        31,
+						StartCol:      31,
+						EndCol:        32,
+						StartColUTF16: 31,
+						EndColUTF16:   32,
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      32,
+					EndPos:        41,
+					StartCol:      33,
+					EndCol:        42,
+					StartColUTF16: 33,
+					EndColUTF16:   42,
+				},
+				Expr: &ast.ExprPropertyFetch{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      32,
+						EndPos:        40,
+						StartCol:      33,
+						EndCol:        41,
+						StartColUTF16: 33,
+						EndColUTF16:   41,
+					},
+					Var: &ast.ExprVariable{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      32,
+							EndPos:        34,
+							StartCol:      33,
+							EndCol:        35,
+							StartColUTF16: 33,
+							EndColUTF16:   35,
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      32,
+								EndPos:        34,
+								StartCol:      33,
+								EndCol:        35,
+								StartColUTF16: 33,
+								EndColUTF16:   35,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$a"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      32,
+									EndPos:        34,
+									StartCol:      33,
+									EndCol:        35,
+									StartColUTF16: 33,
+									EndColUTF16:   35,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      31,
+											EndPos:        32,
+											StartCol:      32,
+											EndCol:        33,
+											StartColUTF16: 32,
+											EndColUTF16:   33,
+										},
+									},
+								},
+							},
+							Value: []byte("$a"),
+						},
+					},
+					ObjectOperatorTkn: &token.Token{
+						ID:    token.T_OBJECT_OPERATOR,
+						Value: []byte("->"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      34,
+							EndPos:        36,
+							StartCol:      35,
+							EndCol:        37,
+							StartColUTF16: 35,
+							EndColUTF16:   37,
+						},
+					},
+					Prop: &ast.Identifier{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      36,
+							EndPos:        40,
+							StartCol:      37,
+							EndCol:        41,
+							StartColUTF16: 37,
+							EndColUTF16:   41,
+						},
+						IdentifierTkn: &token.Token{
+							ID:    token.T_STRING,
+							Value: []byte("enum"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      36,
+								EndPos:        40,
+								StartCol:      37,
+								EndCol:        41,
+								StartColUTF16: 37,
+								EndColUTF16:   41,
+							},
+						},
+						Value: []byte("enum"),
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      40,
+						EndPos:        41,
+						StartCol:      41,
+						EndCol:        42,
+						StartColUTF16: 41,
+						EndColUTF16:   42,
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      42,
+					EndPos:        50,
+					StartCol:      43,
+					EndCol:        51,
+					StartColUTF16: 43,
+					EndColUTF16:   51,
+				},
+				Expr: &ast.ExprClassConstFetch{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      42,
+						EndPos:        49,
+						StartCol:      43,
+						EndCol:        50,
+						StartColUTF16: 43,
+						EndColUTF16:   50,
+					},
+					Class: &ast.Name{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      42,
+							EndPos:        46,
+							StartCol:      43,
+							EndCol:        47,
+							StartColUTF16: 43,
+							EndColUTF16:   47,
+						},
+						Parts: []ast.Vertex{
+							&ast.NamePart{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      42,
+									EndPos:        46,
+									StartCol:      43,
+									EndCol:        47,
+									StartColUTF16: 43,
+									EndColUTF16:   47,
+								},
+								StringTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("enum"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      42,
+										EndPos:        46,
+										StartCol:      43,
+										EndCol:        47,
+										StartColUTF16: 43,
+										EndColUTF16:   47,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      41,
+												EndPos:        42,
+												StartCol:      42,
+												EndCol:        43,
+												StartColUTF16: 42,
+												EndColUTF16:   43,
+											},
+										},
+									},
+								},
+								Value: []byte("enum"),
+							},
+						},
+					},
+					DoubleColonTkn: &token.Token{
+						ID:    token.T_PAAMAYIM_NEKUDOTAYIM,
+						Value: []byte("::"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      46,
+							EndPos:        48,
+							StartCol:      47,
+							EndCol:        49,
+							StartColUTF16: 47,
+							EndColUTF16:   49,
+						},
+					},
+					Const: &ast.Identifier{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      48,
+							EndPos:        49,
+							StartCol:      49,
+							EndCol:        50,
+							StartColUTF16: 49,
+							EndColUTF16:   50,
+						},
+						IdentifierTkn: &token.Token{
+							ID:    token.T_STRING,
+							Value: []byte("X"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      48,
+								EndPos:        49,
+								StartCol:      49,
+								EndCol:        50,
+								StartColUTF16: 49,
+								EndColUTF16:   50,
+							},
+						},
+						Value: []byte("X"),
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      49,
+						EndPos:        50,
+						StartCol:      50,
+						EndCol:        51,
+						StartColUTF16: 50,
+						EndColUTF16:   51,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 1,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp81Readonly(t *testing.T) {
+	src := `<?php readonly class Foo { public readonly int $a; public function __construct(readonly int $b) {} }`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        100,
+			StartCol:      7,
+			EndCol:        101,
+			StartColUTF16: 7,
+			EndColUTF16:   101,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtClass{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        100,
+					StartCol:      7,
+					EndCol:        101,
+					StartColUTF16: 7,
+					EndColUTF16:   101,
+				},
+				Modifiers: []ast.Vertex{
+					&ast.Identifier{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        14,
+							StartCol:      7,
+							EndCol:        15,
+							StartColUTF16: 7,
+							EndColUTF16:   15,
+						},
+						IdentifierTkn: &token.Token{
+							ID:    token.T_READONLY,
+							Value: []byte("readonly"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      6,
+								EndPos:        14,
+								StartCol:      7,
+								EndCol:        15,
+								StartColUTF16: 7,
+								EndColUTF16:   15,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_OPEN_TAG,
+									Value: []byte("<?php"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      0,
+										EndPos:        5,
+										StartCol:      1,
+										EndCol:        6,
+										StartColUTF16: 1,
+										EndColUTF16:   6,
+									},
+								},
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      5,
+										EndPos:        6,
+										StartCol:      6,
+										EndCol:        7,
+										StartColUTF16: 6,
+										EndColUTF16:   7,
+									},
+								},
+							},
+						},
+						Value: []byte("readonly"),
+					},
+				},
+				ClassTkn: &token.Token{
+					ID:    token.T_CLASS,
+					Value: []byte("class"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      15,
+						EndPos:        20,
+						StartCol:      16,
+						EndCol:        21,
+						StartColUTF16: 16,
+						EndColUTF16:   21,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      14,
+								EndPos:        15,
+								StartCol:      15,
+								EndCol:        16,
+								StartColUTF16: 15,
+								EndColUTF16:   16,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      21,
+						EndPos:        24,
+						StartCol:      22,
+						EndCol:        25,
+						StartColUTF16: 22,
+						EndColUTF16:   25,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("Foo"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      21,
+							EndPos:        24,
+							StartCol:      22,
+							EndCol:        25,
+							StartColUTF16: 22,
+							EndColUTF16:   25,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      20,
+									EndPos:        21,
+									StartCol:      21,
+									EndCol:        22,
+									StartColUTF16: 21,
+									EndColUTF16:   22,
+								},
+							},
+						},
+					},
+					Value: []byte("Foo"),
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      25,
+						EndPos:        26,
+						StartCol:      26,
+						EndCol:        27,
+						StartColUTF16: 26,
+						EndColUTF16:   27,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      24,
+								EndPos:        25,
+								StartCol:      25,
+								EndCol:        26,
+								StartColUTF16: 25,
+								EndColUTF16:   26,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{
+					&ast.StmtPropertyList{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      27,
+							EndPos:        50,
+							StartCol:      28,
+							EndCol:        51,
+							StartColUTF16: 28,
+							EndColUTF16:   51,
+						},
+						Modifiers: []ast.Vertex{
+							&ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      27,
+									EndPos:        33,
+									StartCol:      28,
+									EndCol:        34,
+									StartColUTF16: 28,
+									EndColUTF16:   34,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_PUBLIC,
+									Value: []byte("public"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      27,
+										EndPos:        33,
+										StartCol:      28,
+										EndCol:        34,
+										StartColUTF16: 28,
+										EndColUTF16:   34,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      26,
+												EndPos:        27,
+												StartCol:      27,
+												EndCol:        28,
+												StartColUTF16: 27,
+												EndColUTF16:   28,
+											},
+										},
+									},
+								},
+								Value: []byte("public"),
+							},
+							&ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      34,
+									EndPos:        42,
+									StartCol:      35,
+									EndCol:        43,
+									StartColUTF16: 35,
+									EndColUTF16:   43,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_READONLY,
+									Value: []byte("readonly"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      34,
+										EndPos:        42,
+										StartCol:      35,
+										EndCol:        43,
+										StartColUTF16: 35,
+										EndColUTF16:   43,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      33,
+												EndPos:        34,
+												StartCol:      34,
+												EndCol:        35,
+												StartColUTF16: 34,
+												EndColUTF16:   35,
+											},
+										},
+									},
+								},
+								Value: []byte("readonly"),
+							},
+						},
+						Type: &ast.Name{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      43,
+								EndPos:        46,
+								StartCol:      44,
+								EndCol:        47,
+								StartColUTF16: 44,
+								EndColUTF16:   47,
+							},
+							Parts: []ast.Vertex{
+								&ast.NamePart{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      43,
+										EndPos:        46,
+										StartCol:      44,
+										EndCol:        47,
+										StartColUTF16: 44,
+										EndColUTF16:   47,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("int"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      43,
+											EndPos:        46,
+											StartCol:      44,
+											EndCol:        47,
+											StartColUTF16: 44,
+											EndColUTF16:   47,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      42,
+													EndPos:        43,
+													StartCol:      43,
+													EndCol:        44,
+													StartColUTF16: 43,
+													EndColUTF16:   44,
+												},
+											},
+										},
+									},
+									Value: []byte("int"),
+								},
+							},
+						},
+						Props: []ast.Vertex{
+							&ast.StmtProperty{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      47,
+									EndPos:        49,
+									StartCol:      48,
+									EndCol:        50,
+									StartColUTF16: 48,
+									EndColUTF16:   50,
+								},
+								Var: &ast.ExprVariable{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      47,
+										EndPos:        49,
+										StartCol:      48,
+										EndCol:        50,
+										StartColUTF16: 48,
+										EndColUTF16:   50,
+									},
+									Name: &ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      47,
+											EndPos:        49,
+											StartCol:      48,
+											EndCol:        50,
+											StartColUTF16: 48,
+											EndColUTF16:   50,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_VARIABLE,
+											Value: []byte("$a"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      47,
+												EndPos:        49,
+												StartCol:      48,
+												EndCol:        50,
+												StartColUTF16: 48,
+												EndColUTF16:   50,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      46,
+														EndPos:        47,
+														StartCol:      47,
+														EndCol:        48,
+														StartColUTF16: 47,
+														EndColUTF16:   48,
+													},
+												},
+											},
+										},
+										Value: []byte("$a"),
+									},
+								},
+							},
+						},
+						SemiColonTkn: &token.Token{
+							ID:    token.ID(59),
+							Value: []byte(";"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      49,
+								EndPos:        50,
+								StartCol:      50,
+								EndCol:        51,
+								StartColUTF16: 50,
+								EndColUTF16:   51,
+							},
+						},
+					},
+					&ast.StmtClassMethod{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      51,
+							EndPos:        98,
+							StartCol:      52,
+							EndCol:        99,
+							StartColUTF16: 52,
+							EndColUTF16:   99,
+						},
+						Modifiers: []ast.Vertex{
+							&ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      51,
+									EndPos:        57,
+									StartCol:      52,
+									EndCol:        58,
+									StartColUTF16: 52,
+									EndColUTF16:   58,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_PUBLIC,
+									Value: []byte("public"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      51,
+										EndPos:        57,
+										StartCol:      52,
+										EndCol:        58,
+										StartColUTF16: 52,
+										EndColUTF16:   58,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      50,
+												EndPos:        51,
+												StartCol:      51,
+												EndCol:        52,
+												StartColUTF16: 51,
+												EndColUTF16:   52,
+											},
+										},
+									},
+								},
+								Value: []byte("public"),
+							},
+						},
+						FunctionTkn: &token.Token{
+							ID:    token.T_FUNCTION,
+							Value: []byte("function"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      58,
+								EndPos:        66,
+								StartCol:      59,
+								EndCol:        67,
+								StartColUTF16: 59,
+								EndColUTF16:   67,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      57,
+										EndPos:        58,
+										StartCol:      58,
+										EndCol:        59,
+										StartColUTF16: 58,
+										EndColUTF16:   59,
+									},
+								},
+							},
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      67,
+								EndPos:        78,
+								StartCol:      68,
+								EndCol:        79,
+								StartColUTF16: 68,
+								EndColUTF16:   79,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_STRING,
+								Value: []byte("__construct"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      67,
+									EndPos:        78,
+									StartCol:      68,
+									EndCol:        79,
+									StartColUTF16: 68,
+									EndColUTF16:   79,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      66,
+											EndPos:        67,
+											StartCol:      67,
+											EndCol:        68,
+											StartColUTF16: 67,
+											EndColUTF16:   68,
+										},
+									},
+								},
+							},
+							Value: []byte("__construct"),
+						},
+						OpenParenthesisTkn: &token.Token{
+							ID:    token.ID(40),
+							Value: []byte("("),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      78,
+								EndPos:        79,
+								StartCol:      79,
+								EndCol:        80,
+								StartColUTF16: 79,
+								EndColUTF16:   80,
+							},
+						},
+						Params: []ast.Vertex{
+							&ast.Parameter{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      79,
+									EndPos:        94,
+									StartCol:      80,
+									EndCol:        95,
+									StartColUTF16: 80,
+									EndColUTF16:   95,
+								},
+								Modifiers: []ast.Vertex{
+									&ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      79,
+											EndPos:        87,
+											StartCol:      80,
+											EndCol:        88,
+											StartColUTF16: 80,
+											EndColUTF16:   88,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_READONLY,
+											Value: []byte("readonly"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      79,
+												EndPos:        87,
+												StartCol:      80,
+												EndCol:        88,
+												StartColUTF16: 80,
+												EndColUTF16:   88,
+											},
+										},
+										Value: []byte("readonly"),
+									},
+								},
+								Type: &ast.Name{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      88,
+										EndPos:        91,
+										StartCol:      89,
+										EndCol:        92,
+										StartColUTF16: 89,
+										EndColUTF16:   92,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      88,
+												EndPos:        91,
+												StartCol:      89,
+												EndCol:        92,
+												StartColUTF16: 89,
+												EndColUTF16:   92,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("int"),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      88,
+													EndPos:        91,
+													StartCol:      89,
+													EndCol:        92,
+													StartColUTF16: 89,
+													EndColUTF16:   92,
+												},
+												FreeFloating: []*token.Token{
+													{
+														ID:    token.T_WHITESPACE,
+														Value: []byte(" "),
+														Position: &position.Position{
+															StartLine:     1,
+															EndLine:       1,
+															StartPos:      87,
+															EndPos:        88,
+															StartCol:      88,
+															EndCol:        89,
+															StartColUTF16: 88,
+															EndColUTF16:   89,
+														},
+													},
+												},
+											},
+											Value: []byte("int"),
+										},
+									},
+								},
+								Var: &ast.ExprVariable{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      92,
+										EndPos:        94,
+										StartCol:      93,
+										EndCol:        95,
+										StartColUTF16: 93,
+										EndColUTF16:   95,
+									},
+									Name: &ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      92,
+											EndPos:        94,
+											StartCol:      93,
+											EndCol:        95,
+											StartColUTF16: 93,
+											EndColUTF16:   95,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_VARIABLE,
+											Value: []byte("$b"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      92,
+												EndPos:        94,
+												StartCol:      93,
+												EndCol:        95,
+												StartColUTF16: 93,
+												EndColUTF16:   95,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      91,
+														EndPos:        92,
+														StartCol:      92,
+														EndCol:        93,
+														StartColUTF16: 92,
+														EndColUTF16:   93,
+													},
+												},
+											},
+										},
+										Value: []byte("$b"),
+									},
+								},
+							},
+						},
+						CloseParenthesisTkn: &token.Token{
+							ID:    token.ID(41),
+							Value: []byte(")"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      94,
+								EndPos:        95,
+								StartCol:      95,
+								EndCol:        96,
+								StartColUTF16: 95,
+								EndColUTF16:   96,
+							},
+						},
+						Stmt: &ast.StmtStmtList{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      96,
+								EndPos:        98,
+								StartCol:      97,
+								EndCol:        99,
+								StartColUTF16: 97,
+								EndColUTF16:   99,
+							},
+							OpenCurlyBracketTkn: &token.Token{
+								ID:    token.ID(123),
+								Value: []byte("{"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      96,
+									EndPos:        97,
+									StartCol:      97,
+									EndCol:        98,
+									StartColUTF16: 97,
+									EndColUTF16:   98,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      95,
+											EndPos:        96,
+											StartCol:      96,
+											EndCol:        97,
+											StartColUTF16: 96,
+											EndColUTF16:   97,
+										},
+									},
+								},
+							},
+							Stmts: []ast.Vertex{},
+							CloseCurlyBracketTkn: &token.Token{
+								ID:    token.ID(125),
+								Value: []byte("}"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      97,
+									EndPos:        98,
+									StartCol:      98,
+									EndCol:        99,
+									StartColUTF16: 98,
+									EndColUTF16:   99,
+								},
+							},
+						},
+					},
+				},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      99,
+						EndPos:        100,
+						StartCol:      100,
+						EndCol:        101,
+						StartColUTF16: 100,
+						EndColUTF16:   101,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      98,
+								EndPos:        99,
+								StartCol:      99,
+								EndCol:        100,
+								StartColUTF16: 99,
+								EndColUTF16:   100,
+							},
+						},
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 1,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp81FirstClassCallable(t *testing.T) {
+	src := `<?php strlen(...); $a->b(...);`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        30,
+			StartCol:      7,
+			EndCol:        31,
+			StartColUTF16: 7,
+			EndColUTF16:   31,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        18,
+					StartCol:      7,
+					EndCol:        19,
+					StartColUTF16: 7,
+					EndColUTF16:   19,
+				},
+				Expr: &ast.ExprFunctionCall{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        17,
+						StartCol:      7,
+						EndCol:        18,
+						StartColUTF16: 7,
+						EndColUTF16:   18,
+					},
+					Function: &ast.Name{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        12,
+							StartCol:      7,
+							EndCol:        13,
+							StartColUTF16: 7,
+							EndColUTF16:   13,
+						},
+						Parts: []ast.Vertex{
+							&ast.NamePart{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      6,
+									EndPos:        12,
+									StartCol:      7,
+									EndCol:        13,
+									StartColUTF16: 7,
+									EndColUTF16:   13,
+								},
+								StringTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("strlen"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      6,
+										EndPos:        12,
+										StartCol:      7,
+										EndCol:        13,
+										StartColUTF16: 7,
+										EndColUTF16:   13,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_OPEN_TAG,
+											Value: []byte("<?php"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      0,
+												EndPos:        5,
+												StartCol:      1,
+												EndCol:        6,
+												StartColUTF16: 1,
+												EndColUTF16:   6,
+											},
+										},
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      5,
+												EndPos:        6,
+												StartCol:      6,
+												EndCol:        7,
+												StartColUTF16: 6,
+												EndColUTF16:   7,
+											},
+										},
+									},
+								},
+								Value: []byte("strlen"),
+							},
+						},
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      12,
+							EndPos:        13,
+							StartCol:      13,
+							EndCol:        14,
+							StartColUTF16: 13,
+							EndColUTF16:   14,
+						},
+					},
+					Args: []ast.Vertex{
+						&ast.Argument{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      13,
+								EndPos:        16,
+								StartCol:      14,
+								EndCol:        17,
+								StartColUTF16: 14,
+								EndColUTF16:   17,
+							},
+							VariadicTkn: &token.Token{
+								ID:    token.T_ELLIPSIS,
+								Value: []byte("..."),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      13,
+									EndPos:        16,
+									StartCol:      14,
+									EndCol:        17,
+									StartColUTF16: 14,
+									EndColUTF16:   17,
+								},
+							},
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      16,
+							EndPos:        17,
+							StartCol:      17,
+							EndCol:        18,
+							StartColUTF16: 17,
+							EndColUTF16:   18,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      17,
+						EndPos:        18,
+						StartCol:      18,
+						EndCol:        19,
+						StartColUTF16: 18,
+						EndColUTF16:   19,
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      19,
+					EndPos:        30,
+					StartCol:      20,
+					EndCol:        31,
+					StartColUTF16: 20,
+					EndColUTF16:   31,
+				},
+				Expr: &ast.ExprMethodCall{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      19,
+						EndPos:        29,
+						StartCol:      20,
+						EndCol:        30,
+						StartColUTF16: 20,
+						EndColUTF16:   30,
+					},
+					Var: &ast.ExprVariable{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      19,
+							EndPos:        21,
+							StartCol:      20,
+							EndCol:        22,
+							StartColUTF16: 20,
+							EndColUTF16:   22,
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      19,
+								EndPos:        21,
+								StartCol:      20,
+								EndCol:        22,
+								StartColUTF16: 20,
+								EndColUTF16:   22,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$a"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      19,
+									EndPos:        21,
+									StartCol:      20,
+									EndCol:        22,
+									StartColUTF16: 20,
+									EndColUTF16:   22,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      18,
+											EndPos:        19,
+											StartCol:      19,
+											EndCol:        20,
+											StartColUTF16: 19,
+											EndColUTF16:   20,
+										},
+									},
+								},
+							},
+							Value: []byte("$a"),
+						},
+					},
+					ObjectOperatorTkn: &token.Token{
+						ID:    token.T_OBJECT_OPERATOR,
+						Value: []byte("->"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      21,
+							EndPos:        23,
+							StartCol:      22,
+							EndCol:        24,
+							StartColUTF16: 22,
+							EndColUTF16:   24,
+						},
+					},
+					Method: &ast.Identifier{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      23,
+							EndPos:        24,
+							StartCol:      24,
+							EndCol:        25,
+							StartColUTF16: 24,
+							EndColUTF16:   25,
+						},
+						IdentifierTkn: &token.Token{
+							ID:    token.T_STRING,
+							Value: []byte("b"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      23,
+								EndPos:        24,
+								StartCol:      24,
+								EndCol:        25,
+								StartColUTF16: 24,
+								EndColUTF16:   25,
+							},
+						},
+						Value: []byte("b"),
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      24,
+							EndPos:        25,
+							StartCol:      25,
+							EndCol:        26,
+							StartColUTF16: 25,
+							EndColUTF16:   26,
+						},
+					},
+					Args: []ast.Vertex{
+						&ast.Argument{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      25,
+								EndPos:        28,
+								StartCol:      26,
+								EndCol:        29,
+								StartColUTF16: 26,
+								EndColUTF16:   29,
+							},
+							VariadicTkn: &token.Token{
+								ID:    token.T_ELLIPSIS,
+								Value: []byte("..."),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      25,
+									EndPos:        28,
+									StartCol:      26,
+									EndCol:        29,
+									StartColUTF16: 26,
+									EndColUTF16:   29,
+								},
+							},
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      28,
+							EndPos:        29,
+							StartCol:      29,
+							EndCol:        30,
+							StartColUTF16: 29,
+							EndColUTF16:   30,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      29,
+						EndPos:        30,
+						StartCol:      30,
+						EndCol:        31,
+						StartColUTF16: 30,
+						EndColUTF16:   31,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 1,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp81IntersectionType(t *testing.T) {
+	src := `<?php function f(A&B $a, &$b): C&D {}`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        37,
+			StartCol:      7,
+			EndCol:        38,
+			StartColUTF16: 7,
+			EndColUTF16:   38,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtFunction{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        37,
+					StartCol:      7,
+					EndCol:        38,
+					StartColUTF16: 7,
+					EndColUTF16:   38,
+				},
+				FunctionTkn: &token.Token{
+					ID:    token.T_FUNCTION,
+					Value: []byte("function"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        14,
+						StartCol:      7,
+						EndCol:        15,
+						StartColUTF16: 7,
+						EndColUTF16:   15,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_OPEN_TAG,
+							Value: []byte("<?php"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      0,
+								EndPos:        5,
+								StartCol:      1,
+								EndCol:        6,
+								StartColUTF16: 1,
+								EndColUTF16:   6,
+							},
+						},
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      5,
+								EndPos:        6,
+								StartCol:      6,
+								EndCol:        7,
+								StartColUTF16: 6,
+								EndColUTF16:   7,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      15,
+						EndPos:        16,
+						StartCol:      16,
+						EndCol:        17,
+						StartColUTF16: 16,
+						EndColUTF16:   17,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("f"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      15,
+							EndPos:        16,
+							StartCol:      16,
+							EndCol:        17,
+							StartColUTF16: 16,
+							EndColUTF16:   17,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      14,
+									EndPos:        15,
+									StartCol:      15,
+									EndCol:        16,
+									StartColUTF16: 15,
+									EndColUTF16:   16,
+								},
+							},
+						},
+					},
+					Value: []byte("f"),
+				},
+				OpenParenthesisTkn: &token.Token{
+					ID:    token.ID(40),
+					Value: []byte("("),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      16,
+						EndPos:        17,
+						StartCol:      17,
+						EndCol:        18,
+						StartColUTF16: 17,
+						EndColUTF16:   18,
+					},
+				},
+				Params: []ast.Vertex{
+					&ast.Parameter{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      17,
+							EndPos:        23,
+							StartCol:      18,
+							EndCol:        24,
+							StartColUTF16: 18,
+							EndColUTF16:   24,
+						},
+						Type: &ast.Intersection{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      17,
+								EndPos:        20,
+								StartCol:      18,
+								EndCol:        21,
+								StartColUTF16: 18,
+								EndColUTF16:   21,
+							},
+							Types: []ast.Vertex{
+								&ast.Name{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      17,
+										EndPos:        18,
+										StartCol:      18,
+										EndCol:        19,
+										StartColUTF16: 18,
+										EndColUTF16:   19,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      17,
+												EndPos:        18,
+												StartCol:      18,
+												EndCol:        19,
+												StartColUTF16: 18,
+												EndColUTF16:   19,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("A"),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      17,
+													EndPos:        18,
+													StartCol:      18,
+													EndCol:        19,
+													StartColUTF16: 18,
+													EndColUTF16:   19,
+												},
+											},
+											Value: []byte("A"),
+										},
+									},
+								},
+								&ast.Name{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      19,
+										EndPos:        20,
+										StartCol:      20,
+										EndCol:        21,
+										StartColUTF16: 20,
+										EndColUTF16:   21,
+									},
+									Parts: []ast.Vertex{
+										&ast.NamePart{
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      19,
+												EndPos:        20,
+												StartCol:      20,
+												EndCol:        21,
+												StartColUTF16: 20,
+												EndColUTF16:   21,
+											},
+											StringTkn: &token.Token{
+												ID:    token.T_STRING,
+												Value: []byte("B"),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      19,
+													EndPos:        20,
+													StartCol:      20,
+													EndCol:        21,
+													StartColUTF16: 20,
+													EndColUTF16:   21,
+												},
+											},
+											Value: []byte("B"),
+										},
+									},
+								},
+							},
+							SeparatorTkns: []*token.Token{
+								{
+									ID:    token.T_AMPERSAND_NOT_FOLLOWED_BY_VAR_OR_VARARG,
+									Value: []byte("&"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      18,
+										EndPos:        19,
+										StartCol:      19,
+										EndCol:        20,
+										StartColUTF16: 19,
+										EndColUTF16:   20,
+									},
+								},
+							},
+						},
+						Var: &ast.ExprVariable{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      21,
+								EndPos:        23,
+								StartCol:      22,
+								EndCol:        24,
+								StartColUTF16: 22,
+								EndColUTF16:   24,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      21,
+									EndPos:        23,
+									StartCol:      22,
+									EndCol:        24,
+									StartColUTF16: 22,
+									EndColUTF16:   24,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_VARIABLE,
+									Value: []byte("$a"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      21,
+										EndPos:        23,
+										StartCol:      22,
+										EndCol:        24,
+										StartColUTF16: 22,
+										EndColUTF16:   24,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      20,
+												EndPos:        21,
+												StartCol:      21,
+												EndCol:        22,
+												StartColUTF16: 21,
+												EndColUTF16:   22,
+											},
+										},
+									},
+								},
+								Value: []byte("$a"),
+							},
+						},
+					},
+					&ast.Parameter{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      25,
+							EndPos:        28,
+							StartCol:      26,
+							EndCol:        29,
+							StartColUTF16: 26,
+							EndColUTF16:   29,
+						},
+						AmpersandTkn: &token.Token{
+							ID:    token.ID(38),
+							Value: []byte("&"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      25,
+								EndPos:        26,
+								StartCol:      26,
+								EndCol:        27,
+								StartColUTF16: 26,
+								EndColUTF16:   27,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      24,
+										EndPos:        25,
+										StartCol:      25,
+										EndCol:        26,
+										StartColUTF16: 25,
+										EndColUTF16:   26,
+									},
+								},
+							},
+						},
+						Var: &ast.ExprVariable{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      26,
+								EndPos:        28,
+								StartCol:      27,
+								EndCol:        29,
+								StartColUTF16: 27,
+								EndColUTF16:   29,
+							},
+							Name: &ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      26,
+									EndPos:        28,
+									StartCol:      27,
+									EndCol:        29,
+									StartColUTF16: 27,
+									EndColUTF16:   29,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_VARIABLE,
+									Value: []byte("$b"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      26,
+										EndPos:        28,
+										StartCol:      27,
+										EndCol:        29,
+										StartColUTF16: 27,
+										EndColUTF16:   29,
+									},
+								},
+								Value: []byte("$b"),
+							},
+						},
+					},
+				},
+				SeparatorTkns: []*token.Token{
+					{
+						ID:    token.ID(44),
+						Value: []byte(","),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      23,
+							EndPos:        24,
+							StartCol:      24,
+							EndCol:        25,
+							StartColUTF16: 24,
+							EndColUTF16:   25,
+						},
+					},
+				},
+				CloseParenthesisTkn: &token.Token{
+					ID:    token.ID(41),
+					Value: []byte(")"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      28,
+						EndPos:        29,
+						StartCol:      29,
+						EndCol:        30,
+						StartColUTF16: 29,
+						EndColUTF16:   30,
+					},
+				},
+				ColonTkn: &token.Token{
+					ID:    token.ID(58),
+					Value: []byte(":"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      29,
+						EndPos:        30,
+						StartCol:      30,
+						EndCol:        31,
+						StartColUTF16: 30,
+						EndColUTF16:   31,
+					},
+				},
+				ReturnType: &ast.Intersection{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      31,
+						EndPos:        34,
+						StartCol:      32,
+						EndCol:        35,
+						StartColUTF16: 32,
+						EndColUTF16:   35,
+					},
+					Types: []ast.Vertex{
+						&ast.Name{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      31,
+								EndPos:        32,
+								StartCol:      32,
+								EndCol:        33,
+								StartColUTF16: 32,
+								EndColUTF16:   33,
+							},
+							Parts: []ast.Vertex{
+								&ast.NamePart{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      31,
+										EndPos:        32,
+										StartCol:      32,
+										EndCol:        33,
+										StartColUTF16: 32,
+										EndColUTF16:   33,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("C"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      31,
+											EndPos:        32,
+											StartCol:      32,
+											EndCol:        33,
+											StartColUTF16: 32,
+											EndColUTF16:   33,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      30,
+													EndPos:        31,
+													StartCol:      31,
+													EndCol:        32,
+													StartColUTF16: 31,
+													EndColUTF16:   32,
+												},
+											},
+										},
+									},
+									Value: []byte("C"),
+								},
+							},
+						},
+						&ast.Name{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      33,
+								EndPos:        34,
+								StartCol:      34,
+								EndCol:        35,
+								StartColUTF16: 34,
+								EndColUTF16:   35,
+							},
+							Parts: []ast.Vertex{
+								&ast.NamePart{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      33,
+										EndPos:        34,
+										StartCol:      34,
+										EndCol:        35,
+										StartColUTF16: 34,
+										EndColUTF16:   35,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("D"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      33,
+											EndPos:        34,
+											StartCol:      34,
+											EndCol:        35,
+											StartColUTF16: 34,
+											EndColUTF16:   35,
+										},
+									},
+									Value: []byte("D"),
+								},
+							},
+						},
+					},
+					SeparatorTkns: []*token.Token{
+						{
+							ID:    token.T_AMPERSAND_NOT_FOLLOWED_BY_VAR_OR_VARARG,
+							Value: []byte("&"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      32,
+								EndPos:        33,
+								StartCol:      33,
+								EndCol:        34,
+								StartColUTF16: 33,
+								EndColUTF16:   34,
+							},
+						},
+					},
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      35,
+						EndPos:        36,
+						StartCol:      36,
+						EndCol:        37,
+						StartColUTF16: 36,
+						EndColUTF16:   37,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      34,
+								EndPos:        35,
+								StartCol:      35,
+								EndCol:        36,
+								StartColUTF16: 35,
+								EndColUTF16:   36,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      36,
+						EndPos:        37,
+						StartCol:      37,
+						EndCol:        38,
+						StartColUTF16: 37,
+						EndColUTF16:   38,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 1,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp83TypedClassConstant(t *testing.T) {
+	src := `<?php class Foo { const int A = 1; }`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        36,
+			StartCol:      7,
+			EndCol:        37,
+			StartColUTF16: 7,
+			EndColUTF16:   37,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtClass{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        36,
+					StartCol:      7,
+					EndCol:        37,
+					StartColUTF16: 7,
+					EndColUTF16:   37,
+				},
+				ClassTkn: &token.Token{
+					ID:    token.T_CLASS,
+					Value: []byte("class"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        11,
+						StartCol:      7,
+						EndCol:        12,
+						StartColUTF16: 7,
+						EndColUTF16:   12,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_OPEN_TAG,
+							Value: []byte("<?php"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      0,
+								EndPos:        5,
+								StartCol:      1,
+								EndCol:        6,
+								StartColUTF16: 1,
+								EndColUTF16:   6,
+							},
+						},
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      5,
+								EndPos:        6,
+								StartCol:      6,
+								EndCol:        7,
+								StartColUTF16: 6,
+								EndColUTF16:   7,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      12,
+						EndPos:        15,
+						StartCol:      13,
+						EndCol:        16,
+						StartColUTF16: 13,
+						EndColUTF16:   16,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("Foo"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      12,
+							EndPos:        15,
+							StartCol:      13,
+							EndCol:        16,
+							StartColUTF16: 13,
+							EndColUTF16:   16,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      11,
+									EndPos:        12,
+									StartCol:      12,
+									EndCol:        13,
+									StartColUTF16: 12,
+									EndColUTF16:   13,
+								},
+							},
+						},
+					},
+					Value: []byte("Foo"),
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      16,
+						EndPos:        17,
+						StartCol:      17,
+						EndCol:        18,
+						StartColUTF16: 17,
+						EndColUTF16:   18,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      15,
+								EndPos:        16,
+								StartCol:      16,
+								EndCol:        17,
+								StartColUTF16: 16,
+								EndColUTF16:   17,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{
+					&ast.StmtClassConstList{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      18,
+							EndPos:        34,
+							StartCol:      19,
+							EndCol:        35,
+							StartColUTF16: 19,
+							EndColUTF16:   35,
+						},
+						ConstTkn: &token.Token{
+							ID:    token.T_CONST,
+							Value: []byte("const"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      18,
+								EndPos:        23,
+								StartCol:      19,
+								EndCol:        24,
+								StartColUTF16: 19,
+								EndColUTF16:   24,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      17,
+										EndPos:        18,
+										StartCol:      18,
+										EndCol:        19,
+										StartColUTF16: 18,
+										EndColUTF16:   19,
+									},
+								},
+							},
+						},
+						Type: &ast.Name{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      24,
+								EndPos:        27,
+								StartCol:      25,
+								EndCol:        28,
+								StartColUTF16: 25,
+								EndColUTF16:   28,
+							},
+							Parts: []ast.Vertex{
+								&ast.NamePart{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      24,
+										EndPos:        27,
+										StartCol:      25,
+										EndCol:        28,
+										StartColUTF16: 25,
+										EndColUTF16:   28,
+									},
+									StringTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("int"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      24,
+											EndPos:        27,
+											StartCol:      25,
+											EndCol:        28,
+											StartColUTF16: 25,
+											EndColUTF16:   28,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      23,
+													EndPos:        24,
+													StartCol:      24,
+													EndCol:        25,
+													StartColUTF16: 24,
+													EndColUTF16:   25,
+												},
+											},
+										},
+									},
+									Value: []byte("int"),
+								},
+							},
+						},
+						Consts: []ast.Vertex{
+							&ast.StmtConstant{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      28,
+									EndPos:        33,
+									StartCol:      29,
+									EndCol:        34,
+									StartColUTF16: 29,
+									EndColUTF16:   34,
+								},
+								Name: &ast.Identifier{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      28,
+										EndPos:        29,
+										StartCol:      29,
+										EndCol:        30,
+										StartColUTF16: 29,
+										EndColUTF16:   30,
+									},
+									IdentifierTkn: &token.Token{
+										ID:    token.T_STRING,
+										Value: []byte("A"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      28,
+											EndPos:        29,
+											StartCol:      29,
+											EndCol:        30,
+											StartColUTF16: 29,
+											EndColUTF16:   30,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      27,
+													EndPos:        28,
+													StartCol:      28,
+													EndCol:        29,
+													StartColUTF16: 28,
+													EndColUTF16:   29,
+												},
+											},
+										},
+									},
+									Value: []byte("A"),
+								},
+								EqualTkn: &token.Token{
+									ID:    token.ID(61),
+									Value: []byte("="),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      30,
+										EndPos:        31,
+										StartCol:      31,
+										EndCol:        32,
+										StartColUTF16: 31,
+										EndColUTF16:   32,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      29,
+												EndPos:        30,
+												StartCol:      30,
+												EndCol:        31,
+												StartColUTF16: 30,
+												EndColUTF16:   31,
+											},
+										},
+									},
+								},
+								Expr: &ast.ScalarLnumber{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      32,
+										EndPos:        33,
+										StartCol:      33,
+										EndCol:        34,
+										StartColUTF16: 33,
+										EndColUTF16:   34,
+									},
+									NumberTkn: &token.Token{
+										ID:    token.T_LNUMBER,
+										Value: []byte("1"),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      32,
+											EndPos:        33,
+											StartCol:      33,
+											EndCol:        34,
+											StartColUTF16: 33,
+											EndColUTF16:   34,
+										},
+										FreeFloating: []*token.Token{
+											{
+												ID:    token.T_WHITESPACE,
+												Value: []byte(" "),
+												Position: &position.Position{
+													StartLine:     1,
+													EndLine:       1,
+													StartPos:      31,
+													EndPos:        32,
+													StartCol:      32,
+													EndCol:        33,
+													StartColUTF16: 32,
+													EndColUTF16:   33,
+												},
+											},
+										},
+									},
+									Value: []byte("1"),
+								},
+							},
+						},
+						SemiColonTkn: &token.Token{
+							ID:    token.ID(59),
+							Value: []byte(";"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      33,
+								EndPos:        34,
+								StartCol:      34,
+								EndCol:        35,
+								StartColUTF16: 34,
+								EndColUTF16:   35,
+							},
+						},
+					},
+				},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      35,
+						EndPos:        36,
+						StartCol:      36,
+						EndCol:        37,
+						StartColUTF16: 36,
+						EndColUTF16:   37,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      34,
+								EndPos:        35,
+								StartCol:      35,
+								EndCol:        36,
+								StartColUTF16: 35,
+								EndColUTF16:   36,
+							},
+						},
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp83DynamicClassConstFetch(t *testing.T) {
+	src := `<?php Foo::{$a};`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        16,
+			StartCol:      7,
+			EndCol:        17,
+			StartColUTF16: 7,
+			EndColUTF16:   17,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        16,
+					StartCol:      7,
+					EndCol:        17,
+					StartColUTF16: 7,
+					EndColUTF16:   17,
+				},
+				Expr: &ast.ExprClassConstFetch{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        15,
+						StartCol:      7,
+						EndCol:        16,
+						StartColUTF16: 7,
+						EndColUTF16:   16,
+					},
+					Class: &ast.Name{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        9,
+							StartCol:      7,
+							EndCol:        10,
+							StartColUTF16: 7,
+							EndColUTF16:   10,
+						},
+						Parts: []ast.Vertex{
+							&ast.NamePart{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      6,
+									EndPos:        9,
+									StartCol:      7,
+									EndCol:        10,
+									StartColUTF16: 7,
+									EndColUTF16:   10,
+								},
+								StringTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("Foo"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      6,
+										EndPos:        9,
+										StartCol:      7,
+										EndCol:        10,
+										StartColUTF16: 7,
+										EndColUTF16:   10,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_OPEN_TAG,
+											Value: []byte("<?php"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      0,
+												EndPos:        5,
+												StartCol:      1,
+												EndCol:        6,
+												StartColUTF16: 1,
+												EndColUTF16:   6,
+											},
+										},
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      5,
+												EndPos:        6,
+												StartCol:      6,
+												EndCol:        7,
+												StartColUTF16: 6,
+												EndColUTF16:   7,
+											},
+										},
+									},
+								},
+								Value: []byte("Foo"),
+							},
+						},
+					},
+					DoubleColonTkn: &token.Token{
+						ID:    token.T_PAAMAYIM_NEKUDOTAYIM,
+						Value: []byte("::"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      9,
+							EndPos:        11,
+							StartCol:      10,
+							EndCol:        12,
+							StartColUTF16: 10,
+							EndColUTF16:   12,
+						},
+					},
+					OpenCurlyBracketTkn: &token.Token{
+						ID:    token.ID(123),
+						Value: []byte("{"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      11,
+							EndPos:        12,
+							StartCol:      12,
+							EndCol:        13,
+							StartColUTF16: 12,
+							EndColUTF16:   13,
+						},
+					},
+					Const: &ast.ExprVariable{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      12,
+							EndPos:        14,
+							StartCol:      13,
+							EndCol:        15,
+							StartColUTF16: 13,
+							EndColUTF16:   15,
+						},
+						Name: &ast.Identifier{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      12,
+								EndPos:        14,
+								StartCol:      13,
+								EndCol:        15,
+								StartColUTF16: 13,
+								EndColUTF16:   15,
+							},
+							IdentifierTkn: &token.Token{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$a"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      12,
+									EndPos:        14,
+									StartCol:      13,
+									EndCol:        15,
+									StartColUTF16: 13,
+									EndColUTF16:   15,
+								},
+							},
+							Value: []byte("$a"),
+						},
+					},
+					CloseCurlyBracketTkn: &token.Token{
+						ID:    token.ID(125),
+						Value: []byte("}"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      14,
+							EndPos:        15,
+							StartCol:      15,
+							EndCol:        16,
+							StartColUTF16: 15,
+							EndColUTF16:   16,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      15,
+						EndPos:        16,
+						StartCol:      16,
+						EndCol:        17,
+						StartColUTF16: 16,
+						EndColUTF16:   17,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+func TestRecoverStmt(t *testing.T) {
+	src := `<?php $a = ; foo();`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        19,
+			StartCol:      7,
+			EndCol:        20,
+			StartColUTF16: 7,
+			EndColUTF16:   20,
+		},
+		Stmts: []ast.Vertex{
+			&ast.BadStmt{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        10,
+					StartCol:      7,
+					EndCol:        11,
+					StartColUTF16: 7,
+					EndColUTF16:   11,
+				},
+				SkippedTkns: []*token.Token{
+					{
+						ID:    token.T_VARIABLE,
+						Value: []byte("$a"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        8,
+							StartCol:      7,
+							EndCol:        9,
+							StartColUTF16: 7,
+							EndColUTF16:   9,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_OPEN_TAG,
+								Value: []byte("<?php"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      0,
+									EndPos:        5,
+									StartCol:      1,
+									EndCol:        6,
+									StartColUTF16: 1,
+									EndColUTF16:   6,
+								},
+							},
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      5,
+									EndPos:        6,
+									StartCol:      6,
+									EndCol:        7,
+									StartColUTF16: 6,
+									EndColUTF16:   7,
+								},
+							},
+						},
+					},
+					{
+						ID:    token.ID(61),
+						Value: []byte("="),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      9,
+							EndPos:        10,
+							StartCol:      10,
+							EndCol:        11,
+							StartColUTF16: 10,
+							EndColUTF16:   11,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      8,
+									EndPos:        9,
+									StartCol:      9,
+									EndCol:        10,
+									StartColUTF16: 9,
+									EndColUTF16:   10,
+								},
+							},
+						},
+					},
+				},
+			},
+			&ast.StmtNop{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      11,
+					EndPos:        12,
+					StartCol:      12,
+					EndCol:        13,
+					StartColUTF16: 12,
+					EndColUTF16:   13,
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      11,
+						EndPos:        12,
+						StartCol:      12,
+						EndCol:        13,
+						StartColUTF16: 12,
+						EndColUTF16:   13,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      10,
+								EndPos:        11,
+								StartCol:      11,
+								EndCol:        12,
+								StartColUTF16: 11,
+								EndColUTF16:   12,
+							},
+						},
+					},
+				},
+			},
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      13,
+					EndPos:        19,
+					StartCol:      14,
+					EndCol:        20,
+					StartColUTF16: 14,
+					EndColUTF16:   20,
+				},
+				Expr: &ast.ExprFunctionCall{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      13,
+						EndPos:        18,
+						StartCol:      14,
+						EndCol:        19,
+						StartColUTF16: 14,
+						EndColUTF16:   19,
+					},
+					Function: &ast.Name{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      13,
+							EndPos:        16,
+							StartCol:      14,
+							EndCol:        17,
+							StartColUTF16: 14,
+							EndColUTF16:   17,
+						},
+						Parts: []ast.Vertex{
+							&ast.NamePart{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      13,
+									EndPos:        16,
+									StartCol:      14,
+									EndCol:        17,
+									StartColUTF16: 14,
+									EndColUTF16:   17,
+								},
+								StringTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("foo"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      13,
+										EndPos:        16,
+										StartCol:      14,
+										EndCol:        17,
+										StartColUTF16: 14,
+										EndColUTF16:   17,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      12,
+												EndPos:        13,
+												StartCol:      13,
+												EndCol:        14,
+												StartColUTF16: 13,
+												EndColUTF16:   14,
+											},
+										},
+									},
+								},
+								Value: []byte("foo"),
+							},
+						},
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      16,
+							EndPos:        17,
+							StartCol:      17,
+							EndCol:        18,
+							StartColUTF16: 17,
+							EndColUTF16:   18,
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      17,
+							EndPos:        18,
+							StartCol:      18,
+							EndCol:        19,
+							StartColUTF16: 18,
+							EndColUTF16:   19,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      18,
+						EndPos:        19,
+						StartCol:      19,
+						EndCol:        20,
+						StartColUTF16: 19,
+						EndColUTF16:   20,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestRecoverClassStmt(t *testing.T) {
+	src := `<?php class A { pub $a; public $b; }`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        36,
+			StartCol:      7,
+			EndCol:        37,
+			StartColUTF16: 7,
+			EndColUTF16:   37,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtClass{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        36,
+					StartCol:      7,
+					EndCol:        37,
+					StartColUTF16: 7,
+					EndColUTF16:   37,
+				},
+				ClassTkn: &token.Token{
+					ID:    token.T_CLASS,
+					Value: []byte("class"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        11,
+						StartCol:      7,
+						EndCol:        12,
+						StartColUTF16: 7,
+						EndColUTF16:   12,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_OPEN_TAG,
+							Value: []byte("<?php"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      0,
+								EndPos:        5,
+								StartCol:      1,
+								EndCol:        6,
+								StartColUTF16: 1,
+								EndColUTF16:   6,
+							},
+						},
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      5,
+								EndPos:        6,
+								StartCol:      6,
+								EndCol:        7,
+								StartColUTF16: 6,
+								EndColUTF16:   7,
+							},
+						},
+					},
+				},
+				Name: &ast.Identifier{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      12,
+						EndPos:        13,
+						StartCol:      13,
+						EndCol:        14,
+						StartColUTF16: 13,
+						EndColUTF16:   14,
+					},
+					IdentifierTkn: &token.Token{
+						ID:    token.T_STRING,
+						Value: []byte("A"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      12,
+							EndPos:        13,
+							StartCol:      13,
+							EndCol:        14,
+							StartColUTF16: 13,
+							EndColUTF16:   14,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      11,
+									EndPos:        12,
+									StartCol:      12,
+									EndCol:        13,
+									StartColUTF16: 12,
+									EndColUTF16:   13,
+								},
+							},
+						},
+					},
+					Value: []byte("A"),
+				},
+				OpenCurlyBracketTkn: &token.Token{
+					ID:    token.ID(123),
+					Value: []byte("{"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      14,
+						EndPos:        15,
+						StartCol:      15,
+						EndCol:        16,
+						StartColUTF16: 15,
+						EndColUTF16:   16,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      13,
+								EndPos:        14,
+								StartCol:      14,
+								EndCol:        15,
+								StartColUTF16: 14,
+								EndColUTF16:   15,
+							},
+						},
+					},
+				},
+				Stmts: []ast.Vertex{
+					&ast.BadStmt{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      16,
+							EndPos:        23,
+							StartCol:      17,
+							EndCol:        24,
+							StartColUTF16: 17,
+							EndColUTF16:   24,
+						},
+						SkippedTkns: []*token.Token{
+							{
+								ID:    token.T_STRING,
+								Value: []byte("pub"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      16,
+									EndPos:        19,
+									StartCol:      17,
+									EndCol:        20,
+									StartColUTF16: 17,
+									EndColUTF16:   20,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      15,
+											EndPos:        16,
+											StartCol:      16,
+											EndCol:        17,
+											StartColUTF16: 16,
+											EndColUTF16:   17,
+										},
+									},
+								},
+							},
+							{
+								ID:    token.T_VARIABLE,
+								Value: []byte("$a"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      20,
+									EndPos:        22,
+									StartCol:      21,
+									EndCol:        23,
+									StartColUTF16: 21,
+									EndColUTF16:   23,
+								},
+								FreeFloating: []*token.Token{
+									{
+										ID:    token.T_WHITESPACE,
+										Value: []byte(" "),
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      19,
+											EndPos:        20,
+											StartCol:      20,
+											EndCol:        21,
+											StartColUTF16: 20,
+											EndColUTF16:   21,
+										},
+									},
+								},
+							},
+							{
+								ID:    token.ID(59),
+								Value: []byte(";"),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      22,
+									EndPos:        23,
+									StartCol:      23,
+									EndCol:        24,
+									StartColUTF16: 23,
+									EndColUTF16:   24,
+								},
+							},
+						},
+					},
+					&ast.StmtPropertyList{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      24,
+							EndPos:        34,
+							StartCol:      25,
+							EndCol:        35,
+							StartColUTF16: 25,
+							EndColUTF16:   35,
+						},
+						Modifiers: []ast.Vertex{
+							&ast.Identifier{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      24,
+									EndPos:        30,
+									StartCol:      25,
+									EndCol:        31,
+									StartColUTF16: 25,
+									EndColUTF16:   31,
+								},
+								IdentifierTkn: &token.Token{
+									ID:    token.T_PUBLIC,
+									Value: []byte("public"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      24,
+										EndPos:        30,
+										StartCol:      25,
+										EndCol:        31,
+										StartColUTF16: 25,
+										EndColUTF16:   31,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      23,
+												EndPos:        24,
+												StartCol:      24,
+												EndCol:        25,
+												StartColUTF16: 24,
+												EndColUTF16:   25,
+											},
+										},
+									},
+								},
+								Value: []byte("public"),
+							},
+						},
+						Props: []ast.Vertex{
+							&ast.StmtProperty{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      31,
+									EndPos:        33,
+									StartCol:      32,
+									EndCol:        34,
+									StartColUTF16: 32,
+									EndColUTF16:   34,
+								},
+								Var: &ast.ExprVariable{
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      31,
+										EndPos:        33,
+										StartCol:      32,
+										EndCol:        34,
+										StartColUTF16: 32,
+										EndColUTF16:   34,
+									},
+									Name: &ast.Identifier{
+										Position: &position.Position{
+											StartLine:     1,
+											EndLine:       1,
+											StartPos:      31,
+											EndPos:        33,
+											StartCol:      32,
+											EndCol:        34,
+											StartColUTF16: 32,
+											EndColUTF16:   34,
+										},
+										IdentifierTkn: &token.Token{
+											ID:    token.T_VARIABLE,
+											Value: []byte("$b"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      31,
+												EndPos:        33,
+												StartCol:      32,
+												EndCol:        34,
+												StartColUTF16: 32,
+												EndColUTF16:   34,
+											},
+											FreeFloating: []*token.Token{
+												{
+													ID:    token.T_WHITESPACE,
+													Value: []byte(" "),
+													Position: &position.Position{
+														StartLine:     1,
+														EndLine:       1,
+														StartPos:      30,
+														EndPos:        31,
+														StartCol:      31,
+														EndCol:        32,
+														StartColUTF16: 31,
+														EndColUTF16:   32,
+													},
+												},
+											},
+										},
+										Value: []byte("$b"),
+									},
+								},
+							},
+						},
+						SemiColonTkn: &token.Token{
+							ID:    token.ID(59),
+							Value: []byte(";"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      33,
+								EndPos:        34,
+								StartCol:      34,
+								EndCol:        35,
+								StartColUTF16: 34,
+								EndColUTF16:   35,
+							},
+						},
+					},
+				},
+				CloseCurlyBracketTkn: &token.Token{
+					ID:    token.ID(125),
+					Value: []byte("}"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      35,
+						EndPos:        36,
+						StartCol:      36,
+						EndCol:        37,
+						StartColUTF16: 36,
+						EndColUTF16:   37,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      34,
+								EndPos:        35,
+								StartCol:      35,
+								EndCol:        36,
+								StartColUTF16: 35,
+								EndColUTF16:   36,
+							},
+						},
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestRecoverArgument(t *testing.T) {
+	src := `<?php foo(1, , 2);`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        18,
+			StartCol:      7,
+			EndCol:        19,
+			StartColUTF16: 7,
+			EndColUTF16:   19,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtExpression{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        18,
+					StartCol:      7,
+					EndCol:        19,
+					StartColUTF16: 7,
+					EndColUTF16:   19,
+				},
+				Expr: &ast.ExprFunctionCall{
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        17,
+						StartCol:      7,
+						EndCol:        18,
+						StartColUTF16: 7,
+						EndColUTF16:   18,
+					},
+					Function: &ast.Name{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      6,
+							EndPos:        9,
+							StartCol:      7,
+							EndCol:        10,
+							StartColUTF16: 7,
+							EndColUTF16:   10,
+						},
+						Parts: []ast.Vertex{
+							&ast.NamePart{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      6,
+									EndPos:        9,
+									StartCol:      7,
+									EndCol:        10,
+									StartColUTF16: 7,
+									EndColUTF16:   10,
+								},
+								StringTkn: &token.Token{
+									ID:    token.T_STRING,
+									Value: []byte("foo"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      6,
+										EndPos:        9,
+										StartCol:      7,
+										EndCol:        10,
+										StartColUTF16: 7,
+										EndColUTF16:   10,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_OPEN_TAG,
+											Value: []byte("<?php"),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      0,
+												EndPos:        5,
+												StartCol:      1,
+												EndCol:        6,
+												StartColUTF16: 1,
+												EndColUTF16:   6,
+											},
+										},
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      5,
+												EndPos:        6,
+												StartCol:      6,
+												EndCol:        7,
+												StartColUTF16: 6,
+												EndColUTF16:   7,
+											},
+										},
+									},
+								},
+								Value: []byte("foo"),
+							},
+						},
+					},
+					OpenParenthesisTkn: &token.Token{
+						ID:    token.ID(40),
+						Value: []byte("("),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      9,
+							EndPos:        10,
+							StartCol:      10,
+							EndCol:        11,
+							StartColUTF16: 10,
+							EndColUTF16:   11,
+						},
+					},
+					Args: []ast.Vertex{
+						&ast.Argument{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      10,
+								EndPos:        11,
+								StartCol:      11,
+								EndCol:        12,
+								StartColUTF16: 11,
+								EndColUTF16:   12,
+							},
+							Expr: &ast.ScalarLnumber{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      10,
+									EndPos:        11,
+									StartCol:      11,
+									EndCol:        12,
+									StartColUTF16: 11,
+									EndColUTF16:   12,
+								},
+								NumberTkn: &token.Token{
+									ID:    token.T_LNUMBER,
+									Value: []byte("1"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      10,
+										EndPos:        11,
+										StartCol:      11,
+										EndCol:        12,
+										StartColUTF16: 11,
+										EndColUTF16:   12,
+									},
+								},
+								Value: []byte("1"),
+							},
+						},
+						&ast.BadExpr{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      13,
+								EndPos:        13,
+								StartCol:      14,
+								EndCol:        14,
+								StartColUTF16: 14,
+								EndColUTF16:   14,
+							},
+						},
+						&ast.Argument{
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      15,
+								EndPos:        16,
+								StartCol:      16,
+								EndCol:        17,
+								StartColUTF16: 16,
+								EndColUTF16:   17,
+							},
+							Expr: &ast.ScalarLnumber{
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      15,
+									EndPos:        16,
+									StartCol:      16,
+									EndCol:        17,
+									StartColUTF16: 16,
+									EndColUTF16:   17,
+								},
+								NumberTkn: &token.Token{
+									ID:    token.T_LNUMBER,
+									Value: []byte("2"),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      15,
+										EndPos:        16,
+										StartCol:      16,
+										EndCol:        17,
+										StartColUTF16: 16,
+										EndColUTF16:   17,
+									},
+									FreeFloating: []*token.Token{
+										{
+											ID:    token.T_WHITESPACE,
+											Value: []byte(" "),
+											Position: &position.Position{
+												StartLine:     1,
+												EndLine:       1,
+												StartPos:      14,
+												EndPos:        15,
+												StartCol:      15,
+												EndCol:        16,
+												StartColUTF16: 15,
+												EndColUTF16:   16,
+											},
+										},
+									},
+								},
+								Value: []byte("2"),
+							},
+						},
+					},
+					SeparatorTkns: []*token.Token{
+						{
+							ID:    token.ID(44),
+							Value: []byte(","),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      11,
+								EndPos:        12,
+								StartCol:      12,
+								EndCol:        13,
+								StartColUTF16: 12,
+								EndColUTF16:   13,
+							},
+						},
+						{
+							ID:    token.ID(44),
+							Value: []byte(","),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      13,
+								EndPos:        14,
+								StartCol:      14,
+								EndCol:        15,
+								StartColUTF16: 14,
+								EndColUTF16:   15,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      12,
+										EndPos:        13,
+										StartCol:      13,
+										EndCol:        14,
+										StartColUTF16: 13,
+										EndColUTF16:   14,
+									},
+								},
+							},
+						},
+					},
+					CloseParenthesisTkn: &token.Token{
+						ID:    token.ID(41),
+						Value: []byte(")"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      16,
+							EndPos:        17,
+							StartCol:      17,
+							EndCol:        18,
+							StartColUTF16: 17,
+							EndColUTF16:   18,
+						},
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      17,
+						EndPos:        18,
+						StartCol:      18,
+						EndCol:        19,
+						StartColUTF16: 18,
+						EndColUTF16:   19,
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestRecoverUnexpectedEOF(t *testing.T) {
+	src := `<?php echo 1; class A { public $a;`
+
+	expected := &ast.Root{
+		Position: &position.Position{
+			StartLine:     1,
+			EndLine:       1,
+			StartPos:      6,
+			EndPos:        34,
+			StartCol:      7,
+			EndCol:        35,
+			StartColUTF16: 7,
+			EndColUTF16:   35,
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtEcho{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      6,
+					EndPos:        13,
+					StartCol:      7,
+					EndCol:        14,
+					StartColUTF16: 7,
+					EndColUTF16:   14,
+				},
+				EchoTkn: &token.Token{
+					ID:    token.T_ECHO,
+					Value: []byte("echo"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      6,
+						EndPos:        10,
+						StartCol:      7,
+						EndCol:        11,
+						StartColUTF16: 7,
+						EndColUTF16:   11,
+					},
+					FreeFloating: []*token.Token{
+						{
+							ID:    token.T_OPEN_TAG,
+							Value: []byte("<?php"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      0,
+								EndPos:        5,
+								StartCol:      1,
+								EndCol:        6,
+								StartColUTF16: 1,
+								EndColUTF16:   6,
+							},
+						},
+						{
+							ID:    token.T_WHITESPACE,
+							Value: []byte(" "),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      5,
+								EndPos:        6,
+								StartCol:      6,
+								EndCol:        7,
+								StartColUTF16: 6,
+								EndColUTF16:   7,
+							},
+						},
+					},
+				},
+				Exprs: []ast.Vertex{
+					&ast.ScalarLnumber{
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      11,
+							EndPos:        12,
+							StartCol:      12,
+							EndCol:        13,
+							StartColUTF16: 12,
+							EndColUTF16:   13,
+						},
+						NumberTkn: &token.Token{
+							ID:    token.T_LNUMBER,
+							Value: []byte("1"),
+							Position: &position.Position{
+								StartLine:     1,
+								EndLine:       1,
+								StartPos:      11,
+								EndPos:        12,
+								StartCol:      12,
+								EndCol:        13,
+								StartColUTF16: 12,
+								EndColUTF16:   13,
+							},
+							FreeFloating: []*token.Token{
+								{
+									ID:    token.T_WHITESPACE,
+									Value: []byte(" "),
+									Position: &position.Position{
+										StartLine:     1,
+										EndLine:       1,
+										StartPos:      10,
+										EndPos:        11,
+										StartCol:      11,
+										EndCol:        12,
+										StartColUTF16: 11,
+										EndColUTF16:   12,
+									},
+								},
+							},
+						},
+						Value: []byte("1"),
+					},
+				},
+				SemiColonTkn: &token.Token{
+					ID:    token.ID(59),
+					Value: []byte(";"),
+					Position: &position.Position{
+						StartLine:     1,
+						EndLine:       1,
+						StartPos:      12,
+						EndPos:        13,
+						StartCol:      13,
+						EndCol:        14,
+						StartColUTF16: 13,
+						EndColUTF16:   14,
+					},
+				},
+			},
+			&ast.BadStmt{
+				Position: &position.Position{
+					StartLine:     1,
+					EndLine:       1,
+					StartPos:      14,
+					EndPos:        34,
+					StartCol:      15,
+					EndCol:        35,
+					StartColUTF16: 15,
+					EndColUTF16:   35,
+				},
+				SkippedTkns: []*token.Token{
+					{
+						ID:    token.T_CLASS,
+						Value: []byte("class"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      14,
+							EndPos:        19,
+							StartCol:      15,
+							EndCol:        20,
+							StartColUTF16: 15,
+							EndColUTF16:   20,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      13,
+									EndPos:        14,
+									StartCol:      14,
+									EndCol:        15,
+									StartColUTF16: 14,
+									EndColUTF16:   15,
+								},
+							},
+						},
+					},
+					{
+						ID:    token.T_STRING,
+						Value: []byte("A"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      20,
+							EndPos:        21,
+							StartCol:      21,
+							EndCol:        22,
+							StartColUTF16: 21,
+							EndColUTF16:   22,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      19,
+									EndPos:        20,
+									StartCol:      20,
+									EndCol:        21,
+									StartColUTF16: 20,
+									EndColUTF16:   21,
+								},
+							},
+						},
+					},
+					{
+						ID:    token.ID(123),
+						Value: []byte("{"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      22,
+							EndPos:        23,
+							StartCol:      23,
+							EndCol:        24,
+							StartColUTF16: 23,
+							EndColUTF16:   24,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      21,
+									EndPos:        22,
+									StartCol:      22,
+									EndCol:        23,
+									StartColUTF16: 22,
+									EndColUTF16:   23,
+								},
+							},
+						},
+					},
+					{
+						ID:    token.T_PUBLIC,
+						Value: []byte("public"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      24,
+							EndPos:        30,
+							StartCol:      25,
+							EndCol:        31,
+							StartColUTF16: 25,
+							EndColUTF16:   31,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      23,
+									EndPos:        24,
+									StartCol:      24,
+									EndCol:        25,
+									StartColUTF16: 24,
+									EndColUTF16:   25,
+								},
+							},
+						},
+					},
+					{
+						ID:    token.T_VARIABLE,
+						Value: []byte("$a"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      31,
+							EndPos:        33,
+							StartCol:      32,
+							EndCol:        34,
+							StartColUTF16: 32,
+							EndColUTF16:   34,
+						},
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte(" "),
+								Position: &position.Position{
+									StartLine:     1,
+									EndLine:       1,
+									StartPos:      30,
+									EndPos:        31,
+									StartCol:      31,
+									EndCol:        32,
+									StartColUTF16: 31,
+									EndColUTF16:   32,
+								},
+							},
+						},
+					},
+					{
+						ID:    token.ID(59),
+						Value: []byte(";"),
+						Position: &position.Position{
+							StartLine:     1,
+							EndLine:       1,
+							StartPos:      33,
+							EndPos:        34,
+							StartCol:      34,
+							EndCol:        35,
+							StartColUTF16: 34,
+							EndColUTF16:   35,
+						},
+					},
+				},
+			},
+		},
+		EndTkn: &token.Token{},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	actual := php8parser.GetRootNode()
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestSyntaxErrorExpectedTokens(t *testing.T) {
+	src := "<?php\n$a->;"
+
+	expected := []*errors.Error{
+		{
+			Msg:        "syntax error: unexpected ';', expecting T_STRING or T_VARIABLE or '{' or '$'",
+			Pos:        &position.Position{StartLine: 2, EndLine: 2, StartPos: 10, EndPos: 11, StartCol: 5, EndCol: 6, StartColUTF16: 5, EndColUTF16: 6},
+			TokenID:    token.ID(';'),
+			TokenValue: []byte(";"),
+			Expected:   []token.ID{token.T_STRING, token.T_VARIABLE, token.ID('{'), token.ID('$')},
+			Line:       2,
+			Column:     5,
+		},
+	}
+
+	parserErrors := []*errors.Error{}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+		ErrorHandlerFunc: func(e *errors.Error) {
+			parserErrors = append(parserErrors, e)
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	assert.DeepEqual(t, expected, parserErrors)
+}
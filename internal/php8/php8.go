@@ -0,0 +1,8379 @@
+// Code generated by goyacc -o internal/php8/php8.go internal/php8/php8.y. DO NOT EDIT.
+
+// line internal/php8/php8.y:2
+package php8
+
+import __yyfmt__ "fmt"
+
+// line internal/php8/php8.y:2
+
+import (
+	"strconv"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+// line internal/php8/php8.y:14
+type yySymType struct {
+	yys   int
+	node  ast.Vertex
+	token *token.Token
+	list  []ast.Vertex
+}
+
+const T_INCLUDE = 57346
+const T_INCLUDE_ONCE = 57347
+const T_EXIT = 57348
+const T_IF = 57349
+const T_LNUMBER = 57350
+const T_DNUMBER = 57351
+const T_STRING = 57352
+const T_STRING_VARNAME = 57353
+const T_VARIABLE = 57354
+const T_NUM_STRING = 57355
+const T_INLINE_HTML = 57356
+const T_CHARACTER = 57357
+const T_BAD_CHARACTER = 57358
+const T_ENCAPSED_AND_WHITESPACE = 57359
+const T_CONSTANT_ENCAPSED_STRING = 57360
+const T_ECHO = 57361
+const T_DO = 57362
+const T_WHILE = 57363
+const T_ENDWHILE = 57364
+const T_FOR = 57365
+const T_ENDFOR = 57366
+const T_FOREACH = 57367
+const T_ENDFOREACH = 57368
+const T_DECLARE = 57369
+const T_ENDDECLARE = 57370
+const T_AS = 57371
+const T_SWITCH = 57372
+const T_ENDSWITCH = 57373
+const T_CASE = 57374
+const T_DEFAULT = 57375
+const T_BREAK = 57376
+const T_CONTINUE = 57377
+const T_GOTO = 57378
+const T_FUNCTION = 57379
+const T_FN = 57380
+const T_CONST = 57381
+const T_RETURN = 57382
+const T_TRY = 57383
+const T_CATCH = 57384
+const T_FINALLY = 57385
+const T_THROW = 57386
+const T_USE = 57387
+const T_INSTEADOF = 57388
+const T_GLOBAL = 57389
+const T_VAR = 57390
+const T_UNSET = 57391
+const T_ISSET = 57392
+const T_EMPTY = 57393
+const T_HALT_COMPILER = 57394
+const T_CLASS = 57395
+const T_TRAIT = 57396
+const T_INTERFACE = 57397
+const T_EXTENDS = 57398
+const T_IMPLEMENTS = 57399
+const T_OBJECT_OPERATOR = 57400
+const T_DOUBLE_ARROW = 57401
+const T_LIST = 57402
+const T_ARRAY = 57403
+const T_CALLABLE = 57404
+const T_CLASS_C = 57405
+const T_TRAIT_C = 57406
+const T_METHOD_C = 57407
+const T_FUNC_C = 57408
+const T_LINE = 57409
+const T_FILE = 57410
+const T_COMMENT = 57411
+const T_DOC_COMMENT = 57412
+const T_OPEN_TAG = 57413
+const T_OPEN_TAG_WITH_ECHO = 57414
+const T_CLOSE_TAG = 57415
+const T_WHITESPACE = 57416
+const T_START_HEREDOC = 57417
+const T_END_HEREDOC = 57418
+const T_DOLLAR_OPEN_CURLY_BRACES = 57419
+const T_CURLY_OPEN = 57420
+const T_PAAMAYIM_NEKUDOTAYIM = 57421
+const T_NAMESPACE = 57422
+const T_NS_C = 57423
+const T_DIR = 57424
+const T_NS_SEPARATOR = 57425
+const T_ELLIPSIS = 57426
+const T_EVAL = 57427
+const T_REQUIRE = 57428
+const T_REQUIRE_ONCE = 57429
+const T_LOGICAL_OR = 57430
+const T_LOGICAL_XOR = 57431
+const T_LOGICAL_AND = 57432
+const T_INSTANCEOF = 57433
+const T_NEW = 57434
+const T_CLONE = 57435
+const T_ELSEIF = 57436
+const T_ELSE = 57437
+const T_ENDIF = 57438
+const T_PRINT = 57439
+const T_YIELD = 57440
+const T_STATIC = 57441
+const T_ABSTRACT = 57442
+const T_FINAL = 57443
+const T_PRIVATE = 57444
+const T_PROTECTED = 57445
+const T_PUBLIC = 57446
+const T_INC = 57447
+const T_DEC = 57448
+const T_YIELD_FROM = 57449
+const T_INT_CAST = 57450
+const T_DOUBLE_CAST = 57451
+const T_STRING_CAST = 57452
+const T_ARRAY_CAST = 57453
+const T_OBJECT_CAST = 57454
+const T_BOOL_CAST = 57455
+const T_UNSET_CAST = 57456
+const T_COALESCE = 57457
+const T_SPACESHIP = 57458
+const T_NOELSE = 57459
+const T_PLUS_EQUAL = 57460
+const T_MINUS_EQUAL = 57461
+const T_MUL_EQUAL = 57462
+const T_POW_EQUAL = 57463
+const T_DIV_EQUAL = 57464
+const T_CONCAT_EQUAL = 57465
+const T_MOD_EQUAL = 57466
+const T_AND_EQUAL = 57467
+const T_OR_EQUAL = 57468
+const T_XOR_EQUAL = 57469
+const T_SL_EQUAL = 57470
+const T_SR_EQUAL = 57471
+const T_COALESCE_EQUAL = 57472
+const T_BOOLEAN_OR = 57473
+const T_BOOLEAN_AND = 57474
+const T_POW = 57475
+const T_SL = 57476
+const T_SR = 57477
+const T_IS_IDENTICAL = 57478
+const T_IS_NOT_IDENTICAL = 57479
+const T_IS_EQUAL = 57480
+const T_IS_NOT_EQUAL = 57481
+const T_IS_SMALLER_OR_EQUAL = 57482
+const T_IS_GREATER_OR_EQUAL = 57483
+const T_NULLSAFE_OBJECT_OPERATOR = 57484
+const T_MATCH = 57485
+const T_ATTRIBUTE = 57486
+const T_ENUM = 57487
+const T_READONLY = 57488
+const T_AMPERSAND_NOT_FOLLOWED_BY_VAR_OR_VARARG = 57489
+const T_FRAGMENT_EXPR = 57490
+const T_FRAGMENT_STMTS = 57491
+const T_FRAGMENT_CLASS_MEMBER = 57492
+
+var yyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
+	"T_INCLUDE",
+	"T_INCLUDE_ONCE",
+	"T_EXIT",
+	"T_IF",
+	"T_LNUMBER",
+	"T_DNUMBER",
+	"T_STRING",
+	"T_STRING_VARNAME",
+	"T_VARIABLE",
+	"T_NUM_STRING",
+	"T_INLINE_HTML",
+	"T_CHARACTER",
+	"T_BAD_CHARACTER",
+	"T_ENCAPSED_AND_WHITESPACE",
+	"T_CONSTANT_ENCAPSED_STRING",
+	"T_ECHO",
+	"T_DO",
+	"T_WHILE",
+	"T_ENDWHILE",
+	"T_FOR",
+	"T_ENDFOR",
+	"T_FOREACH",
+	"T_ENDFOREACH",
+	"T_DECLARE",
+	"T_ENDDECLARE",
+	"T_AS",
+	"T_SWITCH",
+	"T_ENDSWITCH",
+	"T_CASE",
+	"T_DEFAULT",
+	"T_BREAK",
+	"T_CONTINUE",
+	"T_GOTO",
+	"T_FUNCTION",
+	"T_FN",
+	"T_CONST",
+	"T_RETURN",
+	"T_TRY",
+	"T_CATCH",
+	"T_FINALLY",
+	"T_THROW",
+	"T_USE",
+	"T_INSTEADOF",
+	"T_GLOBAL",
+	"T_VAR",
+	"T_UNSET",
+	"T_ISSET",
+	"T_EMPTY",
+	"T_HALT_COMPILER",
+	"T_CLASS",
+	"T_TRAIT",
+	"T_INTERFACE",
+	"T_EXTENDS",
+	"T_IMPLEMENTS",
+	"T_OBJECT_OPERATOR",
+	"T_DOUBLE_ARROW",
+	"T_LIST",
+	"T_ARRAY",
+	"T_CALLABLE",
+	"T_CLASS_C",
+	"T_TRAIT_C",
+	"T_METHOD_C",
+	"T_FUNC_C",
+	"T_LINE",
+	"T_FILE",
+	"T_COMMENT",
+	"T_DOC_COMMENT",
+	"T_OPEN_TAG",
+	"T_OPEN_TAG_WITH_ECHO",
+	"T_CLOSE_TAG",
+	"T_WHITESPACE",
+	"T_START_HEREDOC",
+	"T_END_HEREDOC",
+	"T_DOLLAR_OPEN_CURLY_BRACES",
+	"T_CURLY_OPEN",
+	"T_PAAMAYIM_NEKUDOTAYIM",
+	"T_NAMESPACE",
+	"T_NS_C",
+	"T_DIR",
+	"T_NS_SEPARATOR",
+	"T_ELLIPSIS",
+	"T_EVAL",
+	"T_REQUIRE",
+	"T_REQUIRE_ONCE",
+	"T_LOGICAL_OR",
+	"T_LOGICAL_XOR",
+	"T_LOGICAL_AND",
+	"T_INSTANCEOF",
+	"T_NEW",
+	"T_CLONE",
+	"T_ELSEIF",
+	"T_ELSE",
+	"T_ENDIF",
+	"T_PRINT",
+	"T_YIELD",
+	"T_STATIC",
+	"T_ABSTRACT",
+	"T_FINAL",
+	"T_PRIVATE",
+	"T_PROTECTED",
+	"T_PUBLIC",
+	"T_INC",
+	"T_DEC",
+	"T_YIELD_FROM",
+	"T_INT_CAST",
+	"T_DOUBLE_CAST",
+	"T_STRING_CAST",
+	"T_ARRAY_CAST",
+	"T_OBJECT_CAST",
+	"T_BOOL_CAST",
+	"T_UNSET_CAST",
+	"T_COALESCE",
+	"T_SPACESHIP",
+	"T_NOELSE",
+	"T_PLUS_EQUAL",
+	"T_MINUS_EQUAL",
+	"T_MUL_EQUAL",
+	"T_POW_EQUAL",
+	"T_DIV_EQUAL",
+	"T_CONCAT_EQUAL",
+	"T_MOD_EQUAL",
+	"T_AND_EQUAL",
+	"T_OR_EQUAL",
+	"T_XOR_EQUAL",
+	"T_SL_EQUAL",
+	"T_SR_EQUAL",
+	"T_COALESCE_EQUAL",
+	"T_BOOLEAN_OR",
+	"T_BOOLEAN_AND",
+	"T_POW",
+	"T_SL",
+	"T_SR",
+	"T_IS_IDENTICAL",
+	"T_IS_NOT_IDENTICAL",
+	"T_IS_EQUAL",
+	"T_IS_NOT_EQUAL",
+	"T_IS_SMALLER_OR_EQUAL",
+	"T_IS_GREATER_OR_EQUAL",
+	"T_NULLSAFE_OBJECT_OPERATOR",
+	"T_MATCH",
+	"T_ATTRIBUTE",
+	"T_ENUM",
+	"T_READONLY",
+	"T_AMPERSAND_NOT_FOLLOWED_BY_VAR_OR_VARARG",
+	"T_FRAGMENT_EXPR",
+	"T_FRAGMENT_STMTS",
+	"T_FRAGMENT_CLASS_MEMBER",
+	"'\"'",
+	"'`'",
+	"'{'",
+	"'}'",
+	"';'",
+	"':'",
+	"'('",
+	"')'",
+	"'['",
+	"']'",
+	"'?'",
+	"'&'",
+	"'-'",
+	"'+'",
+	"'!'",
+	"'~'",
+	"'@'",
+	"'$'",
+	"','",
+	"'|'",
+	"'='",
+	"'^'",
+	"'*'",
+	"'/'",
+	"'%'",
+	"'<'",
+	"'>'",
+	"'.'",
+}
+
+var yyStatenames = [...]string{}
+
+const yyEofCode = 1
+const yyErrCode = 2
+const yyInitialStackSize = 16
+
+// line internal/php8/php8.y:5223
+
+// line yacctab:1
+var yyExca = [...]int16{
+	-1, 1,
+	1, -1,
+	-2, 0,
+	-1, 2,
+	1, 1,
+	-2, 0,
+	-1, 5,
+	37, 320,
+	39, 320,
+	-2, 0,
+	-1, 45,
+	58, 498,
+	79, 498,
+	142, 498,
+	153, 498,
+	159, 498,
+	-2, 493,
+	-1, 55,
+	157, 501,
+	-2, 511,
+	-1, 93,
+	58, 500,
+	79, 500,
+	142, 500,
+	153, 500,
+	157, 503,
+	159, 500,
+	-2, 486,
+	-1, 121,
+	79, 459,
+	-2, 488,
+	-1, 133,
+	1, 3,
+	-2, 0,
+	-1, 142,
+	37, 321,
+	39, 321,
+	-2, 318,
+	-1, 277,
+	58, 498,
+	79, 498,
+	142, 498,
+	153, 498,
+	159, 498,
+	-2, 379,
+	-1, 280,
+	157, 503,
+	-2, 500,
+	-1, 283,
+	58, 498,
+	79, 498,
+	142, 498,
+	153, 498,
+	159, 498,
+	-2, 381,
+	-1, 421,
+	116, 0,
+	136, 0,
+	137, 0,
+	138, 0,
+	139, 0,
+	-2, 404,
+	-1, 422,
+	116, 0,
+	136, 0,
+	137, 0,
+	138, 0,
+	139, 0,
+	-2, 405,
+	-1, 423,
+	116, 0,
+	136, 0,
+	137, 0,
+	138, 0,
+	139, 0,
+	-2, 406,
+	-1, 424,
+	116, 0,
+	136, 0,
+	137, 0,
+	138, 0,
+	139, 0,
+	-2, 407,
+	-1, 425,
+	140, 0,
+	141, 0,
+	176, 0,
+	177, 0,
+	-2, 408,
+	-1, 426,
+	140, 0,
+	141, 0,
+	176, 0,
+	177, 0,
+	-2, 409,
+	-1, 427,
+	140, 0,
+	141, 0,
+	176, 0,
+	177, 0,
+	-2, 410,
+	-1, 428,
+	140, 0,
+	141, 0,
+	176, 0,
+	177, 0,
+	-2, 411,
+	-1, 429,
+	116, 0,
+	136, 0,
+	137, 0,
+	138, 0,
+	139, 0,
+	-2, 412,
+	-1, 436,
+	158, 179,
+	169, 179,
+	-2, 498,
+	-1, 486,
+	158, 541,
+	160, 541,
+	169, 541,
+	-2, 498,
+	-1, 491,
+	58, 499,
+	79, 499,
+	142, 499,
+	153, 499,
+	157, 502,
+	159, 499,
+	-2, 414,
+	-1, 505,
+	157, 527,
+	-2, 489,
+	-1, 507,
+	157, 529,
+	-2, 518,
+	-1, 589,
+	157, 527,
+	-2, 491,
+	-1, 591,
+	157, 529,
+	-2, 519,
+	-1, 611,
+	158, 236,
+	-2, 92,
+	-1, 620,
+	29, 83,
+	156, 83,
+	-2, 96,
+	-1, 623,
+	156, 16,
+	-2, 462,
+	-1, 626,
+	156, 49,
+	-2, 434,
+	-1, 627,
+	156, 76,
+	-2, 458,
+	-1, 636,
+	156, 68,
+	-2, 474,
+	-1, 637,
+	156, 69,
+	-2, 475,
+	-1, 638,
+	156, 70,
+	-2, 476,
+	-1, 639,
+	156, 65,
+	-2, 477,
+	-1, 640,
+	156, 67,
+	-2, 478,
+	-1, 641,
+	156, 66,
+	-2, 479,
+	-1, 642,
+	156, 71,
+	-2, 480,
+	-1, 643,
+	156, 64,
+	-2, 481,
+	-1, 645,
+	157, 445,
+	-2, 45,
+	-1, 646,
+	157, 445,
+	-2, 72,
+	-1, 673,
+	171, 76,
+	-2, 254,
+	-1, 674,
+	171, 56,
+	-2, 263,
+	-1, 675,
+	171, 57,
+	-2, 264,
+	-1, 716,
+	158, 236,
+	-2, 92,
+	-1, 742,
+	157, 502,
+	-2, 499,
+	-1, 782,
+	158, 126,
+	-2, 0,
+	-1, 840,
+	158, 206,
+	-2, 498,
+	-1, 851,
+	158, 236,
+	-2, 92,
+	-1, 856,
+	37, 320,
+	39, 320,
+	-2, 0,
+	-1, 864,
+	158, 540,
+	160, 540,
+	169, 540,
+	-2, 498,
+	-1, 870,
+	157, 528,
+	-2, 490,
+	-1, 871,
+	157, 528,
+	-2, 492,
+	-1, 880,
+	158, 126,
+	-2, 92,
+	-1, 924,
+	158, 207,
+	-2, 498,
+	-1, 940,
+	37, 320,
+	39, 320,
+	-2, 0,
+	-1, 942,
+	94, 231,
+	95, 231,
+	96, 231,
+	-2, 0,
+	-1, 970,
+	158, 236,
+	-2, 92,
+	-1, 990,
+	158, 206,
+	-2, 498,
+	-1, 992,
+	158, 209,
+	-2, 470,
+	-1, 996,
+	94, 232,
+	95, 232,
+	96, 232,
+	-2, 0,
+	-1, 1001,
+	37, 320,
+	39, 320,
+	-2, 0,
+	-1, 1003,
+	37, 320,
+	39, 320,
+	-2, 0,
+	-1, 1043,
+	37, 320,
+	39, 320,
+	-2, 0,
+	-1, 1046,
+	37, 320,
+	39, 320,
+	-2, 0,
+	-1, 1060,
+	31, 222,
+	32, 222,
+	33, 222,
+	154, 222,
+	-2, 0,
+	-1, 1081,
+	31, 221,
+	32, 221,
+	33, 221,
+	154, 221,
+	-2, 0,
+}
+
+const yyPrivate = 57344
+
+const yyLast = 9575
+
+var yyAct = [...]int16{
+	29, 1070, 953, 1027, 127, 986, 773, 856, 618, 949,
+	839, 268, 664, 169, 472, 145, 129, 917, 821, 10,
+	672, 125, 136, 179, 179, 179, 389, 173, 191, 345,
+	906, 510, 347, 656, 159, 164, 820, 665, 383, 666,
+	788, 8, 616, 718, 42, 603, 663, 775, 435, 509,
+	477, 384, 88, 464, 9, 153, 270, 89, 175, 260,
+	272, 276, 154, 190, 284, 285, 286, 287, 288, 93,
+	593, 289, 290, 291, 292, 293, 294, 295, 184, 298,
+	178, 462, 306, 45, 307, 308, 309, 187, 91, 171,
+	183, 168, 2, 592, 302, 314, 334, 133, 323, 324,
+	504, 326, 327, 170, 180, 181, 313, 1090, 1064, 862,
+	812, 315, 816, 813, 808, 798, 736, 400, 659, 278,
+	278, 379, 1087, 654, 478, 653, 119, 810, 896, 86,
+	661, 280, 280, 1088, 972, 119, 119, 807, 803, 652,
+	155, 658, 804, 795, 250, 277, 283, 320, 342, 220,
+	10, 709, 804, 682, 401, 398, 186, 796, 358, 652,
+	349, 1013, 378, 1011, 1009, 910, 880, 372, 402, 399,
+	782, 769, 8, 336, 153, 387, 707, 391, 392, 376,
+	362, 153, 698, 316, 369, 9, 484, 375, 471, 1017,
+	385, 206, 337, 339, 403, 404, 405, 406, 407, 408,
+	409, 410, 411, 412, 413, 414, 415, 416, 417, 418,
+	419, 420, 421, 422, 423, 424, 425, 426, 427, 428,
+	429, 992, 431, 433, 1071, 437, 359, 343, 872, 867,
+	163, 205, 207, 208, 756, 446, 448, 449, 450, 451,
+	452, 453, 454, 455, 456, 457, 458, 459, 460, 746,
+	121, 749, 747, 365, 439, 743, 396, 377, 186, 155,
+	132, 1083, 119, 474, 179, 476, 1065, 479, 272, 442,
+	397, 378, 252, 320, 371, 487, 335, 730, 265, 482,
+	489, 278, 120, 272, 752, 310, 360, 251, 372, 753,
+	1054, 120, 120, 280, 490, 728, 132, 132, 179, 119,
+	483, 1035, 1034, 1025, 261, 499, 498, 436, 1004, 316,
+	997, 179, 935, 922, 882, 878, 875, 481, 480, 480,
+	505, 589, 604, 605, 866, 837, 606, 430, 826, 438,
+	131, 278, 473, 126, 610, 784, 612, 744, 466, 617,
+	318, 272, 735, 280, 475, 319, 361, 353, 354, 281,
+	1042, 994, 649, 1029, 1028, 954, 220, 486, 970, 925,
+	153, 865, 266, 278, 507, 591, 131, 131, 677, 126,
+	126, 660, 851, 264, 1072, 280, 340, 932, 492, 263,
+	692, 333, 10, 325, 376, 726, 281, 322, 346, 501,
+	355, 503, 684, 368, 687, 494, 495, 321, 206, 209,
+	210, 702, 748, 191, 8, 834, 671, 497, 835, 220,
+	297, 594, 601, 588, 267, 338, 716, 9, 120, 696,
+	694, 611, 783, 494, 488, 495, 495, 494, 204, 203,
+	153, 53, 443, 705, 441, 262, 225, 693, 205, 207,
+	208, 224, 711, 202, 712, 223, 177, 667, 714, 706,
+	184, 206, 209, 210, 676, 120, 385, 679, 216, 218,
+	176, 174, 341, 598, 685, 157, 703, 1093, 691, 1092,
+	1101, 319, 220, 713, 981, 982, 470, 981, 982, 445,
+	733, 204, 203, 725, 600, 272, 738, 701, 700, 272,
+	228, 205, 207, 208, 215, 217, 202, 1082, 723, 1061,
+	741, 1036, 1031, 755, 1024, 248, 249, 758, 978, 933,
+	132, 921, 920, 724, 206, 155, 132, 717, 235, 236,
+	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
+	247, 727, 364, 918, 363, 916, 811, 913, 904, 889,
+	888, 737, 138, 715, 204, 203, 697, 599, 732, 722,
+	681, 678, 734, 444, 205, 207, 208, 440, 597, 202,
+	1055, 353, 354, 395, 596, 394, 393, 353, 354, 366,
+	1023, 234, 144, 1020, 1007, 757, 1005, 974, 754, 1095,
+	131, 1068, 650, 126, 132, 137, 131, 1067, 143, 126,
+	1006, 760, 650, 650, 1000, 650, 1030, 179, 763, 980,
+	995, 355, 960, 959, 958, 303, 941, 355, 937, 877,
+	496, 857, 721, 226, 493, 780, 662, 768, 3, 4,
+	5, 53, 135, 276, 318, 306, 307, 308, 777, 206,
+	781, 132, 323, 324, 220, 326, 327, 1053, 301, 149,
+	150, 151, 148, 147, 146, 315, 961, 374, 761, 764,
+	765, 480, 480, 766, 892, 767, 132, 158, 166, 759,
+	167, 350, 158, 794, 341, 253, 595, 350, 312, 311,
+	304, 305, 231, 233, 232, 1051, 206, 762, 374, 799,
+	800, 320, 801, 802, 53, 814, 152, 374, 130, 115,
+	229, 230, 374, 374, 1074, 1050, 10, 830, 391, 832,
+	376, 341, 822, 132, 165, 836, 132, 809, 687, 1048,
+	687, 805, 116, 117, 671, 53, 720, 316, 8, 346,
+	132, 355, 261, 852, 831, 908, 131, 160, 923, 126,
+	385, 9, 819, 777, 828, 824, 818, 863, 688, 797,
+	719, 690, 343, 465, 609, 468, 683, 373, 845, 855,
+	156, 330, 331, 87, 869, 890, 838, 186, 118, 344,
+	999, 853, 929, 930, 891, 278, 278, 357, 859, 356,
+	604, 353, 354, 388, 825, 868, 686, 280, 280, 370,
+	1089, 132, 1078, 617, 885, 130, 115, 657, 188, 879,
+	131, 436, 840, 126, 303, 53, 794, 278, 823, 894,
+	854, 188, 254, 1032, 981, 982, 858, 132, 166, 280,
+	167, 874, 162, 901, 902, 876, 303, 905, 750, 132,
+	172, 496, 153, 864, 689, 883, 130, 115, 887, 303,
+	893, 650, 907, 463, 328, 909, 461, 911, 822, 258,
+	687, 257, 895, 256, 272, 687, 687, 897, 898, 255,
+	899, 900, 227, 919, 671, 926, 939, 914, 934, 304,
+	305, 912, 134, 943, 1, 940, 272, 881, 777, 776,
+	353, 354, 48, 136, 927, 142, 303, 944, 952, 319,
+	608, 304, 305, 139, 140, 780, 787, 931, 942, 131,
+	847, 299, 126, 329, 304, 305, 903, 777, 998, 278,
+	467, 966, 349, 785, 434, 793, 827, 965, 726, 355,
+	355, 280, 355, 355, 956, 1056, 1091, 391, 355, 833,
+	962, 971, 907, 964, 822, 924, 272, 976, 955, 975,
+	687, 385, 687, 651, 989, 936, 983, 991, 985, 973,
+	607, 304, 305, 979, 385, 1001, 984, 981, 982, 1003,
+	149, 150, 151, 148, 147, 146, 946, 136, 957, 385,
+	996, 987, 950, 1008, 948, 1010, 1012, 1015, 303, 947,
+	346, 82, 259, 496, 1018, 815, 967, 1019, 141, 469,
+	303, 278, 1026, 968, 969, 332, 1021, 777, 1022, 352,
+	669, 351, 668, 280, 687, 348, 271, 152, 44, 43,
+	1040, 1041, 602, 1069, 17, 655, 303, 990, 1043, 615,
+	952, 300, 1049, 385, 1046, 16, 1033, 1037, 136, 1047,
+	136, 189, 745, 1039, 317, 56, 55, 122, 57, 92,
+	1059, 90, 79, 304, 305, 1014, 296, 269, 793, 69,
+	1063, 68, 792, 791, 790, 304, 305, 789, 185, 1075,
+	385, 1076, 1077, 182, 46, 1060, 385, 1079, 355, 355,
+	136, 355, 355, 136, 1057, 928, 844, 774, 390, 380,
+	138, 304, 305, 987, 132, 161, 119, 385, 367, 41,
+	40, 39, 124, 38, 37, 6, 1080, 1081, 1052, 1016,
+	1098, 1099, 0, 0, 0, 1085, 1086, 385, 385, 0,
+	144, 0, 385, 385, 0, 0, 195, 197, 196, 220,
+	0, 0, 0, 137, 0, 0, 143, 385, 0, 385,
+	0, 1100, 0, 1102, 842, 123, 0, 0, 0, 0,
+	0, 0, 355, 222, 219, 0, 0, 0, 0, 0,
+	0, 346, 0, 0, 131, 0, 0, 126, 0, 193,
+	194, 206, 209, 210, 211, 212, 213, 214, 216, 218,
+	0, 0, 0, 281, 0, 200, 0, 149, 150, 151,
+	148, 147, 146, 1029, 1028, 0, 0, 0, 0, 221,
+	199, 204, 203, 0, 0, 0, 0, 0, 198, 0,
+	201, 205, 207, 208, 215, 217, 202, 0, 0, 0,
+	0, 0, 0, 0, 0, 355, 0, 0, 0, 0,
+	0, 0, 53, 0, 152, 0, 0, 0, 0, 0,
+	0, 279, 1073, 843, 0, 0, 841, 0, 0, 0,
+	0, 0, 120, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 619, 1038, 631, 632, 623, 530,
+	102, 103, 620, 0, 119, 0, 0, 0, 0, 0,
+	124, 534, 535, 536, 537, 538, 539, 540, 541, 542,
+	543, 544, 564, 565, 566, 567, 568, 556, 557, 645,
+	646, 559, 560, 545, 546, 547, 624, 549, 550, 551,
+	552, 553, 629, 630, 0, 576, 574, 575, 571, 572,
+	0, 0, 621, 647, 570, 643, 639, 640, 641, 636,
+	637, 0, 0, 0, 0, 0, 1084, 112, 0, 0,
+	0, 0, 648, 642, 638, 126, 614, 633, 634, 635,
+	523, 524, 525, 526, 628, 622, 531, 532, 533, 625,
+	626, 627, 512, 513, 514, 515, 516, 61, 62, 85,
+	70, 71, 72, 73, 74, 75, 76, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 644, 53, 587, 517, 0,
+	0, 0, 0, 113, 80, 0, 0, 0, 0, 67,
+	613, 59, 0, 0, 0, 64, 63, 65, 66, 78,
+	120, 619, 0, 631, 632, 623, 530, 102, 103, 620,
+	0, 119, 0, 0, 0, 0, 0, 124, 534, 535,
+	536, 537, 538, 539, 540, 541, 542, 543, 544, 564,
+	565, 566, 567, 568, 556, 557, 645, 646, 559, 560,
+	545, 546, 547, 624, 549, 550, 551, 552, 553, 629,
+	630, 0, 576, 574, 575, 571, 572, 0, 0, 621,
+	647, 570, 643, 639, 640, 641, 636, 637, 0, 0,
+	0, 0, 0, 0, 112, 0, 0, 0, 0, 648,
+	642, 638, 126, 884, 633, 634, 635, 523, 524, 525,
+	526, 628, 622, 531, 532, 533, 625, 626, 627, 512,
+	513, 514, 515, 516, 61, 62, 85, 70, 71, 72,
+	73, 74, 75, 76, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 132,
+	0, 119, 644, 53, 587, 517, 0, 124, 0, 0,
+	113, 80, 0, 0, 0, 0, 67, 0, 59, 0,
+	0, 0, 64, 63, 65, 66, 78, 120, 7, 0,
+	97, 98, 77, 54, 102, 103, 36, 0, 119, 0,
+	28, 0, 0, 0, 124, 27, 19, 18, 0, 20,
+	123, 31, 0, 32, 0, 0, 21, 0, 0, 0,
+	22, 23, 35, 47, 115, 14, 24, 34, 0, 131,
+	81, 13, 126, 25, 0, 30, 95, 96, 11, 49,
+	50, 51, 0, 0, 0, 0, 58, 123, 281, 111,
+	107, 108, 109, 104, 105, 0, 0, 0, 0, 0,
+	0, 112, 0, 0, 0, 0, 12, 110, 106, 126,
+	0, 99, 100, 101, 0, 0, 0, 0, 94, 60,
+	0, 0, 0, 83, 84, 26, 116, 117, 0, 0,
+	0, 61, 62, 85, 70, 71, 72, 73, 74, 75,
+	76, 0, 0, 0, 0, 0, 279, 0, 282, 0,
+	0, 0, 0, 0, 0, 0, 0, 120, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 114,
+	53, 52, 118, 0, 0, 0, 0, 113, 80, 15,
+	817, 33, 0, 67, 0, 59, 0, 0, 0, 64,
+	63, 65, 66, 78, 120, 7, 0, 97, 98, 77,
+	54, 102, 103, 36, 0, 119, 0, 28, 47, 115,
+	0, 124, 27, 19, 18, 0, 20, 0, 31, 0,
+	32, 0, 0, 21, 49, 50, 51, 22, 23, 35,
+	47, 115, 14, 24, 34, 0, 0, 81, 13, 0,
+	25, 0, 30, 95, 96, 11, 49, 50, 51, 0,
+	0, 0, 0, 58, 123, 0, 111, 107, 108, 109,
+	104, 105, 0, 0, 0, 0, 0, 0, 112, 0,
+	156, 116, 117, 12, 110, 106, 126, 0, 99, 100,
+	101, 0, 0, 0, 0, 94, 60, 0, 0, 0,
+	83, 84, 26, 116, 117, 0, 0, 0, 61, 62,
+	85, 70, 71, 72, 73, 74, 75, 76, 0, 0,
+	0, 0, 0, 0, 0, 53, 52, 118, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 114, 53, 52, 118,
+	0, 0, 0, 0, 113, 80, 15, 680, 33, 0,
+	67, 0, 59, 0, 0, 0, 64, 63, 65, 66,
+	78, 120, 7, 0, 97, 98, 77, 54, 102, 103,
+	36, 0, 119, 0, 28, 0, 0, 0, 124, 27,
+	19, 18, 0, 20, 0, 31, 0, 32, 0, 0,
+	21, 0, 0, 0, 22, 23, 35, 47, 115, 14,
+	24, 34, 0, 0, 81, 13, 0, 25, 0, 30,
+	95, 96, 11, 49, 50, 51, 0, 0, 0, 0,
+	58, 123, 0, 111, 107, 108, 109, 104, 105, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	12, 110, 106, 126, 0, 99, 100, 101, 0, 0,
+	0, 0, 94, 60, 0, 0, 0, 83, 84, 26,
+	116, 117, 0, 0, 0, 61, 62, 85, 70, 71,
+	72, 73, 74, 75, 76, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 114, 53, 52, 118, 0, 0, 0,
+	0, 113, 80, 15, 0, 33, 0, 67, 0, 59,
+	0, 0, 0, 64, 63, 65, 66, 78, 120, 382,
+	0, 97, 98, 77, 54, 102, 103, 36, 0, 119,
+	0, 28, 0, 0, 0, 124, 27, 19, 18, 0,
+	20, 0, 31, 0, 32, 0, 0, 21, 0, 0,
+	0, 22, 23, 35, 47, 115, 0, 24, 34, 0,
+	0, 81, 0, 0, 25, 0, 30, 95, 96, 386,
+	49, 50, 51, 0, 0, 0, 0, 58, 123, 0,
+	111, 107, 108, 109, 104, 105, 0, 0, 0, 0,
+	0, 0, 112, 0, 0, 0, 0, 131, 110, 106,
+	126, 0, 99, 100, 101, 0, 0, 0, 0, 94,
+	60, 0, 0, 0, 83, 84, 26, 116, 117, 0,
+	0, 0, 61, 62, 85, 70, 71, 72, 73, 74,
+	75, 76, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	114, 53, 52, 118, 0, 0, 0, 0, 113, 80,
+	15, 1104, 33, 0, 67, 0, 59, 0, 0, 0,
+	64, 63, 65, 66, 78, 120, 382, 0, 97, 98,
+	77, 54, 102, 103, 36, 0, 119, 0, 28, 0,
+	0, 0, 124, 27, 19, 18, 0, 20, 0, 31,
+	0, 32, 0, 0, 21, 0, 0, 0, 22, 23,
+	35, 47, 115, 0, 24, 34, 0, 0, 81, 0,
+	0, 25, 0, 30, 95, 96, 386, 49, 50, 51,
+	0, 0, 0, 0, 58, 123, 0, 111, 107, 108,
+	109, 104, 105, 0, 0, 0, 0, 0, 0, 112,
+	0, 0, 0, 0, 131, 110, 106, 126, 0, 99,
+	100, 101, 0, 0, 0, 0, 94, 60, 0, 0,
+	0, 83, 84, 26, 116, 117, 0, 0, 0, 61,
+	62, 85, 70, 71, 72, 73, 74, 75, 76, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 114, 53, 52,
+	118, 0, 0, 0, 0, 113, 80, 15, 1103, 33,
+	0, 67, 0, 59, 0, 0, 0, 64, 63, 65,
+	66, 78, 120, 382, 0, 97, 98, 77, 54, 102,
+	103, 36, 0, 119, 0, 28, 0, 0, 0, 124,
+	27, 19, 18, 0, 20, 0, 31, 0, 32, 0,
+	0, 21, 0, 0, 0, 22, 23, 35, 47, 115,
+	0, 24, 34, 0, 0, 81, 0, 0, 25, 0,
+	30, 95, 96, 386, 49, 50, 51, 0, 0, 0,
+	0, 58, 123, 0, 111, 107, 108, 109, 104, 105,
+	0, 0, 0, 0, 0, 0, 112, 0, 0, 0,
+	0, 131, 110, 106, 126, 0, 99, 100, 101, 0,
+	0, 0, 0, 94, 60, 0, 0, 0, 83, 84,
+	26, 116, 117, 0, 0, 0, 61, 62, 85, 70,
+	71, 72, 73, 74, 75, 76, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 114, 53, 52, 118, 0, 0,
+	0, 0, 113, 80, 15, 1097, 33, 0, 67, 0,
+	59, 0, 0, 0, 64, 63, 65, 66, 78, 120,
+	382, 0, 97, 98, 77, 54, 102, 103, 36, 0,
+	119, 0, 28, 0, 0, 0, 124, 27, 19, 18,
+	0, 20, 0, 31, 0, 32, 0, 0, 21, 0,
+	0, 0, 22, 23, 35, 47, 115, 0, 24, 34,
+	0, 0, 81, 0, 0, 25, 0, 30, 95, 96,
+	386, 49, 50, 51, 0, 0, 0, 0, 58, 123,
+	0, 111, 107, 108, 109, 104, 105, 0, 0, 0,
+	0, 0, 0, 112, 0, 0, 0, 0, 131, 110,
+	106, 126, 0, 99, 100, 101, 0, 0, 0, 0,
+	94, 60, 0, 0, 0, 83, 84, 26, 116, 117,
+	0, 0, 0, 61, 62, 85, 70, 71, 72, 73,
+	74, 75, 76, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 114, 53, 52, 118, 0, 0, 0, 0, 113,
+	80, 15, 1096, 33, 0, 67, 0, 59, 0, 0,
+	0, 64, 63, 65, 66, 78, 120, 382, 0, 97,
+	98, 77, 54, 102, 103, 36, 0, 119, 0, 28,
+	0, 0, 0, 124, 27, 19, 18, 0, 20, 1094,
+	31, 0, 32, 0, 0, 21, 0, 0, 0, 22,
+	23, 35, 47, 115, 0, 24, 34, 0, 0, 81,
+	0, 0, 25, 0, 30, 95, 96, 386, 49, 50,
+	51, 0, 0, 0, 0, 58, 123, 0, 111, 107,
+	108, 109, 104, 105, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 131, 110, 106, 126, 0,
+	99, 100, 101, 0, 0, 0, 0, 94, 60, 0,
+	0, 0, 83, 84, 26, 116, 117, 0, 0, 0,
+	61, 62, 85, 70, 71, 72, 73, 74, 75, 76,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 114, 53,
+	52, 118, 0, 0, 0, 0, 113, 80, 15, 0,
+	33, 0, 67, 0, 59, 0, 0, 0, 64, 63,
+	65, 66, 78, 120, 382, 0, 97, 98, 77, 54,
+	102, 103, 36, 0, 119, 0, 28, 0, 0, 0,
+	124, 27, 19, 18, 0, 20, 0, 31, 0, 32,
+	0, 0, 21, 0, 0, 0, 22, 23, 35, 47,
+	115, 0, 24, 34, 0, 0, 81, 0, 0, 25,
+	0, 30, 95, 96, 386, 49, 50, 51, 0, 0,
+	0, 0, 58, 123, 0, 111, 107, 108, 109, 104,
+	105, 0, 0, 0, 0, 0, 0, 112, 0, 0,
+	0, 0, 131, 110, 106, 126, 0, 99, 100, 101,
+	0, 0, 0, 0, 94, 60, 0, 0, 0, 83,
+	84, 26, 116, 117, 0, 0, 0, 61, 62, 85,
+	70, 71, 72, 73, 74, 75, 76, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 114, 53, 52, 118, 0,
+	0, 0, 0, 113, 80, 15, 1066, 33, 0, 67,
+	0, 59, 0, 0, 0, 64, 63, 65, 66, 78,
+	120, 382, 0, 97, 98, 77, 54, 102, 103, 36,
+	0, 119, 0, 28, 0, 0, 0, 124, 27, 19,
+	18, 0, 20, 0, 31, 1062, 32, 0, 0, 21,
+	0, 0, 0, 22, 23, 35, 47, 115, 0, 24,
+	34, 0, 0, 81, 0, 0, 25, 0, 30, 95,
+	96, 386, 49, 50, 51, 0, 0, 0, 0, 58,
+	123, 0, 111, 107, 108, 109, 104, 105, 0, 0,
+	0, 0, 0, 0, 112, 0, 0, 0, 0, 131,
+	110, 106, 126, 0, 99, 100, 101, 0, 0, 0,
+	0, 94, 60, 0, 0, 0, 83, 84, 26, 116,
+	117, 0, 0, 0, 61, 62, 85, 70, 71, 72,
+	73, 74, 75, 76, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 114, 53, 52, 118, 0, 0, 0, 0,
+	113, 80, 15, 0, 33, 0, 67, 0, 59, 0,
+	0, 0, 64, 63, 65, 66, 78, 120, 382, 0,
+	97, 98, 77, 54, 102, 103, 36, 0, 119, 0,
+	28, 0, 0, 0, 124, 27, 19, 18, 0, 20,
+	0, 31, 0, 32, 993, 0, 21, 0, 0, 0,
+	22, 23, 35, 47, 115, 0, 24, 34, 0, 0,
+	81, 0, 0, 25, 0, 30, 95, 96, 386, 49,
+	50, 51, 0, 0, 0, 0, 58, 123, 0, 111,
+	107, 108, 109, 104, 105, 0, 0, 0, 0, 0,
+	0, 112, 0, 0, 0, 0, 131, 110, 106, 126,
+	0, 99, 100, 101, 0, 0, 0, 0, 94, 60,
+	0, 0, 0, 83, 84, 26, 116, 117, 0, 0,
+	0, 61, 62, 85, 70, 71, 72, 73, 74, 75,
+	76, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 114,
+	53, 52, 118, 0, 0, 0, 0, 113, 80, 15,
+	0, 33, 0, 67, 0, 59, 0, 0, 0, 64,
+	63, 65, 66, 78, 120, 382, 0, 97, 98, 77,
+	54, 102, 103, 36, 0, 119, 0, 28, 0, 0,
+	0, 124, 27, 19, 18, 977, 20, 0, 31, 0,
+	32, 0, 0, 21, 0, 0, 0, 22, 23, 35,
+	47, 115, 0, 24, 34, 0, 0, 81, 0, 0,
+	25, 0, 30, 95, 96, 386, 49, 50, 51, 0,
+	0, 0, 0, 58, 123, 0, 111, 107, 108, 109,
+	104, 105, 0, 0, 0, 0, 0, 0, 112, 0,
+	0, 0, 0, 131, 110, 106, 126, 0, 99, 100,
+	101, 0, 0, 0, 0, 94, 60, 0, 0, 0,
+	83, 84, 26, 116, 117, 0, 0, 0, 61, 62,
+	85, 70, 71, 72, 73, 74, 75, 76, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 114, 53, 52, 118,
+	0, 0, 0, 0, 113, 80, 15, 0, 33, 0,
+	67, 0, 59, 0, 0, 0, 64, 63, 65, 66,
+	78, 120, 382, 0, 97, 98, 77, 54, 102, 103,
+	36, 0, 119, 0, 28, 0, 0, 0, 124, 27,
+	19, 18, 0, 20, 0, 31, 0, 32, 0, 0,
+	21, 0, 0, 0, 22, 23, 35, 47, 115, 0,
+	24, 34, 0, 0, 81, 0, 0, 25, 0, 30,
+	95, 96, 386, 49, 50, 51, 0, 0, 0, 0,
+	58, 123, 0, 111, 107, 108, 109, 104, 105, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	131, 110, 106, 126, 0, 99, 100, 101, 0, 0,
+	0, 0, 94, 60, 0, 0, 850, 83, 84, 26,
+	116, 117, 0, 0, 0, 61, 62, 85, 70, 71,
+	72, 73, 74, 75, 76, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 114, 53, 52, 118, 0, 0, 0,
+	0, 113, 80, 15, 0, 33, 0, 67, 0, 59,
+	0, 0, 0, 64, 63, 65, 66, 78, 120, 382,
+	0, 97, 98, 77, 54, 102, 103, 36, 0, 119,
+	0, 28, 0, 0, 0, 124, 27, 19, 18, 0,
+	20, 0, 31, 0, 32, 0, 0, 21, 0, 0,
+	0, 22, 23, 35, 47, 115, 0, 24, 34, 0,
+	0, 81, 0, 0, 25, 0, 30, 95, 96, 386,
+	49, 50, 51, 0, 0, 0, 0, 58, 123, 0,
+	111, 107, 108, 109, 104, 105, 0, 0, 0, 0,
+	0, 0, 112, 0, 0, 0, 0, 131, 110, 106,
+	126, 0, 99, 100, 101, 0, 0, 0, 0, 94,
+	60, 0, 0, 0, 83, 84, 26, 116, 117, 0,
+	0, 0, 61, 62, 85, 70, 71, 72, 73, 74,
+	75, 76, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	114, 53, 52, 118, 0, 0, 0, 0, 113, 80,
+	15, 710, 33, 0, 67, 0, 59, 0, 0, 0,
+	64, 63, 65, 66, 78, 120, 382, 0, 97, 98,
+	77, 54, 102, 103, 36, 0, 119, 0, 28, 0,
+	0, 0, 124, 27, 19, 18, 0, 20, 0, 31,
+	0, 32, 0, 0, 21, 0, 0, 0, 22, 23,
+	35, 47, 115, 0, 24, 34, 0, 0, 81, 0,
+	0, 25, 0, 30, 95, 96, 386, 49, 50, 51,
+	0, 0, 0, 0, 58, 123, 0, 111, 107, 108,
+	109, 104, 105, 0, 0, 0, 0, 0, 0, 112,
+	0, 0, 0, 0, 131, 110, 106, 126, 0, 99,
+	100, 101, 0, 0, 0, 0, 94, 60, 0, 0,
+	0, 83, 84, 26, 116, 117, 0, 0, 0, 61,
+	62, 85, 70, 71, 72, 73, 74, 75, 76, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 114, 53, 52,
+	118, 0, 0, 0, 0, 113, 80, 15, 381, 33,
+	0, 67, 0, 59, 0, 0, 0, 64, 63, 65,
+	66, 78, 120, 382, 0, 97, 98, 77, 54, 102,
+	103, 36, 0, 119, 0, 28, 0, 0, 0, 124,
+	27, 19, 18, 0, 20, 0, 31, 0, 32, 0,
+	0, 21, 0, 0, 0, 22, 23, 35, 47, 115,
+	0, 24, 34, 0, 0, 81, 0, 0, 25, 0,
+	30, 95, 96, 386, 49, 50, 51, 0, 0, 0,
+	0, 58, 123, 144, 111, 107, 108, 109, 104, 105,
+	0, 0, 0, 0, 0, 0, 112, 0, 0, 143,
+	0, 131, 110, 106, 126, 0, 99, 100, 101, 0,
+	0, 0, 0, 94, 60, 0, 0, 0, 83, 84,
+	26, 116, 117, 0, 0, 0, 61, 62, 85, 70,
+	71, 72, 73, 74, 75, 76, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	149, 150, 151, 148, 147, 146, 0, 0, 0, 0,
+	0, 0, 0, 0, 114, 53, 52, 118, 0, 0,
+	0, 0, 113, 80, 15, 0, 33, 0, 67, 0,
+	59, 0, 0, 0, 64, 63, 65, 66, 78, 120,
+	518, 519, 529, 530, 0, 53, 508, 152, 119, 0,
+	0, 0, 0, 0, 0, 534, 535, 536, 537, 538,
+	539, 540, 541, 542, 543, 544, 564, 565, 566, 567,
+	568, 556, 557, 558, 585, 559, 560, 545, 546, 547,
+	548, 549, 550, 551, 552, 553, 554, 555, 0, 576,
+	574, 575, 571, 572, 0, 0, 563, 569, 570, 577,
+	578, 580, 579, 581, 582, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 573, 584, 583, 0,
+	0, 520, 521, 522, 523, 524, 525, 526, 527, 528,
+	531, 532, 533, 561, 562, 511, 512, 513, 514, 515,
+	516, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 586,
+	0, 587, 517, 0, 0, 0, 0, 0, 0, 590,
+	518, 519, 529, 530, 0, 0, 508, 0, 119, 0,
+	0, 0, 0, 0, 120, 534, 535, 536, 537, 538,
+	539, 540, 541, 542, 543, 544, 564, 565, 566, 567,
+	568, 556, 557, 558, 585, 559, 560, 545, 546, 547,
+	548, 549, 550, 551, 552, 553, 554, 555, 0, 576,
+	574, 575, 571, 572, 0, 0, 563, 569, 570, 577,
+	578, 580, 579, 581, 582, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 573, 584, 583, 0,
+	0, 520, 521, 522, 523, 524, 525, 526, 527, 528,
+	531, 532, 533, 561, 562, 511, 512, 513, 514, 515,
+	516, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 98, 77, 54, 102, 103,
+	36, 0, 119, 0, 28, 0, 0, 0, 124, 27,
+	19, 18, 0, 20, 0, 31, 0, 32, 0, 586,
+	21, 587, 517, 0, 22, 23, 35, 130, 115, 506,
+	24, 34, 0, 0, 81, 0, 0, 25, 0, 30,
+	95, 96, 0, 0, 120, 0, 0, 0, 0, 0,
+	58, 123, 0, 111, 107, 108, 109, 104, 105, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	131, 110, 106, 126, 0, 99, 100, 101, 0, 0,
+	0, 0, 94, 60, 0, 0, 0, 83, 84, 26,
+	0, 0, 0, 0, 0, 61, 62, 85, 70, 71,
+	72, 73, 74, 75, 76, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 114, 53, 0, 0, 0, 0, 0,
+	0, 113, 80, 15, 0, 33, 988, 67, 0, 59,
+	0, 0, 0, 64, 63, 65, 66, 78, 120, 97,
+	98, 77, 54, 102, 103, 36, 0, 119, 0, 28,
+	0, 0, 0, 124, 27, 19, 18, 0, 20, 0,
+	31, 0, 32, 0, 0, 21, 0, 0, 0, 22,
+	23, 35, 130, 115, 0, 24, 34, 0, 0, 81,
+	0, 0, 25, 0, 30, 95, 96, 0, 0, 0,
+	0, 0, 0, 0, 0, 58, 123, 0, 111, 107,
+	108, 109, 104, 105, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 131, 110, 106, 126, 0,
+	99, 100, 101, 0, 0, 0, 0, 94, 60, 0,
+	0, 0, 83, 84, 26, 0, 0, 0, 0, 0,
+	61, 62, 85, 70, 71, 72, 73, 74, 75, 76,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 114, 53,
+	0, 0, 0, 0, 0, 0, 113, 80, 15, 0,
+	33, 1058, 67, 0, 59, 0, 0, 0, 64, 63,
+	65, 66, 78, 120, 97, 98, 77, 54, 102, 103,
+	36, 0, 119, 0, 28, 0, 0, 0, 124, 27,
+	19, 18, 0, 20, 0, 31, 0, 32, 0, 0,
+	21, 0, 0, 0, 22, 23, 35, 130, 115, 0,
+	24, 34, 0, 0, 81, 0, 0, 25, 0, 30,
+	95, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+	58, 123, 0, 111, 107, 108, 109, 104, 105, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	131, 110, 106, 126, 0, 99, 100, 101, 0, 0,
+	0, 0, 94, 60, 0, 0, 0, 83, 84, 26,
+	0, 0, 0, 0, 0, 61, 62, 85, 70, 71,
+	72, 73, 74, 75, 76, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 114, 53, 0, 0, 0, 0, 0,
+	0, 113, 80, 15, 0, 33, 860, 67, 0, 59,
+	0, 0, 0, 64, 63, 65, 66, 78, 120, 97,
+	98, 77, 54, 102, 103, 36, 0, 119, 0, 28,
+	0, 0, 0, 124, 27, 19, 18, 0, 20, 0,
+	31, 0, 32, 0, 0, 21, 0, 0, 0, 22,
+	23, 35, 130, 115, 0, 24, 34, 0, 0, 81,
+	0, 0, 25, 0, 30, 95, 96, 0, 0, 0,
+	0, 0, 0, 0, 0, 58, 123, 0, 111, 107,
+	108, 109, 104, 105, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 131, 110, 106, 126, 0,
+	99, 100, 101, 0, 0, 0, 0, 94, 60, 0,
+	0, 0, 83, 84, 26, 0, 0, 0, 0, 0,
+	61, 62, 85, 70, 71, 72, 73, 74, 75, 76,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 114, 53,
+	0, 0, 0, 0, 0, 0, 113, 80, 15, 0,
+	33, 846, 67, 0, 59, 0, 0, 0, 64, 63,
+	65, 66, 78, 120, 97, 98, 77, 54, 102, 103,
+	36, 0, 119, 0, 28, 0, 0, 0, 124, 27,
+	19, 18, 0, 20, 0, 31, 0, 32, 0, 0,
+	21, 0, 0, 0, 22, 23, 35, 130, 115, 0,
+	24, 34, 0, 0, 81, 0, 0, 25, 0, 30,
+	95, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+	58, 123, 0, 111, 107, 108, 109, 104, 105, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	131, 110, 106, 126, 0, 99, 100, 101, 0, 0,
+	0, 0, 94, 60, 0, 0, 0, 83, 84, 26,
+	0, 0, 0, 0, 0, 61, 62, 85, 70, 71,
+	72, 73, 74, 75, 76, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 114, 53, 0, 0, 0, 0, 0,
+	0, 113, 80, 15, 0, 33, 829, 67, 0, 59,
+	0, 0, 0, 64, 63, 65, 66, 78, 120, 97,
+	98, 77, 54, 102, 103, 36, 0, 119, 0, 28,
+	0, 0, 0, 124, 27, 19, 18, 0, 20, 0,
+	31, 0, 32, 0, 0, 21, 0, 0, 0, 22,
+	23, 35, 130, 115, 0, 24, 34, 0, 0, 81,
+	0, 0, 25, 0, 30, 95, 96, 0, 0, 0,
+	0, 0, 0, 0, 0, 58, 123, 0, 111, 107,
+	108, 109, 104, 105, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 131, 110, 106, 126, 0,
+	99, 100, 101, 0, 0, 0, 0, 94, 60, 0,
+	0, 0, 83, 84, 26, 0, 0, 0, 0, 0,
+	61, 62, 85, 70, 71, 72, 73, 74, 75, 76,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 114, 53,
+	0, 0, 0, 0, 0, 0, 113, 80, 15, 0,
+	33, 0, 67, 0, 59, 0, 0, 0, 64, 63,
+	65, 66, 78, 120, 518, 519, 529, 530, 0, 0,
+	670, 0, 0, 0, 0, 0, 0, 0, 0, 534,
+	535, 536, 537, 538, 539, 540, 541, 542, 543, 544,
+	564, 565, 566, 567, 568, 556, 557, 558, 585, 559,
+	560, 545, 546, 547, 548, 549, 550, 551, 552, 553,
+	554, 555, 0, 576, 574, 575, 571, 572, 0, 0,
+	563, 674, 675, 577, 578, 580, 579, 581, 582, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	648, 584, 583, 126, 0, 520, 521, 522, 523, 524,
+	525, 526, 527, 528, 531, 532, 533, 561, 562, 673,
+	512, 513, 514, 515, 516, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 586, 0, 587, 517, 518, 519, 529,
+	530, 0, 0, 620, 0, 0, 0, 0, 0, 0,
+	0, 667, 534, 535, 536, 537, 538, 539, 540, 541,
+	542, 543, 544, 564, 565, 566, 567, 568, 556, 557,
+	558, 585, 559, 560, 545, 546, 547, 548, 549, 550,
+	551, 552, 553, 554, 555, 0, 576, 574, 575, 571,
+	572, 0, 0, 563, 569, 570, 577, 578, 580, 579,
+	581, 582, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 648, 584, 583, 126, 0, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 531, 532, 533,
+	561, 562, 511, 512, 513, 514, 515, 516, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 586, 0, 587, 517,
+	518, 519, 529, 530, 0, 0, 620, 886, 0, 0,
+	0, 0, 0, 0, 0, 534, 535, 536, 537, 538,
+	539, 540, 541, 542, 543, 544, 564, 565, 566, 567,
+	568, 556, 557, 558, 585, 559, 560, 545, 546, 547,
+	548, 549, 550, 551, 552, 553, 554, 555, 0, 576,
+	574, 575, 571, 572, 0, 0, 563, 569, 570, 577,
+	578, 580, 579, 581, 582, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 648, 584, 583, 126,
+	0, 520, 521, 522, 523, 524, 525, 526, 527, 528,
+	531, 532, 533, 561, 562, 511, 512, 513, 514, 515,
+	516, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 97, 98, 77, 0, 102, 103, 132, 0, 119,
+	0, 0, 0, 0, 0, 124, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 586,
+	0, 587, 517, 0, 130, 115, 0, 0, 0, 0,
+	786, 81, 0, 0, 0, 138, 0, 95, 96, 0,
+	0, 0, 0, 0, 0, 0, 0, 275, 123, 0,
+	111, 107, 108, 109, 104, 105, 0, 0, 0, 0,
+	0, 0, 112, 0, 0, 144, 0, 131, 110, 106,
+	126, 274, 99, 100, 101, 0, 0, 0, 137, 94,
+	60, 143, 0, 0, 83, 84, 128, 0, 0, 0,
+	0, 0, 61, 62, 85, 70, 71, 72, 73, 74,
+	75, 76, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	114, 53, 149, 150, 151, 148, 147, 146, 113, 80,
+	0, 0, 0, 0, 67, 0, 59, 0, 0, 273,
+	64, 63, 65, 66, 78, 120, 97, 98, 77, 0,
+	102, 103, 132, 0, 119, 0, 0, 0, 0, 0,
+	124, 0, 0, 0, 0, 0, 0, 53, 0, 152,
+	0, 0, 0, 0, 0, 951, 0, 1045, 0, 130,
+	115, 0, 0, 0, 0, 0, 81, 0, 0, 0,
+	0, 0, 95, 96, 0, 0, 0, 0, 0, 0,
+	0, 0, 58, 123, 0, 111, 107, 108, 109, 104,
+	105, 0, 0, 0, 0, 0, 0, 112, 0, 0,
+	0, 0, 131, 110, 106, 126, 0, 99, 100, 101,
+	0, 0, 0, 0, 94, 60, 0, 0, 0, 83,
+	84, 128, 0, 0, 0, 0, 0, 61, 62, 85,
+	70, 71, 72, 73, 74, 75, 76, 97, 98, 77,
+	0, 102, 103, 132, 0, 119, 0, 0, 0, 0,
+	0, 124, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 114, 53, 0, 0, 0,
+	130, 115, 0, 113, 80, 0, 0, 81, 0, 67,
+	0, 59, 0, 95, 96, 64, 63, 65, 66, 78,
+	120, 0, 0, 58, 123, 0, 111, 107, 108, 109,
+	104, 105, 0, 0, 0, 0, 0, 0, 112, 0,
+	0, 0, 0, 131, 110, 106, 126, 0, 99, 100,
+	101, 0, 0, 0, 0, 94, 60, 0, 0, 0,
+	83, 84, 128, 0, 0, 0, 0, 0, 61, 62,
+	85, 70, 71, 72, 73, 74, 75, 76, 97, 98,
+	77, 0, 102, 103, 132, 0, 119, 0, 0, 0,
+	0, 0, 124, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 114, 53, 0, 0,
+	0, 130, 115, 0, 113, 80, 0, 0, 81, 0,
+	67, 779, 59, 0, 95, 96, 64, 63, 65, 66,
+	78, 120, 0, 0, 740, 123, 0, 111, 107, 108,
+	109, 104, 105, 0, 0, 0, 0, 0, 0, 112,
+	0, 0, 0, 0, 131, 110, 106, 126, 0, 99,
+	100, 101, 0, 0, 0, 0, 94, 60, 138, 0,
+	0, 83, 84, 128, 0, 0, 0, 0, 0, 61,
+	62, 85, 70, 71, 72, 73, 74, 75, 76, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 137, 0, 0, 143, 0, 0, 114, 53, 0,
+	0, 0, 0, 0, 0, 113, 80, 0, 0, 0,
+	0, 67, 0, 59, 0, 0, 739, 64, 63, 65,
+	66, 78, 120, 97, 98, 77, 0, 102, 103, 132,
+	500, 119, 0, 0, 0, 0, 0, 124, 0, 0,
+	0, 0, 0, 0, 0, 149, 150, 151, 148, 147,
+	146, 0, 0, 0, 0, 0, 130, 115, 0, 0,
+	0, 0, 0, 81, 0, 0, 0, 0, 0, 95,
+	96, 0, 0, 0, 0, 0, 0, 0, 0, 58,
+	123, 0, 111, 107, 108, 109, 104, 105, 0, 0,
+	53, 0, 152, 0, 112, 0, 0, 0, 0, 131,
+	110, 106, 126, 0, 99, 100, 101, 0, 0, 0,
+	0, 94, 60, 0, 0, 0, 83, 84, 128, 0,
+	0, 0, 0, 0, 61, 62, 85, 70, 71, 72,
+	73, 74, 75, 76, 97, 98, 77, 0, 102, 103,
+	132, 0, 119, 0, 0, 0, 0, 0, 124, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 114, 53, 0, 0, 0, 130, 115, 0,
+	113, 80, 0, 0, 81, 0, 67, 0, 59, 0,
+	95, 96, 64, 63, 65, 66, 78, 120, 0, 0,
+	58, 123, 0, 111, 107, 108, 109, 104, 105, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	131, 110, 106, 126, 0, 99, 100, 101, 0, 0,
+	0, 0, 94, 60, 0, 0, 0, 83, 84, 128,
+	0, 0, 0, 0, 0, 61, 62, 85, 70, 71,
+	72, 73, 74, 75, 76, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 114, 53, 0, 0, 0, 0, 0,
+	0, 113, 80, 0, 0, 0, 0, 67, 0, 59,
+	0, 0, 447, 64, 63, 65, 66, 78, 120, 97,
+	98, 77, 0, 102, 103, 132, 0, 119, 0, 0,
+	0, 0, 0, 124, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 130, 115, 0, 0, 0, 0, 0, 81,
+	0, 0, 0, 0, 0, 95, 96, 0, 0, 0,
+	0, 0, 0, 0, 0, 58, 123, 0, 111, 107,
+	108, 109, 104, 105, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 131, 110, 106, 126, 0,
+	99, 100, 101, 0, 0, 0, 0, 94, 60, 0,
+	0, 0, 83, 84, 128, 0, 0, 0, 0, 0,
+	61, 62, 85, 70, 71, 72, 73, 74, 75, 76,
+	97, 98, 77, 0, 102, 103, 132, 0, 119, 0,
+	0, 0, 0, 0, 124, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 114, 53,
+	0, 0, 0, 130, 115, 0, 113, 80, 0, 0,
+	81, 432, 67, 0, 59, 0, 95, 96, 64, 63,
+	65, 66, 78, 120, 0, 0, 58, 123, 0, 111,
+	107, 108, 109, 104, 105, 0, 0, 0, 0, 0,
+	0, 112, 0, 0, 0, 0, 131, 110, 106, 126,
+	0, 99, 100, 101, 0, 0, 0, 0, 94, 60,
+	0, 0, 0, 83, 84, 128, 0, 0, 0, 0,
+	0, 61, 62, 85, 70, 71, 72, 73, 74, 75,
+	76, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 114,
+	53, 0, 0, 0, 0, 0, 0, 113, 80, 0,
+	0, 0, 0, 67, 0, 59, 0, 0, 0, 64,
+	63, 65, 66, 78, 120, 518, 519, 529, 530, 0,
+	0, 508, 0, 0, 0, 0, 0, 0, 0, 0,
+	534, 535, 536, 537, 538, 539, 540, 541, 542, 543,
+	544, 564, 565, 566, 567, 568, 556, 557, 558, 585,
+	559, 560, 545, 546, 547, 548, 549, 550, 551, 552,
+	553, 554, 555, 0, 576, 574, 575, 571, 572, 0,
+	0, 563, 569, 570, 577, 578, 580, 579, 581, 582,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 573, 584, 583, 0, 0, 520, 521, 522, 523,
+	524, 525, 526, 527, 528, 531, 532, 533, 561, 562,
+	511, 512, 513, 514, 515, 516, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 518, 519, 529, 530, 0, 0, 963,
+	0, 0, 0, 0, 586, 0, 587, 517, 534, 535,
+	536, 537, 538, 539, 540, 541, 542, 543, 544, 564,
+	565, 566, 567, 568, 556, 557, 558, 585, 559, 560,
+	545, 546, 547, 548, 549, 550, 551, 552, 553, 554,
+	555, 0, 576, 574, 575, 571, 572, 0, 0, 563,
+	569, 570, 577, 578, 580, 579, 581, 582, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 573,
+	584, 583, 0, 0, 520, 521, 522, 523, 524, 525,
+	526, 527, 528, 531, 532, 533, 561, 562, 149, 150,
+	151, 148, 147, 146, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 518, 519, 529, 530, 0, 0, 806, 0, 0,
+	0, 0, 586, 0, 587, 152, 534, 535, 536, 537,
+	538, 539, 540, 541, 542, 543, 544, 564, 565, 566,
+	567, 568, 556, 557, 558, 585, 559, 560, 545, 546,
+	547, 548, 549, 550, 551, 552, 553, 554, 555, 0,
+	576, 574, 575, 571, 572, 0, 0, 563, 569, 570,
+	577, 578, 580, 579, 581, 582, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 573, 584, 583,
+	0, 0, 520, 521, 522, 523, 524, 525, 526, 527,
+	528, 531, 532, 533, 561, 562, 511, 512, 513, 514,
+	515, 516, 195, 197, 196, 220, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 222,
+	219, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	586, 0, 587, 517, 0, 193, 194, 206, 209, 210,
+	211, 212, 213, 214, 216, 218, 0, 0, 0, 0,
+	0, 200, 0, 0, 0, 0, 195, 197, 196, 220,
+	0, 0, 0, 0, 945, 221, 199, 204, 203, 0,
+	0, 0, 0, 0, 198, 0, 201, 205, 207, 208,
+	215, 217, 202, 222, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	194, 206, 209, 210, 211, 212, 213, 214, 216, 218,
+	0, 0, 0, 0, 0, 200, 0, 0, 0, 0,
+	195, 197, 196, 220, 0, 0, 915, 0, 0, 221,
+	199, 204, 203, 0, 0, 0, 0, 0, 198, 0,
+	201, 205, 207, 208, 215, 217, 202, 222, 219, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 193, 194, 206, 209, 210, 211, 212,
+	213, 214, 216, 218, 0, 0, 0, 0, 0, 200,
+	0, 0, 0, 0, 0, 0, 873, 195, 197, 196,
+	220, 0, 0, 221, 199, 204, 203, 0, 0, 0,
+	0, 0, 198, 0, 201, 205, 207, 208, 215, 217,
+	202, 0, 0, 0, 222, 219, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	193, 194, 206, 209, 210, 211, 212, 213, 214, 216,
+	218, 0, 0, 0, 0, 0, 200, 0, 0, 0,
+	0, 0, 0, 871, 195, 197, 196, 220, 0, 0,
+	221, 199, 204, 203, 0, 0, 0, 0, 0, 198,
+	0, 201, 205, 207, 208, 215, 217, 202, 0, 0,
+	0, 222, 219, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 193, 194, 206,
+	209, 210, 211, 212, 213, 214, 216, 218, 0, 0,
+	0, 0, 0, 200, 0, 0, 0, 0, 0, 0,
+	870, 195, 197, 196, 220, 0, 0, 221, 199, 204,
+	203, 0, 0, 0, 0, 0, 198, 0, 201, 205,
+	207, 208, 215, 217, 202, 0, 0, 0, 222, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 193, 194, 206, 209, 210, 211,
+	212, 213, 214, 216, 218, 0, 0, 0, 0, 0,
+	200, 0, 0, 0, 0, 0, 0, 861, 195, 197,
+	196, 220, 0, 0, 221, 199, 204, 203, 0, 0,
+	0, 0, 0, 198, 0, 201, 205, 207, 208, 215,
+	217, 202, 0, 0, 0, 222, 219, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 193, 194, 206, 209, 210, 211, 212, 213, 214,
+	216, 218, 0, 0, 0, 0, 0, 200, 0, 0,
+	0, 0, 195, 197, 196, 220, 0, 0, 849, 0,
+	0, 221, 199, 204, 203, 0, 0, 0, 0, 0,
+	198, 0, 201, 205, 207, 208, 215, 217, 202, 222,
+	219, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 193, 194, 206, 209, 210,
+	211, 212, 213, 214, 216, 218, 0, 0, 0, 0,
+	0, 200, 0, 0, 0, 0, 195, 197, 196, 220,
+	0, 0, 848, 0, 0, 221, 199, 204, 203, 0,
+	0, 0, 0, 0, 198, 0, 201, 205, 207, 208,
+	215, 217, 202, 222, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	194, 206, 209, 210, 211, 212, 213, 214, 216, 218,
+	0, 0, 0, 0, 0, 200, 0, 0, 0, 0,
+	0, 0, 778, 195, 197, 196, 220, 0, 0, 221,
+	199, 204, 203, 0, 0, 0, 0, 0, 198, 0,
+	201, 205, 207, 208, 215, 217, 202, 0, 0, 0,
+	222, 219, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 194, 206, 209,
+	210, 211, 212, 213, 214, 216, 218, 0, 0, 0,
+	0, 0, 200, 0, 0, 0, 0, 195, 197, 196,
+	220, 0, 0, 772, 0, 0, 221, 199, 204, 203,
+	0, 0, 0, 0, 0, 198, 0, 201, 205, 207,
+	208, 215, 217, 202, 222, 219, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	193, 194, 206, 209, 210, 211, 212, 213, 214, 216,
+	218, 0, 0, 0, 0, 0, 200, 0, 0, 0,
+	0, 195, 197, 196, 220, 0, 0, 771, 0, 0,
+	221, 199, 204, 203, 0, 0, 0, 0, 0, 198,
+	0, 201, 205, 207, 208, 215, 217, 202, 222, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 193, 194, 206, 209, 210, 211,
+	212, 213, 214, 216, 218, 0, 0, 0, 0, 0,
+	200, 0, 0, 0, 0, 195, 197, 196, 220, 0,
+	0, 770, 0, 0, 221, 199, 204, 203, 0, 0,
+	0, 0, 0, 198, 0, 201, 205, 207, 208, 215,
+	217, 202, 222, 219, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 193, 194,
+	206, 209, 210, 211, 212, 213, 214, 216, 218, 0,
+	0, 0, 0, 0, 200, 0, 0, 0, 0, 0,
+	0, 751, 195, 197, 196, 220, 0, 0, 221, 199,
+	204, 203, 0, 0, 0, 0, 0, 198, 0, 201,
+	205, 207, 208, 215, 217, 202, 0, 0, 0, 222,
+	219, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 193, 194, 206, 209, 210,
+	211, 212, 213, 214, 216, 218, 0, 0, 0, 0,
+	0, 200, 0, 0, 0, 0, 195, 197, 196, 220,
+	0, 0, 742, 0, 0, 221, 199, 204, 203, 0,
+	0, 0, 0, 0, 198, 0, 201, 205, 207, 208,
+	215, 217, 202, 222, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	194, 206, 209, 210, 211, 212, 213, 214, 216, 218,
+	0, 0, 0, 0, 0, 200, 0, 0, 0, 0,
+	0, 0, 731, 195, 197, 196, 220, 0, 708, 221,
+	199, 204, 203, 0, 0, 0, 0, 0, 198, 0,
+	201, 205, 207, 208, 215, 217, 202, 0, 0, 0,
+	222, 219, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 194, 206, 209,
+	210, 211, 212, 213, 214, 216, 218, 0, 0, 0,
+	0, 0, 200, 0, 0, 0, 0, 195, 197, 196,
+	220, 0, 0, 729, 0, 0, 221, 199, 204, 203,
+	0, 0, 0, 0, 0, 198, 0, 201, 205, 207,
+	208, 215, 217, 202, 222, 219, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	193, 194, 206, 209, 210, 211, 212, 213, 214, 216,
+	218, 0, 0, 0, 0, 0, 200, 0, 0, 0,
+	0, 195, 197, 196, 220, 0, 0, 0, 0, 0,
+	221, 199, 204, 203, 0, 0, 0, 0, 0, 198,
+	0, 201, 205, 207, 208, 215, 217, 202, 222, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 193, 194, 206, 209, 210, 211,
+	212, 213, 214, 216, 218, 0, 0, 0, 0, 0,
+	200, 0, 0, 0, 0, 195, 197, 196, 220, 704,
+	0, 0, 0, 0, 221, 199, 204, 203, 0, 0,
+	0, 0, 0, 198, 0, 201, 205, 207, 208, 215,
+	217, 202, 222, 219, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 193, 194,
+	206, 209, 210, 211, 212, 213, 214, 216, 218, 0,
+	0, 0, 0, 0, 200, 0, 0, 0, 0, 195,
+	197, 196, 220, 0, 0, 699, 0, 0, 221, 199,
+	204, 203, 0, 0, 0, 0, 0, 198, 0, 201,
+	205, 207, 208, 215, 217, 202, 222, 219, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 194, 206, 209, 210, 211, 212, 213,
+	214, 216, 218, 0, 0, 0, 0, 0, 200, 0,
+	0, 0, 0, 195, 197, 196, 220, 0, 0, 695,
+	0, 0, 221, 199, 204, 203, 0, 0, 0, 0,
+	0, 198, 0, 201, 205, 207, 208, 215, 217, 202,
+	222, 219, 0, 0, 0, 0, 0, 0, 485, 0,
+	0, 0, 0, 0, 0, 0, 193, 194, 206, 209,
+	210, 211, 212, 213, 214, 216, 218, 0, 0, 0,
+	0, 0, 200, 0, 0, 0, 0, 195, 197, 196,
+	220, 0, 0, 491, 0, 0, 221, 199, 204, 203,
+	0, 0, 0, 0, 0, 198, 0, 201, 205, 207,
+	208, 215, 217, 202, 222, 219, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	193, 194, 206, 209, 210, 211, 212, 213, 214, 216,
+	218, 0, 0, 0, 0, 0, 200, 0, 0, 0,
+	0, 195, 197, 196, 220, 0, 0, 0, 0, 0,
+	221, 199, 204, 203, 0, 0, 0, 0, 0, 198,
+	0, 201, 205, 207, 208, 215, 217, 202, 222, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 193, 194, 206, 209, 210, 211,
+	212, 213, 214, 216, 218, 0, 0, 0, 0, 0,
+	200, 0, 0, 0, 0, 0, 0, 0, 192, 195,
+	197, 196, 220, 0, 221, 199, 204, 203, 0, 0,
+	0, 0, 0, 198, 0, 201, 205, 207, 208, 215,
+	217, 202, 0, 0, 0, 0, 222, 219, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 194, 206, 209, 210, 211, 212, 213,
+	214, 216, 218, 197, 196, 220, 0, 0, 200, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 221, 199, 204, 203, 0, 0, 0, 222,
+	219, 198, 0, 201, 205, 207, 208, 215, 217, 202,
+	0, 0, 0, 0, 0, 193, 194, 206, 209, 210,
+	211, 212, 213, 214, 216, 218, 196, 220, 0, 0,
+	0, 200, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 199, 204, 203, 0,
+	0, 222, 219, 0, 198, 0, 201, 205, 207, 208,
+	215, 217, 202, 502, 0, 0, 0, 193, 194, 206,
+	209, 210, 211, 212, 213, 214, 216, 218, 0, 0,
+	0, 0, 0, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 0, 221, 199, 204,
+	203, 0, 0, 0, 0, 0, 198, 0, 201, 205,
+	207, 208, 215, 217, 202, 0, 0, 0, 0, 222,
+	219, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 193, 194, 206, 209, 210,
+	211, 212, 213, 214, 216, 218, 220, 0, 0, 0,
+	0, 200, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 199, 204, 203, 0,
+	222, 219, 0, 0, 198, 0, 201, 205, 207, 208,
+	215, 217, 202, 0, 0, 0, 193, 194, 206, 209,
+	210, 211, 212, 213, 214, 216, 218, 220, 0, 0,
+	0, 0, 200, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 221, 199, 204, 203,
+	0, 222, 219, 0, 0, 198, 0, 201, 205, 207,
+	208, 215, 217, 202, 0, 0, 0, 193, 194, 206,
+	209, 210, 211, 212, 213, 214, 216, 218, 220, 0,
+	0, 0, 0, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 199, 204,
+	203, 0, 0, 219, 0, 0, 198, 0, 201, 205,
+	207, 208, 215, 217, 202, 0, 0, 0, 0, 194,
+	206, 209, 210, 211, 212, 213, 214, 216, 218, 220,
+	0, 0, 0, 0, 200, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 199,
+	204, 203, 0, 0, 219, 0, 0, 198, 0, 201,
+	205, 207, 208, 215, 217, 202, 0, 0, 0, 0,
+	0, 206, 209, 210, 211, 212, 213, 214, 216, 218,
+	220, 0, 0, 0, 0, 200, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	199, 204, 203, 0, 0, 219, 0, 0, 198, 0,
+	201, 205, 207, 208, 215, 217, 202, 0, 0, 0,
+	0, 0, 206, 209, 210, 211, 212, 213, 214, 216,
+	218, 220, 0, 0, 0, 0, 200, 0, 0, 138,
+	0, 0, 0, 0, 0, 0, 0, 220, 0, 0,
+	0, 199, 204, 203, 0, 0, 219, 0, 0, 0,
+	0, 201, 205, 207, 208, 215, 217, 202, 0, 144,
+	0, 0, 219, 206, 209, 210, 211, 212, 213, 214,
+	216, 218, 137, 0, 0, 143, 0, 200, 0, 206,
+	209, 210, 211, 212, 213, 214, 216, 218, 0, 0,
+	0, 0, 199, 204, 203, 138, 0, 0, 0, 0,
+	0, 0, 138, 205, 207, 208, 215, 217, 202, 204,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 205,
+	207, 208, 215, 217, 202, 144, 149, 150, 151, 148,
+	147, 146, 144, 0, 0, 0, 0, 0, 137, 0,
+	0, 143, 0, 0, 0, 137, 0, 0, 143, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 53, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 1044, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 149, 150, 151, 148, 147, 146, 0, 149,
+	150, 151, 148, 147, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 53, 0, 152,
+	0, 0, 0, 0, 53, 0, 152, 1002, 0, 0,
+	0, 0, 0, 0, 938,
+}
+
+var yyPact = [...]int16{
+	470, -1000, 1910, 6836, -1000, 6376, -1000, -1000, -1000, -1000,
+	1721, 308, 574, 621, 810, -1000, -1000, -1000, 304, 5335,
+	303, 289, 6836, 6836, 6836, 124, 789, 6836, -1000, 8703,
+	288, 284, 279, -1000, 460, 842, 334, -1000, -1000, -1000,
+	-1000, -1000, -1000, 596, 578, 400, -1000, 125, 612, 839,
+	833, 831, 829, 646, 278, -1000, -1000, 220, 257, 5897,
+	6836, 1539, 1539, 6836, 6836, 6836, 6836, 6836, -1000, -1000,
+	6836, 6836, 6836, 6836, 6836, 6836, 6836, 253, 6836, -1000,
+	994, 6836, -1000, 6836, 6836, 6836, -1000, -1000, -1000, 126,
+	-1000, 590, 589, -1000, 287, 240, 230, 6836, 6836, 226,
+	6836, 6836, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 817, 968, 224, 125, -1000, -1000, -1000, -1000,
+	123, 258, 258, 219, -1000, 581, 797, 8771, 748, 651,
+	125, 579, -1000, 1910, -1000, -1000, 4111, 646, -1000, 506,
+	730, -1000, 851, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 748, 188, 797, 379,
+	-1000, 414, 696, 119, 664, 797, -1000, -1000, -1000, -1000,
+	102, -1000, -50, 3914, 6836, 752, 6836, 6836, 411, 8771,
+	410, 408, 101, -1000, -1000, 0, -1000, -1000, -54, -1,
+	-1000, 8771, -1000, 6836, 6836, 6836, 6836, 6836, 6836, 6836,
+	6836, 6836, 6836, 6836, 6836, 6836, 6836, 6836, 6836, 6836,
+	6836, 6836, 6836, 6836, 6836, 6836, 6836, 6836, 6836, 6836,
+	250, 6725, 6836, 1539, 6836, 810, -1000, 402, -1000, 277,
+	5335, 275, 398, 323, 6560, 6836, 6836, 6836, 6836, 6836,
+	6836, 6836, 6836, 6836, 6836, 6836, 6836, 6836, -1000, -1000,
+	826, -1000, -1000, 823, -1000, 687, -1000, 689, 320, 19,
+	-1000, 258, 6836, 6836, 6836, 114, 114, 5897, 140, 17,
+	-1000, -1000, 8639, 1539, 6836, 267, -1000, -1000, 126, 6836,
+	-1000, -1000, 5897, -1000, 496, 496, 543, 496, 8575, 496,
+	496, 496, 496, 496, 496, 496, -1000, 6836, 496, 462,
+	782, 956, -1000, 248, 6449, 1539, 8771, 8995, 8944, 8995,
+	6836, 4396, 4246, 258, -1000, 571, 587, 405, 258, -1000,
+	-1000, 6836, 6836, 8771, 8771, 6836, 8771, 8771, 864, -1000,
+	804, 593, 782, 6836, 264, 6836, -1000, -1000, 1242, -1000,
+	5897, 821, 581, -1000, -1000, -30, -1000, 775, -1000, -29,
+	710, -40, 469, -1000, -1000, -1000, 5500, 125, -1000, 7001,
+	-1000, 396, 581, -1000, -1000, 1743, -1000, 395, -2, 663,
+	797, -1000, 693, 585, 814, 658, -1000, -1000, 810, 6836,
+	-1000, -1000, -1000, -1000, -1000, 1721, 263, 8511, 262, 391,
+	13, 8771, 8447, -1000, -1000, -1000, -1000, 124, -1000, 776,
+	6836, -1000, 6836, 9097, 9148, 8824, 8995, 8876, 9199, 9266,
+	9266, 9250, 58, 58, 58, 543, 496, 543, 543, 381,
+	381, 318, 318, 318, 318, 265, 265, 265, 265, 318,
+	-1000, 8383, 6836, 9046, 7, -1000, -1000, 8319, -7, 3747,
+	-1000, 6836, -1000, 6836, -1000, -1000, 8995, 6836, 8995, 8995,
+	8995, 8995, 8995, 8995, 8995, 8995, 8995, 8995, 8995, 8995,
+	8995, -1000, 259, 687, 683, 646, 459, -1000, 646, 683,
+	286, 646, 135, -1000, 8255, 117, 8188, 258, -1000, 6836,
+	-1000, 258, 184, -55, 5897, 6284, -1000, 8771, 5897, 8124,
+	95, -1000, 179, -1000, -1000, -1000, -1000, 239, 808, 8057,
+	130, 424, 6836, 74, 258, -1000, 6836, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 258, -1000,
+	6836, -1000, -1000, -1000, -1000, 124, 6836, 6836, 114, 114,
+	124, 687, 2, -1000, 8771, 7993, 7929, -1000, -1000, -1000,
+	7865, 477, 7798, -1000, 6173, 1, -1000, 8771, 266, -1000,
+	-1000, 257, 6836, 253, 6836, 6836, 6836, 748, 287, 240,
+	230, 6836, 6836, 226, 6836, 6836, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 224, 125, 125, 219, 579, 177,
+	-1000, -1000, 646, -1000, 5786, -12, -1000, -56, 710, 710,
+	-1000, 710, 710, -17, 7257, -1000, -33, 809, -43, 389,
+	-61, -58, -1000, -1000, -1000, -1000, 7001, -59, -1000, 1576,
+	-1000, -1000, -1000, 583, 649, -1000, 797, 618, 771, -1000,
+	582, -1000, 8771, -1000, 170, 5170, 6836, 6836, 6836, 252,
+	-1000, -1000, 8771, -1000, 6836, 9046, 167, 1539, 1064, 5005,
+	-1000, 7734, 7670, 3580, 9266, 215, 477, 683, -1000, 646,
+	-1000, -1000, 458, -10, -1000, -1000, -1000, -1000, -1000, 4840,
+	-1000, -1000, -1000, 7603, -1000, -62, 6836, -1000, 8771, 1539,
+	204, 166, -1000, -1000, -1000, 69, -1000, -1000, 762, -1000,
+	-1000, -1000, -1000, 6836, -1000, 8995, -1000, -1000, 7536, -1000,
+	7469, -1000, 68, 7402, -1000, -1000, -1000, 683, 158, 6836,
+	-1000, -1000, 456, 157, -3, -1000, -1000, 477, -1000, -1000,
+	8771, 156, 1409, 6836, -1000, -1000, -1000, 5643, -1000, 385,
+	384, 709, 735, 575, -1000, -1000, 775, -1000, 6836, -1000,
+	-1000, -1000, -1000, -1000, 7257, -27, -61, 809, 809, -1000,
+	809, 809, 6836, 6836, -1000, 383, 6836, -1000, 797, 572,
+	-4, -1000, -1000, 797, 771, -1000, 382, -1000, -1000, -1000,
+	7338, 380, 8771, -1000, 378, 357, 9046, 356, -1000, 155,
+	669, 1539, 202, 5897, -1000, -1000, -1000, 720, 5335, 221,
+	354, 477, 154, -1000, 455, -10, 9420, -1000, 453, -1000,
+	-1000, -1000, 6836, 8995, -1000, 5897, -62, -1000, -1000, 7274,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 6062, 199, -1000,
+	477, 500, -1000, -1000, 6836, 8771, -1000, -1000, -1000, -1000,
+	646, 7129, 7001, -1000, 8771, -1000, -1000, -1000, -1000, -1000,
+	-1000, 8771, 8771, 201, -1000, 8771, -35, -1000, 797, 423,
+	771, -1000, -4, -1000, 3413, 353, 6836, 445, -1000, 915,
+	-1000, -1000, 4510, 1064, -1000, 5897, 61, 3246, -1000, 194,
+	447, -1000, -1000, -1000, 152, 715, 441, -1000, -1000, -1000,
+	9413, -1000, 4081, 8995, 150, 422, 437, 420, -5, -1000,
+	-6, -8, 8771, -1000, 286, -1000, 27, -1000, -1000, -1000,
+	-1000, -1000, -10, -1000, -1000, 7001, -1000, -1000, -1000, -1000,
+	477, 419, 797, -35, -1000, -1000, 416, 349, -1000, 145,
+	-1000, 6836, 198, 442, 347, 772, -1000, -1000, -1000, 144,
+	-1000, 143, -1000, 346, 646, -1000, 4081, 199, 199, 193,
+	-1000, 9347, -1000, 5943, -62, -1000, -1000, -1000, -1000, 6062,
+	650, 6836, 636, -1000, 616, -1000, 553, -1000, -1000, 132,
+	-1000, -1000, 406, -1000, -1000, 4675, 1018, -1000, -1000, -1000,
+	-1000, -1000, 344, 3079, 4510, -1000, -1000, 96, -1000, 2912,
+	434, 428, 212, 1068, -1000, -1000, 540, -1000, 6836, 8771,
+	6836, 6836, 770, -1000, 199, -1000, -1000, -1000, -1000, -1000,
+	4081, -1000, 342, -1000, 103, 646, -1000, -1000, -1000, -36,
+	-1000, -1000, 768, -1000, -1000, 8771, 8771, 8995, -64, 314,
+	2745, 4081, -1000, 426, -1000, 2578, 2411, -1000, 212, -1000,
+	6836, -1000, -1000, -1000, 315, -1000, -1000, -1000, -1000, 8771,
+	2244, -1000, 2077, -1000, -1000,
+}
+
+var yyPgo = [...]int16{
+	0, 1089, 1088, 96, 31, 49, 8, 14, 3, 1085,
+	250, 38, 1084, 1083, 1081, 1080, 1079, 1078, 18, 1075,
+	91, 13, 89, 1069, 26, 1068, 0, 80, 6, 1067,
+	1066, 1065, 48, 83, 47, 32, 42, 1054, 1053, 90,
+	1048, 87, 856, 40, 1047, 1044, 1043, 1042, 33, 63,
+	1041, 95, 88, 106, 52, 1039, 1037, 11, 1036, 1032,
+	1, 1031, 100, 50, 1029, 69, 57, 1028, 1027, 1026,
+	1025, 1024, 94, 1022, 1021, 1017, 29, 1015, 103, 1009,
+	1005, 1004, 1003, 1002, 46, 999, 30, 36, 230, 37,
+	21, 998, 996, 56, 45, 39, 2, 12, 20, 995,
+	992, 991, 990, 989, 979, 978, 975, 51, 622, 59,
+	972, 44, 971, 9, 969, 964, 962, 958, 753, 70,
+	93, 933, 919, 916, 5, 915, 906, 129, 904, 53,
+	43, 900, 898, 15, 798, 10, 638, 891, 890, 17,
+	886, 92, 27, 7, 884, 883, 875, 872, 16, 869,
+	867, 864, 81,
+}
+
+var yyR1 = [...]uint8{
+	0, 151, 151, 151, 151, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 5, 5, 5, 5, 5,
+	5, 5, 5, 6, 6, 109, 109, 110, 110, 111,
+	148, 148, 149, 149, 141, 141, 90, 90, 10, 10,
+	10, 107, 107, 107, 107, 107, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 134,
+	134, 17, 17, 19, 19, 7, 7, 87, 87, 86,
+	86, 88, 88, 18, 18, 21, 21, 20, 20, 78,
+	78, 142, 142, 23, 23, 23, 23, 23, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
+	11, 138, 138, 75, 75, 31, 31, 128, 128, 32,
+	12, 1, 1, 2, 2, 13, 13, 147, 147, 118,
+	118, 118, 14, 15, 16, 104, 104, 105, 106, 106,
+	129, 129, 131, 131, 130, 130, 135, 135, 135, 135,
+	125, 125, 124, 124, 30, 30, 122, 122, 122, 122,
+	139, 139, 139, 8, 8, 126, 126, 85, 85, 77,
+	77, 91, 91, 81, 81, 28, 28, 29, 29, 34,
+	34, 150, 150, 117, 117, 117, 117, 35, 35, 97,
+	97, 97, 97, 95, 95, 100, 100, 102, 102, 99,
+	99, 99, 99, 98, 98, 98, 101, 101, 103, 103,
+	96, 96, 119, 119, 119, 79, 79, 36, 36, 36,
+	36, 38, 38, 39, 40, 40, 41, 41, 143, 143,
+	42, 42, 42, 42, 108, 108, 108, 108, 108, 76,
+	76, 121, 121, 121, 140, 140, 43, 43, 44, 45,
+	45, 45, 45, 47, 47, 46, 123, 123, 145, 145,
+	144, 144, 146, 146, 133, 133, 133, 133, 133, 133,
+	133, 80, 80, 48, 48, 84, 84, 89, 89, 22,
+	74, 74, 49, 24, 24, 25, 25, 51, 50, 50,
+	50, 112, 114, 114, 115, 115, 113, 113, 116, 116,
+	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
+	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
+	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
+	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
+	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
+	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
+	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
+	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
+	37, 37, 127, 127, 152, 3, 3, 3, 132, 132,
+	82, 82, 60, 60, 61, 61, 61, 61, 52, 52,
+	53, 53, 58, 58, 137, 137, 137, 120, 120, 65,
+	65, 65, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 66, 66,
+	66, 66, 66, 26, 26, 27, 27, 64, 67, 67,
+	67, 68, 68, 68, 69, 69, 69, 69, 69, 69,
+	69, 33, 33, 33, 33, 54, 54, 54, 70, 70,
+	71, 71, 71, 71, 71, 71, 71, 62, 62, 62,
+	63, 63, 63, 57, 93, 93, 56, 56, 92, 92,
+	92, 92, 92, 92, 92, 136, 136, 136, 136, 72,
+	72, 72, 72, 72, 72, 72, 73, 73, 73, 73,
+	55, 55, 55, 55, 55, 55, 55, 83, 83, 94,
+}
+
+var yyR2 = [...]int8{
+	0, 1, 2, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 1, 3, 4,
+	1, 2, 0, 1, 2, 0, 1, 3, 1, 3,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	4, 3, 5, 4, 3, 4, 3, 4, 3, 1,
+	1, 6, 7, 6, 7, 0, 1, 3, 1, 3,
+	1, 3, 1, 1, 2, 1, 3, 1, 2, 3,
+	1, 2, 0, 1, 1, 1, 2, 4, 3, 1,
+	1, 5, 7, 9, 5, 3, 3, 3, 3, 3,
+	3, 1, 2, 6, 7, 9, 5, 1, 6, 3,
+	2, 0, 9, 1, 3, 0, 4, 1, 3, 1,
+	11, 0, 1, 0, 1, 9, 8, 1, 2, 1,
+	1, 1, 6, 7, 8, 0, 2, 5, 0, 2,
+	0, 2, 0, 2, 0, 2, 1, 2, 4, 3,
+	1, 4, 1, 4, 1, 4, 3, 4, 4, 5,
+	0, 5, 4, 1, 1, 1, 4, 5, 6, 1,
+	3, 6, 7, 3, 6, 2, 0, 1, 3, 6,
+	8, 0, 2, 1, 1, 1, 1, 0, 1, 1,
+	2, 1, 1, 1, 1, 3, 3, 3, 3, 1,
+	2, 1, 1, 1, 1, 1, 3, 3, 3, 3,
+	0, 2, 2, 3, 4, 1, 3, 1, 3, 2,
+	1, 3, 1, 1, 3, 1, 1, 3, 2, 0,
+	1, 2, 3, 1, 4, 4, 5, 1, 10, 1,
+	3, 1, 2, 3, 1, 2, 2, 2, 3, 3,
+	3, 4, 3, 1, 1, 3, 1, 3, 1, 1,
+	0, 1, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 3, 1, 2, 4, 3, 1, 4, 4, 4,
+	3, 1, 1, 0, 1, 3, 1, 8, 3, 2,
+	3, 7, 0, 2, 1, 3, 4, 4, 1, 3,
+	6, 5, 3, 4, 2, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
+	2, 2, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	2, 2, 2, 2, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 1, 5, 4, 3, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 1,
+	3, 2, 1, 2, 1, 2, 4, 2, 1, 2,
+	2, 3, 11, 9, 0, 0, 1, 1, 0, 4,
+	3, 1, 1, 2, 2, 4, 4, 2, 1, 1,
+	1, 1, 0, 3, 0, 1, 1, 0, 1, 4,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 2, 3, 3, 1, 1, 1, 3,
+	5, 3, 5, 1, 1, 0, 1, 1, 1, 3,
+	1, 1, 3, 1, 1, 4, 4, 4, 4, 4,
+	1, 1, 1, 3, 3, 1, 4, 2, 3, 3,
+	1, 4, 4, 3, 3, 3, 3, 1, 3, 1,
+	1, 3, 1, 1, 0, 1, 3, 1, 3, 1,
+	4, 2, 2, 6, 4, 2, 2, 1, 2, 1,
+	4, 3, 3, 3, 6, 3, 1, 1, 2, 1,
+	5, 4, 2, 2, 4, 2, 2, 1, 3, 1,
+}
+
+var yyChk = [...]int16{
+	-1000, -151, -141, 148, 149, 150, -9, 2, -11, -107,
+	-148, 52, 80, 45, 39, 153, -77, -81, 21, 20,
+	23, 30, 34, 35, 40, 47, 99, 19, 14, -26,
+	49, 25, 27, 155, 41, 36, 10, -12, -13, -14,
+	-15, -16, -111, -85, -91, -33, -37, 37, -147, 53,
+	54, 55, 145, 144, 7, -69, -70, -67, 60, 159,
+	93, 105, 106, 164, 163, 165, 166, 157, -50, -55,
+	108, 109, 110, 111, 112, 113, 114, 6, 167, -59,
+	152, 44, -112, 97, 98, 107, -127, -118, -54, -66,
+	-61, -52, -64, -65, 92, 50, 51, 4, 5, 85,
+	86, 87, 8, 9, 67, 68, 82, 64, 65, 66,
+	81, 63, 75, 151, 143, 38, 100, 101, 146, 12,
+	168, -10, -68, 61, 18, -90, 83, -26, 99, -148,
+	37, 80, 10, -141, -42, -108, -148, 45, 2, -145,
+	-144, -105, -146, 48, 32, -133, 104, 103, 102, 99,
+	100, 101, 146, -111, -107, -127, 99, 157, 83, -90,
+	153, -19, -134, -88, -90, 83, 37, 39, -20, -21,
+	-78, -22, 10, -142, 157, -11, 157, 157, -27, -26,
+	-27, -27, -38, -39, -54, -40, -127, -41, 12, -74,
+	-49, -26, 155, 131, 132, 88, 90, 89, 170, 162,
+	147, 172, 178, 164, 163, 173, 133, 174, 175, 134,
+	135, 136, 137, 138, 139, 176, 140, 177, 141, 116,
+	91, 161, 115, 157, 157, 157, 153, 10, 156, 94,
+	95, 94, 96, 95, 171, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 105, 106,
+	-3, 162, 147, 53, -118, 10, 10, 10, 10, -110,
+	-109, -10, 157, 159, 153, 58, 142, 157, -57, -56,
+	-93, -92, -26, 162, 84, 60, -26, -33, -66, 157,
+	-65, 99, 159, -33, -26, -26, -26, -26, -26, -26,
+	-26, -26, -26, -26, -26, -26, -58, 157, -26, -137,
+	17, -136, -72, 12, 77, 78, -26, -26, -26, -26,
+	159, 79, 79, -53, -51, -148, -52, -71, 53, -10,
+	-54, 157, 157, -26, -26, 157, -26, -26, 17, 76,
+	-136, -136, 17, 157, -3, 153, -54, -119, 157, -119,
+	157, 83, -90, -3, -108, -76, -10, -35, -99, -98,
+	161, -101, -103, 61, 62, -10, 39, 37, -133, -152,
+	-127, 158, -90, 155, 153, -141, 155, -17, -88, -90,
+	83, 155, 169, 83, 29, -90, -21, 155, 169, 171,
+	-23, 154, 2, -11, -107, -148, 52, -26, 21, -24,
+	-25, -26, -26, 155, 155, 155, 155, 169, 155, 169,
+	171, 155, 169, -26, -26, -26, -26, -26, -26, -26,
+	-26, -26, -26, -26, -26, -26, -26, -26, -26, -26,
+	-26, -26, -26, -26, -26, -26, -26, -26, -26, -26,
+	-53, -26, 156, -26, -128, -32, -33, -26, -78, -142,
+	155, 157, -11, 157, 155, 156, -26, 162, -26, -26,
+	-26, -26, -26, -26, -26, -26, -26, -26, -26, -26,
+	-26, 10, -152, 10, -129, 56, -152, -131, 56, -104,
+	156, 169, -7, -119, -26, -27, -26, -63, 10, 153,
+	-54, -63, -57, 160, 169, 59, -33, -26, 157, -26,
+	-57, 158, -27, 152, -72, -72, 17, 159, 58, -26,
+	11, -33, 59, -27, -62, -6, 153, -54, 10, -5,
+	-4, 99, 100, 101, 102, 103, 104, 146, 4, 5,
+	85, 86, 87, 88, 89, 90, 91, 92, 93, 6,
+	7, 94, 95, 96, 19, 20, 21, 22, 23, 24,
+	25, 26, 27, 28, 29, 41, 42, 43, 44, 45,
+	46, 47, 48, 49, 50, 51, 35, 36, 37, 39,
+	40, 97, 98, 60, 30, 31, 32, 33, 34, 61,
+	62, 56, 57, 80, 54, 55, 53, 63, 64, 66,
+	65, 67, 68, 82, 81, 38, 143, 145, -62, -6,
+	153, -54, -120, -119, -51, 79, 159, 153, 58, 142,
+	79, -120, -83, -94, -26, -26, -26, 76, 76, 151,
+	-26, 157, -26, 158, 84, -79, -36, -26, -6, 2,
+	10, 60, 93, 6, 44, 97, 98, 99, 92, 50,
+	51, 4, 5, 85, 86, 87, 67, 68, 82, 64,
+	65, 66, 81, 63, 143, 37, 38, 61, 80, -57,
+	10, -121, 169, 155, 153, -80, -48, 12, 170, 147,
+	-98, 170, 147, -84, -97, -89, -95, 161, -100, -102,
+	10, -5, -98, 99, 61, 62, -3, -6, 155, -141,
+	154, 155, 155, 83, -90, -20, 83, -90, 153, 10,
+	83, -22, -26, -107, 157, 158, 157, 155, 169, 158,
+	-39, -41, -26, -49, 156, -26, -7, 169, 29, 158,
+	154, -26, -26, -142, -26, -152, 157, -129, -130, 57,
+	-10, 153, -152, -76, -130, -97, 99, -109, 160, 158,
+	160, 154, -119, -26, -119, 158, 171, -93, -26, 162,
+	60, -57, 158, 160, 158, -73, 10, 13, 163, 12,
+	10, 154, 154, 159, 154, -26, 160, -119, -26, -119,
+	-26, -54, -27, -26, -63, -63, -54, -129, -7, 169,
+	158, 158, 158, -28, -29, -34, -149, -148, 154, 158,
+	-26, -7, 169, 156, 158, -10, 154, -140, -43, -44,
+	-45, -46, -47, -10, -6, 155, 169, -152, 171, -98,
+	-98, -98, -98, 155, 169, -84, 10, 170, 147, -95,
+	170, 147, 171, 171, -6, -106, 171, 154, 153, 83,
+	-87, -18, -21, -134, 153, -152, 158, -126, -11, 156,
+	-26, -24, -26, -122, 153, 156, -26, 158, -32, -135,
+	-33, 162, 60, 159, -30, -11, 156, -138, 158, 158,
+	96, 157, -28, -130, -152, -76, -143, 153, -152, -11,
+	156, 154, 171, -26, -33, 157, 158, 160, 13, -26,
+	154, 154, 160, 154, -130, 158, -94, 153, 158, -7,
+	169, -150, 158, -36, 84, -26, 154, -43, 155, 155,
+	46, 29, 79, -48, -26, -89, 155, -95, -95, -95,
+	-95, -26, -26, -152, 155, -26, -86, -21, 153, -7,
+	169, -21, -87, 155, -142, 158, 155, -139, 155, -139,
+	155, 155, 158, 59, -33, 157, -57, -142, -31, 42,
+	43, -11, 156, 155, -28, 158, -152, 153, 154, -42,
+	-143, 153, -142, -26, -57, 160, -152, -114, -115, -113,
+	-116, 33, -26, -96, 156, -34, -35, -117, 104, 103,
+	102, 146, -76, 10, -4, -133, -6, -152, -152, -152,
+	157, -7, 169, -86, 154, -18, -7, 22, 155, -24,
+	154, 32, 33, -139, 31, -139, -124, -11, 156, -135,
+	-33, -57, 160, 28, 157, 153, -142, 158, -132, 45,
+	153, -143, 154, -143, 158, 154, 153, 154, -7, 169,
+	-7, 169, -7, 169, -152, -97, -1, 162, -6, -28,
+	154, -21, -7, 154, 155, 158, -26, -8, 156, 155,
+	154, 155, 31, -142, 158, 158, 155, -75, -10, -142,
+	-96, -96, 157, -143, 154, 154, -143, -113, 59, -26,
+	59, 59, -2, 84, 158, 154, -125, -11, 156, -8,
+	-142, 155, 26, -124, 12, 170, 154, 153, 153, -82,
+	-60, 12, 162, 154, 154, -26, -26, -26, 12, -96,
+	-142, -142, 155, 158, -10, -142, -142, 158, 169, 12,
+	171, -123, 155, 153, 24, 153, 154, 154, -60, -26,
+	-142, 155, -142, 154, 154,
+}
+
+var yyDef = [...]int16{
+	95, -2, -2, 0, 95, -2, 94, 106, 107, 108,
+	0, 0, 0, 0, 0, 142, 149, 150, 0, 0,
+	0, 0, 495, 495, 495, 0, 458, 0, 161, 0,
+	0, 0, 0, 167, 0, 0, 96, 101, 102, 103,
+	104, 105, 90, 229, 0, -2, 494, 445, 0, 0,
+	0, 0, 0, 0, 0, -2, 512, 497, 0, 534,
+	0, 0, 0, 0, 0, 0, 0, 0, 415, 419,
+	0, 0, 0, 0, 0, 0, 0, 462, 0, 429,
+	464, 0, 432, 0, 434, 0, 438, 187, 504, 487,
+	510, 0, 0, -2, 0, 0, 0, 0, 0, 0,
+	0, 0, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 0, 0, 445, 189, 190, 191, 515,
+	0, -2, 0, 0, 471, 98, 0, 2, 458, 0,
+	445, 0, 96, -2, 4, 290, 320, 0, 293, 247,
+	0, 297, -2, 319, 444, 322, 324, 325, 326, 327,
+	328, 329, 330, 91, 109, 440, 0, 0, 0, 0,
+	95, 0, 0, 0, 135, 0, 119, 120, 132, 137,
+	0, 140, 0, 0, 0, 0, 343, 0, 0, 496,
+	0, 0, 0, 282, 283, 0, 439, 285, 286, 0,
+	341, 342, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 142, 0, 170, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 378, 380,
+	444, 446, 447, 0, 188, 200, 444, 202, 195, 125,
+	87, 85, 0, 495, 0, 0, 0, 534, 0, 533,
+	537, 535, 539, 0, 0, 0, 364, -2, 0, 0,
+	-2, 458, 534, -2, 400, 401, 402, 403, 0, 420,
+	421, 422, 423, 424, 425, 426, 427, 495, 428, 0,
+	465, 466, 547, 549, 0, 0, 431, 433, 435, 437,
+	495, 0, 0, 467, 349, 0, 460, 461, 467, 459,
+	520, 0, 0, 562, 563, 0, 565, 566, 0, 483,
+	0, 0, 0, 0, 0, 0, 517, 454, 0, 457,
+	534, 0, 100, 444, 291, 0, 299, 0, 248, 259,
+	0, 261, 262, 263, 264, 265, 0, 445, 323, 0,
+	441, 0, 99, 111, 95, 0, 114, 0, 0, 135,
+	0, 116, 0, 0, 0, 135, 138, 118, 0, 0,
+	141, 148, 143, 144, 145, 0, 0, 0, 0, 0,
+	344, 346, 0, 155, 156, 157, 158, 0, 159, 0,
+	0, 160, 0, 382, 383, 384, 385, 386, 387, 388,
+	389, 390, 391, 392, 393, 394, 395, 396, 397, 398,
+	399, -2, -2, -2, -2, -2, -2, -2, -2, -2,
+	413, 0, 0, 418, 125, 177, -2, 0, 0, 0,
+	169, 0, 230, 0, 233, 142, 362, 0, 365, 366,
+	367, 368, 369, 370, 371, 372, 373, 374, 375, 376,
+	377, 444, 0, 200, 204, 0, 0, 444, 0, 204,
+	0, 126, 0, 86, 0, 0, 0, 513, 530, 0,
+	532, 514, 0, 470, 534, 0, -2, 542, 534, 0,
+	0, -2, 0, 430, 548, 545, 546, 0, 0, 0,
+	0, 498, 0, 0, 0, -2, 0, -2, 83, 84,
+	75, 76, 77, 78, 79, 80, 81, 82, 5, 6,
+	7, 8, 9, 10, 11, 12, 13, 14, 15, 16,
+	17, 18, 19, 20, 21, 22, 23, 24, 25, 26,
+	27, 28, 29, 30, 31, 32, 33, 34, 35, 36,
+	37, 38, 39, 40, 41, 42, 43, 44, 45, 46,
+	47, 48, 49, 50, 51, 52, 53, 54, 55, 56,
+	57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
+	67, 68, 69, 70, 71, 72, 73, 74, 0, -2,
+	0, -2, 348, 468, 350, 0, 495, 0, 0, 0,
+	0, 200, 125, 567, 569, 0, 0, 482, 485, 484,
+	0, -2, 0, 272, 0, 125, 275, 277, 0, 280,
+	-2, 50, 15, -2, 35, 48, -2, -2, 14, 41,
+	42, 5, 6, 7, 8, 9, -2, -2, -2, -2,
+	-2, -2, -2, -2, 73, -2, -2, 56, 60, 0,
+	97, 292, 0, 301, 0, 0, 332, 444, 0, 0,
+	260, 0, 0, 0, 0, 336, 249, 0, 251, 252,
+	96, 0, 253, -2, -2, -2, 0, 198, 110, 0,
+	113, 115, 117, 0, 135, 131, 0, 135, 0, 136,
+	0, 139, 444, 146, 0, 0, 0, 343, 0, 0,
+	281, 284, 287, 340, 0, 417, 0, 126, 0, 0,
+	171, 0, 0, 0, 363, 0, -2, 204, 444, 0,
+	201, 289, 0, 203, 444, 196, 254, 88, 89, 0,
+	505, 507, 508, 0, 509, 0, 0, 536, 538, 0,
+	0, 0, -2, 470, 463, 0, 556, 557, 0, 559,
+	551, 552, 553, 0, 555, 436, 506, 455, 0, 456,
+	0, 525, 0, 0, 523, 524, 526, 204, 0, 126,
+	561, 564, 0, 0, 125, 237, 241, 93, 516, 273,
+	279, 0, -2, 0, 469, 300, 302, 0, 304, 0,
+	0, 314, 0, 0, 313, 294, 0, 333, 0, 266,
+	268, 267, 269, 295, 0, 0, 0, 0, 0, 250,
+	0, 0, 0, 0, 444, 0, 0, 112, 0, 0,
+	125, 128, 133, 0, 0, 339, 0, 151, 225, 142,
+	0, 0, 345, 154, 220, 220, 416, 0, 178, 0,
+	-2, 0, 0, 534, 166, 214, 142, 175, 0, 0,
+	0, -2, 0, 444, 0, 205, -2, 289, 0, 227,
+	142, 531, 0, 361, -2, 534, 544, 550, 558, 0,
+	-2, -2, 521, 522, 444, 560, 568, 352, 270, 235,
+	-2, 247, 274, 276, 0, 278, 303, 305, 306, 307,
+	0, 0, 0, 331, 444, 335, 296, 255, 257, 256,
+	258, 444, 444, 0, 197, 199, 125, 130, 0, 0,
+	126, 134, 125, 147, 0, 0, 343, 0, 220, 0,
+	220, 163, 0, 0, -2, 534, 0, 0, 168, 0,
+	0, 228, 142, 234, 0, 448, 0, 289, 192, 288,
+	-2, 289, -2, 360, 0, 0, 0, 0, 125, 354,
+	125, 125, 358, 444, 0, 238, 181, 242, 243, 244,
+	245, 246, 308, 309, 310, 312, 315, 334, 337, 338,
+	-2, 0, 126, 125, 123, 127, 0, 0, 152, 0,
+	216, 0, 0, 0, 0, 0, 164, 212, 142, 0,
+	-2, 0, -2, 0, 0, 142, -2, 270, 270, 0,
+	289, -2, 193, -2, 543, 554, 289, 351, 353, 126,
+	0, 126, 0, 126, 0, 271, 183, 182, 311, 0,
+	121, 129, 0, 124, 226, 0, 0, 142, 223, 224,
+	217, 218, 0, 0, 0, 208, 215, 0, 173, 0,
+	0, 0, 0, -2, 186, 194, -2, 355, 0, 359,
+	0, 0, 0, 184, 270, 122, 153, 210, 142, 142,
+	-2, 219, 0, 165, 0, 0, 176, 142, 142, 0,
+	451, 452, 0, 185, 347, 356, 357, 443, 239, 0,
+	0, -2, 213, 0, 174, 0, 0, 449, 0, 453,
+	0, 298, 316, 142, 0, 142, 180, 442, 450, 240,
+	0, 211, 0, 317, 172,
+}
+
+var yyTok1 = [...]uint8{
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 165, 151, 3, 168, 175, 162, 3,
+	157, 158, 173, 164, 169, 163, 178, 174, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 156, 155,
+	176, 171, 177, 161, 167, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 159, 3, 160, 172, 3, 152, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 153, 170, 154, 166,
+}
+
+var yyTok2 = [...]uint8{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83, 84, 85, 86, 87, 88, 89, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 147, 148, 149, 150,
+}
+
+var yyTok3 = [...]int8{
+	0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+// line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = true
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := int(yyPact[state])
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := int(yyExca[i])
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = int(yyTok1[0])
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = int(yyTok1[char])
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = int(yyTok2[char-yyPrivate])
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = int(yyTok3[i+0])
+		if token == char {
+			token = int(yyTok3[i+1])
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = int(yyTok2[1]) /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = int(yyPact[yystate])
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = int(yyDef[yystate])
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = int(yyExca[xi+0])
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = int(yyExca[xi+1])
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.(*Parser).syntaxError(yystate, yytoken)
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= int(yyR2[yyn])
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = int(yyAct[yyg])
+	} else {
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 1:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:301
+		{
+			yylex.(*Parser).currentToken.Value = nil
+
+			yylex.(*Parser).rootNode = &ast.Root{
+				Position: yylex.(*Parser).builder.NewNodeListPosition(yyDollar[1].list),
+				Stmts:    yyDollar[1].list,
+				EndTkn:   yylex.(*Parser).currentToken,
+			}
+		}
+	case 2:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:311
+		{
+			yylex.(*Parser).rootNode = &ast.Root{
+				Position: yylex.(*Parser).builder.NewNodePosition(yyDollar[2].node),
+				Stmts:    []ast.Vertex{yyDollar[2].node},
+			}
+		}
+	case 3:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:318
+		{
+			yylex.(*Parser).rootNode = &ast.Root{
+				Position: yylex.(*Parser).builder.NewNodeListPosition(yyDollar[2].list),
+				Stmts:    yyDollar[2].list,
+			}
+		}
+	case 4:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:325
+		{
+			yylex.(*Parser).rootNode = &ast.Root{
+				Position: yylex.(*Parser).builder.NewNodePosition(yyDollar[2].node),
+				Stmts:    []ast.Vertex{yyDollar[2].node},
+			}
+		}
+	case 5:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:334
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 6:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:334
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 7:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:334
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 8:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:334
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 9:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:334
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 10:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:334
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 11:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:334
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 12:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:334
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 13:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 14:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 15:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 16:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 17:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 18:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 19:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 20:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 21:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 22:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 23:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 24:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:335
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 25:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 26:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 27:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 28:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 29:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 30:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 31:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 33:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 35:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:337
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 36:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:337
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 37:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:337
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 38:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:337
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 39:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:337
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 40:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:337
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 41:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:337
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 42:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:337
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 43:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:337
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 44:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:337
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 45:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 46:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 47:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 48:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 49:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 50:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 51:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 53:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 54:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 55:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:338
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 56:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:339
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 57:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:339
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 58:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:339
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:339
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:339
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:339
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:339
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:339
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 64:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 65:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 66:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 67:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 68:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 69:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 70:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 71:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 72:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 73:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 74:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:340
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 75:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:345
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 76:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:348
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 77:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:348
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 78:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:348
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 79:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:348
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 80:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:348
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 81:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:348
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 82:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:348
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 83:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:353
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 84:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:357
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 85:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:364
+		{
+			yyVAL.node = &ast.Attribute{
+				Position: yylex.(*Parser).builder.NewNodePosition(yyDollar[1].node),
+				Name:     yyDollar[1].node,
+			}
+		}
+	case 86:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:371
+		{
+			yyVAL.node = &ast.Attribute{
+				Position:            yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[2].node),
+				Name:                yyDollar[1].node,
+				OpenParenthesisTkn:  yyDollar[2].node.(*ArgumentList).OpenParenthesisTkn,
+				Args:                yyDollar[2].node.(*ArgumentList).Arguments,
+				SeparatorTkns:       yyDollar[2].node.(*ArgumentList).SeparatorTkns,
+				CloseParenthesisTkn: yyDollar[2].node.(*ArgumentList).CloseParenthesisTkn,
+			}
+		}
+	case 87:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:385
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 88:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:391
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 89:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:401
+		{
+			if yyDollar[3].token != nil {
+				yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[3].token)
+			}
+
+			yyVAL.node = &ast.AttributeGroup{
+				Position:          yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				OpenAttributeTkn:  yyDollar[1].token,
+				Attrs:             yyDollar[2].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:     yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseAttributeTkn: yyDollar[4].token,
+			}
+		}
+	case 90:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:418
+		{
+			yyVAL.list = []ast.Vertex{yyDollar[1].node}
+		}
+	case 91:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:422
+		{
+			yyVAL.list = append(yyDollar[1].list, yyDollar[2].node)
+		}
+	case 92:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:429
+		{
+			yyVAL.list = nil
+		}
+	case 93:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:433
+		{
+			yyVAL.list = yyDollar[1].list
+		}
+	case 94:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:440
+		{
+			if yyDollar[2].node != nil {
+				yyVAL.list = append(yyDollar[1].list, yyDollar[2].node)
+			}
+
+			yylex.(*Parser).topStmts = yyVAL.list
+		}
+	case 95:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:448
+		{
+			yyVAL.list = []ast.Vertex{}
+		}
+	case 96:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:455
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{
+					&ast.NamePart{
+						Position:  yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+						StringTkn: yyDollar[1].token,
+						Value:     yyDollar[1].token.Value,
+					},
+				},
+			}
+		}
+	case 97:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:467
+		{
+			part := &ast.NamePart{
+				Position:  yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+				StringTkn: yyDollar[3].token,
+				Value:     yyDollar[3].token.Value,
+			}
+
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, part)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 98:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:483
+		{
+			yyVAL.node = &ast.Name{
+				Position:      yylex.(*Parser).builder.NewNodeListPosition(yyDollar[1].node.(*ParserSeparatedList).Items),
+				Parts:         yyDollar[1].node.(*ParserSeparatedList).Items,
+				SeparatorTkns: yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns,
+			}
+		}
+	case 99:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:491
+		{
+			yyVAL.node = &ast.NameRelative{
+				Position:       yylex.(*Parser).builder.NewTokenNodeListPosition(yyDollar[1].token, yyDollar[3].node.(*ParserSeparatedList).Items),
+				NsTkn:          yyDollar[1].token,
+				NsSeparatorTkn: yyDollar[2].token,
+				Parts:          yyDollar[3].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:  yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+			}
+		}
+	case 100:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:501
+		{
+			yyVAL.node = &ast.NameFullyQualified{
+				Position:       yylex.(*Parser).builder.NewTokenNodeListPosition(yyDollar[1].token, yyDollar[2].node.(*ParserSeparatedList).Items),
+				NsSeparatorTkn: yyDollar[1].token,
+				Parts:          yyDollar[2].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:  yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+			}
+		}
+	case 101:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:513
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 102:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:517
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 103:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:521
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 104:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:525
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 105:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:529
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 106:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:536
+		{
+			yyVAL.node = yylex.(*Parser).recovery.NewBadStmt()
+		}
+	case 107:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:540
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 108:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:544
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 109:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:548
+		{
+			switch n := yyDollar[2].node.(type) {
+			case *ast.StmtFunction:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtClass:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtTrait:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtInterface:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtEnum:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			}
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 110:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:570
+		{
+			yyVAL.node = &ast.StmtHaltCompiler{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				HaltCompilerTkn:     yyDollar[1].token,
+				OpenParenthesisTkn:  yyDollar[2].token,
+				CloseParenthesisTkn: yyDollar[3].token,
+				SemiColonTkn:        yyDollar[4].token,
+			}
+		}
+	case 111:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:580
+		{
+			yyVAL.node = &ast.StmtNamespace{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				NsTkn:    yyDollar[1].token,
+				Name: &ast.Name{
+					Position:      yylex.(*Parser).builder.NewNodeListPosition(yyDollar[2].node.(*ParserSeparatedList).Items),
+					Parts:         yyDollar[2].node.(*ParserSeparatedList).Items,
+					SeparatorTkns: yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+				},
+				SemiColonTkn: yyDollar[3].token,
+			}
+		}
+	case 112:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:593
+		{
+			yyVAL.node = &ast.StmtNamespace{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[5].token),
+				NsTkn:    yyDollar[1].token,
+				Name: &ast.Name{
+					Position:      yylex.(*Parser).builder.NewNodeListPosition(yyDollar[2].node.(*ParserSeparatedList).Items),
+					Parts:         yyDollar[2].node.(*ParserSeparatedList).Items,
+					SeparatorTkns: yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+				},
+				OpenCurlyBracketTkn:  yyDollar[3].token,
+				Stmts:                yyDollar[4].list,
+				CloseCurlyBracketTkn: yyDollar[5].token,
+			}
+		}
+	case 113:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:608
+		{
+			yyVAL.node = &ast.StmtNamespace{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				NsTkn:                yyDollar[1].token,
+				OpenCurlyBracketTkn:  yyDollar[2].token,
+				Stmts:                yyDollar[3].list,
+				CloseCurlyBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 114:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:618
+		{
+			use := yyDollar[2].node.(*ast.StmtGroupUseList)
+
+			use.Position = yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token)
+			use.UseTkn = yyDollar[1].token
+			use.SemiColonTkn = yyDollar[3].token
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 115:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:628
+		{
+			use := yyDollar[3].node.(*ast.StmtGroupUseList)
+
+			use.Position = yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token)
+			use.UseTkn = yyDollar[1].token
+			use.Type = yyDollar[2].node
+			use.SemiColonTkn = yyDollar[4].token
+
+			yyVAL.node = yyDollar[3].node
+		}
+	case 116:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:639
+		{
+			yyVAL.node = &ast.StmtUseList{
+				Position:      yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				UseTkn:        yyDollar[1].token,
+				Uses:          yyDollar[2].node.(*ParserSeparatedList).Items,
+				SeparatorTkns: yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+				SemiColonTkn:  yyDollar[3].token,
+			}
+		}
+	case 117:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:649
+		{
+			yyVAL.node = &ast.StmtUseList{
+				Position:      yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				UseTkn:        yyDollar[1].token,
+				Type:          yyDollar[2].node,
+				Uses:          yyDollar[3].node.(*ParserSeparatedList).Items,
+				SeparatorTkns: yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+				SemiColonTkn:  yyDollar[4].token,
+			}
+		}
+	case 118:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:660
+		{
+			yyVAL.node = &ast.StmtConstList{
+				Position:      yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				ConstTkn:      yyDollar[1].token,
+				Consts:        yyDollar[2].node.(*ParserSeparatedList).Items,
+				SeparatorTkns: yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+				SemiColonTkn:  yyDollar[3].token,
+			}
+		}
+	case 119:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:673
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:681
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 121:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:692
+		{
+			if yyDollar[5].token != nil {
+				yyDollar[4].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[4].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[5].token)
+			}
+
+			yyVAL.node = &ast.StmtGroupUseList{
+				Position: yylex.(*Parser).builder.NewNodeListTokenPosition(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[6].token),
+				Prefix: &ast.Name{
+					Position:      yylex.(*Parser).builder.NewNodeListPosition(yyDollar[1].node.(*ParserSeparatedList).Items),
+					Parts:         yyDollar[1].node.(*ParserSeparatedList).Items,
+					SeparatorTkns: yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns,
+				},
+				NsSeparatorTkn:       yyDollar[2].token,
+				OpenCurlyBracketTkn:  yyDollar[3].token,
+				Uses:                 yyDollar[4].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:        yyDollar[4].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseCurlyBracketTkn: yyDollar[6].token,
+			}
+		}
+	case 122:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		// line internal/php8/php8.y:712
+		{
+			if yyDollar[6].token != nil {
+				yyDollar[5].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[5].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[6].token)
+			}
+
+			yyVAL.node = &ast.StmtGroupUseList{
+				Position:              yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[7].token),
+				LeadingNsSeparatorTkn: yyDollar[1].token,
+				Prefix: &ast.Name{
+					Position:      yylex.(*Parser).builder.NewNodeListPosition(yyDollar[2].node.(*ParserSeparatedList).Items),
+					Parts:         yyDollar[2].node.(*ParserSeparatedList).Items,
+					SeparatorTkns: yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+				},
+				NsSeparatorTkn:       yyDollar[3].token,
+				OpenCurlyBracketTkn:  yyDollar[4].token,
+				Uses:                 yyDollar[5].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:        yyDollar[5].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseCurlyBracketTkn: yyDollar[7].token,
+			}
+		}
+	case 123:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:736
+		{
+			if yyDollar[5].token != nil {
+				yyDollar[4].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[4].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[5].token)
+			}
+
+			yyVAL.node = &ast.StmtGroupUseList{
+				Position: yylex.(*Parser).builder.NewNodeListTokenPosition(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[6].token),
+				Prefix: &ast.Name{
+					Position:      yylex.(*Parser).builder.NewNodeListPosition(yyDollar[1].node.(*ParserSeparatedList).Items),
+					Parts:         yyDollar[1].node.(*ParserSeparatedList).Items,
+					SeparatorTkns: yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns,
+				},
+				NsSeparatorTkn:       yyDollar[2].token,
+				OpenCurlyBracketTkn:  yyDollar[3].token,
+				Uses:                 yyDollar[4].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:        yyDollar[4].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseCurlyBracketTkn: yyDollar[6].token,
+			}
+		}
+	case 124:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		// line internal/php8/php8.y:756
+		{
+			if yyDollar[6].token != nil {
+				yyDollar[5].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[5].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[6].token)
+			}
+
+			yyVAL.node = &ast.StmtGroupUseList{
+				Position:              yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[7].token),
+				LeadingNsSeparatorTkn: yyDollar[1].token,
+				Prefix: &ast.Name{
+					Position:      yylex.(*Parser).builder.NewNodeListPosition(yyDollar[2].node.(*ParserSeparatedList).Items),
+					Parts:         yyDollar[2].node.(*ParserSeparatedList).Items,
+					SeparatorTkns: yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+				},
+				NsSeparatorTkn:       yyDollar[3].token,
+				OpenCurlyBracketTkn:  yyDollar[4].token,
+				Uses:                 yyDollar[5].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:        yyDollar[5].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseCurlyBracketTkn: yyDollar[7].token,
+			}
+		}
+	case 125:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:780
+		{
+			yyVAL.token = nil
+		}
+	case 126:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:784
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 127:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:791
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 128:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:798
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 129:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:807
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:814
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 131:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:823
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 132:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:830
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 133:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:839
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 134:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:843
+		{
+			decl := yyDollar[2].node.(*ast.StmtUse)
+			decl.Type = yyDollar[1].node
+			decl.Position = yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[2].node)
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 135:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:854
+		{
+			yyVAL.node = &ast.StmtUse{
+				Position: yylex.(*Parser).builder.NewNodeListPosition(yyDollar[1].node.(*ParserSeparatedList).Items),
+				Use: &ast.Name{
+					Position:      yylex.(*Parser).builder.NewNodeListPosition(yyDollar[1].node.(*ParserSeparatedList).Items),
+					Parts:         yyDollar[1].node.(*ParserSeparatedList).Items,
+					SeparatorTkns: yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns,
+				},
+			}
+		}
+	case 136:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:865
+		{
+			yyVAL.node = &ast.StmtUse{
+				Position: yylex.(*Parser).builder.NewNodeListTokenPosition(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].token),
+				Use: &ast.Name{
+					Position:      yylex.(*Parser).builder.NewNodeListPosition(yyDollar[1].node.(*ParserSeparatedList).Items),
+					Parts:         yyDollar[1].node.(*ParserSeparatedList).Items,
+					SeparatorTkns: yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns,
+				},
+				AsTkn: yyDollar[2].token,
+				Alias: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+					IdentifierTkn: yyDollar[3].token,
+					Value:         yyDollar[3].token.Value,
+				},
+			}
+		}
+	case 137:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:885
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 138:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:889
+		{
+			decl := yyDollar[2].node.(*ast.StmtUse)
+			decl.NsSeparatorTkn = yyDollar[1].token
+			decl.Position = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node)
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 139:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:900
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 140:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:907
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 141:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:916
+		{
+			if yyDollar[2].node != nil {
+				yyVAL.list = append(yyDollar[1].list, yyDollar[2].node)
+			}
+		}
+	case 142:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:922
+		{
+			yyVAL.list = []ast.Vertex{}
+		}
+	case 143:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:929
+		{
+			yyVAL.node = yylex.(*Parser).recovery.NewBadStmt()
+		}
+	case 144:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:933
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 145:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:937
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 146:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:941
+		{
+			switch n := yyDollar[2].node.(type) {
+			case *ast.StmtFunction:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtClass:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtTrait:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtInterface:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtEnum:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			}
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 147:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:963
+		{
+			yyVAL.node = &ast.StmtHaltCompiler{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				HaltCompilerTkn:     yyDollar[1].token,
+				OpenParenthesisTkn:  yyDollar[2].token,
+				CloseParenthesisTkn: yyDollar[3].token,
+				SemiColonTkn:        yyDollar[4].token,
+			}
+		}
+	case 148:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:975
+		{
+			yyVAL.node = &ast.StmtStmtList{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenCurlyBracketTkn:  yyDollar[1].token,
+				Stmts:                yyDollar[2].list,
+				CloseCurlyBracketTkn: yyDollar[3].token,
+			}
+		}
+	case 149:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:984
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 150:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:988
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 151:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:992
+		{
+			yyDollar[5].node.(*ast.StmtWhile).WhileTkn = yyDollar[1].token
+			yyDollar[5].node.(*ast.StmtWhile).OpenParenthesisTkn = yyDollar[2].token
+			yyDollar[5].node.(*ast.StmtWhile).Cond = yyDollar[3].node
+			yyDollar[5].node.(*ast.StmtWhile).CloseParenthesisTkn = yyDollar[4].token
+			yyDollar[5].node.(*ast.StmtWhile).Position = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[5].node)
+
+			yyVAL.node = yyDollar[5].node
+		}
+	case 152:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		// line internal/php8/php8.y:1002
+		{
+			yyVAL.node = &ast.StmtDo{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[7].token),
+				DoTkn:               yyDollar[1].token,
+				Stmt:                yyDollar[2].node,
+				WhileTkn:            yyDollar[3].token,
+				OpenParenthesisTkn:  yyDollar[4].token,
+				Cond:                yyDollar[5].node,
+				CloseParenthesisTkn: yyDollar[6].token,
+				SemiColonTkn:        yyDollar[7].token,
+			}
+		}
+	case 153:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		// line internal/php8/php8.y:1015
+		{
+			yyDollar[9].node.(*ast.StmtFor).ForTkn = yyDollar[1].token
+			yyDollar[9].node.(*ast.StmtFor).OpenParenthesisTkn = yyDollar[2].token
+			yyDollar[9].node.(*ast.StmtFor).Init = yyDollar[3].node.(*ParserSeparatedList).Items
+			yyDollar[9].node.(*ast.StmtFor).InitSeparatorTkns = yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns
+			yyDollar[9].node.(*ast.StmtFor).InitSemiColonTkn = yyDollar[4].token
+			yyDollar[9].node.(*ast.StmtFor).Cond = yyDollar[5].node.(*ParserSeparatedList).Items
+			yyDollar[9].node.(*ast.StmtFor).CondSeparatorTkns = yyDollar[5].node.(*ParserSeparatedList).SeparatorTkns
+			yyDollar[9].node.(*ast.StmtFor).CondSemiColonTkn = yyDollar[6].token
+			yyDollar[9].node.(*ast.StmtFor).Loop = yyDollar[7].node.(*ParserSeparatedList).Items
+			yyDollar[9].node.(*ast.StmtFor).LoopSeparatorTkns = yyDollar[7].node.(*ParserSeparatedList).SeparatorTkns
+			yyDollar[9].node.(*ast.StmtFor).CloseParenthesisTkn = yyDollar[8].token
+			yyDollar[9].node.(*ast.StmtFor).Position = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[9].node)
+
+			yyVAL.node = yyDollar[9].node
+		}
+	case 154:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:1032
+		{
+			yyDollar[5].node.(*ast.StmtSwitch).SwitchTkn = yyDollar[1].token
+			yyDollar[5].node.(*ast.StmtSwitch).OpenParenthesisTkn = yyDollar[2].token
+			yyDollar[5].node.(*ast.StmtSwitch).Cond = yyDollar[3].node
+			yyDollar[5].node.(*ast.StmtSwitch).CloseParenthesisTkn = yyDollar[4].token
+			yyDollar[5].node.(*ast.StmtSwitch).Position = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[5].node)
+
+			yyVAL.node = yyDollar[5].node
+		}
+	case 155:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1042
+		{
+			yyVAL.node = &ast.StmtBreak{
+				Position:     yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				BreakTkn:     yyDollar[1].token,
+				Expr:         yyDollar[2].node,
+				SemiColonTkn: yyDollar[3].token,
+			}
+		}
+	case 156:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1051
+		{
+			yyVAL.node = &ast.StmtContinue{
+				Position:     yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				ContinueTkn:  yyDollar[1].token,
+				Expr:         yyDollar[2].node,
+				SemiColonTkn: yyDollar[3].token,
+			}
+		}
+	case 157:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1060
+		{
+			yyVAL.node = &ast.StmtReturn{
+				Position:     yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				ReturnTkn:    yyDollar[1].token,
+				Expr:         yyDollar[2].node,
+				SemiColonTkn: yyDollar[3].token,
+			}
+		}
+	case 158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1069
+		{
+			yyDollar[2].node.(*ast.StmtGlobal).GlobalTkn = yyDollar[1].token
+			yyDollar[2].node.(*ast.StmtGlobal).SemiColonTkn = yyDollar[3].token
+			yyDollar[2].node.(*ast.StmtGlobal).Position = yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token)
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 159:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1077
+		{
+			yyDollar[2].node.(*ast.StmtStatic).StaticTkn = yyDollar[1].token
+			yyDollar[2].node.(*ast.StmtStatic).SemiColonTkn = yyDollar[3].token
+			yyDollar[2].node.(*ast.StmtStatic).Position = yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token)
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 160:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1085
+		{
+			yyDollar[2].node.(*ast.StmtEcho).EchoTkn = yyDollar[1].token
+			yyDollar[2].node.(*ast.StmtEcho).SemiColonTkn = yyDollar[3].token
+			yyDollar[2].node.(*ast.StmtEcho).Position = yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token)
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 161:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1093
+		{
+			yyVAL.node = &ast.StmtInlineHtml{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				InlineHtmlTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 162:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:1101
+		{
+			yyVAL.node = &ast.StmtExpression{
+				Position:     yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[2].token),
+				Expr:         yyDollar[1].node,
+				SemiColonTkn: yyDollar[2].token,
+			}
+		}
+	case 163:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:1109
+		{
+			yyDollar[3].node.(*ast.StmtUnset).UnsetTkn = yyDollar[1].token
+			yyDollar[3].node.(*ast.StmtUnset).OpenParenthesisTkn = yyDollar[2].token
+			if yyDollar[4].token != nil {
+				yyDollar[3].node.(*ast.StmtUnset).SeparatorTkns = append(yyDollar[3].node.(*ast.StmtUnset).SeparatorTkns, yyDollar[4].token)
+			}
+			yyDollar[3].node.(*ast.StmtUnset).CloseParenthesisTkn = yyDollar[5].token
+			yyDollar[3].node.(*ast.StmtUnset).SemiColonTkn = yyDollar[6].token
+			yyDollar[3].node.(*ast.StmtUnset).Position = yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[6].token)
+
+			yyVAL.node = yyDollar[3].node
+		}
+	case 164:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		// line internal/php8/php8.y:1122
+		{
+			foreach := yyDollar[7].node.(*ast.StmtForeach)
+
+			foreach.Position = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[7].node)
+			foreach.ForeachTkn = yyDollar[1].token
+			foreach.OpenParenthesisTkn = yyDollar[2].token
+			foreach.Expr = yyDollar[3].node
+			foreach.AsTkn = yyDollar[4].token
+			foreach.Var = yyDollar[5].node
+			foreach.CloseParenthesisTkn = yyDollar[6].token
+
+			if val, ok := yyDollar[5].node.(*ast.StmtForeach); ok {
+				foreach.AmpersandTkn = val.AmpersandTkn
+				foreach.Var = val.Var
+			}
+
+			yyVAL.node = foreach
+		}
+	case 165:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		// line internal/php8/php8.y:1141
+		{
+			foreach := yyDollar[9].node.(*ast.StmtForeach)
+
+			foreach.Position = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[9].node)
+			foreach.ForeachTkn = yyDollar[1].token
+			foreach.OpenParenthesisTkn = yyDollar[2].token
+			foreach.Expr = yyDollar[3].node
+			foreach.AsTkn = yyDollar[4].token
+			foreach.Key = yyDollar[5].node
+			foreach.DoubleArrowTkn = yyDollar[6].token
+			foreach.Var = yyDollar[7].node
+			foreach.CloseParenthesisTkn = yyDollar[8].token
+
+			if val, ok := yyDollar[7].node.(*ast.StmtForeach); ok {
+				foreach.AmpersandTkn = val.AmpersandTkn
+				foreach.Var = val.Var
+			}
+
+			yyVAL.node = foreach
+		}
+	case 166:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:1162
+		{
+			yyDollar[5].node.(*ast.StmtDeclare).DeclareTkn = yyDollar[1].token
+			yyDollar[5].node.(*ast.StmtDeclare).OpenParenthesisTkn = yyDollar[2].token
+			yyDollar[5].node.(*ast.StmtDeclare).Consts = yyDollar[3].node.(*ParserSeparatedList).Items
+			yyDollar[5].node.(*ast.StmtDeclare).SeparatorTkns = yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns
+			yyDollar[5].node.(*ast.StmtDeclare).CloseParenthesisTkn = yyDollar[4].token
+			yyDollar[5].node.(*ast.StmtDeclare).Position = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[5].node)
+
+			yyVAL.node = yyDollar[5].node
+		}
+	case 167:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1173
+		{
+			yyVAL.node = &ast.StmtNop{
+				Position:     yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				SemiColonTkn: yyDollar[1].token,
+			}
+		}
+	case 168:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:1180
+		{
+			pos := yylex.(*Parser).builder.NewTokenNodeListPosition(yyDollar[1].token, yyDollar[5].list)
+			if yyDollar[6].node != nil {
+				pos = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[6].node)
+			}
+
+			yyVAL.node = &ast.StmtTry{
+				Position:             pos,
+				TryTkn:               yyDollar[1].token,
+				OpenCurlyBracketTkn:  yyDollar[2].token,
+				Stmts:                yyDollar[3].list,
+				CloseCurlyBracketTkn: yyDollar[4].token,
+				Catches:              yyDollar[5].list,
+				Finally:              yyDollar[6].node,
+			}
+		}
+	case 169:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1197
+		{
+			yyVAL.node = &ast.StmtGoto{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				GotoTkn:  yyDollar[1].token,
+				Label: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+					IdentifierTkn: yyDollar[2].token,
+					Value:         yyDollar[2].token.Value,
+				},
+				SemiColonTkn: yyDollar[3].token,
+			}
+		}
+	case 170:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:1210
+		{
+			yyVAL.node = &ast.StmtLabel{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[2].token),
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					IdentifierTkn: yyDollar[1].token,
+					Value:         yyDollar[1].token.Value,
+				},
+				ColonTkn: yyDollar[2].token,
+			}
+		}
+	case 171:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1224
+		{
+			yyVAL.list = []ast.Vertex{}
+		}
+	case 172:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		// line internal/php8/php8.y:1228
+		{
+			catch := yyDollar[4].node.(*ast.StmtCatch)
+			catch.CatchTkn = yyDollar[2].token
+			catch.OpenParenthesisTkn = yyDollar[3].token
+			catch.Var = &ast.ExprVariable{
+				Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[5].token),
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[5].token),
+					IdentifierTkn: yyDollar[5].token,
+					Value:         yyDollar[5].token.Value,
+				},
+			}
+			catch.CloseParenthesisTkn = yyDollar[6].token
+			catch.OpenCurlyBracketTkn = yyDollar[7].token
+			catch.Stmts = yyDollar[8].list
+			catch.CloseCurlyBracketTkn = yyDollar[9].token
+			catch.Position = yylex.(*Parser).builder.NewTokensPosition(yyDollar[2].token, yyDollar[9].token)
+
+			yyVAL.list = append(yyDollar[1].list, catch)
+		}
+	case 173:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1251
+		{
+			yyVAL.node = &ast.StmtCatch{
+				Types: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 174:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1257
+		{
+			yyDollar[1].node.(*ast.StmtCatch).SeparatorTkns = append(yyDollar[1].node.(*ast.StmtCatch).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ast.StmtCatch).Types = append(yyDollar[1].node.(*ast.StmtCatch).Types, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 175:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1267
+		{
+			yyVAL.node = nil
+		}
+	case 176:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:1271
+		{
+			yyVAL.node = &ast.StmtFinally{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				FinallyTkn:           yyDollar[1].token,
+				OpenCurlyBracketTkn:  yyDollar[2].token,
+				Stmts:                yyDollar[3].list,
+				CloseCurlyBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 177:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1284
+		{
+			yyVAL.node = &ast.StmtUnset{
+				Vars: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 178:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1290
+		{
+			yyDollar[1].node.(*ast.StmtUnset).Vars = append(yyDollar[1].node.(*ast.StmtUnset).Vars, yyDollar[3].node)
+			yyDollar[1].node.(*ast.StmtUnset).SeparatorTkns = append(yyDollar[1].node.(*ast.StmtUnset).SeparatorTkns, yyDollar[2].token)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 179:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1300
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 180:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		// line internal/php8/php8.y:1307
+		{
+			yyVAL.node = &ast.StmtFunction{
+				Position:     yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[11].token),
+				FunctionTkn:  yyDollar[1].token,
+				AmpersandTkn: yyDollar[2].token,
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+					IdentifierTkn: yyDollar[3].token,
+					Value:         yyDollar[3].token.Value,
+				},
+				OpenParenthesisTkn:   yyDollar[5].token,
+				Params:               yyDollar[6].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:        yyDollar[6].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseParenthesisTkn:  yyDollar[7].token,
+				ColonTkn:             yyDollar[8].node.(*ReturnType).ColonTkn,
+				ReturnType:           yyDollar[8].node.(*ReturnType).Type,
+				OpenCurlyBracketTkn:  yyDollar[9].token,
+				Stmts:                yyDollar[10].list,
+				CloseCurlyBracketTkn: yyDollar[11].token,
+			}
+		}
+	case 181:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1332
+		{
+			yyVAL.token = nil
+		}
+	case 182:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1336
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 183:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1343
+		{
+			yyVAL.token = nil
+		}
+	case 184:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1347
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 185:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		// line internal/php8/php8.y:1354
+		{
+			class := &ast.StmtClass{
+				Position:  yylex.(*Parser).builder.NewOptionalListTokensPosition(yyDollar[1].list, yyDollar[2].token, yyDollar[9].token),
+				Modifiers: yyDollar[1].list,
+				ClassTkn:  yyDollar[2].token,
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+					IdentifierTkn: yyDollar[3].token,
+					Value:         yyDollar[3].token.Value,
+				},
+				OpenCurlyBracketTkn:  yyDollar[7].token,
+				Stmts:                yyDollar[8].list,
+				CloseCurlyBracketTkn: yyDollar[9].token,
+			}
+
+			if yyDollar[4].node != nil {
+				class.ExtendsTkn = yyDollar[4].node.(*ast.StmtClass).ExtendsTkn
+				class.Extends = yyDollar[4].node.(*ast.StmtClass).Extends
+			}
+
+			if yyDollar[5].node != nil {
+				class.ImplementsTkn = yyDollar[5].node.(*ast.StmtClass).ImplementsTkn
+				class.Implements = yyDollar[5].node.(*ast.StmtClass).Implements
+				class.ImplementsSeparatorTkns = yyDollar[5].node.(*ast.StmtClass).ImplementsSeparatorTkns
+			}
+
+			yyVAL.node = class
+		}
+	case 186:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		// line internal/php8/php8.y:1383
+		{
+			class := &ast.StmtClass{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[8].token),
+				ClassTkn: yyDollar[1].token,
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+					IdentifierTkn: yyDollar[2].token,
+					Value:         yyDollar[2].token.Value,
+				},
+				OpenCurlyBracketTkn:  yyDollar[6].token,
+				Stmts:                yyDollar[7].list,
+				CloseCurlyBracketTkn: yyDollar[8].token,
+			}
+
+			if yyDollar[3].node != nil {
+				class.ExtendsTkn = yyDollar[3].node.(*ast.StmtClass).ExtendsTkn
+				class.Extends = yyDollar[3].node.(*ast.StmtClass).Extends
+			}
+
+			if yyDollar[4].node != nil {
+				class.ImplementsTkn = yyDollar[4].node.(*ast.StmtClass).ImplementsTkn
+				class.Implements = yyDollar[4].node.(*ast.StmtClass).Implements
+				class.ImplementsSeparatorTkns = yyDollar[4].node.(*ast.StmtClass).ImplementsSeparatorTkns
+			}
+
+			yyVAL.node = class
+		}
+	case 187:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1414
+		{
+			yyVAL.list = []ast.Vertex{yyDollar[1].node}
+		}
+	case 188:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:1418
+		{
+			yyVAL.list = append(yyDollar[1].list, yyDollar[2].node)
+		}
+	case 189:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1425
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 190:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1433
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 191:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1441
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+
+			yylex.(*Parser).requires(version.FeatureReadonlyClass, yyDollar[1].token.Position)
+		}
+	case 192:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:1454
+		{
+			yyVAL.node = &ast.StmtTrait{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[6].token),
+				TraitTkn: yyDollar[1].token,
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+					IdentifierTkn: yyDollar[2].token,
+					Value:         yyDollar[2].token.Value,
+				},
+				OpenCurlyBracketTkn:  yyDollar[4].token,
+				Stmts:                yyDollar[5].list,
+				CloseCurlyBracketTkn: yyDollar[6].token,
+			}
+		}
+	case 193:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		// line internal/php8/php8.y:1472
+		{
+			iface := &ast.StmtInterface{
+				Position:     yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[7].token),
+				InterfaceTkn: yyDollar[1].token,
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+					IdentifierTkn: yyDollar[2].token,
+					Value:         yyDollar[2].token.Value,
+				},
+				OpenCurlyBracketTkn:  yyDollar[5].token,
+				Stmts:                yyDollar[6].list,
+				CloseCurlyBracketTkn: yyDollar[7].token,
+			}
+
+			if yyDollar[3].node != nil {
+				iface.ExtendsTkn = yyDollar[3].node.(*ast.StmtInterface).ExtendsTkn
+				iface.Extends = yyDollar[3].node.(*ast.StmtInterface).Extends
+				iface.ExtendsSeparatorTkns = yyDollar[3].node.(*ast.StmtInterface).ExtendsSeparatorTkns
+			}
+
+			yyVAL.node = iface
+		}
+	case 194:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		// line internal/php8/php8.y:1498
+		{
+			enum := &ast.StmtEnum{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[8].token),
+				EnumTkn:  yyDollar[1].token,
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+					IdentifierTkn: yyDollar[2].token,
+					Value:         yyDollar[2].token.Value,
+				},
+				OpenCurlyBracketTkn:  yyDollar[6].token,
+				Stmts:                yyDollar[7].list,
+				CloseCurlyBracketTkn: yyDollar[8].token,
+			}
+
+			if yyDollar[3].node != nil {
+				enum.ColonTkn = yyDollar[3].node.(*ast.StmtEnum).ColonTkn
+				enum.Type = yyDollar[3].node.(*ast.StmtEnum).Type
+			}
+
+			if yyDollar[4].node != nil {
+				enum.ImplementsTkn = yyDollar[4].node.(*ast.StmtClass).ImplementsTkn
+				enum.Implements = yyDollar[4].node.(*ast.StmtClass).Implements
+				enum.ImplementsSeparatorTkns = yyDollar[4].node.(*ast.StmtClass).ImplementsSeparatorTkns
+			}
+
+			yyVAL.node = enum
+		}
+	case 195:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1529
+		{
+			yyVAL.node = nil
+		}
+	case 196:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:1533
+		{
+			yyVAL.node = &ast.StmtEnum{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				ColonTkn: yyDollar[1].token,
+				Type:     yyDollar[2].node,
+			}
+		}
+	case 197:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:1544
+		{
+			enumCase := &ast.StmtEnumCase{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[5].token),
+				CaseTkn:  yyDollar[1].token,
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+					IdentifierTkn: yyDollar[3].token,
+					Value:         yyDollar[3].token.Value,
+				},
+				SemiColonTkn: yyDollar[5].token,
+			}
+
+			if yyDollar[4].node != nil {
+				enumCase.EqualTkn = yyDollar[4].node.(*ast.StmtEnumCase).EqualTkn
+				enumCase.Expr = yyDollar[4].node.(*ast.StmtEnumCase).Expr
+			}
+
+			yyVAL.node = enumCase
+		}
+	case 198:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1567
+		{
+			yyVAL.node = nil
+		}
+	case 199:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:1571
+		{
+			yyVAL.node = &ast.StmtEnumCase{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				EqualTkn: yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 200:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1582
+		{
+			yyVAL.node = nil
+		}
+	case 201:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:1586
+		{
+			yyVAL.node = &ast.StmtClass{
+				Position:   yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				ExtendsTkn: yyDollar[1].token,
+				Extends:    yyDollar[2].node,
+			}
+		}
+	case 202:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1597
+		{
+			yyVAL.node = nil
+		}
+	case 203:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:1601
+		{
+			yyVAL.node = &ast.StmtInterface{
+				Position:             yylex.(*Parser).builder.NewTokenNodeListPosition(yyDollar[1].token, yyDollar[2].node.(*ParserSeparatedList).Items),
+				ExtendsTkn:           yyDollar[1].token,
+				Extends:              yyDollar[2].node.(*ParserSeparatedList).Items,
+				ExtendsSeparatorTkns: yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+			}
+		}
+	case 204:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1613
+		{
+			yyVAL.node = nil
+		}
+	case 205:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:1617
+		{
+			yyVAL.node = &ast.StmtClass{
+				Position:                yylex.(*Parser).builder.NewTokenNodeListPosition(yyDollar[1].token, yyDollar[2].node.(*ParserSeparatedList).Items),
+				ImplementsTkn:           yyDollar[1].token,
+				Implements:              yyDollar[2].node.(*ParserSeparatedList).Items,
+				ImplementsSeparatorTkns: yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+			}
+		}
+	case 206:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1629
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 207:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:1633
+		{
+			yyVAL.node = &ast.StmtForeach{
+				Position:     yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				AmpersandTkn: yyDollar[1].token,
+				Var:          yyDollar[2].node,
+			}
+		}
+	case 208:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:1641
+		{
+			yyVAL.node = &ast.ExprList{
+				Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				ListTkn:         yyDollar[1].token,
+				OpenBracketTkn:  yyDollar[2].token,
+				Items:           yyDollar[3].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:   yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 209:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1652
+		{
+			yyVAL.node = &ast.ExprList{
+				Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenBracketTkn:  yyDollar[1].token,
+				Items:           yyDollar[2].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:   yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseBracketTkn: yyDollar[3].token,
+			}
+		}
+	case 210:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1665
+		{
+			yyVAL.node = &ast.StmtFor{
+				Position: yylex.(*Parser).builder.NewNodePosition(yyDollar[1].node),
+				Stmt:     yyDollar[1].node,
+			}
+		}
+	case 211:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:1672
+		{
+			yyVAL.node = &ast.StmtFor{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				ColonTkn: yyDollar[1].token,
+				Stmt: &ast.StmtStmtList{
+					Position: yylex.(*Parser).builder.NewNodeListPosition(yyDollar[2].list),
+					Stmts:    yyDollar[2].list,
+				},
+				EndForTkn:    yyDollar[3].token,
+				SemiColonTkn: yyDollar[4].token,
+			}
+		}
+	case 212:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1688
+		{
+			yyVAL.node = &ast.StmtForeach{
+				Position: yylex.(*Parser).builder.NewNodePosition(yyDollar[1].node),
+				Stmt:     yyDollar[1].node,
+			}
+		}
+	case 213:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:1695
+		{
+			yyVAL.node = &ast.StmtForeach{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				ColonTkn: yyDollar[1].token,
+				Stmt: &ast.StmtStmtList{
+					Position: yylex.(*Parser).builder.NewNodeListPosition(yyDollar[2].list),
+					Stmts:    yyDollar[2].list,
+				},
+				EndForeachTkn: yyDollar[3].token,
+				SemiColonTkn:  yyDollar[4].token,
+			}
+		}
+	case 214:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1711
+		{
+			yyVAL.node = &ast.StmtDeclare{
+				Position: yylex.(*Parser).builder.NewNodePosition(yyDollar[1].node),
+				Stmt:     yyDollar[1].node,
+			}
+		}
+	case 215:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:1718
+		{
+			yyVAL.node = &ast.StmtDeclare{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				ColonTkn: yyDollar[1].token,
+				Stmt: &ast.StmtStmtList{
+					Position: yylex.(*Parser).builder.NewNodeListPosition(yyDollar[2].list),
+					Stmts:    yyDollar[2].list,
+				},
+				EndDeclareTkn: yyDollar[3].token,
+				SemiColonTkn:  yyDollar[4].token,
+			}
+		}
+	case 216:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1734
+		{
+			yyVAL.node = &ast.StmtSwitch{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenCurlyBracketTkn:  yyDollar[1].token,
+				Cases:                yyDollar[2].list,
+				CloseCurlyBracketTkn: yyDollar[3].token,
+			}
+		}
+	case 217:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:1743
+		{
+			yyVAL.node = &ast.StmtSwitch{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				OpenCurlyBracketTkn:  yyDollar[1].token,
+				CaseSeparatorTkn:     yyDollar[2].token,
+				Cases:                yyDollar[3].list,
+				CloseCurlyBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 218:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:1753
+		{
+			yyVAL.node = &ast.StmtSwitch{
+				Position:     yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				ColonTkn:     yyDollar[1].token,
+				Cases:        yyDollar[2].list,
+				EndSwitchTkn: yyDollar[3].token,
+				SemiColonTkn: yyDollar[4].token,
+			}
+		}
+	case 219:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:1763
+		{
+			yyVAL.node = &ast.StmtSwitch{
+				Position:         yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[5].token),
+				ColonTkn:         yyDollar[1].token,
+				CaseSeparatorTkn: yyDollar[2].token,
+				Cases:            yyDollar[3].list,
+				EndSwitchTkn:     yyDollar[4].token,
+				SemiColonTkn:     yyDollar[5].token,
+			}
+		}
+	case 220:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1777
+		{
+			yyVAL.list = nil
+		}
+	case 221:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:1781
+		{
+			yyVAL.list = append(yyDollar[1].list, &ast.StmtCase{
+				Position:         yylex.(*Parser).builder.NewTokenNodeListPosition(yyDollar[2].token, yyDollar[5].list),
+				CaseTkn:          yyDollar[2].token,
+				Cond:             yyDollar[3].node,
+				CaseSeparatorTkn: yyDollar[4].token,
+				Stmts:            yyDollar[5].list,
+			})
+		}
+	case 222:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:1791
+		{
+			yyVAL.list = append(yyDollar[1].list, &ast.StmtDefault{
+				Position:         yylex.(*Parser).builder.NewTokenNodeListPosition(yyDollar[2].token, yyDollar[4].list),
+				DefaultTkn:       yyDollar[2].token,
+				CaseSeparatorTkn: yyDollar[3].token,
+				Stmts:            yyDollar[4].list,
+			})
+		}
+	case 223:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1803
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 224:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1807
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 225:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1814
+		{
+			yyVAL.node = &ast.StmtWhile{
+				Position: yylex.(*Parser).builder.NewNodePosition(yyDollar[1].node),
+				Stmt:     yyDollar[1].node,
+			}
+		}
+	case 226:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:1821
+		{
+			yyVAL.node = &ast.StmtWhile{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				ColonTkn: yyDollar[1].token,
+				Stmt: &ast.StmtStmtList{
+					Position: yylex.(*Parser).builder.NewNodeListPosition(yyDollar[2].list),
+					Stmts:    yyDollar[2].list,
+				},
+				EndWhileTkn:  yyDollar[3].token,
+				SemiColonTkn: yyDollar[4].token,
+			}
+		}
+	case 227:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:1837
+		{
+			yyVAL.node = &ast.StmtIf{
+				Position:            yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[5].node),
+				IfTkn:               yyDollar[1].token,
+				OpenParenthesisTkn:  yyDollar[2].token,
+				Cond:                yyDollar[3].node,
+				CloseParenthesisTkn: yyDollar[4].token,
+				Stmt:                yyDollar[5].node,
+			}
+		}
+	case 228:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:1848
+		{
+			yyDollar[1].node.(*ast.StmtIf).ElseIf = append(yyDollar[1].node.(*ast.StmtIf).ElseIf, &ast.StmtElseIf{
+				Position:            yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[2].token, yyDollar[6].node),
+				ElseIfTkn:           yyDollar[2].token,
+				OpenParenthesisTkn:  yyDollar[3].token,
+				Cond:                yyDollar[4].node,
+				CloseParenthesisTkn: yyDollar[5].token,
+				Stmt:                yyDollar[6].node,
+			})
+
+			yyDollar[1].node.(*ast.StmtIf).Position = yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[6].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 229:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1866
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1870
+		{
+			yyDollar[1].node.(*ast.StmtIf).Else = &ast.StmtElse{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[2].token, yyDollar[3].node),
+				ElseTkn:  yyDollar[2].token,
+				Stmt:     yyDollar[3].node,
+			}
+
+			yyDollar[1].node.(*ast.StmtIf).Position = yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 231:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:1885
+		{
+			yyVAL.node = &ast.StmtIf{
+				Position:            yylex.(*Parser).builder.NewTokenNodeListPosition(yyDollar[1].token, yyDollar[6].list),
+				IfTkn:               yyDollar[1].token,
+				OpenParenthesisTkn:  yyDollar[2].token,
+				Cond:                yyDollar[3].node,
+				CloseParenthesisTkn: yyDollar[4].token,
+				ColonTkn:            yyDollar[5].token,
+				Stmt: &ast.StmtStmtList{
+					Position: yylex.(*Parser).builder.NewNodeListPosition(yyDollar[6].list),
+					Stmts:    yyDollar[6].list,
+				},
+			}
+		}
+	case 232:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		// line internal/php8/php8.y:1900
+		{
+			yyDollar[1].node.(*ast.StmtIf).ElseIf = append(yyDollar[1].node.(*ast.StmtIf).ElseIf, &ast.StmtElseIf{
+				Position:            yylex.(*Parser).builder.NewTokenNodeListPosition(yyDollar[2].token, yyDollar[7].list),
+				ElseIfTkn:           yyDollar[2].token,
+				OpenParenthesisTkn:  yyDollar[3].token,
+				Cond:                yyDollar[4].node,
+				CloseParenthesisTkn: yyDollar[5].token,
+				ColonTkn:            yyDollar[6].token,
+				Stmt: &ast.StmtStmtList{
+					Position: yylex.(*Parser).builder.NewNodeListPosition(yyDollar[7].list),
+					Stmts:    yyDollar[7].list,
+				},
+			})
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 233:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1920
+		{
+			yyDollar[1].node.(*ast.StmtIf).EndIfTkn = yyDollar[2].token
+			yyDollar[1].node.(*ast.StmtIf).SemiColonTkn = yyDollar[3].token
+			yyDollar[1].node.(*ast.StmtIf).Position = yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[3].token)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 234:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:1928
+		{
+			yyDollar[1].node.(*ast.StmtIf).Else = &ast.StmtElse{
+				Position: yylex.(*Parser).builder.NewTokenNodeListPosition(yyDollar[2].token, yyDollar[4].list),
+				ElseTkn:  yyDollar[2].token,
+				ColonTkn: yyDollar[3].token,
+				Stmt: &ast.StmtStmtList{
+					Position: yylex.(*Parser).builder.NewNodeListPosition(yyDollar[4].list),
+					Stmts:    yyDollar[4].list,
+				},
+			}
+			yyDollar[1].node.(*ast.StmtIf).EndIfTkn = yyDollar[5].token
+			yyDollar[1].node.(*ast.StmtIf).SemiColonTkn = yyDollar[6].token
+			yyDollar[1].node.(*ast.StmtIf).Position = yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[6].token)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 235:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:1948
+		{
+			if yyDollar[2].token != nil {
+				yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			}
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 236:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:1956
+		{
+			yyVAL.node = &ParserSeparatedList{}
+		}
+	case 237:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:1963
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 238:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:1969
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 239:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:1979
+		{
+			pos := yylex.(*Parser).builder.NewTokenPosition(yyDollar[6].token)
+			if yyDollar[1].list != nil {
+				pos = yylex.(*Parser).builder.NewNodeListTokenPosition(yyDollar[1].list, yyDollar[6].token)
+			} else if yyDollar[2].list != nil {
+				pos = yylex.(*Parser).builder.NewNodeListTokenPosition(yyDollar[2].list, yyDollar[6].token)
+			} else if yyDollar[3].node != nil {
+				pos = yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[3].node, yyDollar[6].token)
+			} else if yyDollar[4].token != nil {
+				pos = yylex.(*Parser).builder.NewTokensPosition(yyDollar[4].token, yyDollar[6].token)
+			} else if yyDollar[5].token != nil {
+				pos = yylex.(*Parser).builder.NewTokensPosition(yyDollar[5].token, yyDollar[6].token)
+			}
+
+			yyVAL.node = &ast.Parameter{
+				Position:     pos,
+				AttrGroups:   yyDollar[1].list,
+				Modifiers:    yyDollar[2].list,
+				Type:         yyDollar[3].node,
+				AmpersandTkn: yyDollar[4].token,
+				VariadicTkn:  yyDollar[5].token,
+				Var: &ast.ExprVariable{
+					Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[6].token),
+					Name: &ast.Identifier{
+						Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[6].token),
+						IdentifierTkn: yyDollar[6].token,
+						Value:         yyDollar[6].token.Value,
+					},
+				},
+			}
+		}
+	case 240:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		// line internal/php8/php8.y:2011
+		{
+			yylex.(*Parser).checkInitializer(yyDollar[8].node)
+
+			pos := yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[6].token, yyDollar[8].node)
+			if yyDollar[1].list != nil {
+				pos = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, yyDollar[8].node)
+			} else if yyDollar[2].list != nil {
+				pos = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[2].list, yyDollar[8].node)
+			} else if yyDollar[3].node != nil {
+				pos = yylex.(*Parser).builder.NewNodesPosition(yyDollar[3].node, yyDollar[8].node)
+			} else if yyDollar[4].token != nil {
+				pos = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[4].token, yyDollar[8].node)
+			} else if yyDollar[5].token != nil {
+				pos = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[5].token, yyDollar[8].node)
+			}
+
+			yyVAL.node = &ast.Parameter{
+				Position:     pos,
+				AttrGroups:   yyDollar[1].list,
+				Modifiers:    yyDollar[2].list,
+				Type:         yyDollar[3].node,
+				AmpersandTkn: yyDollar[4].token,
+				VariadicTkn:  yyDollar[5].token,
+				Var: &ast.ExprVariable{
+					Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[6].token),
+					Name: &ast.Identifier{
+						Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[6].token),
+						IdentifierTkn: yyDollar[6].token,
+						Value:         yyDollar[6].token.Value,
+					},
+				},
+				EqualTkn:     yyDollar[7].token,
+				DefaultValue: yyDollar[8].node,
+			}
+		}
+	case 241:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:2050
+		{
+			yyVAL.list = nil
+		}
+	case 242:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2054
+		{
+			yyVAL.list = append(yyDollar[1].list, yyDollar[2].node)
+		}
+	case 243:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2061
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 244:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2069
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 245:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2077
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 246:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2085
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 247:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:2096
+		{
+			yyVAL.node = nil
+		}
+	case 248:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2100
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 249:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2107
+		{
+			yylex.(*Parser).checkType(yyDollar[1].node, true)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 250:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2113
+		{
+			yyVAL.node = &ast.Nullable{
+				Position:    yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				QuestionTkn: yyDollar[1].token,
+				Expr:        yyDollar[2].node,
+			}
+		}
+	case 251:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2121
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 252:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2125
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 253:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2132
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 254:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2136
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 255:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2147
+		{
+			yylex.(*Parser).checkType(yyDollar[1].node, false)
+			yylex.(*Parser).checkType(yyDollar[3].node, false)
+
+			yyVAL.node = &ast.Union{
+				Position:      yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Types:         []ast.Vertex{yyDollar[1].node, yyDollar[3].node},
+				SeparatorTkns: []*token.Token{yyDollar[2].token},
+			}
+		}
+	case 256:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2158
+		{
+			yylex.(*Parser).checkType(yyDollar[3].node, false)
+
+			yyDollar[1].node.(*ast.Union).Types = append(yyDollar[1].node.(*ast.Union).Types, yyDollar[3].node)
+			yyDollar[1].node.(*ast.Union).SeparatorTkns = append(yyDollar[1].node.(*ast.Union).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ast.Union).Position = yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 257:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2171
+		{
+			yyVAL.node = &ast.Intersection{
+				Position:      yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Types:         []ast.Vertex{yyDollar[1].node, yyDollar[3].node},
+				SeparatorTkns: []*token.Token{yyDollar[2].token},
+			}
+		}
+	case 258:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2179
+		{
+			yyDollar[1].node.(*ast.Intersection).Types = append(yyDollar[1].node.(*ast.Intersection).Types, yyDollar[3].node)
+			yyDollar[1].node.(*ast.Intersection).SeparatorTkns = append(yyDollar[1].node.(*ast.Intersection).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ast.Intersection).Position = yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 259:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2190
+		{
+			yylex.(*Parser).checkType(yyDollar[1].node, true)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 260:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2196
+		{
+			yyVAL.node = &ast.Nullable{
+				Position:    yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				QuestionTkn: yyDollar[1].token,
+				Expr:        yyDollar[2].node,
+			}
+		}
+	case 261:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2204
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 262:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2208
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 263:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2215
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 264:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2223
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 265:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2231
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 266:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2238
+		{
+			yylex.(*Parser).checkType(yyDollar[1].node, false)
+			yylex.(*Parser).checkType(yyDollar[3].node, false)
+
+			yyVAL.node = &ast.Union{
+				Position:      yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Types:         []ast.Vertex{yyDollar[1].node, yyDollar[3].node},
+				SeparatorTkns: []*token.Token{yyDollar[2].token},
+			}
+		}
+	case 267:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2249
+		{
+			yylex.(*Parser).checkType(yyDollar[3].node, false)
+
+			yyDollar[1].node.(*ast.Union).Types = append(yyDollar[1].node.(*ast.Union).Types, yyDollar[3].node)
+			yyDollar[1].node.(*ast.Union).SeparatorTkns = append(yyDollar[1].node.(*ast.Union).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ast.Union).Position = yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 268:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2262
+		{
+			yyVAL.node = &ast.Intersection{
+				Position:      yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Types:         []ast.Vertex{yyDollar[1].node, yyDollar[3].node},
+				SeparatorTkns: []*token.Token{yyDollar[2].token},
+			}
+		}
+	case 269:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2270
+		{
+			yyDollar[1].node.(*ast.Intersection).Types = append(yyDollar[1].node.(*ast.Intersection).Types, yyDollar[3].node)
+			yyDollar[1].node.(*ast.Intersection).SeparatorTkns = append(yyDollar[1].node.(*ast.Intersection).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ast.Intersection).Position = yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 270:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:2281
+		{
+			yyVAL.node = &ReturnType{}
+		}
+	case 271:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2285
+		{
+			yyVAL.node = &ReturnType{
+				ColonTkn: yyDollar[1].token,
+				Type:     yyDollar[2].node,
+			}
+		}
+	case 272:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2295
+		{
+			yyVAL.node = &ArgumentList{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[2].token),
+				OpenParenthesisTkn:  yyDollar[1].token,
+				CloseParenthesisTkn: yyDollar[2].token,
+			}
+		}
+	case 273:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2303
+		{
+			yyVAL.node = &ArgumentList{
+				Position:           yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenParenthesisTkn: yyDollar[1].token,
+				Arguments: []ast.Vertex{
+					&ast.Argument{
+						Position:    yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+						VariadicTkn: yyDollar[2].token,
+					},
+				},
+				CloseParenthesisTkn: yyDollar[3].token,
+			}
+
+			yylex.(*Parser).requires(version.FeatureFirstClassCallable, yyDollar[2].token.Position)
+		}
+	case 274:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:2319
+		{
+			argumentList := yyDollar[2].node.(*ArgumentList)
+			argumentList.Position = yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token)
+			argumentList.OpenParenthesisTkn = yyDollar[1].token
+			if yyDollar[3].token != nil {
+				argumentList.SeparatorTkns = append(argumentList.SeparatorTkns, yyDollar[3].token)
+			}
+			argumentList.CloseParenthesisTkn = yyDollar[4].token
+
+			yyVAL.node = argumentList
+		}
+	case 275:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2334
+		{
+			yyVAL.node = &ArgumentList{
+				Arguments: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 276:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2340
+		{
+			yyDollar[1].node.(*ArgumentList).SeparatorTkns = append(yyDollar[1].node.(*ArgumentList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ArgumentList).Arguments = append(yyDollar[1].node.(*ArgumentList).Arguments, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 277:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2350
+		{
+			yyVAL.node = &ast.Argument{
+				Position: yylex.(*Parser).builder.NewNodePosition(yyDollar[1].node),
+				Expr:     yyDollar[1].node,
+			}
+		}
+	case 278:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2357
+		{
+			yyVAL.node = &ast.Argument{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[3].node),
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					IdentifierTkn: yyDollar[1].token,
+					Value:         yyDollar[1].token.Value,
+				},
+				ColonTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 279:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2370
+		{
+			yyVAL.node = &ast.Argument{
+				Position:    yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				VariadicTkn: yyDollar[1].token,
+				Expr:        yyDollar[2].node,
+			}
+		}
+	case 280:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2378
+		{
+			yyVAL.node = yylex.(*Parser).recovery.NewBadExpr()
+		}
+	case 281:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2385
+		{
+			yyDollar[1].node.(*ast.StmtGlobal).Vars = append(yyDollar[1].node.(*ast.StmtGlobal).Vars, yyDollar[3].node)
+			yyDollar[1].node.(*ast.StmtGlobal).SeparatorTkns = append(yyDollar[1].node.(*ast.StmtGlobal).SeparatorTkns, yyDollar[2].token)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 282:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2392
+		{
+			yyVAL.node = &ast.StmtGlobal{
+				Vars: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 283:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2401
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 284:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2408
+		{
+			yyDollar[1].node.(*ast.StmtStatic).Vars = append(yyDollar[1].node.(*ast.StmtStatic).Vars, yyDollar[3].node)
+			yyDollar[1].node.(*ast.StmtStatic).SeparatorTkns = append(yyDollar[1].node.(*ast.StmtStatic).SeparatorTkns, yyDollar[2].token)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 285:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2415
+		{
+			yyVAL.node = &ast.StmtStatic{
+				Vars: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 286:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2424
+		{
+
+			yyVAL.node = &ast.StmtStaticVar{
+				Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				Var: &ast.ExprVariable{
+					Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					Name: &ast.Identifier{
+						Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+						IdentifierTkn: yyDollar[1].token,
+						Value:         yyDollar[1].token.Value,
+					},
+				},
+			}
+		}
+	case 287:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2439
+		{
+			yylex.(*Parser).checkInitializer(yyDollar[3].node)
+
+			yyVAL.node = &ast.StmtStaticVar{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[3].node),
+				Var: &ast.ExprVariable{
+					Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					Name: &ast.Identifier{
+						Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+						IdentifierTkn: yyDollar[1].token,
+						Value:         yyDollar[1].token.Value,
+					},
+				},
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 288:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2460
+		{
+			yyVAL.list = append(yyDollar[1].list, yyDollar[2].node)
+		}
+	case 289:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:2464
+		{
+			yyVAL.list = []ast.Vertex{}
+		}
+	case 290:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2471
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 291:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2475
+		{
+			switch n := yyDollar[2].node.(type) {
+			case *ast.StmtPropertyList:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtClassConstList:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtClassMethod:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			case *ast.StmtEnumCase:
+				n.AttrGroups = yyDollar[1].list
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, n)
+			}
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 292:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2494
+		{
+			traitUse := &ast.StmtTraitUse{
+				Position:      yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[3].node),
+				UseTkn:        yyDollar[1].token,
+				Traits:        yyDollar[2].node.(*ParserSeparatedList).Items,
+				SeparatorTkns: yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+			}
+
+			switch n := yyDollar[3].node.(type) {
+			case *TraitAdaptationList:
+				traitUse.OpenCurlyBracketTkn = n.OpenCurlyBracketTkn
+				traitUse.Adaptations = n.Adaptations
+				traitUse.CloseCurlyBracketTkn = n.CloseCurlyBracketTkn
+			case *ast.StmtNop:
+				traitUse.SemiColonTkn = n.SemiColonTkn
+			}
+
+			yyVAL.node = traitUse
+		}
+	case 293:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2514
+		{
+			yyVAL.node = yylex.(*Parser).recovery.NewBadStmt()
+		}
+	case 294:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:2521
+		{
+			yyVAL.node = &ast.StmtPropertyList{
+				Position:      yylex.(*Parser).builder.NewNodeListTokenPosition(yyDollar[1].list, yyDollar[4].token),
+				Modifiers:     yyDollar[1].list,
+				Type:          yyDollar[2].node,
+				Props:         yyDollar[3].node.(*ParserSeparatedList).Items,
+				SeparatorTkns: yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+				SemiColonTkn:  yyDollar[4].token,
+			}
+		}
+	case 295:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:2532
+		{
+			yyVAL.node = &ast.StmtClassConstList{
+				Position:      yylex.(*Parser).builder.NewOptionalListTokensPosition(yyDollar[1].list, yyDollar[2].token, yyDollar[4].token),
+				Modifiers:     yyDollar[1].list,
+				ConstTkn:      yyDollar[2].token,
+				Consts:        yyDollar[3].node.(*ParserSeparatedList).Items,
+				SeparatorTkns: yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+				SemiColonTkn:  yyDollar[4].token,
+			}
+		}
+	case 296:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:2543
+		{
+			yyVAL.node = &ast.StmtClassConstList{
+				Position:      yylex.(*Parser).builder.NewOptionalListTokensPosition(yyDollar[1].list, yyDollar[2].token, yyDollar[5].token),
+				Modifiers:     yyDollar[1].list,
+				ConstTkn:      yyDollar[2].token,
+				Type:          yyDollar[3].node,
+				Consts:        yyDollar[4].node.(*ParserSeparatedList).Items,
+				SeparatorTkns: yyDollar[4].node.(*ParserSeparatedList).SeparatorTkns,
+				SemiColonTkn:  yyDollar[5].token,
+			}
+
+			yylex.(*Parser).requires(version.FeatureTypedClassConstant, yyDollar[3].node.GetPosition())
+		}
+	case 297:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2557
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 298:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		// line internal/php8/php8.y:2561
+		{
+			pos := yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[2].token, yyDollar[10].node)
+			if yyDollar[1].list != nil {
+				pos = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, yyDollar[10].node)
+			}
+
+			yyVAL.node = &ast.StmtClassMethod{
+				Position:     pos,
+				Modifiers:    yyDollar[1].list,
+				FunctionTkn:  yyDollar[2].token,
+				AmpersandTkn: yyDollar[3].token,
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[4].token),
+					IdentifierTkn: yyDollar[4].token,
+					Value:         yyDollar[4].token.Value,
+				},
+				OpenParenthesisTkn:  yyDollar[6].token,
+				Params:              yyDollar[7].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:       yyDollar[7].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseParenthesisTkn: yyDollar[8].token,
+				ColonTkn:            yyDollar[9].node.(*ReturnType).ColonTkn,
+				ReturnType:          yyDollar[9].node.(*ReturnType).Type,
+				Stmt:                yyDollar[10].node,
+			}
+		}
+	case 299:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2590
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 300:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2596
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 301:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2606
+		{
+			yyVAL.node = &ast.StmtNop{
+				Position:     yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				SemiColonTkn: yyDollar[1].token,
+			}
+		}
+	case 302:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2613
+		{
+			yyVAL.node = &TraitAdaptationList{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[2].token),
+				OpenCurlyBracketTkn:  yyDollar[1].token,
+				CloseCurlyBracketTkn: yyDollar[2].token,
+			}
+		}
+	case 303:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2621
+		{
+			yyVAL.node = &TraitAdaptationList{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenCurlyBracketTkn:  yyDollar[1].token,
+				Adaptations:          yyDollar[2].list,
+				CloseCurlyBracketTkn: yyDollar[3].token,
+			}
+		}
+	case 304:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2633
+		{
+			yyVAL.list = []ast.Vertex{yyDollar[1].node}
+		}
+	case 305:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2637
+		{
+			yyVAL.list = append(yyDollar[1].list, yyDollar[2].node)
+		}
+	case 306:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2644
+		{
+			yyDollar[1].node.(*ast.StmtTraitUsePrecedence).SemiColonTkn = yyDollar[2].token
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 307:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2650
+		{
+			yyDollar[1].node.(*ast.StmtTraitUseAlias).SemiColonTkn = yyDollar[2].token
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 308:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2659
+		{
+			yyVAL.node = &ast.StmtTraitUsePrecedence{
+				Position:       yylex.(*Parser).builder.NewNodeNodeListPosition(yyDollar[1].node, yyDollar[3].node.(*ParserSeparatedList).Items),
+				Trait:          yyDollar[1].node.(*TraitMethodRef).Trait,
+				DoubleColonTkn: yyDollar[1].node.(*TraitMethodRef).DoubleColonTkn,
+				Method:         yyDollar[1].node.(*TraitMethodRef).Method,
+				InsteadofTkn:   yyDollar[2].token,
+				Insteadof:      yyDollar[3].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:  yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+			}
+		}
+	case 309:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2674
+		{
+			yyVAL.node = &ast.StmtTraitUseAlias{
+				Position:       yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[3].token),
+				Trait:          yyDollar[1].node.(*TraitMethodRef).Trait,
+				DoubleColonTkn: yyDollar[1].node.(*TraitMethodRef).DoubleColonTkn,
+				Method:         yyDollar[1].node.(*TraitMethodRef).Method,
+				AsTkn:          yyDollar[2].token,
+				Alias: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+					IdentifierTkn: yyDollar[3].token,
+					Value:         yyDollar[3].token.Value,
+				},
+			}
+		}
+	case 310:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2689
+		{
+			yyVAL.node = &ast.StmtTraitUseAlias{
+				Position:       yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[3].token),
+				Trait:          yyDollar[1].node.(*TraitMethodRef).Trait,
+				DoubleColonTkn: yyDollar[1].node.(*TraitMethodRef).DoubleColonTkn,
+				Method:         yyDollar[1].node.(*TraitMethodRef).Method,
+				AsTkn:          yyDollar[2].token,
+				Alias: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+					IdentifierTkn: yyDollar[3].token,
+					Value:         yyDollar[3].token.Value,
+				},
+			}
+		}
+	case 311:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:2704
+		{
+			yyVAL.node = &ast.StmtTraitUseAlias{
+				Position:       yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[4].token),
+				Trait:          yyDollar[1].node.(*TraitMethodRef).Trait,
+				DoubleColonTkn: yyDollar[1].node.(*TraitMethodRef).DoubleColonTkn,
+				Method:         yyDollar[1].node.(*TraitMethodRef).Method,
+				AsTkn:          yyDollar[2].token,
+				Modifier:       yyDollar[3].node,
+				Alias: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[4].token),
+					IdentifierTkn: yyDollar[4].token,
+					Value:         yyDollar[4].token.Value,
+				},
+			}
+		}
+	case 312:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2720
+		{
+			yyVAL.node = &ast.StmtTraitUseAlias{
+				Position:       yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Trait:          yyDollar[1].node.(*TraitMethodRef).Trait,
+				DoubleColonTkn: yyDollar[1].node.(*TraitMethodRef).DoubleColonTkn,
+				Method:         yyDollar[1].node.(*TraitMethodRef).Method,
+				AsTkn:          yyDollar[2].token,
+				Modifier:       yyDollar[3].node,
+			}
+		}
+	case 313:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2734
+		{
+			yyVAL.node = &TraitMethodRef{
+				Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				Method: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					IdentifierTkn: yyDollar[1].token,
+					Value:         yyDollar[1].token.Value,
+				},
+			}
+		}
+	case 314:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2745
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 315:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2752
+		{
+			yyVAL.node = &TraitMethodRef{
+				Position:       yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[3].token),
+				Trait:          yyDollar[1].node,
+				DoubleColonTkn: yyDollar[2].token,
+				Method: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+					IdentifierTkn: yyDollar[3].token,
+					Value:         yyDollar[3].token.Value,
+				},
+			}
+		}
+	case 316:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2768
+		{
+			yyVAL.node = &ast.StmtNop{
+				Position:     yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				SemiColonTkn: yyDollar[1].token,
+			}
+		}
+	case 317:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2775
+		{
+			yyVAL.node = &ast.StmtStmtList{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenCurlyBracketTkn:  yyDollar[1].token,
+				Stmts:                yyDollar[2].list,
+				CloseCurlyBracketTkn: yyDollar[3].token,
+			}
+		}
+	case 318:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2787
+		{
+			yyVAL.list = yyDollar[1].list
+		}
+	case 319:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2791
+		{
+			yyVAL.list = []ast.Vertex{
+				&ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					IdentifierTkn: yyDollar[1].token,
+					Value:         yyDollar[1].token.Value,
+				},
+			}
+		}
+	case 320:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:2804
+		{
+			yyVAL.list = nil
+		}
+	case 321:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2808
+		{
+			yyVAL.list = yyDollar[1].list
+		}
+	case 322:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2815
+		{
+			yyVAL.list = []ast.Vertex{yyDollar[1].node}
+		}
+	case 323:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2819
+		{
+			yyVAL.list = append(yyDollar[1].list, yyDollar[2].node)
+		}
+	case 324:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2826
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 325:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2834
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 326:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2842
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 327:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2850
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 328:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2858
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 329:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2866
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 330:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2874
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 331:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2885
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 332:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2892
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 333:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:2901
+		{
+			yyVAL.node = &ast.StmtProperty{
+				Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				Var: &ast.ExprVariable{
+					Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					Name: &ast.Identifier{
+						Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+						IdentifierTkn: yyDollar[1].token,
+						Value:         yyDollar[1].token.Value,
+					},
+				},
+				Expr: nil,
+			}
+		}
+	case 334:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:2916
+		{
+			yylex.(*Parser).checkInitializer(yyDollar[3].node)
+
+			yyVAL.node = &ast.StmtProperty{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[3].node),
+				Var: &ast.ExprVariable{
+					Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					Name: &ast.Identifier{
+						Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+						IdentifierTkn: yyDollar[1].token,
+						Value:         yyDollar[1].token.Value,
+					},
+				},
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 335:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:2937
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 336:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:2944
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 337:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:2953
+		{
+			yylex.(*Parser).checkInitializer(yyDollar[3].node)
+
+			yyVAL.node = &ast.StmtConstant{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[3].node),
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					IdentifierTkn: yyDollar[1].token,
+					Value:         yyDollar[1].token.Value,
+				},
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 338:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:2968
+		{
+			yylex.(*Parser).checkInitializer(yyDollar[3].node)
+
+			yyVAL.node = &ast.StmtConstant{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[3].node),
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					IdentifierTkn: yyDollar[1].token,
+					Value:         yyDollar[1].token.Value,
+				},
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 339:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:2986
+		{
+			yylex.(*Parser).checkInitializer(yyDollar[3].node)
+
+			yyVAL.node = &ast.StmtConstant{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[3].node),
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					IdentifierTkn: yyDollar[1].token,
+					Value:         yyDollar[1].token.Value,
+				},
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 340:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3004
+		{
+			yyDollar[1].node.(*ast.StmtEcho).Exprs = append(yyDollar[1].node.(*ast.StmtEcho).Exprs, yyDollar[3].node)
+			yyDollar[1].node.(*ast.StmtEcho).SeparatorTkns = append(yyDollar[1].node.(*ast.StmtEcho).SeparatorTkns, yyDollar[2].token)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 341:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3011
+		{
+			yyVAL.node = &ast.StmtEcho{
+				Exprs: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 342:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3020
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 343:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:3027
+		{
+			yyVAL.node = &ParserSeparatedList{}
+		}
+	case 344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3031
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 345:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3038
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 346:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3045
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 347:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		// line internal/php8/php8.y:3054
+		{
+			if yyDollar[2].node == nil {
+				yyDollar[2].node = &ArgumentList{}
+			}
+
+			class := &ast.StmtClass{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[8].token),
+				ClassTkn:             yyDollar[1].token,
+				OpenParenthesisTkn:   yyDollar[2].node.(*ArgumentList).OpenParenthesisTkn,
+				Args:                 yyDollar[2].node.(*ArgumentList).Arguments,
+				SeparatorTkns:        yyDollar[2].node.(*ArgumentList).SeparatorTkns,
+				CloseParenthesisTkn:  yyDollar[2].node.(*ArgumentList).CloseParenthesisTkn,
+				OpenCurlyBracketTkn:  yyDollar[6].token,
+				Stmts:                yyDollar[7].list,
+				CloseCurlyBracketTkn: yyDollar[8].token,
+			}
+
+			if yyDollar[3].node != nil {
+				class.ExtendsTkn = yyDollar[3].node.(*ast.StmtClass).ExtendsTkn
+				class.Extends = yyDollar[3].node.(*ast.StmtClass).Extends
+			}
+
+			if yyDollar[4].node != nil {
+				class.ImplementsTkn = yyDollar[4].node.(*ast.StmtClass).ImplementsTkn
+				class.Implements = yyDollar[4].node.(*ast.StmtClass).Implements
+				class.ImplementsSeparatorTkns = yyDollar[4].node.(*ast.StmtClass).ImplementsSeparatorTkns
+			}
+
+			yyVAL.node = class
+		}
+	case 348:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3088
+		{
+			if yyDollar[3].node != nil {
+				yyVAL.node = &ast.ExprNew{
+					Position:            yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[3].node),
+					NewTkn:              yyDollar[1].token,
+					Class:               yyDollar[2].node,
+					OpenParenthesisTkn:  yyDollar[3].node.(*ArgumentList).OpenParenthesisTkn,
+					Args:                yyDollar[3].node.(*ArgumentList).Arguments,
+					SeparatorTkns:       yyDollar[3].node.(*ArgumentList).SeparatorTkns,
+					CloseParenthesisTkn: yyDollar[3].node.(*ArgumentList).CloseParenthesisTkn,
+				}
+			} else {
+				yyVAL.node = &ast.ExprNew{
+					Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+					NewTkn:   yyDollar[1].token,
+					Class:    yyDollar[2].node,
+				}
+			}
+		}
+	case 349:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3108
+		{
+			yyVAL.node = &ast.ExprNew{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				NewTkn:   yyDollar[1].token,
+				Class:    yyDollar[2].node,
+			}
+		}
+	case 350:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3116
+		{
+			yyDollar[3].node.(*ast.StmtClass).AttrGroups = yyDollar[2].list
+			yyDollar[3].node.(*ast.StmtClass).Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[2].list, yyDollar[3].node)
+
+			yyVAL.node = &ast.ExprNew{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[3].node),
+				NewTkn:   yyDollar[1].token,
+				Class:    yyDollar[3].node,
+			}
+		}
+	case 351:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		// line internal/php8/php8.y:3130
+		{
+			yyVAL.node = &ast.ExprMatch{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[7].token),
+				MatchTkn:             yyDollar[1].token,
+				OpenParenthesisTkn:   yyDollar[2].token,
+				Expr:                 yyDollar[3].node,
+				CloseParenthesisTkn:  yyDollar[4].token,
+				OpenCurlyBracketTkn:  yyDollar[5].token,
+				Arms:                 yyDollar[6].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:        yyDollar[6].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseCurlyBracketTkn: yyDollar[7].token,
+			}
+		}
+	case 352:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:3147
+		{
+			yyVAL.node = &ParserSeparatedList{}
+		}
+	case 353:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3151
+		{
+			if yyDollar[2].token != nil {
+				yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			}
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 354:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3162
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 355:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3168
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 356:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:3178
+		{
+			if yyDollar[2].token != nil {
+				yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			}
+
+			yyVAL.node = &ast.MatchArm{
+				Position:       yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[4].node),
+				Exprs:          yyDollar[1].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:  yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns,
+				DoubleArrowTkn: yyDollar[3].token,
+				ReturnExpr:     yyDollar[4].node,
+			}
+		}
+	case 357:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:3192
+		{
+			yyVAL.node = &ast.MatchArm{
+				Position:        yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[4].node),
+				DefaultTkn:      yyDollar[1].token,
+				DefaultCommaTkn: yyDollar[2].token,
+				DoubleArrowTkn:  yyDollar[3].token,
+				ReturnExpr:      yyDollar[4].node,
+			}
+		}
+	case 358:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3205
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 359:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3211
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 360:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:3221
+		{
+			yyVAL.node = &ast.ExprAssign{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[6].node),
+				Var: &ast.ExprList{
+					Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+					ListTkn:         yyDollar[1].token,
+					OpenBracketTkn:  yyDollar[2].token,
+					Items:           yyDollar[3].node.(*ParserSeparatedList).Items,
+					SeparatorTkns:   yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+					CloseBracketTkn: yyDollar[4].token,
+				},
+				EqualTkn: yyDollar[5].token,
+				Expr:     yyDollar[6].node,
+			}
+		}
+	case 361:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:3237
+		{
+			yyVAL.node = &ast.ExprAssign{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[5].node),
+				Var: &ast.ExprList{
+					Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+					OpenBracketTkn:  yyDollar[1].token,
+					Items:           yyDollar[2].node.(*ParserSeparatedList).Items,
+					SeparatorTkns:   yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+					CloseBracketTkn: yyDollar[3].token,
+				},
+				EqualTkn: yyDollar[4].token,
+				Expr:     yyDollar[5].node,
+			}
+		}
+	case 362:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3252
+		{
+			yyVAL.node = &ast.ExprAssign{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 363:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:3261
+		{
+			yyVAL.node = &ast.ExprAssignReference{
+				Position:     yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[4].node),
+				Var:          yyDollar[1].node,
+				EqualTkn:     yyDollar[2].token,
+				AmpersandTkn: yyDollar[3].token,
+				Expr:         yyDollar[4].node,
+			}
+		}
+	case 364:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3271
+		{
+			yyVAL.node = &ast.ExprClone{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				CloneTkn: yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 365:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3279
+		{
+			yyVAL.node = &ast.ExprAssignPlus{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 366:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3288
+		{
+			yyVAL.node = &ast.ExprAssignMinus{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 367:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3297
+		{
+			yyVAL.node = &ast.ExprAssignMul{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 368:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3306
+		{
+			yyVAL.node = &ast.ExprAssignPow{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 369:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3315
+		{
+			yyVAL.node = &ast.ExprAssignDiv{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 370:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3324
+		{
+			yyVAL.node = &ast.ExprAssignConcat{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 371:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3333
+		{
+			yyVAL.node = &ast.ExprAssignMod{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 372:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3342
+		{
+			yyVAL.node = &ast.ExprAssignBitwiseAnd{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 373:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3351
+		{
+			yyVAL.node = &ast.ExprAssignBitwiseOr{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 374:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3360
+		{
+			yyVAL.node = &ast.ExprAssignBitwiseXor{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 375:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3369
+		{
+			yyVAL.node = &ast.ExprAssignShiftLeft{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 376:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3378
+		{
+			yyVAL.node = &ast.ExprAssignShiftRight{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 377:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3387
+		{
+			yyVAL.node = &ast.ExprAssignCoalesce{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:      yyDollar[1].node,
+				EqualTkn: yyDollar[2].token,
+				Expr:     yyDollar[3].node,
+			}
+		}
+	case 378:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3396
+		{
+			yyVAL.node = &ast.ExprPostInc{
+				Position: yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[2].token),
+				Var:      yyDollar[1].node,
+				IncTkn:   yyDollar[2].token,
+			}
+		}
+	case 379:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3404
+		{
+			yyVAL.node = &ast.ExprPreInc{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				IncTkn:   yyDollar[1].token,
+				Var:      yyDollar[2].node,
+			}
+		}
+	case 380:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3412
+		{
+			yyVAL.node = &ast.ExprPostDec{
+				Position: yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[2].token),
+				Var:      yyDollar[1].node,
+				DecTkn:   yyDollar[2].token,
+			}
+		}
+	case 381:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3420
+		{
+			yyVAL.node = &ast.ExprPreDec{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				DecTkn:   yyDollar[1].token,
+				Var:      yyDollar[2].node,
+			}
+		}
+	case 382:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3428
+		{
+			yyVAL.node = &ast.ExprBinaryBooleanOr{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 383:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3437
+		{
+			yyVAL.node = &ast.ExprBinaryBooleanAnd{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 384:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3446
+		{
+			yyVAL.node = &ast.ExprBinaryLogicalOr{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 385:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3455
+		{
+			yyVAL.node = &ast.ExprBinaryLogicalAnd{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 386:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3464
+		{
+			yyVAL.node = &ast.ExprBinaryLogicalXor{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 387:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3473
+		{
+			yyVAL.node = &ast.ExprBinaryBitwiseOr{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 388:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3482
+		{
+			yyVAL.node = &ast.ExprBinaryBitwiseAnd{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 389:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3491
+		{
+			yyVAL.node = &ast.ExprBinaryBitwiseAnd{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 390:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3500
+		{
+			yyVAL.node = &ast.ExprBinaryBitwiseXor{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 391:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3509
+		{
+			yyVAL.node = &ast.ExprBinaryConcat{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 392:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3518
+		{
+			yyVAL.node = &ast.ExprBinaryPlus{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 393:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3527
+		{
+			yyVAL.node = &ast.ExprBinaryMinus{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 394:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3536
+		{
+			yyVAL.node = &ast.ExprBinaryMul{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 395:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3545
+		{
+			yyVAL.node = &ast.ExprBinaryPow{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 396:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3554
+		{
+			yyVAL.node = &ast.ExprBinaryDiv{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 397:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3563
+		{
+			yyVAL.node = &ast.ExprBinaryMod{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 398:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3572
+		{
+			yyVAL.node = &ast.ExprBinaryShiftLeft{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 399:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3581
+		{
+			yyVAL.node = &ast.ExprBinaryShiftRight{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 400:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3590
+		{
+			yyVAL.node = &ast.ExprUnaryPlus{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				PlusTkn:  yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 401:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3598
+		{
+			yyVAL.node = &ast.ExprUnaryMinus{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				MinusTkn: yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 402:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3606
+		{
+			yyVAL.node = &ast.ExprBooleanNot{
+				Position:       yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				ExclamationTkn: yyDollar[1].token,
+				Expr:           yyDollar[2].node,
+			}
+		}
+	case 403:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3614
+		{
+			yyVAL.node = &ast.ExprBitwiseNot{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				TildaTkn: yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 404:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3622
+		{
+			yyVAL.node = &ast.ExprBinaryIdentical{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 405:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3631
+		{
+			yyVAL.node = &ast.ExprBinaryNotIdentical{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 406:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3640
+		{
+			yyVAL.node = &ast.ExprBinaryEqual{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 407:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3649
+		{
+			yyVAL.node = &ast.ExprBinaryNotEqual{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 408:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3658
+		{
+			yyVAL.node = &ast.ExprBinarySmaller{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 409:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3667
+		{
+			yyVAL.node = &ast.ExprBinarySmallerOrEqual{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 410:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3676
+		{
+			yyVAL.node = &ast.ExprBinaryGreater{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 411:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3685
+		{
+			yyVAL.node = &ast.ExprBinaryGreaterOrEqual{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 412:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3694
+		{
+			yyVAL.node = &ast.ExprBinarySpaceship{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 413:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3703
+		{
+			yyVAL.node = &ast.ExprInstanceOf{
+				Position:      yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Expr:          yyDollar[1].node,
+				InstanceOfTkn: yyDollar[2].token,
+				Class:         yyDollar[3].node,
+			}
+		}
+	case 414:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3712
+		{
+			yyVAL.node = &ast.ExprBrackets{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenParenthesisTkn:  yyDollar[1].token,
+				Expr:                yyDollar[2].node,
+				CloseParenthesisTkn: yyDollar[3].token,
+			}
+		}
+	case 415:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3721
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 416:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:3725
+		{
+			yyVAL.node = &ast.ExprTernary{
+				Position:    yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[5].node),
+				Cond:        yyDollar[1].node,
+				QuestionTkn: yyDollar[2].token,
+				IfTrue:      yyDollar[3].node,
+				ColonTkn:    yyDollar[4].token,
+				IfFalse:     yyDollar[5].node,
+			}
+		}
+	case 417:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:3736
+		{
+			yyVAL.node = &ast.ExprTernary{
+				Position:    yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[4].node),
+				Cond:        yyDollar[1].node,
+				QuestionTkn: yyDollar[2].token,
+				ColonTkn:    yyDollar[3].token,
+				IfFalse:     yyDollar[4].node,
+			}
+		}
+	case 418:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3746
+		{
+			yyVAL.node = &ast.ExprBinaryCoalesce{
+				Position: yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Left:     yyDollar[1].node,
+				OpTkn:    yyDollar[2].token,
+				Right:    yyDollar[3].node,
+			}
+		}
+	case 419:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3755
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 420:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3759
+		{
+			yyVAL.node = &ast.ExprCastInt{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				CastTkn:  yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 421:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3767
+		{
+			yyVAL.node = &ast.ExprCastDouble{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				CastTkn:  yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 422:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3775
+		{
+			yyVAL.node = &ast.ExprCastString{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				CastTkn:  yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 423:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3783
+		{
+			yyVAL.node = &ast.ExprCastArray{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				CastTkn:  yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 424:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3791
+		{
+			yyVAL.node = &ast.ExprCastObject{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				CastTkn:  yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 425:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3799
+		{
+			yyVAL.node = &ast.ExprCastBool{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				CastTkn:  yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 426:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3807
+		{
+			yyVAL.node = &ast.ExprCastUnset{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				CastTkn:  yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 427:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3815
+		{
+			exit := &ast.ExprExit{
+				ExitTkn: yyDollar[1].token,
+			}
+
+			if yyDollar[2].node == nil {
+				exit.Position = yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token)
+			} else {
+				exit.Position = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node)
+				exit.OpenParenthesisTkn = yyDollar[2].node.(*ast.ExprBrackets).OpenParenthesisTkn
+				exit.Expr = yyDollar[2].node.(*ast.ExprBrackets).Expr
+				exit.CloseParenthesisTkn = yyDollar[2].node.(*ast.ExprBrackets).CloseParenthesisTkn
+			}
+
+			yyVAL.node = exit
+		}
+	case 428:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3832
+		{
+			yyVAL.node = &ast.ExprErrorSuppress{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				AtTkn:    yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 429:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3840
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 430:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3844
+		{
+			yyVAL.node = &ast.ExprShellExec{
+				Position:         yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenBacktickTkn:  yyDollar[1].token,
+				Parts:            yyDollar[2].list,
+				CloseBacktickTkn: yyDollar[3].token,
+			}
+		}
+	case 431:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3853
+		{
+			yyVAL.node = &ast.ExprThrow{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				ThrowTkn: yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 432:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3861
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 433:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3865
+		{
+			yyVAL.node = &ast.ExprPrint{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				PrintTkn: yyDollar[1].token,
+				Expr:     yyDollar[2].node,
+			}
+		}
+	case 434:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3873
+		{
+			yyVAL.node = &ast.ExprYield{
+				Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				YieldTkn: yyDollar[1].token,
+			}
+		}
+	case 435:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3880
+		{
+			yyVAL.node = &ast.ExprYield{
+				Position: yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				YieldTkn: yyDollar[1].token,
+				Val:      yyDollar[2].node,
+			}
+		}
+	case 436:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:3888
+		{
+			yyVAL.node = &ast.ExprYield{
+				Position:       yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[4].node),
+				YieldTkn:       yyDollar[1].token,
+				Key:            yyDollar[2].node,
+				DoubleArrowTkn: yyDollar[3].token,
+				Val:            yyDollar[4].node,
+			}
+		}
+	case 437:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3898
+		{
+			yyVAL.node = &ast.ExprYieldFrom{
+				Position:     yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				YieldFromTkn: yyDollar[1].token,
+				Expr:         yyDollar[2].node,
+			}
+		}
+	case 438:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:3906
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 439:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3910
+		{
+			switch n := yyDollar[2].node.(type) {
+			case *ast.ExprClosure:
+				n.Position = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node)
+				n.StaticTkn = yyDollar[1].token
+			case *ast.ExprArrowFunction:
+				n.Position = yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node)
+				n.StaticTkn = yyDollar[1].token
+			}
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 440:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:3923
+		{
+			switch n := yyDollar[2].node.(type) {
+			case *ast.ExprClosure:
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, yyDollar[2].node)
+				n.AttrGroups = yyDollar[1].list
+			case *ast.ExprArrowFunction:
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, yyDollar[2].node)
+				n.AttrGroups = yyDollar[1].list
+			}
+
+			yyVAL.node = yyDollar[2].node
+		}
+	case 441:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:3936
+		{
+			switch n := yyDollar[3].node.(type) {
+			case *ast.ExprClosure:
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, yyDollar[3].node)
+				n.AttrGroups = yyDollar[1].list
+				n.StaticTkn = yyDollar[2].token
+			case *ast.ExprArrowFunction:
+				n.Position = yylex.(*Parser).builder.NewNodeListNodePosition(yyDollar[1].list, yyDollar[3].node)
+				n.AttrGroups = yyDollar[1].list
+				n.StaticTkn = yyDollar[2].token
+			}
+
+			yyVAL.node = yyDollar[3].node
+		}
+	case 442:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		// line internal/php8/php8.y:3954
+		{
+			closure := yyDollar[7].node.(*ast.ExprClosure)
+
+			closure.Position = yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[11].token)
+			closure.FunctionTkn = yyDollar[1].token
+			closure.AmpersandTkn = yyDollar[2].token
+			closure.OpenParenthesisTkn = yyDollar[4].token
+			closure.Params = yyDollar[5].node.(*ParserSeparatedList).Items
+			closure.SeparatorTkns = yyDollar[5].node.(*ParserSeparatedList).SeparatorTkns
+			closure.CloseParenthesisTkn = yyDollar[6].token
+			closure.ColonTkn = yyDollar[8].node.(*ReturnType).ColonTkn
+			closure.ReturnType = yyDollar[8].node.(*ReturnType).Type
+			closure.OpenCurlyBracketTkn = yyDollar[9].token
+			closure.Stmts = yyDollar[10].list
+			closure.CloseCurlyBracketTkn = yyDollar[11].token
+
+			yyVAL.node = closure
+		}
+	case 443:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		// line internal/php8/php8.y:3973
+		{
+			yyVAL.node = &ast.ExprArrowFunction{
+				Position:            yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[9].node),
+				FnTkn:               yyDollar[1].token,
+				AmpersandTkn:        yyDollar[2].token,
+				OpenParenthesisTkn:  yyDollar[3].token,
+				Params:              yyDollar[4].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:       yyDollar[4].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseParenthesisTkn: yyDollar[5].token,
+				ColonTkn:            yyDollar[6].node.(*ReturnType).ColonTkn,
+				ReturnType:          yyDollar[6].node.(*ReturnType).Type,
+				DoubleArrowTkn:      yyDollar[8].token,
+				Expr:                yyDollar[9].node,
+			}
+		}
+	case 445:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:3996
+		{
+			yyVAL.token = nil
+		}
+	case 446:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4000
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 447:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4004
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 448:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:4011
+		{
+			yyVAL.node = &ast.ExprClosure{}
+		}
+	case 449:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4015
+		{
+			yyVAL.node = &ast.ExprClosure{
+				UseTkn:                 yyDollar[1].token,
+				UseOpenParenthesisTkn:  yyDollar[2].token,
+				Uses:                   yyDollar[3].node.(*ParserSeparatedList).Items,
+				UseSeparatorTkns:       yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+				UseCloseParenthesisTkn: yyDollar[4].token,
+			}
+		}
+	case 450:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4028
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 451:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4035
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 452:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4044
+		{
+			yyVAL.node = &ast.ExprClosureUse{
+				Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				Var: &ast.ExprVariable{
+					Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					Name: &ast.Identifier{
+						Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+						IdentifierTkn: yyDollar[1].token,
+						Value:         yyDollar[1].token.Value,
+					},
+				},
+			}
+		}
+	case 453:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:4058
+		{
+			yyVAL.node = &ast.ExprClosureUse{
+				Position:     yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[2].token),
+				AmpersandTkn: yyDollar[1].token,
+				Var: &ast.ExprVariable{
+					Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+					Name: &ast.Identifier{
+						Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+						IdentifierTkn: yyDollar[2].token,
+						Value:         yyDollar[2].token.Value,
+					},
+				},
+			}
+		}
+	case 454:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:4076
+		{
+			yyVAL.node = &ast.ExprFunctionCall{
+				Position:            yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[2].node),
+				Function:            yyDollar[1].node,
+				OpenParenthesisTkn:  yyDollar[2].node.(*ArgumentList).OpenParenthesisTkn,
+				Args:                yyDollar[2].node.(*ArgumentList).Arguments,
+				SeparatorTkns:       yyDollar[2].node.(*ArgumentList).SeparatorTkns,
+				CloseParenthesisTkn: yyDollar[2].node.(*ArgumentList).CloseParenthesisTkn,
+			}
+		}
+	case 455:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4087
+		{
+			staticCall := &ast.ExprStaticCall{
+				Position:            yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[4].node),
+				Class:               yyDollar[1].node,
+				DoubleColonTkn:      yyDollar[2].token,
+				Call:                yyDollar[3].node,
+				OpenParenthesisTkn:  yyDollar[4].node.(*ArgumentList).OpenParenthesisTkn,
+				Args:                yyDollar[4].node.(*ArgumentList).Arguments,
+				SeparatorTkns:       yyDollar[4].node.(*ArgumentList).SeparatorTkns,
+				CloseParenthesisTkn: yyDollar[4].node.(*ArgumentList).CloseParenthesisTkn,
+			}
+
+			if brackets, ok := yyDollar[3].node.(*ParserBrackets); ok {
+				staticCall.OpenCurlyBracketTkn = brackets.OpenBracketTkn
+				staticCall.Call = brackets.Child
+				staticCall.CloseCurlyBracketTkn = brackets.CloseBracketTkn
+			}
+
+			yyVAL.node = staticCall
+		}
+	case 456:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4108
+		{
+			staticCall := &ast.ExprStaticCall{
+				Position:            yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[4].node),
+				Class:               yyDollar[1].node,
+				DoubleColonTkn:      yyDollar[2].token,
+				Call:                yyDollar[3].node,
+				OpenParenthesisTkn:  yyDollar[4].node.(*ArgumentList).OpenParenthesisTkn,
+				Args:                yyDollar[4].node.(*ArgumentList).Arguments,
+				SeparatorTkns:       yyDollar[4].node.(*ArgumentList).SeparatorTkns,
+				CloseParenthesisTkn: yyDollar[4].node.(*ArgumentList).CloseParenthesisTkn,
+			}
+
+			if brackets, ok := yyDollar[3].node.(*ParserBrackets); ok {
+				staticCall.OpenCurlyBracketTkn = brackets.OpenBracketTkn
+				staticCall.Call = brackets.Child
+				staticCall.CloseCurlyBracketTkn = brackets.CloseBracketTkn
+			}
+
+			yyVAL.node = staticCall
+		}
+	case 457:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:4129
+		{
+			yyVAL.node = &ast.ExprFunctionCall{
+				Position:            yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[2].node),
+				Function:            yyDollar[1].node,
+				OpenParenthesisTkn:  yyDollar[2].node.(*ArgumentList).OpenParenthesisTkn,
+				Args:                yyDollar[2].node.(*ArgumentList).Arguments,
+				SeparatorTkns:       yyDollar[2].node.(*ArgumentList).SeparatorTkns,
+				CloseParenthesisTkn: yyDollar[2].node.(*ArgumentList).CloseParenthesisTkn,
+			}
+		}
+	case 458:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4143
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 459:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4151
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 460:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4158
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 461:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4162
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 462:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:4169
+		{
+			yyVAL.node = nil
+		}
+	case 463:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4173
+		{
+			yyVAL.node = &ast.ExprBrackets{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenParenthesisTkn:  yyDollar[1].token,
+				Expr:                yyDollar[2].node,
+				CloseParenthesisTkn: yyDollar[3].token,
+			}
+		}
+	case 464:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:4185
+		{
+			yyVAL.list = []ast.Vertex{}
+		}
+	case 465:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4189
+		{
+			yyVAL.list = []ast.Vertex{
+				&ast.ScalarEncapsedStringPart{
+					Position:       yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					EncapsedStrTkn: yyDollar[1].token,
+					Value:          yyDollar[1].token.Value,
+				},
+			}
+		}
+	case 466:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4199
+		{
+			yyVAL.list = yyDollar[1].list
+		}
+	case 467:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:4206
+		{
+			yyVAL.node = nil
+		}
+	case 468:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4210
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 469:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4217
+		{
+			yyVAL.node = &ast.ExprArray{
+				Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				ArrayTkn:        yyDollar[1].token,
+				OpenBracketTkn:  yyDollar[2].token,
+				Items:           yyDollar[3].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:   yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 470:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4228
+		{
+			yyVAL.node = &ast.ExprArray{
+				Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenBracketTkn:  yyDollar[1].token,
+				Items:           yyDollar[2].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:   yyDollar[2].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseBracketTkn: yyDollar[3].token,
+			}
+		}
+	case 471:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4238
+		{
+			yyVAL.node = &ast.ScalarString{
+				Position:  yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				StringTkn: yyDollar[1].token,
+				Value:     yyDollar[1].token.Value,
+			}
+		}
+	case 472:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4249
+		{
+			yyVAL.node = &ast.ScalarLnumber{
+				Position:  yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				NumberTkn: yyDollar[1].token,
+				Value:     yyDollar[1].token.Value,
+			}
+		}
+	case 473:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4257
+		{
+			yyVAL.node = &ast.ScalarDnumber{
+				Position:  yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				NumberTkn: yyDollar[1].token,
+				Value:     yyDollar[1].token.Value,
+			}
+		}
+	case 474:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4265
+		{
+			yyVAL.node = &ast.ScalarMagicConstant{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				MagicConstTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 475:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4273
+		{
+			yyVAL.node = &ast.ScalarMagicConstant{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				MagicConstTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 476:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4281
+		{
+			yyVAL.node = &ast.ScalarMagicConstant{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				MagicConstTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 477:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4289
+		{
+			yyVAL.node = &ast.ScalarMagicConstant{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				MagicConstTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 478:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4297
+		{
+			yyVAL.node = &ast.ScalarMagicConstant{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				MagicConstTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 479:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4305
+		{
+			yyVAL.node = &ast.ScalarMagicConstant{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				MagicConstTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 480:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4313
+		{
+			yyVAL.node = &ast.ScalarMagicConstant{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				MagicConstTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 481:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4321
+		{
+			yyVAL.node = &ast.ScalarMagicConstant{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				MagicConstTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 482:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4329
+		{
+			yyVAL.node = &ast.ScalarHeredoc{
+				Position:       yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenHeredocTkn: yyDollar[1].token,
+				Parts: []ast.Vertex{
+					&ast.ScalarEncapsedStringPart{
+						Position:       yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+						EncapsedStrTkn: yyDollar[2].token,
+						Value:          yyDollar[2].token.Value,
+					},
+				},
+				CloseHeredocTkn: yyDollar[3].token,
+			}
+		}
+	case 483:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:4344
+		{
+			yyVAL.node = &ast.ScalarHeredoc{
+				Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[2].token),
+				OpenHeredocTkn:  yyDollar[1].token,
+				CloseHeredocTkn: yyDollar[2].token,
+			}
+		}
+	case 484:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4352
+		{
+			yyVAL.node = &ast.ScalarEncapsed{
+				Position:      yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenQuoteTkn:  yyDollar[1].token,
+				Parts:         yyDollar[2].list,
+				CloseQuoteTkn: yyDollar[3].token,
+			}
+		}
+	case 485:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4361
+		{
+			yyVAL.node = &ast.ScalarHeredoc{
+				Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenHeredocTkn:  yyDollar[1].token,
+				Parts:           yyDollar[2].list,
+				CloseHeredocTkn: yyDollar[3].token,
+			}
+		}
+	case 486:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4370
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 487:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4374
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 488:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4381
+		{
+			yyVAL.node = &ast.ExprConstFetch{
+				Position: yylex.(*Parser).builder.NewNodePosition(yyDollar[1].node),
+				Const:    yyDollar[1].node,
+			}
+		}
+	case 489:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4388
+		{
+			yyVAL.node = &ast.ExprClassConstFetch{
+				Position:       yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[3].token),
+				Class:          yyDollar[1].node,
+				DoubleColonTkn: yyDollar[2].token,
+				Const: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+					IdentifierTkn: yyDollar[3].token,
+					Value:         yyDollar[3].token.Value,
+				},
+			}
+		}
+	case 490:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:4401
+		{
+			yyVAL.node = &ast.ExprClassConstFetch{
+				Position:             yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[5].token),
+				Class:                yyDollar[1].node,
+				DoubleColonTkn:       yyDollar[2].token,
+				OpenCurlyBracketTkn:  yyDollar[3].token,
+				Const:                yyDollar[4].node,
+				CloseCurlyBracketTkn: yyDollar[5].token,
+			}
+
+			yylex.(*Parser).requires(version.FeatureDynamicClassConstFetch, yyDollar[3].token.Position)
+		}
+	case 491:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4414
+		{
+			yyVAL.node = &ast.ExprClassConstFetch{
+				Position:       yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[3].token),
+				Class:          yyDollar[1].node,
+				DoubleColonTkn: yyDollar[2].token,
+				Const: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+					IdentifierTkn: yyDollar[3].token,
+					Value:         yyDollar[3].token.Value,
+				},
+			}
+		}
+	case 492:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:4427
+		{
+			yyVAL.node = &ast.ExprClassConstFetch{
+				Position:             yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[5].token),
+				Class:                yyDollar[1].node,
+				DoubleColonTkn:       yyDollar[2].token,
+				OpenCurlyBracketTkn:  yyDollar[3].token,
+				Const:                yyDollar[4].node,
+				CloseCurlyBracketTkn: yyDollar[5].token,
+			}
+
+			yylex.(*Parser).requires(version.FeatureDynamicClassConstFetch, yyDollar[3].token.Position)
+		}
+	case 493:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4443
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 494:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4447
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 495:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:4454
+		{
+			yyVAL.node = nil
+		}
+	case 496:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4458
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 497:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4465
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 498:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4472
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 499:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4476
+		{
+			yyVAL.node = &ast.ExprBrackets{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenParenthesisTkn:  yyDollar[1].token,
+				Expr:                yyDollar[2].node,
+				CloseParenthesisTkn: yyDollar[3].token,
+			}
+		}
+	case 500:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4485
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 501:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4492
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 502:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4496
+		{
+			yyVAL.node = &ast.ExprBrackets{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenParenthesisTkn:  yyDollar[1].token,
+				Expr:                yyDollar[2].node,
+				CloseParenthesisTkn: yyDollar[3].token,
+			}
+		}
+	case 503:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4505
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 504:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4512
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 505:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4516
+		{
+			yyVAL.node = &ast.ExprArrayDimFetch{
+				Position:        yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[4].token),
+				Var:             yyDollar[1].node,
+				OpenBracketTkn:  yyDollar[2].token,
+				Dim:             yyDollar[3].node,
+				CloseBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 506:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4526
+		{
+			yyVAL.node = &ast.ExprArrayDimFetch{
+				Position:        yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[4].token),
+				Var:             yyDollar[1].node,
+				OpenBracketTkn:  yyDollar[2].token,
+				Dim:             yyDollar[3].node,
+				CloseBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 507:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4536
+		{
+			yyVAL.node = &ast.ExprArrayDimFetch{
+				Position:        yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[4].token),
+				Var:             yyDollar[1].node,
+				OpenBracketTkn:  yyDollar[2].token,
+				Dim:             yyDollar[3].node,
+				CloseBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 508:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4546
+		{
+			methodCall := &ast.ExprMethodCall{
+				Position:            yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[4].node),
+				Var:                 yyDollar[1].node,
+				ObjectOperatorTkn:   yyDollar[2].token,
+				Method:              yyDollar[3].node,
+				OpenParenthesisTkn:  yyDollar[4].node.(*ArgumentList).OpenParenthesisTkn,
+				Args:                yyDollar[4].node.(*ArgumentList).Arguments,
+				SeparatorTkns:       yyDollar[4].node.(*ArgumentList).SeparatorTkns,
+				CloseParenthesisTkn: yyDollar[4].node.(*ArgumentList).CloseParenthesisTkn,
+			}
+
+			if brackets, ok := yyDollar[3].node.(*ParserBrackets); ok {
+				methodCall.OpenCurlyBracketTkn = brackets.OpenBracketTkn
+				methodCall.Method = brackets.Child
+				methodCall.CloseCurlyBracketTkn = brackets.CloseBracketTkn
+			}
+
+			yyVAL.node = methodCall
+		}
+	case 509:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4567
+		{
+			methodCall := &ast.ExprNullsafeMethodCall{
+				Position:            yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[4].node),
+				Var:                 yyDollar[1].node,
+				ObjectOperatorTkn:   yyDollar[2].token,
+				Method:              yyDollar[3].node,
+				OpenParenthesisTkn:  yyDollar[4].node.(*ArgumentList).OpenParenthesisTkn,
+				Args:                yyDollar[4].node.(*ArgumentList).Arguments,
+				SeparatorTkns:       yyDollar[4].node.(*ArgumentList).SeparatorTkns,
+				CloseParenthesisTkn: yyDollar[4].node.(*ArgumentList).CloseParenthesisTkn,
+			}
+
+			if brackets, ok := yyDollar[3].node.(*ParserBrackets); ok {
+				methodCall.OpenCurlyBracketTkn = brackets.OpenBracketTkn
+				methodCall.Method = brackets.Child
+				methodCall.CloseCurlyBracketTkn = brackets.CloseBracketTkn
+			}
+
+			yyVAL.node = methodCall
+		}
+	case 510:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4588
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 511:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4595
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 512:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4599
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 513:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4603
+		{
+			propertyFetch := &ast.ExprPropertyFetch{
+				Position:          yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:               yyDollar[1].node,
+				ObjectOperatorTkn: yyDollar[2].token,
+				Prop:              yyDollar[3].node,
+			}
+
+			if brackets, ok := yyDollar[3].node.(*ParserBrackets); ok {
+				propertyFetch.OpenCurlyBracketTkn = brackets.OpenBracketTkn
+				propertyFetch.Prop = brackets.Child
+				propertyFetch.CloseCurlyBracketTkn = brackets.CloseBracketTkn
+			}
+
+			yyVAL.node = propertyFetch
+		}
+	case 514:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4620
+		{
+			propertyFetch := &ast.ExprNullsafePropertyFetch{
+				Position:          yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:               yyDollar[1].node,
+				ObjectOperatorTkn: yyDollar[2].token,
+				Prop:              yyDollar[3].node,
+			}
+
+			if brackets, ok := yyDollar[3].node.(*ParserBrackets); ok {
+				propertyFetch.OpenCurlyBracketTkn = brackets.OpenBracketTkn
+				propertyFetch.Prop = brackets.Child
+				propertyFetch.CloseCurlyBracketTkn = brackets.CloseBracketTkn
+			}
+
+			yyVAL.node = propertyFetch
+		}
+	case 515:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4640
+		{
+			yyVAL.node = &ast.ExprVariable{
+				Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					IdentifierTkn: yyDollar[1].token,
+					Value:         yyDollar[1].token.Value,
+				},
+			}
+		}
+	case 516:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4651
+		{
+			yyVAL.node = &ast.ExprVariable{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				DollarTkn:            yyDollar[1].token,
+				OpenCurlyBracketTkn:  yyDollar[2].token,
+				Name:                 yyDollar[3].node,
+				CloseCurlyBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 517:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:4661
+		{
+			yyVAL.node = &ast.ExprVariable{
+				Position:  yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				DollarTkn: yyDollar[1].token,
+				Name:      yyDollar[2].node,
+			}
+		}
+	case 518:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4672
+		{
+			yyVAL.node = &ast.ExprStaticPropertyFetch{
+				Position:       yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Class:          yyDollar[1].node,
+				DoubleColonTkn: yyDollar[2].token,
+				Prop:           yyDollar[3].node,
+			}
+		}
+	case 519:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4681
+		{
+			yyVAL.node = &ast.ExprStaticPropertyFetch{
+				Position:       yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Class:          yyDollar[1].node,
+				DoubleColonTkn: yyDollar[2].token,
+				Prop:           yyDollar[3].node,
+			}
+		}
+	case 520:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4693
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 521:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4697
+		{
+			yyVAL.node = &ast.ExprArrayDimFetch{
+				Position:        yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[4].token),
+				Var:             yyDollar[1].node,
+				OpenBracketTkn:  yyDollar[2].token,
+				Dim:             yyDollar[3].node,
+				CloseBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 522:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4707
+		{
+			yyVAL.node = &ast.ExprArrayDimFetch{
+				Position:        yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[4].token),
+				Var:             yyDollar[1].node,
+				OpenBracketTkn:  yyDollar[2].token,
+				Dim:             yyDollar[3].node,
+				CloseBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 523:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4717
+		{
+			propertyFetch := &ast.ExprPropertyFetch{
+				Position:          yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:               yyDollar[1].node,
+				ObjectOperatorTkn: yyDollar[2].token,
+				Prop:              yyDollar[3].node,
+			}
+
+			if brackets, ok := yyDollar[3].node.(*ParserBrackets); ok {
+				propertyFetch.OpenCurlyBracketTkn = brackets.OpenBracketTkn
+				propertyFetch.Prop = brackets.Child
+				propertyFetch.CloseCurlyBracketTkn = brackets.CloseBracketTkn
+			}
+
+			yyVAL.node = propertyFetch
+		}
+	case 524:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4734
+		{
+			propertyFetch := &ast.ExprNullsafePropertyFetch{
+				Position:          yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Var:               yyDollar[1].node,
+				ObjectOperatorTkn: yyDollar[2].token,
+				Prop:              yyDollar[3].node,
+			}
+
+			if brackets, ok := yyDollar[3].node.(*ParserBrackets); ok {
+				propertyFetch.OpenCurlyBracketTkn = brackets.OpenBracketTkn
+				propertyFetch.Prop = brackets.Child
+				propertyFetch.CloseCurlyBracketTkn = brackets.CloseBracketTkn
+			}
+
+			yyVAL.node = propertyFetch
+		}
+	case 525:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4751
+		{
+			yyVAL.node = &ast.ExprStaticPropertyFetch{
+				Position:       yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Class:          yyDollar[1].node,
+				DoubleColonTkn: yyDollar[2].token,
+				Prop:           yyDollar[3].node,
+			}
+		}
+	case 526:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4760
+		{
+			yyVAL.node = &ast.ExprStaticPropertyFetch{
+				Position:       yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Class:          yyDollar[1].node,
+				DoubleColonTkn: yyDollar[2].token,
+				Prop:           yyDollar[3].node,
+			}
+		}
+	case 527:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4772
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 528:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4780
+		{
+			yyVAL.node = &ParserBrackets{
+				Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenBracketTkn:  yyDollar[1].token,
+				Child:           yyDollar[2].node,
+				CloseBracketTkn: yyDollar[3].token,
+			}
+		}
+	case 529:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4789
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4796
+		{
+			yyVAL.node = &ast.Identifier{
+				Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				IdentifierTkn: yyDollar[1].token,
+				Value:         yyDollar[1].token.Value,
+			}
+		}
+	case 531:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4804
+		{
+			yyVAL.node = &ParserBrackets{
+				Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenBracketTkn:  yyDollar[1].token,
+				Child:           yyDollar[2].node,
+				CloseBracketTkn: yyDollar[3].token,
+			}
+		}
+	case 532:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4813
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 533:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4820
+		{
+			pairList := yyDollar[1].node.(*ParserSeparatedList)
+			fistPair := pairList.Items[0].(*ast.ExprArrayItem)
+
+			if fistPair.Key == nil && fistPair.Val == nil && len(pairList.Items) == 1 {
+				pairList.Items = nil
+			}
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 534:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		// line internal/php8/php8.y:4834
+		{
+			yyVAL.node = &ast.ExprArrayItem{}
+		}
+	case 535:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4838
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 536:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4845
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 537:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4852
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 538:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4861
+		{
+			yyVAL.node = &ast.ExprArrayItem{
+				Position:       yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[3].node),
+				Key:            yyDollar[1].node,
+				DoubleArrowTkn: yyDollar[2].token,
+				Val:            yyDollar[3].node,
+			}
+		}
+	case 539:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4870
+		{
+			yyVAL.node = &ast.ExprArrayItem{
+				Position: yylex.(*Parser).builder.NewNodePosition(yyDollar[1].node),
+				Val:      yyDollar[1].node,
+			}
+		}
+	case 540:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4877
+		{
+			yyVAL.node = &ast.ExprArrayItem{
+				Position:       yylex.(*Parser).builder.NewNodesPosition(yyDollar[1].node, yyDollar[4].node),
+				Key:            yyDollar[1].node,
+				DoubleArrowTkn: yyDollar[2].token,
+				AmpersandTkn:   yyDollar[3].token,
+				Val:            yyDollar[4].node,
+			}
+		}
+	case 541:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:4887
+		{
+			yyVAL.node = &ast.ExprArrayItem{
+				Position:     yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				AmpersandTkn: yyDollar[1].token,
+				Val:          yyDollar[2].node,
+			}
+		}
+	case 542:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:4895
+		{
+			yyVAL.node = &ast.ExprArrayItem{
+				Position:    yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				EllipsisTkn: yyDollar[1].token,
+				Val:         yyDollar[2].node,
+			}
+		}
+	case 543:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:4903
+		{
+			yyVAL.node = &ast.ExprArrayItem{
+				Position:       yylex.(*Parser).builder.NewNodeTokenPosition(yyDollar[1].node, yyDollar[6].token),
+				Key:            yyDollar[1].node,
+				DoubleArrowTkn: yyDollar[2].token,
+				Val: &ast.ExprList{
+					Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[3].token, yyDollar[6].token),
+					ListTkn:         yyDollar[3].token,
+					OpenBracketTkn:  yyDollar[4].token,
+					Items:           yyDollar[5].node.(*ParserSeparatedList).Items,
+					SeparatorTkns:   yyDollar[5].node.(*ParserSeparatedList).SeparatorTkns,
+					CloseBracketTkn: yyDollar[6].token,
+				},
+			}
+		}
+	case 544:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4919
+		{
+			yyVAL.node = &ast.ExprArrayItem{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				Val: &ast.ExprList{
+					Position:        yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+					ListTkn:         yyDollar[1].token,
+					OpenBracketTkn:  yyDollar[2].token,
+					Items:           yyDollar[3].node.(*ParserSeparatedList).Items,
+					SeparatorTkns:   yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+					CloseBracketTkn: yyDollar[4].token,
+				},
+			}
+		}
+	case 545:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:4936
+		{
+			yyVAL.list = append(yyDollar[1].list, yyDollar[2].node)
+		}
+	case 546:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:4940
+		{
+			yyVAL.list = append(
+				yyDollar[1].list,
+				&ast.ScalarEncapsedStringPart{
+					Position:       yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+					EncapsedStrTkn: yyDollar[2].token,
+					Value:          yyDollar[2].token.Value,
+				},
+			)
+		}
+	case 547:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4951
+		{
+			yyVAL.list = []ast.Vertex{yyDollar[1].node}
+		}
+	case 548:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:4955
+		{
+			yyVAL.list = []ast.Vertex{
+				&ast.ScalarEncapsedStringPart{
+					Position:       yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					EncapsedStrTkn: yyDollar[1].token,
+					Value:          yyDollar[1].token.Value,
+				},
+				yyDollar[2].node,
+			}
+		}
+	case 549:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:4969
+		{
+			yyVAL.node = &ast.ExprVariable{
+				Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					IdentifierTkn: yyDollar[1].token,
+					Value:         yyDollar[1].token.Value,
+				},
+			}
+		}
+	case 550:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:4980
+		{
+			yyVAL.node = &ast.ExprArrayDimFetch{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				Var: &ast.ExprVariable{
+					Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					Name: &ast.Identifier{
+						Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+						IdentifierTkn: yyDollar[1].token,
+						Value:         yyDollar[1].token.Value,
+					},
+				},
+				OpenBracketTkn:  yyDollar[2].token,
+				Dim:             yyDollar[3].node,
+				CloseBracketTkn: yyDollar[4].token,
+			}
+		}
+	case 551:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:4997
+		{
+			yyVAL.node = &ast.ExprPropertyFetch{
+				Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				Var: &ast.ExprVariable{
+					Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					Name: &ast.Identifier{
+						Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+						IdentifierTkn: yyDollar[1].token,
+						Value:         yyDollar[1].token.Value,
+					},
+				},
+				ObjectOperatorTkn: yyDollar[2].token,
+				Prop: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[3].token),
+					IdentifierTkn: yyDollar[3].token,
+					Value:         yyDollar[3].token.Value,
+				},
+			}
+		}
+	case 552:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:5017
+		{
+			yyVAL.node = &ast.ScalarEncapsedStringVar{
+				Position:                  yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				DollarOpenCurlyBracketTkn: yyDollar[1].token,
+				Name:                      yyDollar[2].node,
+				CloseCurlyBracketTkn:      yyDollar[3].token,
+			}
+		}
+	case 553:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:5026
+		{
+			yyVAL.node = &ast.ScalarEncapsedStringVar{
+				Position:                  yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				DollarOpenCurlyBracketTkn: yyDollar[1].token,
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+					IdentifierTkn: yyDollar[2].token,
+					Value:         yyDollar[2].token.Value,
+				},
+				CloseCurlyBracketTkn: yyDollar[3].token,
+			}
+		}
+	case 554:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		// line internal/php8/php8.y:5039
+		{
+			yyVAL.node = &ast.ScalarEncapsedStringVar{
+				Position:                  yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				DollarOpenCurlyBracketTkn: yyDollar[1].token,
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+					IdentifierTkn: yyDollar[2].token,
+					Value:         yyDollar[2].token.Value,
+				},
+				OpenSquareBracketTkn:  yyDollar[3].token,
+				Dim:                   yyDollar[4].node,
+				CloseSquareBracketTkn: yyDollar[5].token,
+				CloseCurlyBracketTkn:  yyDollar[6].token,
+			}
+		}
+	case 555:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:5055
+		{
+			yyVAL.node = &ast.ScalarEncapsedStringBrackets{
+				Position:             yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[3].token),
+				OpenCurlyBracketTkn:  yyDollar[1].token,
+				Var:                  yyDollar[2].node,
+				CloseCurlyBracketTkn: yyDollar[3].token,
+			}
+		}
+	case 556:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:5067
+		{
+			yyVAL.node = &ast.ScalarString{
+				Position:  yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				StringTkn: yyDollar[1].token,
+				Value:     yyDollar[1].token.Value,
+			}
+		}
+	case 557:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:5075
+		{
+			// TODO: add option to handle 64 bit integer
+			if _, err := strconv.Atoi(string(yyDollar[1].token.Value)); err == nil {
+				yyVAL.node = &ast.ScalarLnumber{
+					Position:  yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					NumberTkn: yyDollar[1].token,
+					Value:     yyDollar[1].token.Value,
+				}
+			} else {
+				yyVAL.node = &ast.ScalarString{
+					Position:  yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					StringTkn: yyDollar[1].token,
+					Value:     yyDollar[1].token.Value,
+				}
+			}
+		}
+	case 558:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:5092
+		{
+			_, err := strconv.Atoi(string(yyDollar[2].token.Value))
+			isInt := err == nil
+
+			if isInt {
+				yyVAL.node = &ast.ExprUnaryMinus{
+					Position: yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[2].token),
+					MinusTkn: yyDollar[1].token,
+					Expr: &ast.ScalarLnumber{
+						Position:  yylex.(*Parser).builder.NewTokenPosition(yyDollar[2].token),
+						NumberTkn: yyDollar[2].token,
+						Value:     yyDollar[2].token.Value,
+					},
+				}
+			} else {
+				yyVAL.node = &ast.ScalarString{
+					Position:  yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[2].token),
+					MinusTkn:  yyDollar[1].token,
+					StringTkn: yyDollar[2].token,
+					Value:     append([]byte("-"), yyDollar[2].token.Value...),
+				}
+			}
+		}
+	case 559:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:5116
+		{
+			yyVAL.node = &ast.ExprVariable{
+				Position: yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+				Name: &ast.Identifier{
+					Position:      yylex.(*Parser).builder.NewTokenPosition(yyDollar[1].token),
+					IdentifierTkn: yyDollar[1].token,
+					Value:         yyDollar[1].token.Value,
+				},
+			}
+		}
+	case 560:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		// line internal/php8/php8.y:5130
+		{
+			if yyDollar[4].token != nil {
+				yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[4].token)
+			}
+
+			yyVAL.node = &ast.ExprIsset{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[5].token),
+				IssetTkn:            yyDollar[1].token,
+				OpenParenthesisTkn:  yyDollar[2].token,
+				Vars:                yyDollar[3].node.(*ParserSeparatedList).Items,
+				SeparatorTkns:       yyDollar[3].node.(*ParserSeparatedList).SeparatorTkns,
+				CloseParenthesisTkn: yyDollar[5].token,
+			}
+		}
+	case 561:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:5145
+		{
+			yyVAL.node = &ast.ExprEmpty{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				EmptyTkn:            yyDollar[1].token,
+				OpenParenthesisTkn:  yyDollar[2].token,
+				Expr:                yyDollar[3].node,
+				CloseParenthesisTkn: yyDollar[4].token,
+			}
+		}
+	case 562:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:5155
+		{
+			yyVAL.node = &ast.ExprInclude{
+				Position:   yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				IncludeTkn: yyDollar[1].token,
+				Expr:       yyDollar[2].node,
+			}
+		}
+	case 563:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:5163
+		{
+			yyVAL.node = &ast.ExprIncludeOnce{
+				Position:       yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				IncludeOnceTkn: yyDollar[1].token,
+				Expr:           yyDollar[2].node,
+			}
+		}
+	case 564:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		// line internal/php8/php8.y:5171
+		{
+			yyVAL.node = &ast.ExprEval{
+				Position:            yylex.(*Parser).builder.NewTokensPosition(yyDollar[1].token, yyDollar[4].token),
+				EvalTkn:             yyDollar[1].token,
+				OpenParenthesisTkn:  yyDollar[2].token,
+				Expr:                yyDollar[3].node,
+				CloseParenthesisTkn: yyDollar[4].token,
+			}
+		}
+	case 565:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:5181
+		{
+			yyVAL.node = &ast.ExprRequire{
+				Position:   yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				RequireTkn: yyDollar[1].token,
+				Expr:       yyDollar[2].node,
+			}
+		}
+	case 566:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		// line internal/php8/php8.y:5189
+		{
+			yyVAL.node = &ast.ExprRequireOnce{
+				Position:       yylex.(*Parser).builder.NewTokenNodePosition(yyDollar[1].token, yyDollar[2].node),
+				RequireOnceTkn: yyDollar[1].token,
+				Expr:           yyDollar[2].node,
+			}
+		}
+	case 567:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:5200
+		{
+			yyVAL.node = &ParserSeparatedList{
+				Items: []ast.Vertex{yyDollar[1].node},
+			}
+		}
+	case 568:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		// line internal/php8/php8.y:5206
+		{
+			yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns = append(yyDollar[1].node.(*ParserSeparatedList).SeparatorTkns, yyDollar[2].token)
+			yyDollar[1].node.(*ParserSeparatedList).Items = append(yyDollar[1].node.(*ParserSeparatedList).Items, yyDollar[3].node)
+
+			yyVAL.node = yyDollar[1].node
+		}
+	case 569:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		// line internal/php8/php8.y:5216
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	}
+	goto yystack /* stack new state and value */
+}
@@ -7,13 +7,17 @@ import (
 )
 
 type startPos struct {
-	startLine int
-	startPos  int
+	startLine     int
+	startPos      int
+	startCol      int
+	startColUTF16 int
 }
 
 type endPos struct {
-	endLine int
-	endPos  int
+	endLine     int
+	endPos      int
+	endCol      int
+	endColUTF16 int
 }
 
 type Builder struct {
@@ -28,210 +32,143 @@ func NewBuilder() *Builder {
 
 func getListStartPos(l []ast.Vertex) startPos {
 	if l == nil {
-		return startPos{-1, -1}
+		return startPos{-1, -1, -1, -1}
 	}
 
 	if len(l) == 0 {
-		return startPos{-1, -1}
+		return startPos{-1, -1, -1, -1}
 	}
 
 	return getNodeStartPos(l[0])
 }
 
 func getNodeStartPos(n ast.Vertex) startPos {
-	sl := -1
-	sp := -1
-
 	if n == nil {
-		return startPos{-1, -1}
+		return startPos{-1, -1, -1, -1}
 	}
 
-	p := n.GetPosition()
-	if p != nil {
-		sl = p.StartLine
-		sp = p.StartPos
+	return getStartPos(n.GetPosition())
+}
+
+func getTokenStartPos(t *token.Token) startPos {
+	return getStartPos(t.Position)
+}
+
+func getStartPos(p *position.Position) startPos {
+	if p == nil {
+		return startPos{-1, -1, -1, -1}
 	}
 
-	return startPos{sl, sp}
+	return startPos{p.StartLine, p.StartPos, p.StartCol, p.StartColUTF16}
 }
 
 func getListEndPos(l []ast.Vertex) endPos {
 	if l == nil {
-		return endPos{-1, -1}
+		return endPos{-1, -1, -1, -1}
 	}
 
 	if len(l) == 0 {
-		return endPos{-1, -1}
+		return endPos{-1, -1, -1, -1}
 	}
 
 	return getNodeEndPos(l[len(l)-1])
 }
 
 func getNodeEndPos(n ast.Vertex) endPos {
-	el := -1
-	ep := -1
-
 	if n == nil {
-		return endPos{-1, -1}
+		return endPos{-1, -1, -1, -1}
 	}
 
-	p := n.GetPosition()
-	if p != nil {
-		el = p.EndLine
-		ep = p.EndPos
+	return getEndPos(n.GetPosition())
+}
+
+func getTokenEndPos(t *token.Token) endPos {
+	return getEndPos(t.Position)
+}
+
+func getEndPos(p *position.Position) endPos {
+	if p == nil {
+		return endPos{-1, -1, -1, -1}
 	}
 
-	return endPos{el, ep}
+	return endPos{p.EndLine, p.EndPos, p.EndCol, p.EndColUTF16}
 }
 
-// NewNodeListPosition returns new Position
-func (b *Builder) NewNodeListPosition(list []ast.Vertex) *position.Position {
+func (b *Builder) newPosition(start startPos, end endPos) *position.Position {
 	pos := b.pool.Get()
 
-	pos.StartLine = getListStartPos(list).startLine
-	pos.EndLine = getListEndPos(list).endLine
-	pos.StartPos = getListStartPos(list).startPos
-	pos.EndPos = getListEndPos(list).endPos
+	pos.StartLine = start.startLine
+	pos.EndLine = end.endLine
+	pos.StartPos = start.startPos
+	pos.EndPos = end.endPos
+	pos.StartCol = start.startCol
+	pos.EndCol = end.endCol
+	pos.StartColUTF16 = start.startColUTF16
+	pos.EndColUTF16 = end.endColUTF16
 
 	return pos
 }
 
+// NewNodeListPosition returns new Position
+func (b *Builder) NewNodeListPosition(list []ast.Vertex) *position.Position {
+	return b.newPosition(getListStartPos(list), getListEndPos(list))
+}
+
 // NewNodePosition returns new Position
 func (b *Builder) NewNodePosition(n ast.Vertex) *position.Position {
-	pos := b.pool.Get()
-
-	pos.StartLine = getNodeStartPos(n).startLine
-	pos.EndLine = getNodeEndPos(n).endLine
-	pos.StartPos = getNodeStartPos(n).startPos
-	pos.EndPos = getNodeEndPos(n).endPos
-
-	return pos
+	return b.newPosition(getNodeStartPos(n), getNodeEndPos(n))
 }
 
 // NewTokenPosition returns new Position
 func (b *Builder) NewTokenPosition(t *token.Token) *position.Position {
-	pos := b.pool.Get()
-
-	pos.StartLine = t.Position.StartLine
-	pos.EndLine = t.Position.EndLine
-	pos.StartPos = t.Position.StartPos
-	pos.EndPos = t.Position.EndPos
-
-	return pos
+	return b.newPosition(getTokenStartPos(t), getTokenEndPos(t))
 }
 
 // NewTokensPosition returns new Position
 func (b *Builder) NewTokensPosition(startToken *token.Token, endToken *token.Token) *position.Position {
-	pos := b.pool.Get()
-
-	pos.StartLine = startToken.Position.StartLine
-	pos.EndLine = endToken.Position.EndLine
-	pos.StartPos = startToken.Position.StartPos
-	pos.EndPos = endToken.Position.EndPos
-
-	return pos
+	return b.newPosition(getTokenStartPos(startToken), getTokenEndPos(endToken))
 }
 
 // NewTokenNodePosition returns new Position
 func (b *Builder) NewTokenNodePosition(t *token.Token, n ast.Vertex) *position.Position {
-	pos := b.pool.Get()
-
-	pos.StartLine = t.Position.StartLine
-	pos.EndLine = getNodeEndPos(n).endLine
-	pos.StartPos = t.Position.StartPos
-	pos.EndPos = getNodeEndPos(n).endPos
-
-	return pos
+	return b.newPosition(getTokenStartPos(t), getNodeEndPos(n))
 }
 
 // NewNodeTokenPosition returns new Position
 func (b *Builder) NewNodeTokenPosition(n ast.Vertex, t *token.Token) *position.Position {
-	pos := b.pool.Get()
-
-	pos.StartLine = getNodeStartPos(n).startLine
-	pos.EndLine = t.Position.EndLine
-	pos.StartPos = getNodeStartPos(n).startPos
-	pos.EndPos = t.Position.EndPos
-
-	return pos
+	return b.newPosition(getNodeStartPos(n), getTokenEndPos(t))
 }
 
 // NewNodesPosition returns new Position
 func (b *Builder) NewNodesPosition(startNode ast.Vertex, endNode ast.Vertex) *position.Position {
-	pos := b.pool.Get()
-
-	pos.StartLine = getNodeStartPos(startNode).startLine
-	pos.EndLine = getNodeEndPos(endNode).endLine
-	pos.StartPos = getNodeStartPos(startNode).startPos
-	pos.EndPos = getNodeEndPos(endNode).endPos
-
-	return pos
+	return b.newPosition(getNodeStartPos(startNode), getNodeEndPos(endNode))
 }
 
 // NewNodeListTokenPosition returns new Position
 func (b *Builder) NewNodeListTokenPosition(list []ast.Vertex, t *token.Token) *position.Position {
-	pos := b.pool.Get()
-
-	pos.StartLine = getListStartPos(list).startLine
-	pos.EndLine = t.Position.EndLine
-	pos.StartPos = getListStartPos(list).startPos
-	pos.EndPos = t.Position.EndPos
-
-	return pos
+	return b.newPosition(getListStartPos(list), getTokenEndPos(t))
 }
 
 // NewTokenNodeListPosition returns new Position
 func (b *Builder) NewTokenNodeListPosition(t *token.Token, list []ast.Vertex) *position.Position {
-	pos := b.pool.Get()
-
-	pos.StartLine = t.Position.StartLine
-	pos.EndLine = getListEndPos(list).endLine
-	pos.StartPos = t.Position.StartPos
-	pos.EndPos = getListEndPos(list).endPos
-
-	return pos
+	return b.newPosition(getTokenStartPos(t), getListEndPos(list))
 }
 
 // NewNodeNodeListPosition returns new Position
 func (b *Builder) NewNodeNodeListPosition(n ast.Vertex, list []ast.Vertex) *position.Position {
-	pos := b.pool.Get()
-
-	pos.StartLine = getNodeStartPos(n).startLine
-	pos.EndLine = getListEndPos(list).endLine
-	pos.StartPos = getNodeStartPos(n).startPos
-	pos.EndPos = getListEndPos(list).endPos
-
-	return pos
+	return b.newPosition(getNodeStartPos(n), getListEndPos(list))
 }
 
 // NewNodeListNodePosition returns new Position
 func (b *Builder) NewNodeListNodePosition(list []ast.Vertex, n ast.Vertex) *position.Position {
-	pos := b.pool.Get()
-
-	pos.StartLine = getListStartPos(list).startLine
-	pos.EndLine = getNodeEndPos(n).endLine
-	pos.StartPos = getListStartPos(list).startPos
-	pos.EndPos = getNodeEndPos(n).endPos
-
-	return pos
+	return b.newPosition(getListStartPos(list), getNodeEndPos(n))
 }
 
 // NewOptionalListTokensPosition returns new Position
 func (b *Builder) NewOptionalListTokensPosition(list []ast.Vertex, t *token.Token, endToken *token.Token) *position.Position {
-	pos := b.pool.Get()
-
 	if list == nil {
-		pos.StartLine = t.Position.StartLine
-		pos.EndLine = endToken.Position.EndLine
-		pos.StartPos = t.Position.StartPos
-		pos.EndPos = endToken.Position.EndPos
-
-		return pos
+		return b.newPosition(getTokenStartPos(t), getTokenEndPos(endToken))
 	}
-	pos.StartLine = getListStartPos(list).startLine
-	pos.EndLine = endToken.Position.EndLine
-	pos.StartPos = getListStartPos(list).startPos
-	pos.EndPos = endToken.Position.EndPos
 
-	return pos
+	return b.newPosition(getListStartPos(list), getTokenEndPos(endToken))
 }
@@ -365,7 +365,7 @@ func TestNewOptionalListTokensPosition2(t *testing.T) {
 func TestNilNodePos(t *testing.T) {
 	pos := builder.NewBuilder().NewNodesPosition(nil, nil)
 
-	assert.DeepEqual(t, &position.Position{StartLine: -1, EndLine: -1, StartPos: -1, EndPos: -1}, pos)
+	assert.DeepEqual(t, &position.Position{StartLine: -1, EndLine: -1, StartPos: -1, EndPos: -1, StartCol: -1, EndCol: -1, StartColUTF16: -1, EndColUTF16: -1}, pos)
 }
 
 func TestNilNodeListPos(t *testing.T) {
@@ -380,7 +380,7 @@ func TestNilNodeListPos(t *testing.T) {
 
 	pos := builder.NewBuilder().NewNodeNodeListPosition(n1, nil)
 
-	assert.DeepEqual(t, &position.Position{StartLine: 1, EndLine: -1, EndPos: -1}, pos)
+	assert.DeepEqual(t, &position.Position{StartLine: 1, EndLine: -1, EndPos: -1, EndCol: -1, EndColUTF16: -1}, pos)
 }
 
 func TestNilNodeListTokenPos(t *testing.T) {
@@ -396,7 +396,7 @@ func TestNilNodeListTokenPos(t *testing.T) {
 
 	pos := builder.NewBuilder().NewNodeListTokenPosition(nil, tkn)
 
-	assert.DeepEqual(t, &position.Position{StartLine: -1, EndLine: 1, StartPos: -1, EndPos: 3}, pos)
+	assert.DeepEqual(t, &position.Position{StartLine: -1, EndLine: 1, StartPos: -1, EndPos: 3, StartCol: -1, StartColUTF16: -1}, pos)
 }
 
 func TestEmptyNodeListPos(t *testing.T) {
@@ -411,7 +411,7 @@ func TestEmptyNodeListPos(t *testing.T) {
 
 	pos := builder.NewBuilder().NewNodeNodeListPosition(n1, []ast.Vertex{})
 
-	assert.DeepEqual(t, &position.Position{StartLine: 1, EndLine: -1, EndPos: -1}, pos)
+	assert.DeepEqual(t, &position.Position{StartLine: 1, EndLine: -1, EndPos: -1, EndCol: -1, EndColUTF16: -1}, pos)
 }
 
 func TestEmptyNodeListTokenPos(t *testing.T) {
@@ -427,5 +427,5 @@ func TestEmptyNodeListTokenPos(t *testing.T) {
 
 	pos := builder.NewBuilder().NewNodeListTokenPosition([]ast.Vertex{}, tkn)
 
-	assert.DeepEqual(t, &position.Position{StartLine: -1, EndLine: 1, StartPos: -1, EndPos: 3}, pos)
+	assert.DeepEqual(t, &position.Position{StartLine: -1, EndLine: 1, StartPos: -1, EndPos: 3, StartCol: -1, StartColUTF16: -1}, pos)
 }
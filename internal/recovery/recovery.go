@@ -0,0 +1,233 @@
+package recovery
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/z7zmey/php-parser/internal/position"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	pos "github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+var (
+	tokenType      = reflect.TypeOf((*token.Token)(nil))
+	tokenListType  = reflect.TypeOf([]*token.Token(nil))
+	vertexType     = reflect.TypeOf((*ast.Vertex)(nil)).Elem()
+	vertexListType = reflect.TypeOf([]ast.Vertex(nil))
+)
+
+type badNode struct {
+	vertex ast.Vertex
+	lexed  int
+}
+
+// Recovery collects tokens dropped by the parser error recovery
+// and hands them over to the BadStmt and BadExpr nodes
+type Recovery struct {
+	builder *position.Builder
+	tokens  []*token.Token
+	nodes   []badNode
+}
+
+// NewRecovery creates and returns new Recovery
+func NewRecovery(builder *position.Builder) *Recovery {
+	return &Recovery{
+		builder: builder,
+	}
+}
+
+// AddToken must be called for each token passed to the parser
+func (r *Recovery) AddToken(t *token.Token) {
+	r.tokens = append(r.tokens, t)
+}
+
+// NewBadStmt returns BadStmt placeholder for the statement that failed to parse
+func (r *Recovery) NewBadStmt() *ast.BadStmt {
+	n := &ast.BadStmt{
+		Position: r.lookaheadPosition(),
+	}
+
+	r.nodes = append(r.nodes, badNode{n, len(r.tokens)})
+
+	return n
+}
+
+// NewBadExpr returns BadExpr placeholder for the expression that failed to parse
+func (r *Recovery) NewBadExpr() *ast.BadExpr {
+	n := &ast.BadExpr{
+		Position: r.lookaheadPosition(),
+	}
+
+	r.nodes = append(r.nodes, badNode{n, len(r.tokens)})
+
+	return n
+}
+
+// lookaheadPosition returns empty position right before the lookahead token,
+// or right after the previous one if the lookahead is EOF
+func (r *Recovery) lookaheadPosition() *pos.Position {
+	for i := len(r.tokens) - 1; i >= 0; i-- {
+		t := r.tokens[i]
+		if t.Position == nil {
+			continue
+		}
+
+		p := r.builder.NewTokenPosition(t)
+		if i == len(r.tokens)-1 {
+			p.EndLine, p.EndPos, p.EndCol, p.EndColUTF16 = p.StartLine, p.StartPos, p.StartCol, p.StartColUTF16
+		} else {
+			p.StartLine, p.StartPos, p.StartCol, p.StartColUTF16 = p.EndLine, p.EndPos, p.EndCol, p.EndColUTF16
+		}
+
+		return p
+	}
+
+	return nil
+}
+
+// Resolve fills SkippedTkns of the bad nodes found in the tree.
+// Skipped tokens that are not claimed by any of them are appended
+// to the root statements as BadStmt, so that the tree still holds every token.
+func (r *Recovery) Resolve(root *ast.Root, aborted bool) {
+	if len(r.nodes) == 0 && !aborted {
+		return
+	}
+
+	attached := map[*token.Token]bool{}
+	inTree := map[ast.Vertex]bool{}
+	collect(reflect.ValueOf(root), attached, inTree)
+
+	runs := r.runs(attached)
+	claimed := make([]bool, len(runs))
+
+	for _, bn := range r.nodes {
+		if !inTree[bn.vertex] {
+			continue
+		}
+
+		for _, i := range []int{bn.lexed - 1, bn.lexed - 2} {
+			ri := findRun(runs, i)
+			if ri < 0 {
+				continue
+			}
+
+			if !claimed[ri] {
+				claimed[ri] = true
+				r.setSkipped(bn.vertex, r.tokens[runs[ri][0]:runs[ri][1]])
+			}
+			break
+		}
+	}
+
+	added := false
+	for ri, run := range runs {
+		if claimed[ri] {
+			continue
+		}
+
+		n := &ast.BadStmt{}
+		r.setSkipped(n, r.tokens[run[0]:run[1]])
+		root.Stmts = append(root.Stmts, n)
+		added = true
+	}
+
+	if added {
+		sort.SliceStable(root.Stmts, func(i, j int) bool {
+			return root.Stmts[i].GetPosition().StartPos < root.Stmts[j].GetPosition().StartPos
+		})
+	}
+
+	root.Position = r.builder.NewNodeListPosition(root.Stmts)
+}
+
+// runs returns [start, end) ranges of consecutive tokens missing in the tree
+func (r *Recovery) runs(attached map[*token.Token]bool) [][2]int {
+	var runs [][2]int
+
+	start := -1
+	for i, t := range r.tokens {
+		if attached[t] {
+			if start >= 0 {
+				runs = append(runs, [2]int{start, i})
+				start = -1
+			}
+			continue
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+
+	if start >= 0 {
+		runs = append(runs, [2]int{start, len(r.tokens)})
+	}
+
+	return runs
+}
+
+func findRun(runs [][2]int, i int) int {
+	for ri, run := range runs {
+		if i >= run[0] && i < run[1] {
+			return ri
+		}
+	}
+
+	return -1
+}
+
+func (r *Recovery) setSkipped(n ast.Vertex, tkns []*token.Token) {
+	p := r.builder.NewTokensPosition(tkns[0], tkns[len(tkns)-1])
+
+	switch n := n.(type) {
+	case *ast.BadStmt:
+		n.Position = p
+		n.SkippedTkns = tkns
+	case *ast.BadExpr:
+		n.Position = p
+		n.SkippedTkns = tkns
+	}
+}
+
+func collect(v reflect.Value, attached map[*token.Token]bool, inTree map[ast.Vertex]bool) {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+
+	switch n := v.Interface().(type) {
+	case *ast.BadStmt, *ast.BadExpr:
+		inTree[n.(ast.Vertex)] = true
+		return
+	}
+
+	s := v.Elem()
+	if s.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < s.NumField(); i++ {
+		f := s.Field(i)
+
+		switch {
+		case f.Type() == tokenType:
+			if !f.IsNil() {
+				attached[f.Interface().(*token.Token)] = true
+			}
+		case f.Type() == tokenListType:
+			for _, t := range f.Interface().([]*token.Token) {
+				attached[t] = true
+			}
+		case f.Type() == vertexType:
+			collect(f, attached, inTree)
+		case f.Type() == vertexListType:
+			for j := 0; j < f.Len(); j++ {
+				collect(f.Index(j), attached, inTree)
+			}
+		}
+	}
+}
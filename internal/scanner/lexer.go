@@ -24,7 +24,7 @@ type Lexer struct {
 	heredocLabel []byte
 	tokenPool    *token.Pool
 	positionPool *position.Pool
-	newLines     NewLines
+	newLines     position.Lines
 }
 
 func NewLexer(data []byte, config conf.Config) *Lexer {
@@ -38,7 +38,6 @@ func NewLexer(data []byte, config conf.Config) *Lexer {
 
 		tokenPool:    token.NewPool(position.DefaultBlockSize),
 		positionPool: position.NewPool(token.DefaultBlockSize),
-		newLines:     NewLines{make([]int, 0, 128)},
 	}
 
 	initLexer(lex)
@@ -46,6 +45,177 @@ func NewLexer(data []byte, config conf.Config) *Lexer {
 	return lex
 }
 
+// Lex returns the next token
+func (lex *Lexer) Lex() *token.Token {
+	tkn := lex.next()
+	lex.checkFeatures(tkn)
+
+	return tkn
+}
+
+// next returns the next token. PHP 8 tokens that the generated scanner does not
+// know about are recognized here on top of its output.
+func (lex *Lexer) next() *token.Token {
+	// attributes come along with the rest of the PHP 8.0 tokens below
+	if !lex.supports(version.FeatureAttribute) {
+		return lex.scan()
+	}
+
+	cs, top, heredocLabel := lex.cs, lex.top, lex.heredocLabel
+
+	tkn := lex.scan()
+
+	for i, ff := range tkn.FreeFloating {
+		if ff.ID != token.T_COMMENT || !bytes.HasPrefix(ff.Value, []byte("#[")) {
+			continue
+		}
+
+		// "#[" opens an attribute group, restart scanning right after it
+		ts := ff.Position.StartPos
+
+		tkn.FreeFloating = tkn.FreeFloating[:i]
+		lex.p, lex.cs, lex.top, lex.heredocLabel = ts+2, lexer_en_php, top, heredocLabel
+		lex.ts, lex.te = ts, ts+2
+
+		lex.setTokenPosition(tkn)
+		tkn.ID = token.T_ATTRIBUTE
+		tkn.Value = lex.data[ts : ts+2]
+
+		return tkn
+	}
+
+	switch cs {
+	case lexer_en_property, lexer_en_string_var, lexer_en_string_var_index:
+		return tkn
+	}
+
+	switch {
+	case tkn.ID == token.T_STRING && bytes.EqualFold(tkn.Value, []byte("match")):
+		// match next to "\\" is a part of the name like Foo\\Match
+		if lex.inName(tkn) {
+			break
+		}
+		tkn.ID = token.T_MATCH
+	case tkn.ID == token.ID('?') && bytes.HasPrefix(lex.data[lex.p:], []byte("->")):
+		next := lex.scan()
+
+		tkn.ID = token.T_NULLSAFE_OBJECT_OPERATOR
+		tkn.Value = lex.data[tkn.Position.StartPos:next.Position.EndPos]
+		tkn.Position.EndLine = next.Position.EndLine
+		tkn.Position.EndPos = next.Position.EndPos
+		tkn.Position.EndCol = next.Position.EndCol
+		tkn.Position.EndColUTF16 = next.Position.EndColUTF16
+	}
+
+	// enums come along with the rest of the PHP 8.1 tokens below
+	if !lex.supports(version.FeatureEnum) {
+		return tkn
+	}
+
+	switch {
+	case tkn.ID == token.T_STRING && bytes.EqualFold(tkn.Value, []byte("enum")):
+		// enum is a keyword only when a name follows, "enum extends" and
+		// "enum implements" still refer to a class named enum
+		p := lex.skipWhitespaceAndComments(lex.p)
+		if p == lex.p || p == len(lex.data) || !isValidVarNameStart(lex.data[p]) {
+			break
+		}
+		if lex.hasKeywordAt(p, "extends") || lex.hasKeywordAt(p, "implements") {
+			break
+		}
+		tkn.ID = token.T_ENUM
+	case tkn.ID == token.T_STRING && bytes.EqualFold(tkn.Value, []byte("readonly")):
+		// readonly followed by "(" is a call of a function named readonly
+		p := lex.skipWhitespaceAndComments(lex.p)
+		if p < len(lex.data) && lex.data[p] == '(' {
+			break
+		}
+		tkn.ID = token.T_READONLY
+	case tkn.ID == token.ID('&'):
+		// '&' before a variable or "..." may be a by-reference marker, any
+		// other '&' may join the types of an intersection type
+		p := lex.skipWhitespaceAndComments(lex.p)
+		if (p < len(lex.data) && lex.data[p] == '$') || bytes.HasPrefix(lex.data[p:], []byte("...")) {
+			break
+		}
+		tkn.ID = token.T_AMPERSAND_NOT_FOLLOWED_BY_VAR_OR_VARARG
+	}
+
+	return tkn
+}
+
+func (lex *Lexer) supports(f version.Feature) bool {
+	return lex.phpVersion != nil && lex.phpVersion.Supports(f)
+}
+
+// checkFeatures reports the token of the feature the php version does not support
+func (lex *Lexer) checkFeatures(tkn *token.Token) {
+	switch tkn.ID {
+	case token.T_LNUMBER, token.T_DNUMBER:
+		if len(tkn.Value) > 1 && tkn.Value[0] == '0' && (tkn.Value[1] == 'b' || tkn.Value[1] == 'B') {
+			lex.requires(version.FeatureBinaryLiteral, tkn)
+		}
+		if bytes.IndexByte(tkn.Value, '_') >= 0 {
+			lex.requires(version.FeatureNumericLiteralSeparator, tkn)
+		}
+	case token.T_START_HEREDOC:
+		if bytes.IndexByte(tkn.Value, '\'') >= 0 {
+			lex.requires(version.FeatureNowdoc, tkn)
+		}
+	}
+}
+
+// requires reports an error if the php version does not support the feature of the token
+func (lex *Lexer) requires(f version.Feature, tkn *token.Token) {
+	if lex.phpVersion == nil || lex.phpVersion.Supports(f) || lex.errHandlerFunc == nil {
+		return
+	}
+
+	e := errors.NewError(f.Requirement(), tkn.Position)
+	e.Column = tkn.Position.StartCol
+
+	lex.errHandlerFunc(e)
+}
+
+func (lex *Lexer) skipWhitespaceAndComments(p int) int {
+	for p < len(lex.data) {
+		switch {
+		case lex.data[p] == ' ' || lex.data[p] == '\t' || lex.data[p] == '\r' || lex.data[p] == '\n':
+			p++
+		case bytes.HasPrefix(lex.data[p:], []byte("/*")):
+			end := bytes.Index(lex.data[p+2:], []byte("*/"))
+			if end == -1 {
+				return len(lex.data)
+			}
+			p += end + 4
+		case bytes.HasPrefix(lex.data[p:], []byte("//")) || lex.data[p] == '#' && !bytes.HasPrefix(lex.data[p:], []byte("#[")):
+			for p < len(lex.data) && lex.data[p] != '\n' && lex.data[p] != '\r' && !bytes.HasPrefix(lex.data[p:], []byte("?>")) {
+				p++
+			}
+		default:
+			return p
+		}
+	}
+
+	return p
+}
+
+// inName reports whether the token is a part of the namespaced name,
+// the parts of the name are not separated by whitespace in PHP 8
+func (lex *Lexer) inName(tkn *token.Token) bool {
+	start := tkn.Position.StartPos
+	return (start > 0 && lex.data[start-1] == '\\') || (lex.p < len(lex.data) && lex.data[lex.p] == '\\')
+}
+
+func (lex *Lexer) hasKeywordAt(p int, keyword string) bool {
+	l := len(keyword)
+	if len(lex.data) < p+l || !bytes.EqualFold(lex.data[p:p+l], []byte(keyword)) {
+		return false
+	}
+
+	return len(lex.data) == p+l || !isValidVarName(lex.data[p+l])
+}
+
 func (lex *Lexer) setTokenPosition(token *token.Token) {
 	pos := lex.positionPool.Get()
 
@@ -53,10 +223,19 @@ func (lex *Lexer) setTokenPosition(token *token.Token) {
 	pos.EndLine = lex.newLines.GetLine(lex.te - 1)
 	pos.StartPos = lex.ts
 	pos.EndPos = lex.te
+	lex.setColumns(pos)
 
 	token.Position = pos
 }
 
+// setColumns fills columns of the position, the end column points right after the last byte
+func (lex *Lexer) setColumns(pos *position.Position) {
+	pos.StartCol = pos.StartPos - lex.newLines.LineStart(pos.StartLine) + 1
+	pos.EndCol = pos.EndPos - lex.newLines.LineStart(pos.EndLine) + 1
+	pos.StartColUTF16 = lex.newLines.ColumnUTF16(lex.data, pos.StartLine, pos.StartPos)
+	pos.EndColUTF16 = lex.newLines.ColumnUTF16(lex.data, pos.EndLine, pos.EndPos)
+}
+
 func (lex *Lexer) addFreeFloatingToken(t *token.Token, id token.ID, ps, pe int) {
 	skippedTkn := lex.tokenPool.Get()
 	skippedTkn.ID = id
@@ -102,12 +281,7 @@ func (lex *Lexer) isNotStringEnd(s byte) bool {
 }
 
 func (lex *Lexer) isHeredocEnd(p int) bool {
-	o, err := version.New("7.3")
-	if err != nil {
-		panic(err)
-	}
-
-	if lex.phpVersion.GreaterOrEqual(o) {
+	if lex.supports(version.FeatureFlexibleHeredoc) {
 		return lex.isHeredocEndSince73(p)
 	}
 
@@ -207,10 +381,15 @@ func (lex *Lexer) call(state int, fnext int) {
 }
 
 func (lex *Lexer) ret(n int) {
-	lex.top = lex.top - n
-	if lex.top < 0 {
+	if lex.top < n {
+		// unmatched closing brace, keep scanning php code
 		lex.top = 0
+		lex.cs = lexer_en_php
+		lex.p++
+		return
 	}
+
+	lex.top = lex.top - n
 	lex.cs = lex.stack[lex.top]
 	lex.p++
 }
@@ -227,6 +406,16 @@ func (lex *Lexer) ungetCnt(n int) {
 	lex.te = lex.te - n
 }
 
+// TokenLocation returns 1-based line and byte column of the token start,
+// EOF token is located at the end of the input
+func (lex *Lexer) TokenLocation(t *token.Token) (int, int) {
+	if t.Position != nil {
+		return t.Position.StartLine, t.Position.StartCol
+	}
+
+	return lex.newLines.GetLineColumn(len(lex.data))
+}
+
 func (lex *Lexer) error(msg string) {
 	if lex.errHandlerFunc == nil {
 		return
@@ -238,8 +427,13 @@ func (lex *Lexer) error(msg string) {
 		lex.ts,
 		lex.te,
 	)
+	lex.setColumns(pos)
+
+	e := errors.NewError(msg, pos)
+	e.Severity = errors.SeverityWarning
+	e.Column = pos.StartCol
 
-	lex.errHandlerFunc(errors.NewError(msg, pos))
+	lex.errHandlerFunc(e)
 }
 
 func isValidVarNameStart(r byte) bool {
@@ -249,3 +443,117 @@ func isValidVarNameStart(r byte) bool {
 func isValidVarName(r byte) bool {
 	return (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r >= 0x80
 }
+
+// State is a snapshot of the lexer state taken between tokens
+type State struct {
+	cs           int
+	stack        []int
+	heredocLabel []byte
+
+	// offset is where the snapshot is taken, lines are the line starts before it
+	offset int
+	lines  *position.Lines
+}
+
+// Equal reports whether lexing continues the same way from both states
+func (s State) Equal(o State) bool {
+	if s.cs != o.cs || len(s.stack) != len(o.stack) || !bytes.Equal(s.heredocLabel, o.heredocLabel) {
+		return false
+	}
+
+	for i := range s.stack {
+		if s.stack[i] != o.stack[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// State returns the snapshot of the lexer state after the last returned token
+func (lex *Lexer) State() State {
+	s := State{
+		cs:     lex.cs,
+		stack:  make([]int, lex.top),
+		offset: lex.p,
+		lines:  lex.newLines.Cut(lex.p),
+	}
+	copy(s.stack, lex.stack[:lex.top])
+
+	// the label is kept after the heredoc end, ignore it outside the heredoc
+	if isHeredocState(s.cs) || isHeredocState(s.stack...) {
+		s.heredocLabel = make([]byte, len(lex.heredocLabel))
+		copy(s.heredocLabel, lex.heredocLabel)
+	}
+
+	return s
+}
+
+// PHPState returns the state of the lexer in the php code outside of any braces
+func PHPState() State {
+	return State{cs: lexer_en_php}
+}
+
+// FreeFloatingState returns the state after the free floating token ff
+// scanned in the state s
+func FreeFloatingState(s State, ff *token.Token) State {
+	switch {
+	case ff.ID == token.T_OPEN_TAG:
+		return PHPState()
+	case s.cs == lexer_en_main:
+		// shebang line
+		return State{cs: lexer_en_html}
+	}
+
+	// the lines of s end before the token
+	s.lines = nil
+
+	return s
+}
+
+func isHeredocState(states ...int) bool {
+	for _, cs := range states {
+		switch cs {
+		case lexer_en_nowdoc, lexer_en_heredoc, lexer_en_heredoc_end:
+			return true
+		}
+	}
+
+	return false
+}
+
+// Restore continues lexing at the offset p in the saved state,
+// the state may be taken from a lexer of the same source before an edit.
+//
+// The lines saved in the state are reused if p is the state offset, otherwise
+// they are counted from the source start, see RestoreAfterEdit.
+func (lex *Lexer) Restore(s State, p int) {
+	if p == s.offset {
+		lex.RestoreAfterEdit(s, p, p, p)
+		return
+	}
+
+	lex.RestoreAfterEdit(s, p, 0, s.offset)
+}
+
+// RestoreAfterEdit is Restore after the edit replacing the bytes between start and end
+// of the source the state is taken from, the edit ends before the state offset.
+// The lines saved in the state are kept in front of the edit and moved after it,
+// the lines of the states without the saved lines like PHPState are counted
+// from the source start.
+func (lex *Lexer) RestoreAfterEdit(s State, p, start, end int) {
+	lex.p = p
+	lex.ts, lex.te, lex.act = 0, 0, 0
+
+	lex.cs = s.cs
+	lex.stack = append(lex.stack[:0], s.stack...)
+	lex.top = len(s.stack)
+	lex.heredocLabel = s.heredocLabel
+
+	lines := s.lines
+	if lines == nil || start > end || end > s.offset {
+		lines, start, end = &position.Lines{}, 0, s.offset
+	}
+
+	lex.newLines = *lines.Edit(lex.data, start, end, p-s.offset)
+}
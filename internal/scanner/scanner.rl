@@ -20,7 +20,7 @@ func initLexer(lex *Lexer)  {
     %% write init;
 }
 
-func (lex *Lexer) Lex() *token.Token {
+func (lex *Lexer) scan() *token.Token {
     eof := lex.pe
     var tok token.ID
 
@@ -376,6 +376,205 @@ func TestTokens(t *testing.T) {
 	assert.DeepEqual(t, expected, actual)
 }
 
+func TestPhp8Tokens(t *testing.T) {
+	src := `<?php
+	#[Attr] match $a?->b ? -> c $a->match $a ? $b : $c
+	# comment
+	`
+
+	expected := []string{
+		token.T_ATTRIBUTE.String(),
+		token.T_STRING.String(),
+		token.ID(int(']')).String(),
+		token.T_MATCH.String(),
+		token.T_VARIABLE.String(),
+		token.T_NULLSAFE_OBJECT_OPERATOR.String(),
+		token.T_STRING.String(),
+		token.ID(int('?')).String(),
+		token.T_OBJECT_OPERATOR.String(),
+		token.T_STRING.String(),
+		token.T_VARIABLE.String(),
+		token.T_OBJECT_OPERATOR.String(),
+		token.T_STRING.String(),
+		token.T_VARIABLE.String(),
+		token.ID(int('?')).String(),
+		token.T_VARIABLE.String(),
+		token.ID(int(':')).String(),
+		token.T_VARIABLE.String(),
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := NewLexer([]byte(src), config)
+	actual := []string{}
+
+	for {
+		tkn := lexer.Lex()
+		if tkn.ID == 0 {
+			break
+		}
+
+		actual = append(actual, tkn.ID.String())
+	}
+
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp81Tokens(t *testing.T) {
+	src := `<?php
+	enum Foo enum extends readonly readonly() A&B &$a & /* c */ ...$b $a & $b $a&1`
+
+	expected := []string{
+		token.T_ENUM.String(),
+		token.T_STRING.String(),
+		token.T_STRING.String(),
+		token.T_EXTENDS.String(),
+		token.T_READONLY.String(),
+		token.T_STRING.String(),
+		token.ID(int('(')).String(),
+		token.ID(int(')')).String(),
+		token.T_STRING.String(),
+		token.T_AMPERSAND_NOT_FOLLOWED_BY_VAR_OR_VARARG.String(),
+		token.T_STRING.String(),
+		token.ID(int('&')).String(),
+		token.T_VARIABLE.String(),
+		token.ID(int('&')).String(),
+		token.T_ELLIPSIS.String(),
+		token.T_VARIABLE.String(),
+		token.T_VARIABLE.String(),
+		token.ID(int('&')).String(),
+		token.T_VARIABLE.String(),
+		token.T_VARIABLE.String(),
+		token.T_AMPERSAND_NOT_FOLLOWED_BY_VAR_OR_VARARG.String(),
+		token.T_LNUMBER.String(),
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 1,
+		},
+	}
+	lexer := NewLexer([]byte(src), config)
+	actual := []string{}
+
+	for {
+		tkn := lexer.Lex()
+		if tkn.ID == 0 {
+			break
+		}
+
+		actual = append(actual, tkn.ID.String())
+	}
+
+	assert.DeepEqual(t, expected, actual)
+
+	config.Version.Minor = 0
+	lexer = NewLexer([]byte(`<?php enum Foo readonly A&B`), config)
+	actual = []string{}
+
+	for {
+		tkn := lexer.Lex()
+		if tkn.ID == 0 {
+			break
+		}
+
+		actual = append(actual, tkn.ID.String())
+	}
+
+	expected = []string{
+		token.T_STRING.String(),
+		token.T_STRING.String(),
+		token.T_STRING.String(),
+		token.T_STRING.String(),
+		token.ID(int('&')).String(),
+		token.T_STRING.String(),
+	}
+
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8TokensBefore80(t *testing.T) {
+	src := `<?php #[Attr]
+	match $a?->b`
+
+	expected := []string{
+		token.T_STRING.String(),
+		token.T_VARIABLE.String(),
+		token.ID(int('?')).String(),
+		token.T_OBJECT_OPERATOR.String(),
+		token.T_STRING.String(),
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 7,
+			Minor: 4,
+		},
+	}
+	lexer := NewLexer([]byte(src), config)
+	actual := []string{}
+
+	for {
+		tkn := lexer.Lex()
+		if tkn.ID == 0 {
+			break
+		}
+
+		actual = append(actual, tkn.ID.String())
+	}
+
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPhp8MatchInName(t *testing.T) {
+	src := `<?php
+	namespace Foo\Match; use Match\Bar; \match(); match`
+
+	expected := []string{
+		token.T_NAMESPACE.String(),
+		token.T_STRING.String(),
+		token.T_NS_SEPARATOR.String(),
+		token.T_STRING.String(),
+		token.ID(int(';')).String(),
+		token.T_USE.String(),
+		token.T_STRING.String(),
+		token.T_NS_SEPARATOR.String(),
+		token.T_STRING.String(),
+		token.ID(int(';')).String(),
+		token.T_NS_SEPARATOR.String(),
+		token.T_STRING.String(),
+		token.ID(int('(')).String(),
+		token.ID(int(')')).String(),
+		token.ID(int(';')).String(),
+		token.T_MATCH.String(),
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 0,
+		},
+	}
+	lexer := NewLexer([]byte(src), config)
+	actual := []string{}
+
+	for {
+		tkn := lexer.Lex()
+		if tkn.ID == 0 {
+			break
+		}
+
+		actual = append(actual, tkn.ID.String())
+	}
+
+	assert.DeepEqual(t, expected, actual)
+}
+
 func TestShebang(t *testing.T) {
 	src := `#!/usr/bin/env php
 <?php
@@ -1238,6 +1437,75 @@ func TestSlashAfterVariable(t *testing.T) {
 	assert.DeepEqual(t, expectedTokens, actualTokens)
 }
 
+func TestUnmatchedCloseBrace(t *testing.T) {
+	src := `<?php } $foo; "{$bar}"`
+
+	expected := []string{
+		token.ID(int('}')).String(),
+		token.T_VARIABLE.String(),
+		token.ID(int(';')).String(),
+		token.ID(int('"')).String(),
+		token.T_CURLY_OPEN.String(),
+		token.T_VARIABLE.String(),
+		token.ID(int('}')).String(),
+		token.ID(int('"')).String(),
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 7,
+			Minor: 4,
+		},
+	}
+	lexer := NewLexer([]byte(src), config)
+	actual := []string{}
+
+	for {
+		tkn := lexer.Lex()
+		if tkn.ID == 0 {
+			break
+		}
+
+		actual = append(actual, tkn.ID.String())
+	}
+
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestTokenColumns(t *testing.T) {
+	src := "<?php\n$\u00e4 = '\U0001F600'; $b;"
+
+	expected := [][4]int{
+		{1, 4, 1, 3},
+		{5, 6, 4, 5},
+		{7, 13, 6, 10},
+		{13, 14, 10, 11},
+		{15, 17, 12, 14},
+		{17, 18, 14, 15},
+	}
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 7,
+			Minor: 4,
+		},
+	}
+	lexer := NewLexer([]byte(src), config)
+	actual := [][4]int{}
+
+	for {
+		tkn := lexer.Lex()
+		if tkn.ID == 0 {
+			break
+		}
+
+		pos := tkn.Position
+		actual = append(actual, [4]int{pos.StartCol, pos.EndCol, pos.StartColUTF16, pos.EndColUTF16})
+	}
+
+	assert.DeepEqual(t, expected, actual)
+}
+
 func TestCommentEnd(t *testing.T) {
 	src := `<?php //test`
 
@@ -1802,8 +2070,11 @@ func TestIgnoreControllCharacters(t *testing.T) {
 	assert.DeepEqual(t, expected, actual)
 
 	expectedErr := &errors.Error{
-		Msg: "WARNING: Unexpected character in input: '\x04' (ASCII=4)",
-		Pos: &position.Position{StartLine: 1, EndLine: 1, StartPos: 6, EndPos: 7},
+		Msg:      "WARNING: Unexpected character in input: '\x04' (ASCII=4)",
+		Pos:      &position.Position{StartLine: 1, EndLine: 1, StartPos: 6, EndPos: 7, StartCol: 7, EndCol: 8, StartColUTF16: 7, EndColUTF16: 8},
+		Severity: errors.SeverityWarning,
+		Line:     1,
+		Column:   7,
 	}
 	assert.DeepEqual(t, expectedErr, actualErr)
 }
@@ -1898,3 +2169,167 @@ func TestTripleDollar(t *testing.T) {
 	actual = string(tkn.Value)
 	assert.DeepEqual(t, expected, actual)
 }
+
+func lexAll(lex *Lexer) []*token.Token {
+	var tokens []*token.Token
+
+	for {
+		tkn := lex.Lex()
+		tokens = append(tokens, tkn)
+
+		if tkn.ID == 0 {
+			return tokens
+		}
+	}
+}
+
+func TestRestoreState(t *testing.T) {
+	src := "#!/usr/bin/env php\n<html><?php\n" +
+		"$a = <<<EOT\n  foo {$b[1]} $c->d\r\n  bar ${e}\n  EOT;\n" +
+		"$f = <<<'EOT'\nbaz\nEOT;\n" +
+		"echo \"x $g[h] {$i}\", `ls $j`; # comment\r" +
+		"/** doc */ $k?->l; #[Attr] function m() {} ?>\n<?= $n ?>\r\n" +
+		"<?php __halt_compiler(); data"
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+
+	lex := NewLexer([]byte(src), config)
+
+	var offsets []int
+	var states []State
+	var expected []*token.Token
+
+	for {
+		offsets = append(offsets, lex.p)
+		states = append(states, lex.State())
+
+		tkn := lex.Lex()
+		expected = append(expected, tkn)
+
+		if tkn.ID == 0 {
+			break
+		}
+	}
+
+	for i := range states {
+		lex := NewLexer([]byte(src), config)
+		lex.Restore(states[i], offsets[i])
+
+		actual := lexAll(lex)
+
+		assert.Equal(t, len(expected[i:]), len(actual), "token %d", i)
+		for j, tkn := range actual {
+			e := expected[i+j]
+			assert.Equal(t, e.ID, tkn.ID, "token %d", i+j)
+			assert.Equal(t, string(e.Value), string(tkn.Value), "token %d", i+j)
+			assert.DeepEqual(t, e.Position, tkn.Position)
+			assert.DeepEqual(t, e.FreeFloating, tkn.FreeFloating)
+		}
+	}
+}
+
+func TestRestoreStateAfterEdit(t *testing.T) {
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+
+	before := "<?php\n$a = <<<EOT\nfoo\nEOT;\n$b;\n"
+	after := "<?php\n$a = <<<EOT\nfoo $x\nEOT;\n$b;\n"
+
+	// the state at the start of each line
+	lineStates := func(src string) []State {
+		lex := NewLexer([]byte(src), config)
+
+		var states []State
+		line := 0
+		for {
+			for lex.newLines.GetLine(lex.p) > line {
+				states = append(states, lex.State())
+				line++
+			}
+
+			if lex.Lex().ID == 0 {
+				return states
+			}
+		}
+	}
+
+	a := lineStates(before)
+	b := lineStates(after)
+
+	assert.Equal(t, len(a), len(b))
+	assert.Assert(t, a[0].Equal(b[0]))
+	assert.Assert(t, a[1].Equal(b[1]))
+	assert.Assert(t, a[2].Equal(b[2]))
+	assert.Assert(t, !a[2].Equal(a[1]), "heredoc")
+	assert.Assert(t, a[4].Equal(b[4]))
+	assert.Assert(t, a[4].Equal(a[1]), "heredoc label is ignored after the heredoc end")
+
+	lex := NewLexer([]byte(after), config)
+	lex.Restore(a[2], len("<?php\n$a = <<<EOT\n"))
+
+	tkn := lex.Lex()
+	assert.Equal(t, token.T_ENCAPSED_AND_WHITESPACE, tkn.ID)
+	assert.Equal(t, "foo ", string(tkn.Value))
+	assert.Equal(t, 3, tkn.Position.StartLine)
+
+	tkn = lex.Lex()
+	assert.Equal(t, token.T_VARIABLE, tkn.ID)
+	assert.Equal(t, "$x", string(tkn.Value))
+
+	// the lines of the state after the edit are shifted by the edit length
+	edit := len("<?php\n$a = <<<EOT\nfoo")
+	lex = NewLexer([]byte(after), config)
+	lex.RestoreAfterEdit(a[4], a[4].offset+len(after)-len(before), edit, edit)
+
+	tkn = lex.Lex()
+	assert.Equal(t, ";", string(tkn.Value))
+	assert.Equal(t, 5, tkn.Position.StartLine)
+	assert.Equal(t, 3, tkn.Position.StartCol)
+	assert.Equal(t, len("<?php\n$a = <<<EOT\nfoo $x\nEOT;\n$b"), tkn.Position.StartPos)
+}
+
+func TestRestoreStateAfterEditOnLine(t *testing.T) {
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+
+	before := "<?php\n$a = 1; $b;"
+	after := "<?php\n$aaa = 1; $b;"
+
+	lex := NewLexer([]byte(before), config)
+	for lex.Lex().ID != ';' {
+	}
+	state := lex.State()
+
+	// the edit is on the line of the state
+	edit := len("<?php\n$a")
+	lex = NewLexer([]byte(after), config)
+	lex.RestoreAfterEdit(state, state.offset+len(after)-len(before), edit, edit)
+
+	tkn := lex.Lex()
+	assert.Equal(t, "$b", string(tkn.Value))
+	assert.Equal(t, 2, tkn.Position.StartLine)
+	assert.Equal(t, 11, tkn.Position.StartCol)
+
+	// the line break added in front of the state
+	after = "<?php\n$a\n = 1; $b;"
+	lex = NewLexer([]byte(after), config)
+	lex.RestoreAfterEdit(state, state.offset+len(after)-len(before), edit, edit)
+
+	tkn = lex.Lex()
+	assert.Equal(t, "$b", string(tkn.Value))
+	assert.Equal(t, 3, tkn.Position.StartLine)
+	assert.Equal(t, 7, tkn.Position.StartCol)
+}
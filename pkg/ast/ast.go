@@ -1,6 +1,13 @@
 package ast
 
-import "github.com/z7zmey/php-parser/pkg/position"
+import (
+	"reflect"
+
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+//go:generate go run ../../internal/astgen -input node.go -output node_fields.go
 
 type Vertex interface {
 	Accept(v Visitor)
@@ -13,7 +20,13 @@ type Visitor interface {
 	Parameter(n *Parameter)
 	Identifier(n *Identifier)
 	Argument(n *Argument)
+	Attribute(n *Attribute)
+	AttributeGroup(n *AttributeGroup)
+	Union(n *Union)
+	Intersection(n *Intersection)
+	MatchArm(n *MatchArm)
 
+	BadStmt(n *BadStmt)
 	StmtBreak(n *StmtBreak)
 	StmtCase(n *StmtCase)
 	StmtCatch(n *StmtCatch)
@@ -29,6 +42,8 @@ type Visitor interface {
 	StmtEcho(n *StmtEcho)
 	StmtElse(n *StmtElse)
 	StmtElseIf(n *StmtElseIf)
+	StmtEnum(n *StmtEnum)
+	StmtEnumCase(n *StmtEnumCase)
 	StmtExpression(n *StmtExpression)
 	StmtFinally(n *StmtFinally)
 	StmtFor(n *StmtFor)
@@ -62,6 +77,7 @@ type Visitor interface {
 	StmtUseDeclaration(n *StmtUse)
 	StmtWhile(n *StmtWhile)
 
+	BadExpr(n *BadExpr)
 	ExprArray(n *ExprArray)
 	ExprArrayDimFetch(n *ExprArrayDimFetch)
 	ExprArrayItem(n *ExprArrayItem)
@@ -84,8 +100,11 @@ type Visitor interface {
 	ExprInstanceOf(n *ExprInstanceOf)
 	ExprIsset(n *ExprIsset)
 	ExprList(n *ExprList)
+	ExprMatch(n *ExprMatch)
 	ExprMethodCall(n *ExprMethodCall)
 	ExprNew(n *ExprNew)
+	ExprNullsafeMethodCall(n *ExprNullsafeMethodCall)
+	ExprNullsafePropertyFetch(n *ExprNullsafePropertyFetch)
 	ExprPostDec(n *ExprPostDec)
 	ExprPostInc(n *ExprPostInc)
 	ExprPreDec(n *ExprPreDec)
@@ -98,6 +117,7 @@ type Visitor interface {
 	ExprStaticCall(n *ExprStaticCall)
 	ExprStaticPropertyFetch(n *ExprStaticPropertyFetch)
 	ExprTernary(n *ExprTernary)
+	ExprThrow(n *ExprThrow)
 	ExprUnaryMinus(n *ExprUnaryMinus)
 	ExprUnaryPlus(n *ExprUnaryPlus)
 	ExprVariable(n *ExprVariable)
@@ -171,3 +191,108 @@ type Visitor interface {
 	NameRelative(n *NameRelative)
 	NameNamePart(n *NamePart)
 }
+
+// FieldKind is the type of the node field
+type FieldKind int
+
+const (
+	FieldPosition  FieldKind = iota // *position.Position
+	FieldToken                      // *token.Token
+	FieldTokenList                  // []*token.Token
+	FieldNode                       // Vertex
+	FieldNodeList                   // []Vertex
+	FieldValue                      // []byte
+)
+
+// Field describes the node field
+type Field struct {
+	Name string
+	Kind FieldKind
+}
+
+// Child is the child node and the field that holds it,
+// Index is the position in the node list or -1 for the FieldNode fields
+type Child struct {
+	Field string
+	Index int
+	Node  Vertex
+}
+
+func appendChild(c []Child, field string, n Vertex) []Child {
+	if n == nil {
+		return c
+	}
+
+	return append(c, Child{Field: field, Index: -1, Node: n})
+}
+
+func appendChildren(c []Child, field string, list []Vertex) []Child {
+	for i, n := range list {
+		if n != nil {
+			c = append(c, Child{Field: field, Index: i, Node: n})
+		}
+	}
+
+	return c
+}
+
+// Tokens returns the non-nil tokens of the node fields in the declaration order,
+// the tokens of the child nodes are not included
+func Tokens(n Vertex) []*token.Token {
+	var tokens []*token.Token
+
+	v := reflect.ValueOf(n).Elem()
+	for i, f := range Fields(n) {
+		switch f.Kind {
+		case FieldToken:
+			if t, _ := v.Field(i).Interface().(*token.Token); t != nil {
+				tokens = append(tokens, t)
+			}
+		case FieldTokenList:
+			for _, t := range v.Field(i).Interface().([]*token.Token) {
+				if t != nil {
+					tokens = append(tokens, t)
+				}
+			}
+		}
+	}
+
+	return tokens
+}
+
+// FirstToken returns the first token of the node in the field declaration order,
+// the fields of the nodes are declared in the order they are parsed
+func FirstToken(n Vertex) *token.Token {
+	if n == nil {
+		return nil
+	}
+
+	v := reflect.ValueOf(n).Elem()
+	for i, f := range Fields(n) {
+		switch f.Kind {
+		case FieldToken:
+			if t, _ := v.Field(i).Interface().(*token.Token); t != nil {
+				return t
+			}
+		case FieldTokenList:
+			for _, t := range v.Field(i).Interface().([]*token.Token) {
+				if t != nil {
+					return t
+				}
+			}
+		case FieldNode:
+			c, _ := v.Field(i).Interface().(Vertex)
+			if t := FirstToken(c); t != nil {
+				return t
+			}
+		case FieldNodeList:
+			for _, c := range v.Field(i).Interface().([]Vertex) {
+				if t := FirstToken(c); t != nil {
+					return t
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+// Package builder creates the ast nodes.
+//
+// The New* constructors are generated for every node type, they take the child nodes
+// and the values of the node. The fluent builders and the helpers create the common nodes
+// from the names:
+//
+//	class := builder.Class("Foo").Extends("Bar").
+//		Method(builder.Method("save").Public().Returns("void").Body(
+//			builder.Stmt(builder.MethodCall(builder.Var("this"), "flush")),
+//		).Node()).
+//		Node()
+//	builder.Fill(class)
+//
+// The nodes are created without the tokens, Fill sets the tokens of the whole tree
+// by the formatter, so the printed node is valid PHP code. Call Fill once on the root
+// of the built tree and again after the tree is changed.
+// The optional tokens like the reference ampersand or the variadic ellipsis are emitted
+// if they are not nil.
+package builder
+
+//go:generate go run ../../../internal/astgen -builder -input ../node.go -output nodes.go
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/visitor/formatter"
+)
+
+// Fill sets the tokens of the node and its children
+func Fill(n ast.Vertex) {
+	n.Accept(formatter.NewFormatter().WithState(formatter.FormatterStatePHP))
+}
+
+// mark is the placeholder of an optional token, the formatter replaces it
+func mark() *token.Token {
+	return &token.Token{}
+}
+
+// Id returns the identifier
+func Id(value string) *ast.Identifier {
+	return NewIdentifier(value)
+}
+
+// Name returns the name node, the name starting with \ is fully qualified
+// and the name starting with namespace\ is relative
+func Name(name string) ast.Vertex {
+	switch {
+	case strings.HasPrefix(name, `\`):
+		return NewNameFullyQualified(nameParts(name[1:]))
+	case strings.HasPrefix(strings.ToLower(name), `namespace\`):
+		return NewNameRelative(nameParts(name[len(`namespace\`):]))
+	}
+
+	return NewName(nameParts(name))
+}
+
+func nameParts(name string) []ast.Vertex {
+	var parts []ast.Vertex
+	for _, p := range strings.Split(name, `\`) {
+		parts = append(parts, NewNamePart(p))
+	}
+
+	return parts
+}
+
+func names(list []string) []ast.Vertex {
+	var nodes []ast.Vertex
+	for _, n := range list {
+		nodes = append(nodes, Name(n))
+	}
+
+	return nodes
+}
+
+// Type returns the type declaration: ?T is nullable, A|B is union and A&B is intersection
+func Type(typ string) ast.Vertex {
+	switch {
+	case strings.HasPrefix(typ, "?"):
+		return NewNullable(Type(typ[1:]))
+	case strings.Contains(typ, "|"):
+		return NewUnion(types(strings.Split(typ, "|")))
+	case strings.Contains(typ, "&"):
+		return NewIntersection(types(strings.Split(typ, "&")))
+	}
+
+	switch strings.ToLower(typ) {
+	case "array", "callable", "static":
+		return Id(typ)
+	}
+
+	return Name(typ)
+}
+
+func types(list []string) []ast.Vertex {
+	var nodes []ast.Vertex
+	for _, t := range list {
+		nodes = append(nodes, Type(t))
+	}
+
+	return nodes
+}
+
+// Var returns the variable, the name is without the dollar sign
+func Var(name string) *ast.ExprVariable {
+	return NewExprVariable(Id("$" + name))
+}
+
+// String returns the single quoted string
+func String(s string) *ast.ScalarString {
+	s = strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s)
+
+	return NewScalarString("'" + s + "'")
+}
+
+// Int returns the integer number
+func Int(i int) *ast.ScalarLnumber {
+	return NewScalarLnumber(strconv.Itoa(i))
+}
+
+// Float returns the floating point number
+func Float(f float64) *ast.ScalarDnumber {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+
+	return NewScalarDnumber(s)
+}
+
+// Const returns the constant fetch
+func Const(name string) *ast.ExprConstFetch {
+	return NewExprConstFetch(Name(name))
+}
+
+// Bool returns the true or false constant
+func Bool(b bool) *ast.ExprConstFetch {
+	if b {
+		return Const("true")
+	}
+
+	return Const("false")
+}
+
+// Null returns the null constant
+func Null() *ast.ExprConstFetch {
+	return Const("null")
+}
+
+// ClassConst returns the class constant fetch
+func ClassConst(class, name string) *ast.ExprClassConstFetch {
+	return NewExprClassConstFetch(Name(class), Id(name))
+}
+
+// Prop returns the property fetch
+func Prop(v ast.Vertex, name string) *ast.ExprPropertyFetch {
+	return NewExprPropertyFetch(v, Id(name))
+}
+
+// StaticProp returns the static property fetch, the name is without the dollar sign
+func StaticProp(class, name string) *ast.ExprStaticPropertyFetch {
+	return NewExprStaticPropertyFetch(Name(class), Var(name))
+}
+
+// Index returns the array dim fetch, the nil dim appends to the array
+func Index(v, dim ast.Vertex) *ast.ExprArrayDimFetch {
+	return NewExprArrayDimFetch(v, dim)
+}
+
+// args wraps the expressions into the arguments
+func args(exprs []ast.Vertex) []ast.Vertex {
+	var nodes []ast.Vertex
+	for _, e := range exprs {
+		if _, ok := e.(*ast.Argument); !ok {
+			e = NewArgument(nil, e)
+		}
+		nodes = append(nodes, e)
+	}
+
+	return nodes
+}
+
+// Call returns the function call, the arguments are wrapped into ast.Argument
+// unless they are arguments already
+func Call(function string, arguments ...ast.Vertex) *ast.ExprFunctionCall {
+	return NewExprFunctionCall(Name(function), args(arguments))
+}
+
+// MethodCall returns the method call
+func MethodCall(v ast.Vertex, method string, arguments ...ast.Vertex) *ast.ExprMethodCall {
+	return NewExprMethodCall(v, Id(method), args(arguments))
+}
+
+// StaticCall returns the static method call
+func StaticCall(class, method string, arguments ...ast.Vertex) *ast.ExprStaticCall {
+	return NewExprStaticCall(Name(class), Id(method), args(arguments))
+}
+
+// New returns the object creation
+func New(class string, arguments ...ast.Vertex) *ast.ExprNew {
+	return NewExprNew(Name(class), args(arguments))
+}
+
+// Array returns the array, the values are wrapped into ast.ExprArrayItem
+// unless they are array items already
+func Array(items ...ast.Vertex) *ast.ExprArray {
+	var nodes []ast.Vertex
+	for _, i := range items {
+		if _, ok := i.(*ast.ExprArrayItem); !ok {
+			i = Item(nil, i)
+		}
+		nodes = append(nodes, i)
+	}
+
+	return NewExprArray(nodes)
+}
+
+// Item returns the array item, the key may be nil
+func Item(key, val ast.Vertex) *ast.ExprArrayItem {
+	return NewExprArrayItem(key, val)
+}
+
+// Assign returns the assignment
+func Assign(v, expr ast.Vertex) *ast.ExprAssign {
+	return NewExprAssign(v, expr)
+}
+
+// Stmt returns the expression statement
+func Stmt(expr ast.Vertex) *ast.StmtExpression {
+	return NewStmtExpression(expr)
+}
+
+// Return returns the return statement, the expression may be nil
+func Return(expr ast.Vertex) *ast.StmtReturn {
+	return NewStmtReturn(expr)
+}
+
+// Echo returns the echo statement
+func Echo(exprs ...ast.Vertex) *ast.StmtEcho {
+	return NewStmtEcho(exprs)
+}
+
+// If returns the if statement with the braced body
+func If(cond ast.Vertex, stmts ...ast.Vertex) *ast.StmtIf {
+	return NewStmtIf(cond, NewStmtStmtList(stmts), nil, nil)
+}
@@ -0,0 +1,156 @@
+package builder_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/ast/builder"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+)
+
+func print(n ast.Vertex) string {
+	builder.Fill(n)
+
+	o := bytes.NewBufferString("")
+	n.Accept(printer.NewPrinter(o).WithState(printer.PrinterStatePHP))
+
+	return o.String()
+}
+
+// parse checks that the printed statement is valid php code
+func parse(t *testing.T, src string) {
+	_, err := parser.Parse([]byte("<?php "+src), conf.Config{
+		Version: &version.Version{Major: 8, Minor: 3},
+	})
+	assert.NilError(t, err, src)
+}
+
+func TestClass(t *testing.T) {
+	n := builder.Class("Foo").Final().Extends(`\Base`).Implements("Countable", `App\Saver`).
+		Use("Loggable").
+		Const("LIMIT", builder.Int(10)).
+		Property(builder.Property("items").Private().Type("array").Default(builder.Array()).Node()).
+		Method(builder.Method("__construct").Public().
+			Param(builder.Param("name").Private().Readonly().Type("?string").Default(builder.Null()).Node()).
+			Body().
+			Node()).
+		Method(builder.Method("save").Public().Returns("static").
+			Param(
+				builder.Param("items").Type("int|string").Variadic().Node(),
+			).
+			Body(
+				builder.Stmt(builder.Assign(builder.Prop(builder.Var("this"), "items"), builder.Var("items"))),
+				builder.If(builder.Call("count", builder.Var("items")),
+					builder.Echo(builder.String("it's saved"), builder.Float(1)),
+				),
+				builder.Return(builder.Var("this")),
+			).
+			Node()).
+		Node()
+
+	expected := `final class Foo extends \Base implements Countable, App\Saver {
+    use Loggable;
+    const LIMIT = 10;
+    private array $items = array();
+    public function __construct(private readonly ?string $name = null) {}
+    public function save(int|string ...$items): static {
+        $this->items = $items;
+        if (count($items)) {
+            echo 'it\'s saved', 1.0;
+        }
+        return $this;
+    }
+}`
+
+	actual := print(n)
+	assert.Equal(t, expected, actual)
+	parse(t, actual)
+}
+
+func TestInterface(t *testing.T) {
+	n := builder.Interface("Saver").Extends("A", "B").
+		Const("X", builder.String("x")).
+		Method(builder.Method("save").Public().Param(builder.Param("data").ByRef().Node()).Returns("void").Node()).
+		Node()
+
+	expected := `interface Saver extends A, B {
+    const X = 'x';
+    public function save(&$data): void ;
+}`
+
+	actual := print(n)
+	assert.Equal(t, expected, actual)
+	parse(t, actual)
+}
+
+func TestTraitAndFunction(t *testing.T) {
+	trait := builder.Trait("T").
+		Property(builder.Property("count").Static().Type("int").Default(builder.Int(0)).Node()).
+		Method(builder.Method("inc").Abstract().Protected().Node()).
+		Node()
+
+	expected := `trait T {
+    static int $count = 0;
+    abstract protected function inc() ;
+}`
+	assert.Equal(t, expected, print(trait))
+
+	fn := builder.Function("make").ByRef().Param(builder.Param("a").Node()).Body(
+		builder.Return(builder.New(`namespace\Foo`, builder.Var("a"), builder.ClassConst("Foo", "BAR"))),
+	).Node()
+
+	expected = `function &make($a) {
+    return new namespace\Foo($a, Foo::BAR);
+}`
+	actual := print(fn)
+	assert.Equal(t, expected, actual)
+	parse(t, actual)
+}
+
+func TestGenerated(t *testing.T) {
+	n := builder.NewExprTernary(
+		builder.NewExprBinarySmaller(builder.Var("a"), builder.Int(1)),
+		builder.NewExprBooleanNot(builder.Var("b")),
+		builder.StaticCall("Foo", "bar", builder.Index(builder.Var("c"), builder.String("k"))),
+	)
+
+	assert.Equal(t, `$a < 1 ? !$b : Foo::bar($c['k'])`, print(n))
+
+	stmt := builder.NewStmtForeach(builder.Var("list"), builder.Var("k"), builder.Var("v"),
+		builder.NewStmtStmtList([]ast.Vertex{
+			builder.Stmt(builder.MethodCall(builder.Var("this"), "add", builder.Var("k"), builder.Var("v"))),
+		}),
+	)
+
+	expected := `foreach($list as $k => $v) {
+    $this->add($k, $v);
+}`
+	actual := print(stmt)
+	assert.Equal(t, expected, actual)
+	parse(t, actual)
+}
+
+func TestFill(t *testing.T) {
+	n := builder.Call("f", builder.Var("a"))
+	n.Args[0].(*ast.Argument).Name = builder.Id("value")
+	builder.Fill(n)
+
+	assert.Equal(t, `f(value: $a)`, print(n))
+}
+
+func TestEmptyDimAndItem(t *testing.T) {
+	n := builder.Stmt(builder.Assign(
+		builder.NewExprList([]ast.Vertex{builder.Item(nil, nil), builder.Item(nil, builder.Var("a"))}),
+		builder.Index(builder.Var("b"), nil),
+	))
+
+	actual := print(n)
+	assert.Equal(t, `list(, $a) = $b[];`, actual)
+	parse(t, actual)
+}
@@ -0,0 +1,218 @@
+package builder
+
+import (
+	"github.com/z7zmey/php-parser/pkg/ast"
+)
+
+// ClassBuilder builds the class declaration
+type ClassBuilder struct {
+	n *ast.StmtClass
+}
+
+// Class starts the class declaration
+func Class(name string) *ClassBuilder {
+	return &ClassBuilder{
+		n: &ast.StmtClass{Name: Id(name)},
+	}
+}
+
+// Abstract adds the abstract modifier
+func (b *ClassBuilder) Abstract() *ClassBuilder {
+	b.n.Modifiers = append(b.n.Modifiers, Id("abstract"))
+	return b
+}
+
+// Final adds the final modifier
+func (b *ClassBuilder) Final() *ClassBuilder {
+	b.n.Modifiers = append(b.n.Modifiers, Id("final"))
+	return b
+}
+
+// Readonly adds the readonly modifier
+func (b *ClassBuilder) Readonly() *ClassBuilder {
+	b.n.Modifiers = append(b.n.Modifiers, Id("readonly"))
+	return b
+}
+
+// Extends sets the parent class
+func (b *ClassBuilder) Extends(name string) *ClassBuilder {
+	b.n.Extends = Name(name)
+	return b
+}
+
+// Implements adds the interfaces
+func (b *ClassBuilder) Implements(interfaces ...string) *ClassBuilder {
+	b.n.Implements = append(b.n.Implements, names(interfaces)...)
+	return b
+}
+
+// Use adds the trait use statement
+func (b *ClassBuilder) Use(traits ...string) *ClassBuilder {
+	b.n.Stmts = append(b.n.Stmts, NewStmtTraitUse(names(traits), nil))
+	return b
+}
+
+// Const adds the class constant
+func (b *ClassBuilder) Const(name string, value ast.Vertex) *ClassBuilder {
+	b.n.Stmts = append(b.n.Stmts, classConst(name, value))
+	return b
+}
+
+// Property adds the property, see Property
+func (b *ClassBuilder) Property(p ast.Vertex) *ClassBuilder {
+	b.n.Stmts = append(b.n.Stmts, p)
+	return b
+}
+
+// Method adds the method, see Method
+func (b *ClassBuilder) Method(m ast.Vertex) *ClassBuilder {
+	b.n.Stmts = append(b.n.Stmts, m)
+	return b
+}
+
+// Node returns the class
+func (b *ClassBuilder) Node() *ast.StmtClass {
+	return b.n
+}
+
+// InterfaceBuilder builds the interface declaration
+type InterfaceBuilder struct {
+	n *ast.StmtInterface
+}
+
+// Interface starts the interface declaration
+func Interface(name string) *InterfaceBuilder {
+	return &InterfaceBuilder{
+		n: &ast.StmtInterface{Name: Id(name)},
+	}
+}
+
+// Extends adds the parent interfaces
+func (b *InterfaceBuilder) Extends(interfaces ...string) *InterfaceBuilder {
+	b.n.Extends = append(b.n.Extends, names(interfaces)...)
+	return b
+}
+
+// Const adds the interface constant
+func (b *InterfaceBuilder) Const(name string, value ast.Vertex) *InterfaceBuilder {
+	b.n.Stmts = append(b.n.Stmts, classConst(name, value))
+	return b
+}
+
+// Method adds the method, the method without the body is declared with a semicolon
+func (b *InterfaceBuilder) Method(m ast.Vertex) *InterfaceBuilder {
+	b.n.Stmts = append(b.n.Stmts, m)
+	return b
+}
+
+// Node returns the interface
+func (b *InterfaceBuilder) Node() *ast.StmtInterface {
+	return b.n
+}
+
+// TraitBuilder builds the trait declaration
+type TraitBuilder struct {
+	n *ast.StmtTrait
+}
+
+// Trait starts the trait declaration
+func Trait(name string) *TraitBuilder {
+	return &TraitBuilder{
+		n: &ast.StmtTrait{Name: Id(name)},
+	}
+}
+
+// Use adds the trait use statement
+func (b *TraitBuilder) Use(traits ...string) *TraitBuilder {
+	b.n.Stmts = append(b.n.Stmts, NewStmtTraitUse(names(traits), nil))
+	return b
+}
+
+// Property adds the property, see Property
+func (b *TraitBuilder) Property(p ast.Vertex) *TraitBuilder {
+	b.n.Stmts = append(b.n.Stmts, p)
+	return b
+}
+
+// Method adds the method, see Method
+func (b *TraitBuilder) Method(m ast.Vertex) *TraitBuilder {
+	b.n.Stmts = append(b.n.Stmts, m)
+	return b
+}
+
+// Node returns the trait
+func (b *TraitBuilder) Node() *ast.StmtTrait {
+	return b.n
+}
+
+func classConst(name string, value ast.Vertex) *ast.StmtClassConstList {
+	return NewStmtClassConstList(nil, nil, nil, []ast.Vertex{NewStmtConstant(Id(name), value)})
+}
+
+// PropertyBuilder builds the property declaration
+type PropertyBuilder struct {
+	n    *ast.StmtPropertyList
+	prop *ast.StmtProperty
+}
+
+// Property starts the property declaration, the name is without the dollar sign.
+// The property without modifiers is public.
+func Property(name string) *PropertyBuilder {
+	prop := &ast.StmtProperty{Var: Var(name)}
+
+	return &PropertyBuilder{
+		n:    &ast.StmtPropertyList{Props: []ast.Vertex{prop}},
+		prop: prop,
+	}
+}
+
+// Public adds the public modifier
+func (b *PropertyBuilder) Public() *PropertyBuilder {
+	return b.modifier("public")
+}
+
+// Protected adds the protected modifier
+func (b *PropertyBuilder) Protected() *PropertyBuilder {
+	return b.modifier("protected")
+}
+
+// Private adds the private modifier
+func (b *PropertyBuilder) Private() *PropertyBuilder {
+	return b.modifier("private")
+}
+
+// Static adds the static modifier
+func (b *PropertyBuilder) Static() *PropertyBuilder {
+	return b.modifier("static")
+}
+
+// Readonly adds the readonly modifier
+func (b *PropertyBuilder) Readonly() *PropertyBuilder {
+	return b.modifier("readonly")
+}
+
+func (b *PropertyBuilder) modifier(m string) *PropertyBuilder {
+	b.n.Modifiers = append(b.n.Modifiers, Id(m))
+	return b
+}
+
+// Type sets the property type, see Type
+func (b *PropertyBuilder) Type(typ string) *PropertyBuilder {
+	b.n.Type = Type(typ)
+	return b
+}
+
+// Default sets the default value
+func (b *PropertyBuilder) Default(value ast.Vertex) *PropertyBuilder {
+	b.prop.Expr = value
+	return b
+}
+
+// Node returns the property list
+func (b *PropertyBuilder) Node() *ast.StmtPropertyList {
+	if len(b.n.Modifiers) == 0 {
+		b.n.Modifiers = []ast.Vertex{Id("public")}
+	}
+
+	return b.n
+}
@@ -0,0 +1,197 @@
+package builder
+
+import (
+	"github.com/z7zmey/php-parser/pkg/ast"
+)
+
+// FunctionBuilder builds the function declaration
+type FunctionBuilder struct {
+	n *ast.StmtFunction
+}
+
+// Function starts the function declaration
+func Function(name string) *FunctionBuilder {
+	return &FunctionBuilder{
+		n: &ast.StmtFunction{Name: Id(name)},
+	}
+}
+
+// ByRef makes the function return by reference
+func (b *FunctionBuilder) ByRef() *FunctionBuilder {
+	b.n.AmpersandTkn = mark()
+	return b
+}
+
+// Param adds the parameters, see Param
+func (b *FunctionBuilder) Param(params ...ast.Vertex) *FunctionBuilder {
+	b.n.Params = append(b.n.Params, params...)
+	return b
+}
+
+// Returns sets the return type, see Type
+func (b *FunctionBuilder) Returns(typ string) *FunctionBuilder {
+	b.n.ReturnType = Type(typ)
+	return b
+}
+
+// Body adds the statements
+func (b *FunctionBuilder) Body(stmts ...ast.Vertex) *FunctionBuilder {
+	b.n.Stmts = append(b.n.Stmts, stmts...)
+	return b
+}
+
+// Node returns the function
+func (b *FunctionBuilder) Node() *ast.StmtFunction {
+	return b.n
+}
+
+// MethodBuilder builds the method declaration
+type MethodBuilder struct {
+	n     *ast.StmtClassMethod
+	stmts []ast.Vertex
+	body  bool
+}
+
+// Method starts the method declaration. The method is declared with a semicolon
+// instead of the body unless Body is called.
+func Method(name string) *MethodBuilder {
+	return &MethodBuilder{
+		n: &ast.StmtClassMethod{Name: Id(name)},
+	}
+}
+
+// Public adds the public modifier
+func (b *MethodBuilder) Public() *MethodBuilder {
+	return b.modifier("public")
+}
+
+// Protected adds the protected modifier
+func (b *MethodBuilder) Protected() *MethodBuilder {
+	return b.modifier("protected")
+}
+
+// Private adds the private modifier
+func (b *MethodBuilder) Private() *MethodBuilder {
+	return b.modifier("private")
+}
+
+// Static adds the static modifier
+func (b *MethodBuilder) Static() *MethodBuilder {
+	return b.modifier("static")
+}
+
+// Abstract adds the abstract modifier
+func (b *MethodBuilder) Abstract() *MethodBuilder {
+	return b.modifier("abstract")
+}
+
+// Final adds the final modifier
+func (b *MethodBuilder) Final() *MethodBuilder {
+	return b.modifier("final")
+}
+
+func (b *MethodBuilder) modifier(m string) *MethodBuilder {
+	b.n.Modifiers = append(b.n.Modifiers, Id(m))
+	return b
+}
+
+// ByRef makes the method return by reference
+func (b *MethodBuilder) ByRef() *MethodBuilder {
+	b.n.AmpersandTkn = mark()
+	return b
+}
+
+// Param adds the parameters, see Param
+func (b *MethodBuilder) Param(params ...ast.Vertex) *MethodBuilder {
+	b.n.Params = append(b.n.Params, params...)
+	return b
+}
+
+// Returns sets the return type, see Type
+func (b *MethodBuilder) Returns(typ string) *MethodBuilder {
+	b.n.ReturnType = Type(typ)
+	return b
+}
+
+// Body adds the statements, the body without statements is empty braces
+func (b *MethodBuilder) Body(stmts ...ast.Vertex) *MethodBuilder {
+	b.stmts = append(b.stmts, stmts...)
+	b.body = true
+	return b
+}
+
+// Node returns the method
+func (b *MethodBuilder) Node() *ast.StmtClassMethod {
+	b.n.Stmt = &ast.StmtNop{}
+	if b.body {
+		b.n.Stmt = &ast.StmtStmtList{Stmts: b.stmts}
+	}
+
+	return b.n
+}
+
+// ParamBuilder builds the function or method parameter
+type ParamBuilder struct {
+	n *ast.Parameter
+}
+
+// Param starts the parameter, the name is without the dollar sign
+func Param(name string) *ParamBuilder {
+	return &ParamBuilder{
+		n: &ast.Parameter{Var: Var(name)},
+	}
+}
+
+// Type sets the parameter type, see Type
+func (b *ParamBuilder) Type(typ string) *ParamBuilder {
+	b.n.Type = Type(typ)
+	return b
+}
+
+// Default sets the default value
+func (b *ParamBuilder) Default(value ast.Vertex) *ParamBuilder {
+	b.n.DefaultValue = value
+	return b
+}
+
+// ByRef makes the parameter passed by reference
+func (b *ParamBuilder) ByRef() *ParamBuilder {
+	b.n.AmpersandTkn = mark()
+	return b
+}
+
+// Variadic makes the parameter variadic
+func (b *ParamBuilder) Variadic() *ParamBuilder {
+	b.n.VariadicTkn = mark()
+	return b
+}
+
+// Public promotes the constructor parameter to the public property
+func (b *ParamBuilder) Public() *ParamBuilder {
+	return b.modifier("public")
+}
+
+// Protected promotes the constructor parameter to the protected property
+func (b *ParamBuilder) Protected() *ParamBuilder {
+	return b.modifier("protected")
+}
+
+// Private promotes the constructor parameter to the private property
+func (b *ParamBuilder) Private() *ParamBuilder {
+	return b.modifier("private")
+}
+
+// Readonly adds the readonly modifier of the promoted property
+func (b *ParamBuilder) Readonly() *ParamBuilder {
+	return b.modifier("readonly")
+}
+
+func (b *ParamBuilder) modifier(m string) *ParamBuilder {
+	b.n.Modifiers = append(b.n.Modifiers, Id(m))
+	return b
+}
+
+// Node returns the parameter
+func (b *ParamBuilder) Node() *ast.Parameter {
+	return b.n
+}
@@ -0,0 +1,1315 @@
+// Code generated by "astgen -builder -input ../node.go -output nodes.go"; DO NOT EDIT.
+
+package builder
+
+import "github.com/z7zmey/php-parser/pkg/ast"
+
+// NewRoot returns the Root node without the tokens
+func NewRoot(stmts []ast.Vertex) *ast.Root {
+	return &ast.Root{
+		Stmts: stmts,
+	}
+}
+
+// NewNullable returns the Nullable node without the tokens
+func NewNullable(expr ast.Vertex) *ast.Nullable {
+	return &ast.Nullable{
+		Expr: expr,
+	}
+}
+
+// NewParameter returns the Parameter node without the tokens
+func NewParameter(attrGroups []ast.Vertex, modifiers []ast.Vertex, typ ast.Vertex, variable ast.Vertex, defaultValue ast.Vertex) *ast.Parameter {
+	return &ast.Parameter{
+		AttrGroups:   attrGroups,
+		Modifiers:    modifiers,
+		Type:         typ,
+		Var:          variable,
+		DefaultValue: defaultValue,
+	}
+}
+
+// NewIdentifier returns the Identifier node without the tokens
+func NewIdentifier(value string) *ast.Identifier {
+	return &ast.Identifier{
+		Value: []byte(value),
+	}
+}
+
+// NewArgument returns the Argument node without the tokens
+func NewArgument(name ast.Vertex, expr ast.Vertex) *ast.Argument {
+	return &ast.Argument{
+		Name: name,
+		Expr: expr,
+	}
+}
+
+// NewAttribute returns the Attribute node without the tokens
+func NewAttribute(name ast.Vertex, args []ast.Vertex) *ast.Attribute {
+	return &ast.Attribute{
+		Name: name,
+		Args: args,
+	}
+}
+
+// NewAttributeGroup returns the AttributeGroup node without the tokens
+func NewAttributeGroup(attrs []ast.Vertex) *ast.AttributeGroup {
+	return &ast.AttributeGroup{
+		Attrs: attrs,
+	}
+}
+
+// NewUnion returns the Union node without the tokens
+func NewUnion(types []ast.Vertex) *ast.Union {
+	return &ast.Union{
+		Types: types,
+	}
+}
+
+// NewIntersection returns the Intersection node without the tokens
+func NewIntersection(types []ast.Vertex) *ast.Intersection {
+	return &ast.Intersection{
+		Types: types,
+	}
+}
+
+// NewMatchArm returns the MatchArm node without the tokens
+func NewMatchArm(exprs []ast.Vertex, returnExpr ast.Vertex) *ast.MatchArm {
+	return &ast.MatchArm{
+		Exprs:      exprs,
+		ReturnExpr: returnExpr,
+	}
+}
+
+// NewScalarDnumber returns the ScalarDnumber node without the tokens
+func NewScalarDnumber(value string) *ast.ScalarDnumber {
+	return &ast.ScalarDnumber{
+		Value: []byte(value),
+	}
+}
+
+// NewScalarEncapsed returns the ScalarEncapsed node without the tokens
+func NewScalarEncapsed(parts []ast.Vertex) *ast.ScalarEncapsed {
+	return &ast.ScalarEncapsed{
+		Parts: parts,
+	}
+}
+
+// NewScalarEncapsedStringPart returns the ScalarEncapsedStringPart node without the tokens
+func NewScalarEncapsedStringPart(value string) *ast.ScalarEncapsedStringPart {
+	return &ast.ScalarEncapsedStringPart{
+		Value: []byte(value),
+	}
+}
+
+// NewScalarEncapsedStringVar returns the ScalarEncapsedStringVar node without the tokens
+func NewScalarEncapsedStringVar(name ast.Vertex, dim ast.Vertex) *ast.ScalarEncapsedStringVar {
+	return &ast.ScalarEncapsedStringVar{
+		Name: name,
+		Dim:  dim,
+	}
+}
+
+// NewScalarEncapsedStringBrackets returns the ScalarEncapsedStringBrackets node without the tokens
+func NewScalarEncapsedStringBrackets(variable ast.Vertex) *ast.ScalarEncapsedStringBrackets {
+	return &ast.ScalarEncapsedStringBrackets{
+		Var: variable,
+	}
+}
+
+// NewScalarHeredoc returns the ScalarHeredoc node without the tokens
+func NewScalarHeredoc(parts []ast.Vertex) *ast.ScalarHeredoc {
+	return &ast.ScalarHeredoc{
+		Parts: parts,
+	}
+}
+
+// NewScalarLnumber returns the ScalarLnumber node without the tokens
+func NewScalarLnumber(value string) *ast.ScalarLnumber {
+	return &ast.ScalarLnumber{
+		Value: []byte(value),
+	}
+}
+
+// NewScalarMagicConstant returns the ScalarMagicConstant node without the tokens
+func NewScalarMagicConstant(value string) *ast.ScalarMagicConstant {
+	return &ast.ScalarMagicConstant{
+		Value: []byte(value),
+	}
+}
+
+// NewScalarString returns the ScalarString node without the tokens
+func NewScalarString(value string) *ast.ScalarString {
+	return &ast.ScalarString{
+		Value: []byte(value),
+	}
+}
+
+// NewBadStmt returns the BadStmt node without the tokens
+func NewBadStmt() *ast.BadStmt {
+	return &ast.BadStmt{}
+}
+
+// NewStmtBreak returns the StmtBreak node without the tokens
+func NewStmtBreak(expr ast.Vertex) *ast.StmtBreak {
+	return &ast.StmtBreak{
+		Expr: expr,
+	}
+}
+
+// NewStmtCase returns the StmtCase node without the tokens
+func NewStmtCase(cond ast.Vertex, stmts []ast.Vertex) *ast.StmtCase {
+	return &ast.StmtCase{
+		Cond:  cond,
+		Stmts: stmts,
+	}
+}
+
+// NewStmtCatch returns the StmtCatch node without the tokens
+func NewStmtCatch(types []ast.Vertex, variable ast.Vertex, stmts []ast.Vertex) *ast.StmtCatch {
+	return &ast.StmtCatch{
+		Types: types,
+		Var:   variable,
+		Stmts: stmts,
+	}
+}
+
+// NewStmtClass returns the StmtClass node without the tokens
+func NewStmtClass(attrGroups []ast.Vertex, modifiers []ast.Vertex, name ast.Vertex, args []ast.Vertex, extends ast.Vertex, implements []ast.Vertex, stmts []ast.Vertex) *ast.StmtClass {
+	return &ast.StmtClass{
+		AttrGroups: attrGroups,
+		Modifiers:  modifiers,
+		Name:       name,
+		Args:       args,
+		Extends:    extends,
+		Implements: implements,
+		Stmts:      stmts,
+	}
+}
+
+// NewStmtClassConstList returns the StmtClassConstList node without the tokens
+func NewStmtClassConstList(attrGroups []ast.Vertex, modifiers []ast.Vertex, typ ast.Vertex, consts []ast.Vertex) *ast.StmtClassConstList {
+	return &ast.StmtClassConstList{
+		AttrGroups: attrGroups,
+		Modifiers:  modifiers,
+		Type:       typ,
+		Consts:     consts,
+	}
+}
+
+// NewStmtClassMethod returns the StmtClassMethod node without the tokens
+func NewStmtClassMethod(attrGroups []ast.Vertex, modifiers []ast.Vertex, name ast.Vertex, params []ast.Vertex, returnType ast.Vertex, stmt ast.Vertex) *ast.StmtClassMethod {
+	return &ast.StmtClassMethod{
+		AttrGroups: attrGroups,
+		Modifiers:  modifiers,
+		Name:       name,
+		Params:     params,
+		ReturnType: returnType,
+		Stmt:       stmt,
+	}
+}
+
+// NewStmtConstList returns the StmtConstList node without the tokens
+func NewStmtConstList(consts []ast.Vertex) *ast.StmtConstList {
+	return &ast.StmtConstList{
+		Consts: consts,
+	}
+}
+
+// NewStmtConstant returns the StmtConstant node without the tokens
+func NewStmtConstant(name ast.Vertex, expr ast.Vertex) *ast.StmtConstant {
+	return &ast.StmtConstant{
+		Name: name,
+		Expr: expr,
+	}
+}
+
+// NewStmtContinue returns the StmtContinue node without the tokens
+func NewStmtContinue(expr ast.Vertex) *ast.StmtContinue {
+	return &ast.StmtContinue{
+		Expr: expr,
+	}
+}
+
+// NewStmtDeclare returns the StmtDeclare node without the tokens
+func NewStmtDeclare(consts []ast.Vertex, stmt ast.Vertex) *ast.StmtDeclare {
+	return &ast.StmtDeclare{
+		Consts: consts,
+		Stmt:   stmt,
+	}
+}
+
+// NewStmtDefault returns the StmtDefault node without the tokens
+func NewStmtDefault(stmts []ast.Vertex) *ast.StmtDefault {
+	return &ast.StmtDefault{
+		Stmts: stmts,
+	}
+}
+
+// NewStmtDo returns the StmtDo node without the tokens
+func NewStmtDo(stmt ast.Vertex, cond ast.Vertex) *ast.StmtDo {
+	return &ast.StmtDo{
+		Stmt: stmt,
+		Cond: cond,
+	}
+}
+
+// NewStmtEcho returns the StmtEcho node without the tokens
+func NewStmtEcho(exprs []ast.Vertex) *ast.StmtEcho {
+	return &ast.StmtEcho{
+		Exprs: exprs,
+	}
+}
+
+// NewStmtElse returns the StmtElse node without the tokens
+func NewStmtElse(stmt ast.Vertex) *ast.StmtElse {
+	return &ast.StmtElse{
+		Stmt: stmt,
+	}
+}
+
+// NewStmtElseIf returns the StmtElseIf node without the tokens
+func NewStmtElseIf(cond ast.Vertex, stmt ast.Vertex) *ast.StmtElseIf {
+	return &ast.StmtElseIf{
+		Cond: cond,
+		Stmt: stmt,
+	}
+}
+
+// NewStmtEnum returns the StmtEnum node without the tokens
+func NewStmtEnum(attrGroups []ast.Vertex, name ast.Vertex, typ ast.Vertex, implements []ast.Vertex, stmts []ast.Vertex) *ast.StmtEnum {
+	return &ast.StmtEnum{
+		AttrGroups: attrGroups,
+		Name:       name,
+		Type:       typ,
+		Implements: implements,
+		Stmts:      stmts,
+	}
+}
+
+// NewStmtEnumCase returns the StmtEnumCase node without the tokens
+func NewStmtEnumCase(attrGroups []ast.Vertex, name ast.Vertex, expr ast.Vertex) *ast.StmtEnumCase {
+	return &ast.StmtEnumCase{
+		AttrGroups: attrGroups,
+		Name:       name,
+		Expr:       expr,
+	}
+}
+
+// NewStmtExpression returns the StmtExpression node without the tokens
+func NewStmtExpression(expr ast.Vertex) *ast.StmtExpression {
+	return &ast.StmtExpression{
+		Expr: expr,
+	}
+}
+
+// NewStmtFinally returns the StmtFinally node without the tokens
+func NewStmtFinally(stmts []ast.Vertex) *ast.StmtFinally {
+	return &ast.StmtFinally{
+		Stmts: stmts,
+	}
+}
+
+// NewStmtFor returns the StmtFor node without the tokens
+func NewStmtFor(init []ast.Vertex, cond []ast.Vertex, loop []ast.Vertex, stmt ast.Vertex) *ast.StmtFor {
+	return &ast.StmtFor{
+		Init: init,
+		Cond: cond,
+		Loop: loop,
+		Stmt: stmt,
+	}
+}
+
+// NewStmtForeach returns the StmtForeach node without the tokens
+func NewStmtForeach(expr ast.Vertex, key ast.Vertex, variable ast.Vertex, stmt ast.Vertex) *ast.StmtForeach {
+	return &ast.StmtForeach{
+		Expr: expr,
+		Key:  key,
+		Var:  variable,
+		Stmt: stmt,
+	}
+}
+
+// NewStmtFunction returns the StmtFunction node without the tokens
+func NewStmtFunction(attrGroups []ast.Vertex, name ast.Vertex, params []ast.Vertex, returnType ast.Vertex, stmts []ast.Vertex) *ast.StmtFunction {
+	return &ast.StmtFunction{
+		AttrGroups: attrGroups,
+		Name:       name,
+		Params:     params,
+		ReturnType: returnType,
+		Stmts:      stmts,
+	}
+}
+
+// NewStmtGlobal returns the StmtGlobal node without the tokens
+func NewStmtGlobal(vars []ast.Vertex) *ast.StmtGlobal {
+	return &ast.StmtGlobal{
+		Vars: vars,
+	}
+}
+
+// NewStmtGoto returns the StmtGoto node without the tokens
+func NewStmtGoto(label ast.Vertex) *ast.StmtGoto {
+	return &ast.StmtGoto{
+		Label: label,
+	}
+}
+
+// NewStmtHaltCompiler returns the StmtHaltCompiler node without the tokens
+func NewStmtHaltCompiler() *ast.StmtHaltCompiler {
+	return &ast.StmtHaltCompiler{}
+}
+
+// NewStmtIf returns the StmtIf node without the tokens
+func NewStmtIf(cond ast.Vertex, stmt ast.Vertex, elseIf []ast.Vertex, elseStmt ast.Vertex) *ast.StmtIf {
+	return &ast.StmtIf{
+		Cond:   cond,
+		Stmt:   stmt,
+		ElseIf: elseIf,
+		Else:   elseStmt,
+	}
+}
+
+// NewStmtInlineHtml returns the StmtInlineHtml node without the tokens
+func NewStmtInlineHtml(value string) *ast.StmtInlineHtml {
+	return &ast.StmtInlineHtml{
+		Value: []byte(value),
+	}
+}
+
+// NewStmtInterface returns the StmtInterface node without the tokens
+func NewStmtInterface(attrGroups []ast.Vertex, name ast.Vertex, extends []ast.Vertex, stmts []ast.Vertex) *ast.StmtInterface {
+	return &ast.StmtInterface{
+		AttrGroups: attrGroups,
+		Name:       name,
+		Extends:    extends,
+		Stmts:      stmts,
+	}
+}
+
+// NewStmtLabel returns the StmtLabel node without the tokens
+func NewStmtLabel(name ast.Vertex) *ast.StmtLabel {
+	return &ast.StmtLabel{
+		Name: name,
+	}
+}
+
+// NewStmtNamespace returns the StmtNamespace node without the tokens
+func NewStmtNamespace(name ast.Vertex, stmts []ast.Vertex) *ast.StmtNamespace {
+	return &ast.StmtNamespace{
+		Name:  name,
+		Stmts: stmts,
+	}
+}
+
+// NewStmtNop returns the StmtNop node without the tokens
+func NewStmtNop() *ast.StmtNop {
+	return &ast.StmtNop{}
+}
+
+// NewStmtProperty returns the StmtProperty node without the tokens
+func NewStmtProperty(variable ast.Vertex, expr ast.Vertex) *ast.StmtProperty {
+	return &ast.StmtProperty{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewStmtPropertyList returns the StmtPropertyList node without the tokens
+func NewStmtPropertyList(attrGroups []ast.Vertex, modifiers []ast.Vertex, typ ast.Vertex, props []ast.Vertex) *ast.StmtPropertyList {
+	return &ast.StmtPropertyList{
+		AttrGroups: attrGroups,
+		Modifiers:  modifiers,
+		Type:       typ,
+		Props:      props,
+	}
+}
+
+// NewStmtReturn returns the StmtReturn node without the tokens
+func NewStmtReturn(expr ast.Vertex) *ast.StmtReturn {
+	return &ast.StmtReturn{
+		Expr: expr,
+	}
+}
+
+// NewStmtStatic returns the StmtStatic node without the tokens
+func NewStmtStatic(vars []ast.Vertex) *ast.StmtStatic {
+	return &ast.StmtStatic{
+		Vars: vars,
+	}
+}
+
+// NewStmtStaticVar returns the StmtStaticVar node without the tokens
+func NewStmtStaticVar(variable ast.Vertex, expr ast.Vertex) *ast.StmtStaticVar {
+	return &ast.StmtStaticVar{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewStmtStmtList returns the StmtStmtList node without the tokens
+func NewStmtStmtList(stmts []ast.Vertex) *ast.StmtStmtList {
+	return &ast.StmtStmtList{
+		Stmts: stmts,
+	}
+}
+
+// NewStmtSwitch returns the StmtSwitch node without the tokens
+func NewStmtSwitch(cond ast.Vertex, cases []ast.Vertex) *ast.StmtSwitch {
+	return &ast.StmtSwitch{
+		Cond:  cond,
+		Cases: cases,
+	}
+}
+
+// NewStmtThrow returns the StmtThrow node without the tokens
+func NewStmtThrow(expr ast.Vertex) *ast.StmtThrow {
+	return &ast.StmtThrow{
+		Expr: expr,
+	}
+}
+
+// NewStmtTrait returns the StmtTrait node without the tokens
+func NewStmtTrait(attrGroups []ast.Vertex, name ast.Vertex, stmts []ast.Vertex) *ast.StmtTrait {
+	return &ast.StmtTrait{
+		AttrGroups: attrGroups,
+		Name:       name,
+		Stmts:      stmts,
+	}
+}
+
+// NewStmtTraitUse returns the StmtTraitUse node without the tokens
+func NewStmtTraitUse(traits []ast.Vertex, adaptations []ast.Vertex) *ast.StmtTraitUse {
+	return &ast.StmtTraitUse{
+		Traits:      traits,
+		Adaptations: adaptations,
+	}
+}
+
+// NewStmtTraitUseAlias returns the StmtTraitUseAlias node without the tokens
+func NewStmtTraitUseAlias(trait ast.Vertex, method ast.Vertex, modifier ast.Vertex, alias ast.Vertex) *ast.StmtTraitUseAlias {
+	return &ast.StmtTraitUseAlias{
+		Trait:    trait,
+		Method:   method,
+		Modifier: modifier,
+		Alias:    alias,
+	}
+}
+
+// NewStmtTraitUsePrecedence returns the StmtTraitUsePrecedence node without the tokens
+func NewStmtTraitUsePrecedence(trait ast.Vertex, method ast.Vertex, insteadof []ast.Vertex) *ast.StmtTraitUsePrecedence {
+	return &ast.StmtTraitUsePrecedence{
+		Trait:     trait,
+		Method:    method,
+		Insteadof: insteadof,
+	}
+}
+
+// NewStmtTry returns the StmtTry node without the tokens
+func NewStmtTry(stmts []ast.Vertex, catches []ast.Vertex, finally ast.Vertex) *ast.StmtTry {
+	return &ast.StmtTry{
+		Stmts:   stmts,
+		Catches: catches,
+		Finally: finally,
+	}
+}
+
+// NewStmtUnset returns the StmtUnset node without the tokens
+func NewStmtUnset(vars []ast.Vertex) *ast.StmtUnset {
+	return &ast.StmtUnset{
+		Vars: vars,
+	}
+}
+
+// NewStmtUseList returns the StmtUseList node without the tokens
+func NewStmtUseList(typ ast.Vertex, uses []ast.Vertex) *ast.StmtUseList {
+	return &ast.StmtUseList{
+		Type: typ,
+		Uses: uses,
+	}
+}
+
+// NewStmtGroupUseList returns the StmtGroupUseList node without the tokens
+func NewStmtGroupUseList(typ ast.Vertex, prefix ast.Vertex, uses []ast.Vertex) *ast.StmtGroupUseList {
+	return &ast.StmtGroupUseList{
+		Type:   typ,
+		Prefix: prefix,
+		Uses:   uses,
+	}
+}
+
+// NewStmtUse returns the StmtUse node without the tokens
+func NewStmtUse(typ ast.Vertex, use ast.Vertex, alias ast.Vertex) *ast.StmtUse {
+	return &ast.StmtUse{
+		Type:  typ,
+		Use:   use,
+		Alias: alias,
+	}
+}
+
+// NewStmtWhile returns the StmtWhile node without the tokens
+func NewStmtWhile(cond ast.Vertex, stmt ast.Vertex) *ast.StmtWhile {
+	return &ast.StmtWhile{
+		Cond: cond,
+		Stmt: stmt,
+	}
+}
+
+// NewBadExpr returns the BadExpr node without the tokens
+func NewBadExpr() *ast.BadExpr {
+	return &ast.BadExpr{}
+}
+
+// NewExprArray returns the ExprArray node without the tokens
+func NewExprArray(items []ast.Vertex) *ast.ExprArray {
+	return &ast.ExprArray{
+		Items: items,
+	}
+}
+
+// NewExprArrayDimFetch returns the ExprArrayDimFetch node without the tokens
+func NewExprArrayDimFetch(variable ast.Vertex, dim ast.Vertex) *ast.ExprArrayDimFetch {
+	return &ast.ExprArrayDimFetch{
+		Var: variable,
+		Dim: dim,
+	}
+}
+
+// NewExprArrayItem returns the ExprArrayItem node without the tokens
+func NewExprArrayItem(key ast.Vertex, val ast.Vertex) *ast.ExprArrayItem {
+	return &ast.ExprArrayItem{
+		Key: key,
+		Val: val,
+	}
+}
+
+// NewExprArrowFunction returns the ExprArrowFunction node without the tokens
+func NewExprArrowFunction(attrGroups []ast.Vertex, params []ast.Vertex, returnType ast.Vertex, expr ast.Vertex) *ast.ExprArrowFunction {
+	return &ast.ExprArrowFunction{
+		AttrGroups: attrGroups,
+		Params:     params,
+		ReturnType: returnType,
+		Expr:       expr,
+	}
+}
+
+// NewExprBitwiseNot returns the ExprBitwiseNot node without the tokens
+func NewExprBitwiseNot(expr ast.Vertex) *ast.ExprBitwiseNot {
+	return &ast.ExprBitwiseNot{
+		Expr: expr,
+	}
+}
+
+// NewExprBooleanNot returns the ExprBooleanNot node without the tokens
+func NewExprBooleanNot(expr ast.Vertex) *ast.ExprBooleanNot {
+	return &ast.ExprBooleanNot{
+		Expr: expr,
+	}
+}
+
+// NewExprBrackets returns the ExprBrackets node without the tokens
+func NewExprBrackets(expr ast.Vertex) *ast.ExprBrackets {
+	return &ast.ExprBrackets{
+		Expr: expr,
+	}
+}
+
+// NewExprClassConstFetch returns the ExprClassConstFetch node without the tokens
+func NewExprClassConstFetch(class ast.Vertex, constant ast.Vertex) *ast.ExprClassConstFetch {
+	return &ast.ExprClassConstFetch{
+		Class: class,
+		Const: constant,
+	}
+}
+
+// NewExprClone returns the ExprClone node without the tokens
+func NewExprClone(expr ast.Vertex) *ast.ExprClone {
+	return &ast.ExprClone{
+		Expr: expr,
+	}
+}
+
+// NewExprClosure returns the ExprClosure node without the tokens
+func NewExprClosure(attrGroups []ast.Vertex, params []ast.Vertex, uses []ast.Vertex, returnType ast.Vertex, stmts []ast.Vertex) *ast.ExprClosure {
+	return &ast.ExprClosure{
+		AttrGroups: attrGroups,
+		Params:     params,
+		Uses:       uses,
+		ReturnType: returnType,
+		Stmts:      stmts,
+	}
+}
+
+// NewExprClosureUse returns the ExprClosureUse node without the tokens
+func NewExprClosureUse(variable ast.Vertex) *ast.ExprClosureUse {
+	return &ast.ExprClosureUse{
+		Var: variable,
+	}
+}
+
+// NewExprConstFetch returns the ExprConstFetch node without the tokens
+func NewExprConstFetch(constant ast.Vertex) *ast.ExprConstFetch {
+	return &ast.ExprConstFetch{
+		Const: constant,
+	}
+}
+
+// NewExprEmpty returns the ExprEmpty node without the tokens
+func NewExprEmpty(expr ast.Vertex) *ast.ExprEmpty {
+	return &ast.ExprEmpty{
+		Expr: expr,
+	}
+}
+
+// NewExprErrorSuppress returns the ExprErrorSuppress node without the tokens
+func NewExprErrorSuppress(expr ast.Vertex) *ast.ExprErrorSuppress {
+	return &ast.ExprErrorSuppress{
+		Expr: expr,
+	}
+}
+
+// NewExprEval returns the ExprEval node without the tokens
+func NewExprEval(expr ast.Vertex) *ast.ExprEval {
+	return &ast.ExprEval{
+		Expr: expr,
+	}
+}
+
+// NewExprExit returns the ExprExit node without the tokens
+func NewExprExit(expr ast.Vertex) *ast.ExprExit {
+	return &ast.ExprExit{
+		Expr: expr,
+	}
+}
+
+// NewExprFunctionCall returns the ExprFunctionCall node without the tokens
+func NewExprFunctionCall(function ast.Vertex, args []ast.Vertex) *ast.ExprFunctionCall {
+	return &ast.ExprFunctionCall{
+		Function: function,
+		Args:     args,
+	}
+}
+
+// NewExprInclude returns the ExprInclude node without the tokens
+func NewExprInclude(expr ast.Vertex) *ast.ExprInclude {
+	return &ast.ExprInclude{
+		Expr: expr,
+	}
+}
+
+// NewExprIncludeOnce returns the ExprIncludeOnce node without the tokens
+func NewExprIncludeOnce(expr ast.Vertex) *ast.ExprIncludeOnce {
+	return &ast.ExprIncludeOnce{
+		Expr: expr,
+	}
+}
+
+// NewExprInstanceOf returns the ExprInstanceOf node without the tokens
+func NewExprInstanceOf(expr ast.Vertex, class ast.Vertex) *ast.ExprInstanceOf {
+	return &ast.ExprInstanceOf{
+		Expr:  expr,
+		Class: class,
+	}
+}
+
+// NewExprIsset returns the ExprIsset node without the tokens
+func NewExprIsset(vars []ast.Vertex) *ast.ExprIsset {
+	return &ast.ExprIsset{
+		Vars: vars,
+	}
+}
+
+// NewExprList returns the ExprList node without the tokens
+func NewExprList(items []ast.Vertex) *ast.ExprList {
+	return &ast.ExprList{
+		Items: items,
+	}
+}
+
+// NewExprMatch returns the ExprMatch node without the tokens
+func NewExprMatch(expr ast.Vertex, arms []ast.Vertex) *ast.ExprMatch {
+	return &ast.ExprMatch{
+		Expr: expr,
+		Arms: arms,
+	}
+}
+
+// NewExprMethodCall returns the ExprMethodCall node without the tokens
+func NewExprMethodCall(variable ast.Vertex, method ast.Vertex, args []ast.Vertex) *ast.ExprMethodCall {
+	return &ast.ExprMethodCall{
+		Var:    variable,
+		Method: method,
+		Args:   args,
+	}
+}
+
+// NewExprNew returns the ExprNew node without the tokens
+func NewExprNew(class ast.Vertex, args []ast.Vertex) *ast.ExprNew {
+	return &ast.ExprNew{
+		Class: class,
+		Args:  args,
+	}
+}
+
+// NewExprNullsafeMethodCall returns the ExprNullsafeMethodCall node without the tokens
+func NewExprNullsafeMethodCall(variable ast.Vertex, method ast.Vertex, args []ast.Vertex) *ast.ExprNullsafeMethodCall {
+	return &ast.ExprNullsafeMethodCall{
+		Var:    variable,
+		Method: method,
+		Args:   args,
+	}
+}
+
+// NewExprNullsafePropertyFetch returns the ExprNullsafePropertyFetch node without the tokens
+func NewExprNullsafePropertyFetch(variable ast.Vertex, prop ast.Vertex) *ast.ExprNullsafePropertyFetch {
+	return &ast.ExprNullsafePropertyFetch{
+		Var:  variable,
+		Prop: prop,
+	}
+}
+
+// NewExprPostDec returns the ExprPostDec node without the tokens
+func NewExprPostDec(variable ast.Vertex) *ast.ExprPostDec {
+	return &ast.ExprPostDec{
+		Var: variable,
+	}
+}
+
+// NewExprPostInc returns the ExprPostInc node without the tokens
+func NewExprPostInc(variable ast.Vertex) *ast.ExprPostInc {
+	return &ast.ExprPostInc{
+		Var: variable,
+	}
+}
+
+// NewExprPreDec returns the ExprPreDec node without the tokens
+func NewExprPreDec(variable ast.Vertex) *ast.ExprPreDec {
+	return &ast.ExprPreDec{
+		Var: variable,
+	}
+}
+
+// NewExprPreInc returns the ExprPreInc node without the tokens
+func NewExprPreInc(variable ast.Vertex) *ast.ExprPreInc {
+	return &ast.ExprPreInc{
+		Var: variable,
+	}
+}
+
+// NewExprPrint returns the ExprPrint node without the tokens
+func NewExprPrint(expr ast.Vertex) *ast.ExprPrint {
+	return &ast.ExprPrint{
+		Expr: expr,
+	}
+}
+
+// NewExprPropertyFetch returns the ExprPropertyFetch node without the tokens
+func NewExprPropertyFetch(variable ast.Vertex, prop ast.Vertex) *ast.ExprPropertyFetch {
+	return &ast.ExprPropertyFetch{
+		Var:  variable,
+		Prop: prop,
+	}
+}
+
+// NewExprRequire returns the ExprRequire node without the tokens
+func NewExprRequire(expr ast.Vertex) *ast.ExprRequire {
+	return &ast.ExprRequire{
+		Expr: expr,
+	}
+}
+
+// NewExprRequireOnce returns the ExprRequireOnce node without the tokens
+func NewExprRequireOnce(expr ast.Vertex) *ast.ExprRequireOnce {
+	return &ast.ExprRequireOnce{
+		Expr: expr,
+	}
+}
+
+// NewExprShellExec returns the ExprShellExec node without the tokens
+func NewExprShellExec(parts []ast.Vertex) *ast.ExprShellExec {
+	return &ast.ExprShellExec{
+		Parts: parts,
+	}
+}
+
+// NewExprStaticCall returns the ExprStaticCall node without the tokens
+func NewExprStaticCall(class ast.Vertex, call ast.Vertex, args []ast.Vertex) *ast.ExprStaticCall {
+	return &ast.ExprStaticCall{
+		Class: class,
+		Call:  call,
+		Args:  args,
+	}
+}
+
+// NewExprStaticPropertyFetch returns the ExprStaticPropertyFetch node without the tokens
+func NewExprStaticPropertyFetch(class ast.Vertex, prop ast.Vertex) *ast.ExprStaticPropertyFetch {
+	return &ast.ExprStaticPropertyFetch{
+		Class: class,
+		Prop:  prop,
+	}
+}
+
+// NewExprTernary returns the ExprTernary node without the tokens
+func NewExprTernary(cond ast.Vertex, ifTrue ast.Vertex, ifFalse ast.Vertex) *ast.ExprTernary {
+	return &ast.ExprTernary{
+		Cond:    cond,
+		IfTrue:  ifTrue,
+		IfFalse: ifFalse,
+	}
+}
+
+// NewExprThrow returns the ExprThrow node without the tokens
+func NewExprThrow(expr ast.Vertex) *ast.ExprThrow {
+	return &ast.ExprThrow{
+		Expr: expr,
+	}
+}
+
+// NewExprUnaryMinus returns the ExprUnaryMinus node without the tokens
+func NewExprUnaryMinus(expr ast.Vertex) *ast.ExprUnaryMinus {
+	return &ast.ExprUnaryMinus{
+		Expr: expr,
+	}
+}
+
+// NewExprUnaryPlus returns the ExprUnaryPlus node without the tokens
+func NewExprUnaryPlus(expr ast.Vertex) *ast.ExprUnaryPlus {
+	return &ast.ExprUnaryPlus{
+		Expr: expr,
+	}
+}
+
+// NewExprVariable returns the ExprVariable node without the tokens
+func NewExprVariable(name ast.Vertex) *ast.ExprVariable {
+	return &ast.ExprVariable{
+		Name: name,
+	}
+}
+
+// NewExprYield returns the ExprYield node without the tokens
+func NewExprYield(key ast.Vertex, val ast.Vertex) *ast.ExprYield {
+	return &ast.ExprYield{
+		Key: key,
+		Val: val,
+	}
+}
+
+// NewExprYieldFrom returns the ExprYieldFrom node without the tokens
+func NewExprYieldFrom(expr ast.Vertex) *ast.ExprYieldFrom {
+	return &ast.ExprYieldFrom{
+		Expr: expr,
+	}
+}
+
+// NewExprCastArray returns the ExprCastArray node without the tokens
+func NewExprCastArray(expr ast.Vertex) *ast.ExprCastArray {
+	return &ast.ExprCastArray{
+		Expr: expr,
+	}
+}
+
+// NewExprCastBool returns the ExprCastBool node without the tokens
+func NewExprCastBool(expr ast.Vertex) *ast.ExprCastBool {
+	return &ast.ExprCastBool{
+		Expr: expr,
+	}
+}
+
+// NewExprCastDouble returns the ExprCastDouble node without the tokens
+func NewExprCastDouble(expr ast.Vertex) *ast.ExprCastDouble {
+	return &ast.ExprCastDouble{
+		Expr: expr,
+	}
+}
+
+// NewExprCastInt returns the ExprCastInt node without the tokens
+func NewExprCastInt(expr ast.Vertex) *ast.ExprCastInt {
+	return &ast.ExprCastInt{
+		Expr: expr,
+	}
+}
+
+// NewExprCastObject returns the ExprCastObject node without the tokens
+func NewExprCastObject(expr ast.Vertex) *ast.ExprCastObject {
+	return &ast.ExprCastObject{
+		Expr: expr,
+	}
+}
+
+// NewExprCastString returns the ExprCastString node without the tokens
+func NewExprCastString(expr ast.Vertex) *ast.ExprCastString {
+	return &ast.ExprCastString{
+		Expr: expr,
+	}
+}
+
+// NewExprCastUnset returns the ExprCastUnset node without the tokens
+func NewExprCastUnset(expr ast.Vertex) *ast.ExprCastUnset {
+	return &ast.ExprCastUnset{
+		Expr: expr,
+	}
+}
+
+// NewExprAssign returns the ExprAssign node without the tokens
+func NewExprAssign(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssign {
+	return &ast.ExprAssign{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignReference returns the ExprAssignReference node without the tokens
+func NewExprAssignReference(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignReference {
+	return &ast.ExprAssignReference{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignBitwiseAnd returns the ExprAssignBitwiseAnd node without the tokens
+func NewExprAssignBitwiseAnd(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignBitwiseAnd {
+	return &ast.ExprAssignBitwiseAnd{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignBitwiseOr returns the ExprAssignBitwiseOr node without the tokens
+func NewExprAssignBitwiseOr(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignBitwiseOr {
+	return &ast.ExprAssignBitwiseOr{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignBitwiseXor returns the ExprAssignBitwiseXor node without the tokens
+func NewExprAssignBitwiseXor(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignBitwiseXor {
+	return &ast.ExprAssignBitwiseXor{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignCoalesce returns the ExprAssignCoalesce node without the tokens
+func NewExprAssignCoalesce(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignCoalesce {
+	return &ast.ExprAssignCoalesce{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignConcat returns the ExprAssignConcat node without the tokens
+func NewExprAssignConcat(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignConcat {
+	return &ast.ExprAssignConcat{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignDiv returns the ExprAssignDiv node without the tokens
+func NewExprAssignDiv(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignDiv {
+	return &ast.ExprAssignDiv{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignMinus returns the ExprAssignMinus node without the tokens
+func NewExprAssignMinus(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignMinus {
+	return &ast.ExprAssignMinus{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignMod returns the ExprAssignMod node without the tokens
+func NewExprAssignMod(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignMod {
+	return &ast.ExprAssignMod{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignMul returns the ExprAssignMul node without the tokens
+func NewExprAssignMul(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignMul {
+	return &ast.ExprAssignMul{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignPlus returns the ExprAssignPlus node without the tokens
+func NewExprAssignPlus(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignPlus {
+	return &ast.ExprAssignPlus{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignPow returns the ExprAssignPow node without the tokens
+func NewExprAssignPow(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignPow {
+	return &ast.ExprAssignPow{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignShiftLeft returns the ExprAssignShiftLeft node without the tokens
+func NewExprAssignShiftLeft(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignShiftLeft {
+	return &ast.ExprAssignShiftLeft{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprAssignShiftRight returns the ExprAssignShiftRight node without the tokens
+func NewExprAssignShiftRight(variable ast.Vertex, expr ast.Vertex) *ast.ExprAssignShiftRight {
+	return &ast.ExprAssignShiftRight{
+		Var:  variable,
+		Expr: expr,
+	}
+}
+
+// NewExprBinaryBitwiseAnd returns the ExprBinaryBitwiseAnd node without the tokens
+func NewExprBinaryBitwiseAnd(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryBitwiseAnd {
+	return &ast.ExprBinaryBitwiseAnd{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryBitwiseOr returns the ExprBinaryBitwiseOr node without the tokens
+func NewExprBinaryBitwiseOr(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryBitwiseOr {
+	return &ast.ExprBinaryBitwiseOr{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryBitwiseXor returns the ExprBinaryBitwiseXor node without the tokens
+func NewExprBinaryBitwiseXor(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryBitwiseXor {
+	return &ast.ExprBinaryBitwiseXor{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryBooleanAnd returns the ExprBinaryBooleanAnd node without the tokens
+func NewExprBinaryBooleanAnd(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryBooleanAnd {
+	return &ast.ExprBinaryBooleanAnd{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryBooleanOr returns the ExprBinaryBooleanOr node without the tokens
+func NewExprBinaryBooleanOr(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryBooleanOr {
+	return &ast.ExprBinaryBooleanOr{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryCoalesce returns the ExprBinaryCoalesce node without the tokens
+func NewExprBinaryCoalesce(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryCoalesce {
+	return &ast.ExprBinaryCoalesce{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryConcat returns the ExprBinaryConcat node without the tokens
+func NewExprBinaryConcat(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryConcat {
+	return &ast.ExprBinaryConcat{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryDiv returns the ExprBinaryDiv node without the tokens
+func NewExprBinaryDiv(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryDiv {
+	return &ast.ExprBinaryDiv{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryEqual returns the ExprBinaryEqual node without the tokens
+func NewExprBinaryEqual(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryEqual {
+	return &ast.ExprBinaryEqual{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryGreater returns the ExprBinaryGreater node without the tokens
+func NewExprBinaryGreater(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryGreater {
+	return &ast.ExprBinaryGreater{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryGreaterOrEqual returns the ExprBinaryGreaterOrEqual node without the tokens
+func NewExprBinaryGreaterOrEqual(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryGreaterOrEqual {
+	return &ast.ExprBinaryGreaterOrEqual{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryIdentical returns the ExprBinaryIdentical node without the tokens
+func NewExprBinaryIdentical(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryIdentical {
+	return &ast.ExprBinaryIdentical{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryLogicalAnd returns the ExprBinaryLogicalAnd node without the tokens
+func NewExprBinaryLogicalAnd(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryLogicalAnd {
+	return &ast.ExprBinaryLogicalAnd{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryLogicalOr returns the ExprBinaryLogicalOr node without the tokens
+func NewExprBinaryLogicalOr(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryLogicalOr {
+	return &ast.ExprBinaryLogicalOr{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryLogicalXor returns the ExprBinaryLogicalXor node without the tokens
+func NewExprBinaryLogicalXor(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryLogicalXor {
+	return &ast.ExprBinaryLogicalXor{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryMinus returns the ExprBinaryMinus node without the tokens
+func NewExprBinaryMinus(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryMinus {
+	return &ast.ExprBinaryMinus{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryMod returns the ExprBinaryMod node without the tokens
+func NewExprBinaryMod(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryMod {
+	return &ast.ExprBinaryMod{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryMul returns the ExprBinaryMul node without the tokens
+func NewExprBinaryMul(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryMul {
+	return &ast.ExprBinaryMul{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryNotEqual returns the ExprBinaryNotEqual node without the tokens
+func NewExprBinaryNotEqual(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryNotEqual {
+	return &ast.ExprBinaryNotEqual{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryNotIdentical returns the ExprBinaryNotIdentical node without the tokens
+func NewExprBinaryNotIdentical(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryNotIdentical {
+	return &ast.ExprBinaryNotIdentical{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryPlus returns the ExprBinaryPlus node without the tokens
+func NewExprBinaryPlus(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryPlus {
+	return &ast.ExprBinaryPlus{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryPow returns the ExprBinaryPow node without the tokens
+func NewExprBinaryPow(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryPow {
+	return &ast.ExprBinaryPow{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryShiftLeft returns the ExprBinaryShiftLeft node without the tokens
+func NewExprBinaryShiftLeft(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryShiftLeft {
+	return &ast.ExprBinaryShiftLeft{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinaryShiftRight returns the ExprBinaryShiftRight node without the tokens
+func NewExprBinaryShiftRight(left ast.Vertex, right ast.Vertex) *ast.ExprBinaryShiftRight {
+	return &ast.ExprBinaryShiftRight{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinarySmaller returns the ExprBinarySmaller node without the tokens
+func NewExprBinarySmaller(left ast.Vertex, right ast.Vertex) *ast.ExprBinarySmaller {
+	return &ast.ExprBinarySmaller{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinarySmallerOrEqual returns the ExprBinarySmallerOrEqual node without the tokens
+func NewExprBinarySmallerOrEqual(left ast.Vertex, right ast.Vertex) *ast.ExprBinarySmallerOrEqual {
+	return &ast.ExprBinarySmallerOrEqual{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewExprBinarySpaceship returns the ExprBinarySpaceship node without the tokens
+func NewExprBinarySpaceship(left ast.Vertex, right ast.Vertex) *ast.ExprBinarySpaceship {
+	return &ast.ExprBinarySpaceship{
+		Left:  left,
+		Right: right,
+	}
+}
+
+// NewName returns the Name node without the tokens
+func NewName(parts []ast.Vertex) *ast.Name {
+	return &ast.Name{
+		Parts: parts,
+	}
+}
+
+// NewNameFullyQualified returns the NameFullyQualified node without the tokens
+func NewNameFullyQualified(parts []ast.Vertex) *ast.NameFullyQualified {
+	return &ast.NameFullyQualified{
+		Parts: parts,
+	}
+}
+
+// NewNameRelative returns the NameRelative node without the tokens
+func NewNameRelative(parts []ast.Vertex) *ast.NameRelative {
+	return &ast.NameRelative{
+		Parts: parts,
+	}
+}
+
+// NewNamePart returns the NamePart node without the tokens
+func NewNamePart(value string) *ast.NamePart {
+	return &ast.NamePart{
+		Value: []byte(value),
+	}
+}
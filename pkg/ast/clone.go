@@ -0,0 +1,113 @@
+package ast
+
+import (
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+// Clone returns a deep copy of the node including tokens, FreeFloating tokens and positions.
+// Nothing is shared with the original tree, so the copy stays valid after
+// the token and position pools are reused. Nodes and tokens referenced
+// several times are copied once.
+func Clone(n Vertex) Vertex {
+	c := &cloner{
+		nodesMap:     map[Vertex]Vertex{},
+		tokensMap:    map[*token.Token]*token.Token{},
+		positionsMap: map[*position.Position]*position.Position{},
+	}
+
+	return c.node(n)
+}
+
+type cloner struct {
+	nodesMap     map[Vertex]Vertex
+	tokensMap    map[*token.Token]*token.Token
+	positionsMap map[*position.Position]*position.Position
+}
+
+func (c *cloner) node(n Vertex) Vertex {
+	if n == nil {
+		return nil
+	}
+
+	if x, ok := c.nodesMap[n]; ok {
+		return x
+	}
+
+	x := cloneNode(c, n)
+	c.nodesMap[n] = x
+
+	return x
+}
+
+func (c *cloner) nodes(list []Vertex) []Vertex {
+	if list == nil {
+		return nil
+	}
+
+	x := make([]Vertex, len(list))
+	for i, n := range list {
+		x[i] = c.node(n)
+	}
+
+	return x
+}
+
+func (c *cloner) token(t *token.Token) *token.Token {
+	if t == nil {
+		return nil
+	}
+
+	if x, ok := c.tokensMap[t]; ok {
+		return x
+	}
+
+	x := &token.Token{
+		ID:           t.ID,
+		Value:        c.bytes(t.Value),
+		Position:     c.position(t.Position),
+		FreeFloating: c.tokens(t.FreeFloating),
+	}
+	c.tokensMap[t] = x
+
+	return x
+}
+
+func (c *cloner) tokens(list []*token.Token) []*token.Token {
+	if list == nil {
+		return nil
+	}
+
+	x := make([]*token.Token, len(list))
+	for i, t := range list {
+		x[i] = c.token(t)
+	}
+
+	return x
+}
+
+func (c *cloner) position(p *position.Position) *position.Position {
+	if p == nil {
+		return nil
+	}
+
+	if x, ok := c.positionsMap[p]; ok {
+		return x
+	}
+
+	x := *p
+	c.positionsMap[p] = &x
+
+	return &x
+}
+
+func (c *cloner) bytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	x := make([]byte, len(b))
+	copy(x, b)
+
+	return x
+}
@@ -0,0 +1,118 @@
+package ast_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/internal/php8"
+	"github.com/z7zmey/php-parser/internal/scanner"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+)
+
+func parse(t *testing.T, src string) ast.Vertex {
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+		ErrorHandlerFunc: func(e *errors.Error) {
+			t.Fatal(e)
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+
+	return php8parser.GetRootNode()
+}
+
+func printNode(n ast.Vertex) string {
+	o := bytes.NewBufferString("")
+	n.Accept(printer.NewPrinter(o))
+
+	return o.String()
+}
+
+// pointers collects the addresses of the nodes, tokens, positions and byte slices of the tree
+func pointers(v reflect.Value, m map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if !v.IsNil() {
+			pointers(v.Elem(), m)
+		}
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		m[v.Pointer()] = true
+		pointers(v.Elem(), m)
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return
+		}
+		m[v.Pointer()] = true
+		for i := 0; i < v.Len(); i++ {
+			pointers(v.Index(i), m)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			pointers(v.Field(i), m)
+		}
+	}
+}
+
+func TestClone(t *testing.T) {
+	src, err := ioutil.ReadFile("../../internal/php8/test.php")
+	assert.NilError(t, err)
+
+	root := parse(t, string(src))
+	clone := ast.Clone(root)
+
+	assert.Assert(t, ast.Equal(root, clone, ast.EqualOptions{}))
+	assert.Equal(t, string(src), printNode(clone))
+
+	original := map[uintptr]bool{}
+	pointers(reflect.ValueOf(root), original)
+
+	copied := map[uintptr]bool{}
+	pointers(reflect.ValueOf(clone), copied)
+
+	for p := range copied {
+		assert.Assert(t, !original[p], "the clone shares memory with the original tree")
+	}
+}
+
+func TestCloneSharedNodes(t *testing.T) {
+	n := &ast.ExprVariable{Name: &ast.Identifier{Value: []byte("$a")}}
+	list := &ast.StmtEcho{Exprs: []ast.Vertex{n, n}}
+
+	clone := ast.Clone(list).(*ast.StmtEcho)
+
+	assert.Assert(t, clone.Exprs[0] == clone.Exprs[1])
+	assert.Assert(t, clone.Exprs[0] != n)
+}
+
+func TestCloneNil(t *testing.T) {
+	assert.Assert(t, ast.Clone(nil) == nil)
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+	root := parse(t, `<?php echo $a;`)
+	clone := ast.Clone(root)
+
+	stmt := clone.(*ast.Root).Stmts[0].(*ast.StmtEcho)
+	stmt.EchoTkn.Value[0] = 'E'
+	stmt.Exprs[0].(*ast.ExprVariable).Name.(*ast.Identifier).Value = []byte("$b")
+	stmt.Position.StartLine = 10
+
+	assert.Equal(t, `<?php echo $a;`, printNode(root))
+	assert.Equal(t, 1, root.(*ast.Root).Stmts[0].GetPosition().StartLine)
+}
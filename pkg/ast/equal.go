@@ -0,0 +1,154 @@
+package ast
+
+import (
+	"bytes"
+
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+// EqualOptions configures the Equal comparison
+type EqualOptions struct {
+	// IgnorePositions skips positions of nodes and tokens
+	IgnorePositions bool
+
+	// IgnoreFreeFloating skips whitespace and comment FreeFloating tokens
+	IgnoreFreeFloating bool
+
+	// IgnoreNameCase compares the class, function and method names and the types
+	// case-insensitively like PHP does, the variable, property and constant names
+	// are still case-sensitive
+	IgnoreNameCase bool
+}
+
+// Equal reports whether a and b are structurally equal trees
+func Equal(a, b Vertex, opts EqualOptions) bool {
+	e := &equality{
+		opts: opts,
+	}
+
+	return e.node(a, b)
+}
+
+type equality struct {
+	opts EqualOptions
+
+	// fold is set while the names held by the name fields are compared
+	fold bool
+}
+
+func (e *equality) node(a, b Vertex) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return equalNode(e, a, b)
+}
+
+func (e *equality) nodes(a, b []Vertex) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !e.node(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e *equality) token(a, b *token.Token, fold bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.ID == b.ID &&
+		e.bytes(a.Value, b.Value, fold) &&
+		e.position(a.Position, b.Position) &&
+		e.tokens(a.FreeFloating, b.FreeFloating)
+}
+
+func (e *equality) tokens(a, b []*token.Token) bool {
+	if e.opts.IgnoreFreeFloating {
+		a, b = e.skipFreeFloating(a), e.skipFreeFloating(b)
+	}
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !e.token(a[i], b[i], false) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e *equality) skipFreeFloating(list []*token.Token) []*token.Token {
+	var tkns []*token.Token
+	for _, t := range list {
+		switch t.ID {
+		case token.T_WHITESPACE, token.T_COMMENT, token.T_DOC_COMMENT:
+			continue
+		}
+
+		tkns = append(tkns, t)
+	}
+
+	return tkns
+}
+
+func (e *equality) position(a, b *position.Position) bool {
+	if e.opts.IgnorePositions {
+		return true
+	}
+
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
+
+func (e *equality) bytes(a, b []byte, fold bool) bool {
+	if fold {
+		return bytes.EqualFold(a, b)
+	}
+
+	return bytes.Equal(a, b)
+}
+
+// name compares the nodes of the field holding the class, function or method name
+// or the type, the other expressions the field may hold are compared as usual
+func (e *equality) name(a, b Vertex) bool {
+	switch a.(type) {
+	case *Identifier, *Name, *NameFullyQualified, *NameRelative:
+	default:
+		return e.node(a, b)
+	}
+
+	fold := e.fold
+	e.fold = e.opts.IgnoreNameCase
+	equal := e.node(a, b)
+	e.fold = fold
+
+	return equal
+}
+
+func (e *equality) names(a, b []Vertex) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !e.name(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,55 @@
+package ast_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		opts     ast.EqualOptions
+		expected bool
+	}{
+		{`<?php foo($a);`, `<?php foo($a);`, ast.EqualOptions{}, true},
+		{`<?php foo($a);`, `<?php foo($b);`, ast.EqualOptions{}, false},
+		{`<?php foo($a);`, `<?php foo($a, $b);`, ast.EqualOptions{}, false},
+		{`<?php foo($a);`, `<?php $foo($a);`, ast.EqualOptions{}, false},
+
+		{`<?php foo($a);`, "<?php\nfoo($a);", ast.EqualOptions{}, false},
+		{`<?php foo($a);`, "<?php\nfoo($a);", ast.EqualOptions{IgnoreFreeFloating: true}, false},
+		{`<?php foo($a);`, "<?php\nfoo($a);", ast.EqualOptions{IgnorePositions: true}, false},
+		{`<?php foo($a);`, "<?php\nfoo($a);", ast.EqualOptions{IgnoreFreeFloating: true, IgnorePositions: true}, true},
+		{`<?php foo($a);`, `<?php /* c */ foo( $a ) ;`, ast.EqualOptions{IgnoreFreeFloating: true, IgnorePositions: true}, true},
+		{`<?php foo($a);`, `<?= foo($a);`, ast.EqualOptions{IgnoreFreeFloating: true, IgnorePositions: true}, false},
+
+		{`<?php foo($a);`, `<?php FOO($a);`, ast.EqualOptions{}, false},
+		{`<?php foo($a);`, `<?php FOO($a);`, ast.EqualOptions{IgnoreNameCase: true}, true},
+		{`<?php $a->foo();`, `<?php $a->Foo();`, ast.EqualOptions{IgnoreNameCase: true}, true},
+		{`<?php new \A\B;`, `<?php new \a\b;`, ast.EqualOptions{IgnoreNameCase: true}, true},
+		{`<?php foo($a);`, `<?php foo($A);`, ast.EqualOptions{IgnoreNameCase: true}, false},
+		{`<?php $a->foo;`, `<?php $a->Foo;`, ast.EqualOptions{IgnoreNameCase: true}, false},
+		{`<?php echo FOO;`, `<?php echo foo;`, ast.EqualOptions{IgnoreNameCase: true}, false},
+		{`<?php A::FOO;`, `<?php a::foo;`, ast.EqualOptions{IgnoreNameCase: true}, false},
+		{`<?php A::FOO;`, `<?php a::FOO;`, ast.EqualOptions{IgnoreNameCase: true}, true},
+		{`<?php A::foo();`, `<?php a::FOO();`, ast.EqualOptions{IgnoreNameCase: true}, true},
+		{`<?php class A extends B { function f(?C $c): D|E {} }`, `<?php class a extends b { function F(?c $c): d|e {} }`, ast.EqualOptions{IgnoreNameCase: true}, true},
+		{`<?php function f($c) {}`, `<?php function f($C) {}`, ast.EqualOptions{IgnoreNameCase: true}, false},
+	}
+
+	for _, tt := range tests {
+		a := parse(t, tt.a)
+		b := parse(t, tt.b)
+
+		assert.Equal(t, tt.expected, ast.Equal(a, b, tt.opts), "%s == %s", tt.a, tt.b)
+	}
+}
+
+func TestEqualNil(t *testing.T) {
+	assert.Assert(t, ast.Equal(nil, nil, ast.EqualOptions{}))
+	assert.Assert(t, !ast.Equal(&ast.Identifier{}, nil, ast.EqualOptions{}))
+	assert.Assert(t, !ast.Equal(&ast.Identifier{}, &ast.NamePart{}, ast.EqualOptions{}))
+}
@@ -38,6 +38,8 @@ func (n *Nullable) GetPosition() *position.Position {
 // Parameter node
 type Parameter struct {
 	Position     *position.Position
+	AttrGroups   []Vertex
+	Modifiers    []Vertex
 	Type         Vertex
 	AmpersandTkn *token.Token
 	VariadicTkn  *token.Token
@@ -72,6 +74,8 @@ func (n *Identifier) GetPosition() *position.Position {
 // Argument node
 type Argument struct {
 	Position     *position.Position
+	Name         Vertex
+	ColonTkn     *token.Token
 	VariadicTkn  *token.Token
 	AmpersandTkn *token.Token
 	Expr         Vertex
@@ -85,6 +89,90 @@ func (n *Argument) GetPosition() *position.Position {
 	return n.Position
 }
 
+// Attribute node
+type Attribute struct {
+	Position            *position.Position
+	Name                Vertex
+	OpenParenthesisTkn  *token.Token
+	Args                []Vertex
+	SeparatorTkns       []*token.Token
+	CloseParenthesisTkn *token.Token
+}
+
+func (n *Attribute) Accept(v Visitor) {
+	v.Attribute(n)
+}
+
+func (n *Attribute) GetPosition() *position.Position {
+	return n.Position
+}
+
+// AttributeGroup node
+type AttributeGroup struct {
+	Position          *position.Position
+	OpenAttributeTkn  *token.Token
+	Attrs             []Vertex
+	SeparatorTkns     []*token.Token
+	CloseAttributeTkn *token.Token
+}
+
+func (n *AttributeGroup) Accept(v Visitor) {
+	v.AttributeGroup(n)
+}
+
+func (n *AttributeGroup) GetPosition() *position.Position {
+	return n.Position
+}
+
+// Union node
+type Union struct {
+	Position      *position.Position
+	Types         []Vertex
+	SeparatorTkns []*token.Token
+}
+
+func (n *Union) Accept(v Visitor) {
+	v.Union(n)
+}
+
+func (n *Union) GetPosition() *position.Position {
+	return n.Position
+}
+
+// Intersection node
+type Intersection struct {
+	Position      *position.Position
+	Types         []Vertex
+	SeparatorTkns []*token.Token
+}
+
+func (n *Intersection) Accept(v Visitor) {
+	v.Intersection(n)
+}
+
+func (n *Intersection) GetPosition() *position.Position {
+	return n.Position
+}
+
+// MatchArm node
+type MatchArm struct {
+	Position        *position.Position
+	DefaultTkn      *token.Token
+	DefaultCommaTkn *token.Token
+	Exprs           []Vertex
+	SeparatorTkns   []*token.Token
+	DoubleArrowTkn  *token.Token
+	ReturnExpr      Vertex
+}
+
+func (n *MatchArm) Accept(v Visitor) {
+	v.MatchArm(n)
+}
+
+func (n *MatchArm) GetPosition() *position.Position {
+	return n.Position
+}
+
 // ScalarDnumber node
 type ScalarDnumber struct {
 	Position  *position.Position
@@ -228,6 +316,20 @@ func (n *ScalarString) GetPosition() *position.Position {
 	return n.Position
 }
 
+// BadStmt node
+type BadStmt struct {
+	Position    *position.Position
+	SkippedTkns []*token.Token
+}
+
+func (n *BadStmt) Accept(v Visitor) {
+	v.BadStmt(n)
+}
+
+func (n *BadStmt) GetPosition() *position.Position {
+	return n.Position
+}
+
 // StmtBreak node
 type StmtBreak struct {
 	Position     *position.Position
@@ -286,6 +388,7 @@ func (n *StmtCatch) GetPosition() *position.Position {
 // StmtClass node
 type StmtClass struct {
 	Position                *position.Position
+	AttrGroups              []Vertex
 	Modifiers               []Vertex
 	ClassTkn                *token.Token
 	Name                    Vertex
@@ -314,8 +417,10 @@ func (n *StmtClass) GetPosition() *position.Position {
 // StmtClassConstList node
 type StmtClassConstList struct {
 	Position      *position.Position
+	AttrGroups    []Vertex
 	Modifiers     []Vertex
 	ConstTkn      *token.Token
+	Type          Vertex
 	Consts        []Vertex
 	SeparatorTkns []*token.Token
 	SemiColonTkn  *token.Token
@@ -332,6 +437,7 @@ func (n *StmtClassConstList) GetPosition() *position.Position {
 // StmtClassMethod node
 type StmtClassMethod struct {
 	Position            *position.Position
+	AttrGroups          []Vertex
 	Modifiers           []Vertex
 	FunctionTkn         *token.Token
 	AmpersandTkn        *token.Token
@@ -512,6 +618,49 @@ func (n *StmtElseIf) GetPosition() *position.Position {
 	return n.Position
 }
 
+// StmtEnum node
+type StmtEnum struct {
+	Position                *position.Position
+	AttrGroups              []Vertex
+	EnumTkn                 *token.Token
+	Name                    Vertex
+	ColonTkn                *token.Token
+	Type                    Vertex
+	ImplementsTkn           *token.Token
+	Implements              []Vertex
+	ImplementsSeparatorTkns []*token.Token
+	OpenCurlyBracketTkn     *token.Token
+	Stmts                   []Vertex
+	CloseCurlyBracketTkn    *token.Token
+}
+
+func (n *StmtEnum) Accept(v Visitor) {
+	v.StmtEnum(n)
+}
+
+func (n *StmtEnum) GetPosition() *position.Position {
+	return n.Position
+}
+
+// StmtEnumCase node
+type StmtEnumCase struct {
+	Position     *position.Position
+	AttrGroups   []Vertex
+	CaseTkn      *token.Token
+	Name         Vertex
+	EqualTkn     *token.Token
+	Expr         Vertex
+	SemiColonTkn *token.Token
+}
+
+func (n *StmtEnumCase) Accept(v Visitor) {
+	v.StmtEnumCase(n)
+}
+
+func (n *StmtEnumCase) GetPosition() *position.Position {
+	return n.Position
+}
+
 // StmtExpression node
 type StmtExpression struct {
 	Position     *position.Position
@@ -601,6 +750,7 @@ func (n *StmtForeach) GetPosition() *position.Position {
 // StmtFunction node
 type StmtFunction struct {
 	Position             *position.Position
+	AttrGroups           []Vertex
 	FunctionTkn          *token.Token
 	AmpersandTkn         *token.Token
 	Name                 Vertex
@@ -714,6 +864,7 @@ func (n *StmtInlineHtml) GetPosition() *position.Position {
 // StmtInterface node
 type StmtInterface struct {
 	Position             *position.Position
+	AttrGroups           []Vertex
 	InterfaceTkn         *token.Token
 	Name                 Vertex
 	ExtendsTkn           *token.Token
@@ -799,6 +950,7 @@ func (n *StmtProperty) GetPosition() *position.Position {
 // StmtPropertyList node
 type StmtPropertyList struct {
 	Position      *position.Position
+	AttrGroups    []Vertex
 	Modifiers     []Vertex
 	Type          Vertex
 	Props         []Vertex
@@ -922,6 +1074,7 @@ func (n *StmtThrow) GetPosition() *position.Position {
 // StmtTrait node
 type StmtTrait struct {
 	Position             *position.Position
+	AttrGroups           []Vertex
 	TraitTkn             *token.Token
 	Name                 Vertex
 	OpenCurlyBracketTkn  *token.Token
@@ -1115,6 +1268,20 @@ func (n *StmtWhile) GetPosition() *position.Position {
 	return n.Position
 }
 
+// BadExpr node
+type BadExpr struct {
+	Position    *position.Position
+	SkippedTkns []*token.Token
+}
+
+func (n *BadExpr) Accept(v Visitor) {
+	v.BadExpr(n)
+}
+
+func (n *BadExpr) GetPosition() *position.Position {
+	return n.Position
+}
+
 // ExprArray node
 type ExprArray struct {
 	Position        *position.Position
@@ -1171,6 +1338,7 @@ func (n *ExprArrayItem) GetPosition() *position.Position {
 // ExprArrowFunction node
 type ExprArrowFunction struct {
 	Position            *position.Position
+	AttrGroups          []Vertex
 	StaticTkn           *token.Token
 	FnTkn               *token.Token
 	AmpersandTkn        *token.Token
@@ -1239,10 +1407,12 @@ func (n *ExprBrackets) GetPosition() *position.Position {
 
 // ExprClassConstFetch node
 type ExprClassConstFetch struct {
-	Position       *position.Position
-	Class          Vertex
-	DoubleColonTkn *token.Token
-	Const          Vertex
+	Position             *position.Position
+	Class                Vertex
+	DoubleColonTkn       *token.Token
+	OpenCurlyBracketTkn  *token.Token
+	Const                Vertex
+	CloseCurlyBracketTkn *token.Token
 }
 
 func (n *ExprClassConstFetch) Accept(v Visitor) {
@@ -1271,6 +1441,7 @@ func (n *ExprClone) GetPosition() *position.Position {
 // ExprClosure node
 type ExprClosure struct {
 	Position               *position.Position
+	AttrGroups             []Vertex
 	StaticTkn              *token.Token
 	FunctionTkn            *token.Token
 	AmpersandTkn           *token.Token
@@ -1493,6 +1664,27 @@ func (n *ExprList) GetPosition() *position.Position {
 	return n.Position
 }
 
+// ExprMatch node
+type ExprMatch struct {
+	Position             *position.Position
+	MatchTkn             *token.Token
+	OpenParenthesisTkn   *token.Token
+	Expr                 Vertex
+	CloseParenthesisTkn  *token.Token
+	OpenCurlyBracketTkn  *token.Token
+	Arms                 []Vertex
+	SeparatorTkns        []*token.Token
+	CloseCurlyBracketTkn *token.Token
+}
+
+func (n *ExprMatch) Accept(v Visitor) {
+	v.ExprMatch(n)
+}
+
+func (n *ExprMatch) GetPosition() *position.Position {
+	return n.Position
+}
+
 // ExprMethodCall node
 type ExprMethodCall struct {
 	Position             *position.Position
@@ -1534,6 +1726,46 @@ func (n *ExprNew) GetPosition() *position.Position {
 	return n.Position
 }
 
+// ExprNullsafeMethodCall node
+type ExprNullsafeMethodCall struct {
+	Position             *position.Position
+	Var                  Vertex
+	ObjectOperatorTkn    *token.Token
+	OpenCurlyBracketTkn  *token.Token
+	Method               Vertex
+	CloseCurlyBracketTkn *token.Token
+	OpenParenthesisTkn   *token.Token
+	Args                 []Vertex
+	SeparatorTkns        []*token.Token
+	CloseParenthesisTkn  *token.Token
+}
+
+func (n *ExprNullsafeMethodCall) Accept(v Visitor) {
+	v.ExprNullsafeMethodCall(n)
+}
+
+func (n *ExprNullsafeMethodCall) GetPosition() *position.Position {
+	return n.Position
+}
+
+// ExprNullsafePropertyFetch node
+type ExprNullsafePropertyFetch struct {
+	Position             *position.Position
+	Var                  Vertex
+	ObjectOperatorTkn    *token.Token
+	OpenCurlyBracketTkn  *token.Token
+	Prop                 Vertex
+	CloseCurlyBracketTkn *token.Token
+}
+
+func (n *ExprNullsafePropertyFetch) Accept(v Visitor) {
+	v.ExprNullsafePropertyFetch(n)
+}
+
+func (n *ExprNullsafePropertyFetch) GetPosition() *position.Position {
+	return n.Position
+}
+
 // ExprPostDec node
 type ExprPostDec struct {
 	Position *position.Position
@@ -1729,6 +1961,21 @@ func (n *ExprTernary) GetPosition() *position.Position {
 	return n.Position
 }
 
+// ExprThrow node
+type ExprThrow struct {
+	Position *position.Position
+	ThrowTkn *token.Token
+	Expr     Vertex
+}
+
+func (n *ExprThrow) Accept(v Visitor) {
+	v.ExprThrow(n)
+}
+
+func (n *ExprThrow) GetPosition() *position.Position {
+	return n.Position
+}
+
 // ExprUnaryMinus node
 type ExprUnaryMinus struct {
 	Position *position.Position
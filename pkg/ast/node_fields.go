@@ -0,0 +1,5837 @@
+// Code generated by "astgen -input node.go -output node_fields.go"; DO NOT EDIT.
+
+package ast
+
+var (
+	fieldsRoot = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "EndTkn", Kind: FieldToken},
+	}
+	fieldsNullable = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "QuestionTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsParameter = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "Modifiers", Kind: FieldNodeList},
+		{Name: "Type", Kind: FieldNode},
+		{Name: "AmpersandTkn", Kind: FieldToken},
+		{Name: "VariadicTkn", Kind: FieldToken},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "DefaultValue", Kind: FieldNode},
+	}
+	fieldsIdentifier = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "IdentifierTkn", Kind: FieldToken},
+		{Name: "Value", Kind: FieldValue},
+	}
+	fieldsArgument = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "VariadicTkn", Kind: FieldToken},
+		{Name: "AmpersandTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsAttribute = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Args", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsAttributeGroup = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "OpenAttributeTkn", Kind: FieldToken},
+		{Name: "Attrs", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseAttributeTkn", Kind: FieldToken},
+	}
+	fieldsUnion = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Types", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+	}
+	fieldsIntersection = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Types", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+	}
+	fieldsMatchArm = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "DefaultTkn", Kind: FieldToken},
+		{Name: "DefaultCommaTkn", Kind: FieldToken},
+		{Name: "Exprs", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "DoubleArrowTkn", Kind: FieldToken},
+		{Name: "ReturnExpr", Kind: FieldNode},
+	}
+	fieldsScalarDnumber = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "NumberTkn", Kind: FieldToken},
+		{Name: "Value", Kind: FieldValue},
+	}
+	fieldsScalarEncapsed = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "OpenQuoteTkn", Kind: FieldToken},
+		{Name: "Parts", Kind: FieldNodeList},
+		{Name: "CloseQuoteTkn", Kind: FieldToken},
+	}
+	fieldsScalarEncapsedStringPart = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "EncapsedStrTkn", Kind: FieldToken},
+		{Name: "Value", Kind: FieldValue},
+	}
+	fieldsScalarEncapsedStringVar = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "DollarOpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "OpenSquareBracketTkn", Kind: FieldToken},
+		{Name: "Dim", Kind: FieldNode},
+		{Name: "CloseSquareBracketTkn", Kind: FieldToken},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsScalarEncapsedStringBrackets = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsScalarHeredoc = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "OpenHeredocTkn", Kind: FieldToken},
+		{Name: "Parts", Kind: FieldNodeList},
+		{Name: "CloseHeredocTkn", Kind: FieldToken},
+	}
+	fieldsScalarLnumber = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "NumberTkn", Kind: FieldToken},
+		{Name: "Value", Kind: FieldValue},
+	}
+	fieldsScalarMagicConstant = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "MagicConstTkn", Kind: FieldToken},
+		{Name: "Value", Kind: FieldValue},
+	}
+	fieldsScalarString = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "MinusTkn", Kind: FieldToken},
+		{Name: "StringTkn", Kind: FieldToken},
+		{Name: "Value", Kind: FieldValue},
+	}
+	fieldsBadStmt = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "SkippedTkns", Kind: FieldTokenList},
+	}
+	fieldsStmtBreak = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "BreakTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtCase = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "CaseTkn", Kind: FieldToken},
+		{Name: "Cond", Kind: FieldNode},
+		{Name: "CaseSeparatorTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+	}
+	fieldsStmtCatch = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "CatchTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Types", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsStmtClass = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "Modifiers", Kind: FieldNodeList},
+		{Name: "ClassTkn", Kind: FieldToken},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Args", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ExtendsTkn", Kind: FieldToken},
+		{Name: "Extends", Kind: FieldNode},
+		{Name: "ImplementsTkn", Kind: FieldToken},
+		{Name: "Implements", Kind: FieldNodeList},
+		{Name: "ImplementsSeparatorTkns", Kind: FieldTokenList},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsStmtClassConstList = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "Modifiers", Kind: FieldNodeList},
+		{Name: "ConstTkn", Kind: FieldToken},
+		{Name: "Type", Kind: FieldNode},
+		{Name: "Consts", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtClassMethod = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "Modifiers", Kind: FieldNodeList},
+		{Name: "FunctionTkn", Kind: FieldToken},
+		{Name: "AmpersandTkn", Kind: FieldToken},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Params", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "ReturnType", Kind: FieldNode},
+		{Name: "Stmt", Kind: FieldNode},
+	}
+	fieldsStmtConstList = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ConstTkn", Kind: FieldToken},
+		{Name: "Consts", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtConstant = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsStmtContinue = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ContinueTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtDeclare = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "DeclareTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Consts", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "Stmt", Kind: FieldNode},
+		{Name: "EndDeclareTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtDefault = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "DefaultTkn", Kind: FieldToken},
+		{Name: "CaseSeparatorTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+	}
+	fieldsStmtDo = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "DoTkn", Kind: FieldToken},
+		{Name: "Stmt", Kind: FieldNode},
+		{Name: "WhileTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Cond", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtEcho = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "EchoTkn", Kind: FieldToken},
+		{Name: "Exprs", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtElse = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ElseTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "Stmt", Kind: FieldNode},
+	}
+	fieldsStmtElseIf = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ElseIfTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Cond", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "Stmt", Kind: FieldNode},
+	}
+	fieldsStmtEnum = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "EnumTkn", Kind: FieldToken},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "Type", Kind: FieldNode},
+		{Name: "ImplementsTkn", Kind: FieldToken},
+		{Name: "Implements", Kind: FieldNodeList},
+		{Name: "ImplementsSeparatorTkns", Kind: FieldTokenList},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsStmtEnumCase = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "CaseTkn", Kind: FieldToken},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtExpression = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtFinally = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "FinallyTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsStmtFor = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ForTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Init", Kind: FieldNodeList},
+		{Name: "InitSeparatorTkns", Kind: FieldTokenList},
+		{Name: "InitSemiColonTkn", Kind: FieldToken},
+		{Name: "Cond", Kind: FieldNodeList},
+		{Name: "CondSeparatorTkns", Kind: FieldTokenList},
+		{Name: "CondSemiColonTkn", Kind: FieldToken},
+		{Name: "Loop", Kind: FieldNodeList},
+		{Name: "LoopSeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "Stmt", Kind: FieldNode},
+		{Name: "EndForTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtForeach = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ForeachTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "AsTkn", Kind: FieldToken},
+		{Name: "Key", Kind: FieldNode},
+		{Name: "DoubleArrowTkn", Kind: FieldToken},
+		{Name: "AmpersandTkn", Kind: FieldToken},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "Stmt", Kind: FieldNode},
+		{Name: "EndForeachTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtFunction = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "FunctionTkn", Kind: FieldToken},
+		{Name: "AmpersandTkn", Kind: FieldToken},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Params", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "ReturnType", Kind: FieldNode},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsStmtGlobal = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "GlobalTkn", Kind: FieldToken},
+		{Name: "Vars", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtGoto = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "GotoTkn", Kind: FieldToken},
+		{Name: "Label", Kind: FieldNode},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtHaltCompiler = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "HaltCompilerTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtIf = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "IfTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Cond", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "Stmt", Kind: FieldNode},
+		{Name: "ElseIf", Kind: FieldNodeList},
+		{Name: "Else", Kind: FieldNode},
+		{Name: "EndIfTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtInlineHtml = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "InlineHtmlTkn", Kind: FieldToken},
+		{Name: "Value", Kind: FieldValue},
+	}
+	fieldsStmtInterface = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "InterfaceTkn", Kind: FieldToken},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "ExtendsTkn", Kind: FieldToken},
+		{Name: "Extends", Kind: FieldNodeList},
+		{Name: "ExtendsSeparatorTkns", Kind: FieldTokenList},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsStmtLabel = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "ColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtNamespace = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "NsTkn", Kind: FieldToken},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtNop = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtProperty = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsStmtPropertyList = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "Modifiers", Kind: FieldNodeList},
+		{Name: "Type", Kind: FieldNode},
+		{Name: "Props", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtReturn = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ReturnTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtStatic = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "StaticTkn", Kind: FieldToken},
+		{Name: "Vars", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtStaticVar = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsStmtStmtList = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsStmtSwitch = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "SwitchTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Cond", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "CaseSeparatorTkn", Kind: FieldToken},
+		{Name: "Cases", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+		{Name: "EndSwitchTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtThrow = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ThrowTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtTrait = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "TraitTkn", Kind: FieldToken},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsStmtTraitUse = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "UseTkn", Kind: FieldToken},
+		{Name: "Traits", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Adaptations", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtTraitUseAlias = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Trait", Kind: FieldNode},
+		{Name: "DoubleColonTkn", Kind: FieldToken},
+		{Name: "Method", Kind: FieldNode},
+		{Name: "AsTkn", Kind: FieldToken},
+		{Name: "Modifier", Kind: FieldNode},
+		{Name: "Alias", Kind: FieldNode},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtTraitUsePrecedence = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Trait", Kind: FieldNode},
+		{Name: "DoubleColonTkn", Kind: FieldToken},
+		{Name: "Method", Kind: FieldNode},
+		{Name: "InsteadofTkn", Kind: FieldToken},
+		{Name: "Insteadof", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtTry = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "TryTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Catches", Kind: FieldNodeList},
+		{Name: "Finally", Kind: FieldNode},
+	}
+	fieldsStmtUnset = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "UnsetTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Vars", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtUseList = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "UseTkn", Kind: FieldToken},
+		{Name: "Type", Kind: FieldNode},
+		{Name: "Uses", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtGroupUseList = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "UseTkn", Kind: FieldToken},
+		{Name: "Type", Kind: FieldNode},
+		{Name: "LeadingNsSeparatorTkn", Kind: FieldToken},
+		{Name: "Prefix", Kind: FieldNode},
+		{Name: "NsSeparatorTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Uses", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsStmtUse = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Type", Kind: FieldNode},
+		{Name: "NsSeparatorTkn", Kind: FieldToken},
+		{Name: "Use", Kind: FieldNode},
+		{Name: "AsTkn", Kind: FieldToken},
+		{Name: "Alias", Kind: FieldNode},
+	}
+	fieldsStmtWhile = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "WhileTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Cond", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "Stmt", Kind: FieldNode},
+		{Name: "EndWhileTkn", Kind: FieldToken},
+		{Name: "SemiColonTkn", Kind: FieldToken},
+	}
+	fieldsBadExpr = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "SkippedTkns", Kind: FieldTokenList},
+	}
+	fieldsExprArray = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ArrayTkn", Kind: FieldToken},
+		{Name: "OpenBracketTkn", Kind: FieldToken},
+		{Name: "Items", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseBracketTkn", Kind: FieldToken},
+	}
+	fieldsExprArrayDimFetch = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "OpenBracketTkn", Kind: FieldToken},
+		{Name: "Dim", Kind: FieldNode},
+		{Name: "CloseBracketTkn", Kind: FieldToken},
+	}
+	fieldsExprArrayItem = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "EllipsisTkn", Kind: FieldToken},
+		{Name: "Key", Kind: FieldNode},
+		{Name: "DoubleArrowTkn", Kind: FieldToken},
+		{Name: "AmpersandTkn", Kind: FieldToken},
+		{Name: "Val", Kind: FieldNode},
+	}
+	fieldsExprArrowFunction = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "StaticTkn", Kind: FieldToken},
+		{Name: "FnTkn", Kind: FieldToken},
+		{Name: "AmpersandTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Params", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "ReturnType", Kind: FieldNode},
+		{Name: "DoubleArrowTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprBitwiseNot = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "TildaTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprBooleanNot = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ExclamationTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprBrackets = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsExprClassConstFetch = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Class", Kind: FieldNode},
+		{Name: "DoubleColonTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Const", Kind: FieldNode},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsExprClone = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "CloneTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprClosure = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AttrGroups", Kind: FieldNodeList},
+		{Name: "StaticTkn", Kind: FieldToken},
+		{Name: "FunctionTkn", Kind: FieldToken},
+		{Name: "AmpersandTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Params", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "UseTkn", Kind: FieldToken},
+		{Name: "UseOpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Uses", Kind: FieldNodeList},
+		{Name: "UseSeparatorTkns", Kind: FieldTokenList},
+		{Name: "UseCloseParenthesisTkn", Kind: FieldToken},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "ReturnType", Kind: FieldNode},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Stmts", Kind: FieldNodeList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsExprClosureUse = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AmpersandTkn", Kind: FieldToken},
+		{Name: "Var", Kind: FieldNode},
+	}
+	fieldsExprConstFetch = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Const", Kind: FieldNode},
+	}
+	fieldsExprEmpty = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "EmptyTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsExprErrorSuppress = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "AtTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprEval = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "EvalTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsExprExit = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ExitTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsExprFunctionCall = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Function", Kind: FieldNode},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Args", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsExprInclude = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "IncludeTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprIncludeOnce = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "IncludeOnceTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprInstanceOf = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "InstanceOfTkn", Kind: FieldToken},
+		{Name: "Class", Kind: FieldNode},
+	}
+	fieldsExprIsset = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "IssetTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Vars", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsExprList = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ListTkn", Kind: FieldToken},
+		{Name: "OpenBracketTkn", Kind: FieldToken},
+		{Name: "Items", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseBracketTkn", Kind: FieldToken},
+	}
+	fieldsExprMatch = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "MatchTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Arms", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsExprMethodCall = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "ObjectOperatorTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Method", Kind: FieldNode},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Args", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsExprNew = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "NewTkn", Kind: FieldToken},
+		{Name: "Class", Kind: FieldNode},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Args", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsExprNullsafeMethodCall = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "ObjectOperatorTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Method", Kind: FieldNode},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Args", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsExprNullsafePropertyFetch = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "ObjectOperatorTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Prop", Kind: FieldNode},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsExprPostDec = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "DecTkn", Kind: FieldToken},
+	}
+	fieldsExprPostInc = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "IncTkn", Kind: FieldToken},
+	}
+	fieldsExprPreDec = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "DecTkn", Kind: FieldToken},
+		{Name: "Var", Kind: FieldNode},
+	}
+	fieldsExprPreInc = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "IncTkn", Kind: FieldToken},
+		{Name: "Var", Kind: FieldNode},
+	}
+	fieldsExprPrint = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "PrintTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprPropertyFetch = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "ObjectOperatorTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Prop", Kind: FieldNode},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsExprRequire = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "RequireTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprRequireOnce = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "RequireOnceTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprShellExec = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "OpenBacktickTkn", Kind: FieldToken},
+		{Name: "Parts", Kind: FieldNodeList},
+		{Name: "CloseBacktickTkn", Kind: FieldToken},
+	}
+	fieldsExprStaticCall = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Class", Kind: FieldNode},
+		{Name: "DoubleColonTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Call", Kind: FieldNode},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+		{Name: "OpenParenthesisTkn", Kind: FieldToken},
+		{Name: "Args", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+		{Name: "CloseParenthesisTkn", Kind: FieldToken},
+	}
+	fieldsExprStaticPropertyFetch = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Class", Kind: FieldNode},
+		{Name: "DoubleColonTkn", Kind: FieldToken},
+		{Name: "Prop", Kind: FieldNode},
+	}
+	fieldsExprTernary = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Cond", Kind: FieldNode},
+		{Name: "QuestionTkn", Kind: FieldToken},
+		{Name: "IfTrue", Kind: FieldNode},
+		{Name: "ColonTkn", Kind: FieldToken},
+		{Name: "IfFalse", Kind: FieldNode},
+	}
+	fieldsExprThrow = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "ThrowTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprUnaryMinus = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "MinusTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprUnaryPlus = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "PlusTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprVariable = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "DollarTkn", Kind: FieldToken},
+		{Name: "OpenCurlyBracketTkn", Kind: FieldToken},
+		{Name: "Name", Kind: FieldNode},
+		{Name: "CloseCurlyBracketTkn", Kind: FieldToken},
+	}
+	fieldsExprYield = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "YieldTkn", Kind: FieldToken},
+		{Name: "Key", Kind: FieldNode},
+		{Name: "DoubleArrowTkn", Kind: FieldToken},
+		{Name: "Val", Kind: FieldNode},
+	}
+	fieldsExprYieldFrom = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "YieldFromTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprCastArray = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "CastTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprCastBool = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "CastTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprCastDouble = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "CastTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprCastInt = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "CastTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprCastObject = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "CastTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprCastString = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "CastTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprCastUnset = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "CastTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssign = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignReference = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "AmpersandTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignBitwiseAnd = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignBitwiseOr = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignBitwiseXor = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignCoalesce = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignConcat = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignDiv = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignMinus = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignMod = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignMul = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignPlus = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignPow = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignShiftLeft = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprAssignShiftRight = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Var", Kind: FieldNode},
+		{Name: "EqualTkn", Kind: FieldToken},
+		{Name: "Expr", Kind: FieldNode},
+	}
+	fieldsExprBinaryBitwiseAnd = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryBitwiseOr = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryBitwiseXor = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryBooleanAnd = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryBooleanOr = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryCoalesce = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryConcat = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryDiv = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryEqual = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryGreater = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryGreaterOrEqual = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryIdentical = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryLogicalAnd = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryLogicalOr = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryLogicalXor = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryMinus = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryMod = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryMul = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryNotEqual = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryNotIdentical = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryPlus = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryPow = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryShiftLeft = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinaryShiftRight = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinarySmaller = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinarySmallerOrEqual = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsExprBinarySpaceship = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Left", Kind: FieldNode},
+		{Name: "OpTkn", Kind: FieldToken},
+		{Name: "Right", Kind: FieldNode},
+	}
+	fieldsName = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "Parts", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+	}
+	fieldsNameFullyQualified = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "NsSeparatorTkn", Kind: FieldToken},
+		{Name: "Parts", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+	}
+	fieldsNameRelative = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "NsTkn", Kind: FieldToken},
+		{Name: "NsSeparatorTkn", Kind: FieldToken},
+		{Name: "Parts", Kind: FieldNodeList},
+		{Name: "SeparatorTkns", Kind: FieldTokenList},
+	}
+	fieldsNamePart = []Field{
+		{Name: "Position", Kind: FieldPosition},
+		{Name: "StringTkn", Kind: FieldToken},
+		{Name: "Value", Kind: FieldValue},
+	}
+)
+
+// Fields returns the fields of the node type in the declaration order
+func Fields(n Vertex) []Field {
+	switch n.(type) {
+	case *Root:
+		return fieldsRoot
+	case *Nullable:
+		return fieldsNullable
+	case *Parameter:
+		return fieldsParameter
+	case *Identifier:
+		return fieldsIdentifier
+	case *Argument:
+		return fieldsArgument
+	case *Attribute:
+		return fieldsAttribute
+	case *AttributeGroup:
+		return fieldsAttributeGroup
+	case *Union:
+		return fieldsUnion
+	case *Intersection:
+		return fieldsIntersection
+	case *MatchArm:
+		return fieldsMatchArm
+	case *ScalarDnumber:
+		return fieldsScalarDnumber
+	case *ScalarEncapsed:
+		return fieldsScalarEncapsed
+	case *ScalarEncapsedStringPart:
+		return fieldsScalarEncapsedStringPart
+	case *ScalarEncapsedStringVar:
+		return fieldsScalarEncapsedStringVar
+	case *ScalarEncapsedStringBrackets:
+		return fieldsScalarEncapsedStringBrackets
+	case *ScalarHeredoc:
+		return fieldsScalarHeredoc
+	case *ScalarLnumber:
+		return fieldsScalarLnumber
+	case *ScalarMagicConstant:
+		return fieldsScalarMagicConstant
+	case *ScalarString:
+		return fieldsScalarString
+	case *BadStmt:
+		return fieldsBadStmt
+	case *StmtBreak:
+		return fieldsStmtBreak
+	case *StmtCase:
+		return fieldsStmtCase
+	case *StmtCatch:
+		return fieldsStmtCatch
+	case *StmtClass:
+		return fieldsStmtClass
+	case *StmtClassConstList:
+		return fieldsStmtClassConstList
+	case *StmtClassMethod:
+		return fieldsStmtClassMethod
+	case *StmtConstList:
+		return fieldsStmtConstList
+	case *StmtConstant:
+		return fieldsStmtConstant
+	case *StmtContinue:
+		return fieldsStmtContinue
+	case *StmtDeclare:
+		return fieldsStmtDeclare
+	case *StmtDefault:
+		return fieldsStmtDefault
+	case *StmtDo:
+		return fieldsStmtDo
+	case *StmtEcho:
+		return fieldsStmtEcho
+	case *StmtElse:
+		return fieldsStmtElse
+	case *StmtElseIf:
+		return fieldsStmtElseIf
+	case *StmtEnum:
+		return fieldsStmtEnum
+	case *StmtEnumCase:
+		return fieldsStmtEnumCase
+	case *StmtExpression:
+		return fieldsStmtExpression
+	case *StmtFinally:
+		return fieldsStmtFinally
+	case *StmtFor:
+		return fieldsStmtFor
+	case *StmtForeach:
+		return fieldsStmtForeach
+	case *StmtFunction:
+		return fieldsStmtFunction
+	case *StmtGlobal:
+		return fieldsStmtGlobal
+	case *StmtGoto:
+		return fieldsStmtGoto
+	case *StmtHaltCompiler:
+		return fieldsStmtHaltCompiler
+	case *StmtIf:
+		return fieldsStmtIf
+	case *StmtInlineHtml:
+		return fieldsStmtInlineHtml
+	case *StmtInterface:
+		return fieldsStmtInterface
+	case *StmtLabel:
+		return fieldsStmtLabel
+	case *StmtNamespace:
+		return fieldsStmtNamespace
+	case *StmtNop:
+		return fieldsStmtNop
+	case *StmtProperty:
+		return fieldsStmtProperty
+	case *StmtPropertyList:
+		return fieldsStmtPropertyList
+	case *StmtReturn:
+		return fieldsStmtReturn
+	case *StmtStatic:
+		return fieldsStmtStatic
+	case *StmtStaticVar:
+		return fieldsStmtStaticVar
+	case *StmtStmtList:
+		return fieldsStmtStmtList
+	case *StmtSwitch:
+		return fieldsStmtSwitch
+	case *StmtThrow:
+		return fieldsStmtThrow
+	case *StmtTrait:
+		return fieldsStmtTrait
+	case *StmtTraitUse:
+		return fieldsStmtTraitUse
+	case *StmtTraitUseAlias:
+		return fieldsStmtTraitUseAlias
+	case *StmtTraitUsePrecedence:
+		return fieldsStmtTraitUsePrecedence
+	case *StmtTry:
+		return fieldsStmtTry
+	case *StmtUnset:
+		return fieldsStmtUnset
+	case *StmtUseList:
+		return fieldsStmtUseList
+	case *StmtGroupUseList:
+		return fieldsStmtGroupUseList
+	case *StmtUse:
+		return fieldsStmtUse
+	case *StmtWhile:
+		return fieldsStmtWhile
+	case *BadExpr:
+		return fieldsBadExpr
+	case *ExprArray:
+		return fieldsExprArray
+	case *ExprArrayDimFetch:
+		return fieldsExprArrayDimFetch
+	case *ExprArrayItem:
+		return fieldsExprArrayItem
+	case *ExprArrowFunction:
+		return fieldsExprArrowFunction
+	case *ExprBitwiseNot:
+		return fieldsExprBitwiseNot
+	case *ExprBooleanNot:
+		return fieldsExprBooleanNot
+	case *ExprBrackets:
+		return fieldsExprBrackets
+	case *ExprClassConstFetch:
+		return fieldsExprClassConstFetch
+	case *ExprClone:
+		return fieldsExprClone
+	case *ExprClosure:
+		return fieldsExprClosure
+	case *ExprClosureUse:
+		return fieldsExprClosureUse
+	case *ExprConstFetch:
+		return fieldsExprConstFetch
+	case *ExprEmpty:
+		return fieldsExprEmpty
+	case *ExprErrorSuppress:
+		return fieldsExprErrorSuppress
+	case *ExprEval:
+		return fieldsExprEval
+	case *ExprExit:
+		return fieldsExprExit
+	case *ExprFunctionCall:
+		return fieldsExprFunctionCall
+	case *ExprInclude:
+		return fieldsExprInclude
+	case *ExprIncludeOnce:
+		return fieldsExprIncludeOnce
+	case *ExprInstanceOf:
+		return fieldsExprInstanceOf
+	case *ExprIsset:
+		return fieldsExprIsset
+	case *ExprList:
+		return fieldsExprList
+	case *ExprMatch:
+		return fieldsExprMatch
+	case *ExprMethodCall:
+		return fieldsExprMethodCall
+	case *ExprNew:
+		return fieldsExprNew
+	case *ExprNullsafeMethodCall:
+		return fieldsExprNullsafeMethodCall
+	case *ExprNullsafePropertyFetch:
+		return fieldsExprNullsafePropertyFetch
+	case *ExprPostDec:
+		return fieldsExprPostDec
+	case *ExprPostInc:
+		return fieldsExprPostInc
+	case *ExprPreDec:
+		return fieldsExprPreDec
+	case *ExprPreInc:
+		return fieldsExprPreInc
+	case *ExprPrint:
+		return fieldsExprPrint
+	case *ExprPropertyFetch:
+		return fieldsExprPropertyFetch
+	case *ExprRequire:
+		return fieldsExprRequire
+	case *ExprRequireOnce:
+		return fieldsExprRequireOnce
+	case *ExprShellExec:
+		return fieldsExprShellExec
+	case *ExprStaticCall:
+		return fieldsExprStaticCall
+	case *ExprStaticPropertyFetch:
+		return fieldsExprStaticPropertyFetch
+	case *ExprTernary:
+		return fieldsExprTernary
+	case *ExprThrow:
+		return fieldsExprThrow
+	case *ExprUnaryMinus:
+		return fieldsExprUnaryMinus
+	case *ExprUnaryPlus:
+		return fieldsExprUnaryPlus
+	case *ExprVariable:
+		return fieldsExprVariable
+	case *ExprYield:
+		return fieldsExprYield
+	case *ExprYieldFrom:
+		return fieldsExprYieldFrom
+	case *ExprCastArray:
+		return fieldsExprCastArray
+	case *ExprCastBool:
+		return fieldsExprCastBool
+	case *ExprCastDouble:
+		return fieldsExprCastDouble
+	case *ExprCastInt:
+		return fieldsExprCastInt
+	case *ExprCastObject:
+		return fieldsExprCastObject
+	case *ExprCastString:
+		return fieldsExprCastString
+	case *ExprCastUnset:
+		return fieldsExprCastUnset
+	case *ExprAssign:
+		return fieldsExprAssign
+	case *ExprAssignReference:
+		return fieldsExprAssignReference
+	case *ExprAssignBitwiseAnd:
+		return fieldsExprAssignBitwiseAnd
+	case *ExprAssignBitwiseOr:
+		return fieldsExprAssignBitwiseOr
+	case *ExprAssignBitwiseXor:
+		return fieldsExprAssignBitwiseXor
+	case *ExprAssignCoalesce:
+		return fieldsExprAssignCoalesce
+	case *ExprAssignConcat:
+		return fieldsExprAssignConcat
+	case *ExprAssignDiv:
+		return fieldsExprAssignDiv
+	case *ExprAssignMinus:
+		return fieldsExprAssignMinus
+	case *ExprAssignMod:
+		return fieldsExprAssignMod
+	case *ExprAssignMul:
+		return fieldsExprAssignMul
+	case *ExprAssignPlus:
+		return fieldsExprAssignPlus
+	case *ExprAssignPow:
+		return fieldsExprAssignPow
+	case *ExprAssignShiftLeft:
+		return fieldsExprAssignShiftLeft
+	case *ExprAssignShiftRight:
+		return fieldsExprAssignShiftRight
+	case *ExprBinaryBitwiseAnd:
+		return fieldsExprBinaryBitwiseAnd
+	case *ExprBinaryBitwiseOr:
+		return fieldsExprBinaryBitwiseOr
+	case *ExprBinaryBitwiseXor:
+		return fieldsExprBinaryBitwiseXor
+	case *ExprBinaryBooleanAnd:
+		return fieldsExprBinaryBooleanAnd
+	case *ExprBinaryBooleanOr:
+		return fieldsExprBinaryBooleanOr
+	case *ExprBinaryCoalesce:
+		return fieldsExprBinaryCoalesce
+	case *ExprBinaryConcat:
+		return fieldsExprBinaryConcat
+	case *ExprBinaryDiv:
+		return fieldsExprBinaryDiv
+	case *ExprBinaryEqual:
+		return fieldsExprBinaryEqual
+	case *ExprBinaryGreater:
+		return fieldsExprBinaryGreater
+	case *ExprBinaryGreaterOrEqual:
+		return fieldsExprBinaryGreaterOrEqual
+	case *ExprBinaryIdentical:
+		return fieldsExprBinaryIdentical
+	case *ExprBinaryLogicalAnd:
+		return fieldsExprBinaryLogicalAnd
+	case *ExprBinaryLogicalOr:
+		return fieldsExprBinaryLogicalOr
+	case *ExprBinaryLogicalXor:
+		return fieldsExprBinaryLogicalXor
+	case *ExprBinaryMinus:
+		return fieldsExprBinaryMinus
+	case *ExprBinaryMod:
+		return fieldsExprBinaryMod
+	case *ExprBinaryMul:
+		return fieldsExprBinaryMul
+	case *ExprBinaryNotEqual:
+		return fieldsExprBinaryNotEqual
+	case *ExprBinaryNotIdentical:
+		return fieldsExprBinaryNotIdentical
+	case *ExprBinaryPlus:
+		return fieldsExprBinaryPlus
+	case *ExprBinaryPow:
+		return fieldsExprBinaryPow
+	case *ExprBinaryShiftLeft:
+		return fieldsExprBinaryShiftLeft
+	case *ExprBinaryShiftRight:
+		return fieldsExprBinaryShiftRight
+	case *ExprBinarySmaller:
+		return fieldsExprBinarySmaller
+	case *ExprBinarySmallerOrEqual:
+		return fieldsExprBinarySmallerOrEqual
+	case *ExprBinarySpaceship:
+		return fieldsExprBinarySpaceship
+	case *Name:
+		return fieldsName
+	case *NameFullyQualified:
+		return fieldsNameFullyQualified
+	case *NameRelative:
+		return fieldsNameRelative
+	case *NamePart:
+		return fieldsNamePart
+	}
+
+	return nil
+}
+
+// Children returns the child nodes in the declaration order of their fields,
+// nil nodes are omitted
+func Children(n Vertex) []Child {
+	var c []Child
+
+	switch n := n.(type) {
+	case *Root:
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *Nullable:
+		c = appendChild(c, "Expr", n.Expr)
+	case *Parameter:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChildren(c, "Modifiers", n.Modifiers)
+		c = appendChild(c, "Type", n.Type)
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "DefaultValue", n.DefaultValue)
+	case *Identifier:
+	case *Argument:
+		c = appendChild(c, "Name", n.Name)
+		c = appendChild(c, "Expr", n.Expr)
+	case *Attribute:
+		c = appendChild(c, "Name", n.Name)
+		c = appendChildren(c, "Args", n.Args)
+	case *AttributeGroup:
+		c = appendChildren(c, "Attrs", n.Attrs)
+	case *Union:
+		c = appendChildren(c, "Types", n.Types)
+	case *Intersection:
+		c = appendChildren(c, "Types", n.Types)
+	case *MatchArm:
+		c = appendChildren(c, "Exprs", n.Exprs)
+		c = appendChild(c, "ReturnExpr", n.ReturnExpr)
+	case *ScalarDnumber:
+	case *ScalarEncapsed:
+		c = appendChildren(c, "Parts", n.Parts)
+	case *ScalarEncapsedStringPart:
+	case *ScalarEncapsedStringVar:
+		c = appendChild(c, "Name", n.Name)
+		c = appendChild(c, "Dim", n.Dim)
+	case *ScalarEncapsedStringBrackets:
+		c = appendChild(c, "Var", n.Var)
+	case *ScalarHeredoc:
+		c = appendChildren(c, "Parts", n.Parts)
+	case *ScalarLnumber:
+	case *ScalarMagicConstant:
+	case *ScalarString:
+	case *BadStmt:
+	case *StmtBreak:
+		c = appendChild(c, "Expr", n.Expr)
+	case *StmtCase:
+		c = appendChild(c, "Cond", n.Cond)
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtCatch:
+		c = appendChildren(c, "Types", n.Types)
+		c = appendChild(c, "Var", n.Var)
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtClass:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChildren(c, "Modifiers", n.Modifiers)
+		c = appendChild(c, "Name", n.Name)
+		c = appendChildren(c, "Args", n.Args)
+		c = appendChild(c, "Extends", n.Extends)
+		c = appendChildren(c, "Implements", n.Implements)
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtClassConstList:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChildren(c, "Modifiers", n.Modifiers)
+		c = appendChild(c, "Type", n.Type)
+		c = appendChildren(c, "Consts", n.Consts)
+	case *StmtClassMethod:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChildren(c, "Modifiers", n.Modifiers)
+		c = appendChild(c, "Name", n.Name)
+		c = appendChildren(c, "Params", n.Params)
+		c = appendChild(c, "ReturnType", n.ReturnType)
+		c = appendChild(c, "Stmt", n.Stmt)
+	case *StmtConstList:
+		c = appendChildren(c, "Consts", n.Consts)
+	case *StmtConstant:
+		c = appendChild(c, "Name", n.Name)
+		c = appendChild(c, "Expr", n.Expr)
+	case *StmtContinue:
+		c = appendChild(c, "Expr", n.Expr)
+	case *StmtDeclare:
+		c = appendChildren(c, "Consts", n.Consts)
+		c = appendChild(c, "Stmt", n.Stmt)
+	case *StmtDefault:
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtDo:
+		c = appendChild(c, "Stmt", n.Stmt)
+		c = appendChild(c, "Cond", n.Cond)
+	case *StmtEcho:
+		c = appendChildren(c, "Exprs", n.Exprs)
+	case *StmtElse:
+		c = appendChild(c, "Stmt", n.Stmt)
+	case *StmtElseIf:
+		c = appendChild(c, "Cond", n.Cond)
+		c = appendChild(c, "Stmt", n.Stmt)
+	case *StmtEnum:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChild(c, "Name", n.Name)
+		c = appendChild(c, "Type", n.Type)
+		c = appendChildren(c, "Implements", n.Implements)
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtEnumCase:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChild(c, "Name", n.Name)
+		c = appendChild(c, "Expr", n.Expr)
+	case *StmtExpression:
+		c = appendChild(c, "Expr", n.Expr)
+	case *StmtFinally:
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtFor:
+		c = appendChildren(c, "Init", n.Init)
+		c = appendChildren(c, "Cond", n.Cond)
+		c = appendChildren(c, "Loop", n.Loop)
+		c = appendChild(c, "Stmt", n.Stmt)
+	case *StmtForeach:
+		c = appendChild(c, "Expr", n.Expr)
+		c = appendChild(c, "Key", n.Key)
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Stmt", n.Stmt)
+	case *StmtFunction:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChild(c, "Name", n.Name)
+		c = appendChildren(c, "Params", n.Params)
+		c = appendChild(c, "ReturnType", n.ReturnType)
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtGlobal:
+		c = appendChildren(c, "Vars", n.Vars)
+	case *StmtGoto:
+		c = appendChild(c, "Label", n.Label)
+	case *StmtHaltCompiler:
+	case *StmtIf:
+		c = appendChild(c, "Cond", n.Cond)
+		c = appendChild(c, "Stmt", n.Stmt)
+		c = appendChildren(c, "ElseIf", n.ElseIf)
+		c = appendChild(c, "Else", n.Else)
+	case *StmtInlineHtml:
+	case *StmtInterface:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChild(c, "Name", n.Name)
+		c = appendChildren(c, "Extends", n.Extends)
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtLabel:
+		c = appendChild(c, "Name", n.Name)
+	case *StmtNamespace:
+		c = appendChild(c, "Name", n.Name)
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtNop:
+	case *StmtProperty:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *StmtPropertyList:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChildren(c, "Modifiers", n.Modifiers)
+		c = appendChild(c, "Type", n.Type)
+		c = appendChildren(c, "Props", n.Props)
+	case *StmtReturn:
+		c = appendChild(c, "Expr", n.Expr)
+	case *StmtStatic:
+		c = appendChildren(c, "Vars", n.Vars)
+	case *StmtStaticVar:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *StmtStmtList:
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtSwitch:
+		c = appendChild(c, "Cond", n.Cond)
+		c = appendChildren(c, "Cases", n.Cases)
+	case *StmtThrow:
+		c = appendChild(c, "Expr", n.Expr)
+	case *StmtTrait:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChild(c, "Name", n.Name)
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *StmtTraitUse:
+		c = appendChildren(c, "Traits", n.Traits)
+		c = appendChildren(c, "Adaptations", n.Adaptations)
+	case *StmtTraitUseAlias:
+		c = appendChild(c, "Trait", n.Trait)
+		c = appendChild(c, "Method", n.Method)
+		c = appendChild(c, "Modifier", n.Modifier)
+		c = appendChild(c, "Alias", n.Alias)
+	case *StmtTraitUsePrecedence:
+		c = appendChild(c, "Trait", n.Trait)
+		c = appendChild(c, "Method", n.Method)
+		c = appendChildren(c, "Insteadof", n.Insteadof)
+	case *StmtTry:
+		c = appendChildren(c, "Stmts", n.Stmts)
+		c = appendChildren(c, "Catches", n.Catches)
+		c = appendChild(c, "Finally", n.Finally)
+	case *StmtUnset:
+		c = appendChildren(c, "Vars", n.Vars)
+	case *StmtUseList:
+		c = appendChild(c, "Type", n.Type)
+		c = appendChildren(c, "Uses", n.Uses)
+	case *StmtGroupUseList:
+		c = appendChild(c, "Type", n.Type)
+		c = appendChild(c, "Prefix", n.Prefix)
+		c = appendChildren(c, "Uses", n.Uses)
+	case *StmtUse:
+		c = appendChild(c, "Type", n.Type)
+		c = appendChild(c, "Use", n.Use)
+		c = appendChild(c, "Alias", n.Alias)
+	case *StmtWhile:
+		c = appendChild(c, "Cond", n.Cond)
+		c = appendChild(c, "Stmt", n.Stmt)
+	case *BadExpr:
+	case *ExprArray:
+		c = appendChildren(c, "Items", n.Items)
+	case *ExprArrayDimFetch:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Dim", n.Dim)
+	case *ExprArrayItem:
+		c = appendChild(c, "Key", n.Key)
+		c = appendChild(c, "Val", n.Val)
+	case *ExprArrowFunction:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChildren(c, "Params", n.Params)
+		c = appendChild(c, "ReturnType", n.ReturnType)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprBitwiseNot:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprBooleanNot:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprBrackets:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprClassConstFetch:
+		c = appendChild(c, "Class", n.Class)
+		c = appendChild(c, "Const", n.Const)
+	case *ExprClone:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprClosure:
+		c = appendChildren(c, "AttrGroups", n.AttrGroups)
+		c = appendChildren(c, "Params", n.Params)
+		c = appendChildren(c, "Uses", n.Uses)
+		c = appendChild(c, "ReturnType", n.ReturnType)
+		c = appendChildren(c, "Stmts", n.Stmts)
+	case *ExprClosureUse:
+		c = appendChild(c, "Var", n.Var)
+	case *ExprConstFetch:
+		c = appendChild(c, "Const", n.Const)
+	case *ExprEmpty:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprErrorSuppress:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprEval:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprExit:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprFunctionCall:
+		c = appendChild(c, "Function", n.Function)
+		c = appendChildren(c, "Args", n.Args)
+	case *ExprInclude:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprIncludeOnce:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprInstanceOf:
+		c = appendChild(c, "Expr", n.Expr)
+		c = appendChild(c, "Class", n.Class)
+	case *ExprIsset:
+		c = appendChildren(c, "Vars", n.Vars)
+	case *ExprList:
+		c = appendChildren(c, "Items", n.Items)
+	case *ExprMatch:
+		c = appendChild(c, "Expr", n.Expr)
+		c = appendChildren(c, "Arms", n.Arms)
+	case *ExprMethodCall:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Method", n.Method)
+		c = appendChildren(c, "Args", n.Args)
+	case *ExprNew:
+		c = appendChild(c, "Class", n.Class)
+		c = appendChildren(c, "Args", n.Args)
+	case *ExprNullsafeMethodCall:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Method", n.Method)
+		c = appendChildren(c, "Args", n.Args)
+	case *ExprNullsafePropertyFetch:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Prop", n.Prop)
+	case *ExprPostDec:
+		c = appendChild(c, "Var", n.Var)
+	case *ExprPostInc:
+		c = appendChild(c, "Var", n.Var)
+	case *ExprPreDec:
+		c = appendChild(c, "Var", n.Var)
+	case *ExprPreInc:
+		c = appendChild(c, "Var", n.Var)
+	case *ExprPrint:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprPropertyFetch:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Prop", n.Prop)
+	case *ExprRequire:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprRequireOnce:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprShellExec:
+		c = appendChildren(c, "Parts", n.Parts)
+	case *ExprStaticCall:
+		c = appendChild(c, "Class", n.Class)
+		c = appendChild(c, "Call", n.Call)
+		c = appendChildren(c, "Args", n.Args)
+	case *ExprStaticPropertyFetch:
+		c = appendChild(c, "Class", n.Class)
+		c = appendChild(c, "Prop", n.Prop)
+	case *ExprTernary:
+		c = appendChild(c, "Cond", n.Cond)
+		c = appendChild(c, "IfTrue", n.IfTrue)
+		c = appendChild(c, "IfFalse", n.IfFalse)
+	case *ExprThrow:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprUnaryMinus:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprUnaryPlus:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprVariable:
+		c = appendChild(c, "Name", n.Name)
+	case *ExprYield:
+		c = appendChild(c, "Key", n.Key)
+		c = appendChild(c, "Val", n.Val)
+	case *ExprYieldFrom:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprCastArray:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprCastBool:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprCastDouble:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprCastInt:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprCastObject:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprCastString:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprCastUnset:
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssign:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignReference:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignBitwiseAnd:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignBitwiseOr:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignBitwiseXor:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignCoalesce:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignConcat:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignDiv:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignMinus:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignMod:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignMul:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignPlus:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignPow:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignShiftLeft:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprAssignShiftRight:
+		c = appendChild(c, "Var", n.Var)
+		c = appendChild(c, "Expr", n.Expr)
+	case *ExprBinaryBitwiseAnd:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryBitwiseOr:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryBitwiseXor:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryBooleanAnd:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryBooleanOr:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryCoalesce:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryConcat:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryDiv:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryEqual:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryGreater:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryGreaterOrEqual:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryIdentical:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryLogicalAnd:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryLogicalOr:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryLogicalXor:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryMinus:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryMod:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryMul:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryNotEqual:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryNotIdentical:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryPlus:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryPow:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryShiftLeft:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinaryShiftRight:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinarySmaller:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinarySmallerOrEqual:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *ExprBinarySpaceship:
+		c = appendChild(c, "Left", n.Left)
+		c = appendChild(c, "Right", n.Right)
+	case *Name:
+		c = appendChildren(c, "Parts", n.Parts)
+	case *NameFullyQualified:
+		c = appendChildren(c, "Parts", n.Parts)
+	case *NameRelative:
+		c = appendChildren(c, "Parts", n.Parts)
+	case *NamePart:
+	}
+
+	return c
+}
+
+// Kind returns the name of the node type
+func Kind(n Vertex) string {
+	switch n.(type) {
+	case *Root:
+		return "Root"
+	case *Nullable:
+		return "Nullable"
+	case *Parameter:
+		return "Parameter"
+	case *Identifier:
+		return "Identifier"
+	case *Argument:
+		return "Argument"
+	case *Attribute:
+		return "Attribute"
+	case *AttributeGroup:
+		return "AttributeGroup"
+	case *Union:
+		return "Union"
+	case *Intersection:
+		return "Intersection"
+	case *MatchArm:
+		return "MatchArm"
+	case *ScalarDnumber:
+		return "ScalarDnumber"
+	case *ScalarEncapsed:
+		return "ScalarEncapsed"
+	case *ScalarEncapsedStringPart:
+		return "ScalarEncapsedStringPart"
+	case *ScalarEncapsedStringVar:
+		return "ScalarEncapsedStringVar"
+	case *ScalarEncapsedStringBrackets:
+		return "ScalarEncapsedStringBrackets"
+	case *ScalarHeredoc:
+		return "ScalarHeredoc"
+	case *ScalarLnumber:
+		return "ScalarLnumber"
+	case *ScalarMagicConstant:
+		return "ScalarMagicConstant"
+	case *ScalarString:
+		return "ScalarString"
+	case *BadStmt:
+		return "BadStmt"
+	case *StmtBreak:
+		return "StmtBreak"
+	case *StmtCase:
+		return "StmtCase"
+	case *StmtCatch:
+		return "StmtCatch"
+	case *StmtClass:
+		return "StmtClass"
+	case *StmtClassConstList:
+		return "StmtClassConstList"
+	case *StmtClassMethod:
+		return "StmtClassMethod"
+	case *StmtConstList:
+		return "StmtConstList"
+	case *StmtConstant:
+		return "StmtConstant"
+	case *StmtContinue:
+		return "StmtContinue"
+	case *StmtDeclare:
+		return "StmtDeclare"
+	case *StmtDefault:
+		return "StmtDefault"
+	case *StmtDo:
+		return "StmtDo"
+	case *StmtEcho:
+		return "StmtEcho"
+	case *StmtElse:
+		return "StmtElse"
+	case *StmtElseIf:
+		return "StmtElseIf"
+	case *StmtEnum:
+		return "StmtEnum"
+	case *StmtEnumCase:
+		return "StmtEnumCase"
+	case *StmtExpression:
+		return "StmtExpression"
+	case *StmtFinally:
+		return "StmtFinally"
+	case *StmtFor:
+		return "StmtFor"
+	case *StmtForeach:
+		return "StmtForeach"
+	case *StmtFunction:
+		return "StmtFunction"
+	case *StmtGlobal:
+		return "StmtGlobal"
+	case *StmtGoto:
+		return "StmtGoto"
+	case *StmtHaltCompiler:
+		return "StmtHaltCompiler"
+	case *StmtIf:
+		return "StmtIf"
+	case *StmtInlineHtml:
+		return "StmtInlineHtml"
+	case *StmtInterface:
+		return "StmtInterface"
+	case *StmtLabel:
+		return "StmtLabel"
+	case *StmtNamespace:
+		return "StmtNamespace"
+	case *StmtNop:
+		return "StmtNop"
+	case *StmtProperty:
+		return "StmtProperty"
+	case *StmtPropertyList:
+		return "StmtPropertyList"
+	case *StmtReturn:
+		return "StmtReturn"
+	case *StmtStatic:
+		return "StmtStatic"
+	case *StmtStaticVar:
+		return "StmtStaticVar"
+	case *StmtStmtList:
+		return "StmtStmtList"
+	case *StmtSwitch:
+		return "StmtSwitch"
+	case *StmtThrow:
+		return "StmtThrow"
+	case *StmtTrait:
+		return "StmtTrait"
+	case *StmtTraitUse:
+		return "StmtTraitUse"
+	case *StmtTraitUseAlias:
+		return "StmtTraitUseAlias"
+	case *StmtTraitUsePrecedence:
+		return "StmtTraitUsePrecedence"
+	case *StmtTry:
+		return "StmtTry"
+	case *StmtUnset:
+		return "StmtUnset"
+	case *StmtUseList:
+		return "StmtUseList"
+	case *StmtGroupUseList:
+		return "StmtGroupUseList"
+	case *StmtUse:
+		return "StmtUse"
+	case *StmtWhile:
+		return "StmtWhile"
+	case *BadExpr:
+		return "BadExpr"
+	case *ExprArray:
+		return "ExprArray"
+	case *ExprArrayDimFetch:
+		return "ExprArrayDimFetch"
+	case *ExprArrayItem:
+		return "ExprArrayItem"
+	case *ExprArrowFunction:
+		return "ExprArrowFunction"
+	case *ExprBitwiseNot:
+		return "ExprBitwiseNot"
+	case *ExprBooleanNot:
+		return "ExprBooleanNot"
+	case *ExprBrackets:
+		return "ExprBrackets"
+	case *ExprClassConstFetch:
+		return "ExprClassConstFetch"
+	case *ExprClone:
+		return "ExprClone"
+	case *ExprClosure:
+		return "ExprClosure"
+	case *ExprClosureUse:
+		return "ExprClosureUse"
+	case *ExprConstFetch:
+		return "ExprConstFetch"
+	case *ExprEmpty:
+		return "ExprEmpty"
+	case *ExprErrorSuppress:
+		return "ExprErrorSuppress"
+	case *ExprEval:
+		return "ExprEval"
+	case *ExprExit:
+		return "ExprExit"
+	case *ExprFunctionCall:
+		return "ExprFunctionCall"
+	case *ExprInclude:
+		return "ExprInclude"
+	case *ExprIncludeOnce:
+		return "ExprIncludeOnce"
+	case *ExprInstanceOf:
+		return "ExprInstanceOf"
+	case *ExprIsset:
+		return "ExprIsset"
+	case *ExprList:
+		return "ExprList"
+	case *ExprMatch:
+		return "ExprMatch"
+	case *ExprMethodCall:
+		return "ExprMethodCall"
+	case *ExprNew:
+		return "ExprNew"
+	case *ExprNullsafeMethodCall:
+		return "ExprNullsafeMethodCall"
+	case *ExprNullsafePropertyFetch:
+		return "ExprNullsafePropertyFetch"
+	case *ExprPostDec:
+		return "ExprPostDec"
+	case *ExprPostInc:
+		return "ExprPostInc"
+	case *ExprPreDec:
+		return "ExprPreDec"
+	case *ExprPreInc:
+		return "ExprPreInc"
+	case *ExprPrint:
+		return "ExprPrint"
+	case *ExprPropertyFetch:
+		return "ExprPropertyFetch"
+	case *ExprRequire:
+		return "ExprRequire"
+	case *ExprRequireOnce:
+		return "ExprRequireOnce"
+	case *ExprShellExec:
+		return "ExprShellExec"
+	case *ExprStaticCall:
+		return "ExprStaticCall"
+	case *ExprStaticPropertyFetch:
+		return "ExprStaticPropertyFetch"
+	case *ExprTernary:
+		return "ExprTernary"
+	case *ExprThrow:
+		return "ExprThrow"
+	case *ExprUnaryMinus:
+		return "ExprUnaryMinus"
+	case *ExprUnaryPlus:
+		return "ExprUnaryPlus"
+	case *ExprVariable:
+		return "ExprVariable"
+	case *ExprYield:
+		return "ExprYield"
+	case *ExprYieldFrom:
+		return "ExprYieldFrom"
+	case *ExprCastArray:
+		return "ExprCastArray"
+	case *ExprCastBool:
+		return "ExprCastBool"
+	case *ExprCastDouble:
+		return "ExprCastDouble"
+	case *ExprCastInt:
+		return "ExprCastInt"
+	case *ExprCastObject:
+		return "ExprCastObject"
+	case *ExprCastString:
+		return "ExprCastString"
+	case *ExprCastUnset:
+		return "ExprCastUnset"
+	case *ExprAssign:
+		return "ExprAssign"
+	case *ExprAssignReference:
+		return "ExprAssignReference"
+	case *ExprAssignBitwiseAnd:
+		return "ExprAssignBitwiseAnd"
+	case *ExprAssignBitwiseOr:
+		return "ExprAssignBitwiseOr"
+	case *ExprAssignBitwiseXor:
+		return "ExprAssignBitwiseXor"
+	case *ExprAssignCoalesce:
+		return "ExprAssignCoalesce"
+	case *ExprAssignConcat:
+		return "ExprAssignConcat"
+	case *ExprAssignDiv:
+		return "ExprAssignDiv"
+	case *ExprAssignMinus:
+		return "ExprAssignMinus"
+	case *ExprAssignMod:
+		return "ExprAssignMod"
+	case *ExprAssignMul:
+		return "ExprAssignMul"
+	case *ExprAssignPlus:
+		return "ExprAssignPlus"
+	case *ExprAssignPow:
+		return "ExprAssignPow"
+	case *ExprAssignShiftLeft:
+		return "ExprAssignShiftLeft"
+	case *ExprAssignShiftRight:
+		return "ExprAssignShiftRight"
+	case *ExprBinaryBitwiseAnd:
+		return "ExprBinaryBitwiseAnd"
+	case *ExprBinaryBitwiseOr:
+		return "ExprBinaryBitwiseOr"
+	case *ExprBinaryBitwiseXor:
+		return "ExprBinaryBitwiseXor"
+	case *ExprBinaryBooleanAnd:
+		return "ExprBinaryBooleanAnd"
+	case *ExprBinaryBooleanOr:
+		return "ExprBinaryBooleanOr"
+	case *ExprBinaryCoalesce:
+		return "ExprBinaryCoalesce"
+	case *ExprBinaryConcat:
+		return "ExprBinaryConcat"
+	case *ExprBinaryDiv:
+		return "ExprBinaryDiv"
+	case *ExprBinaryEqual:
+		return "ExprBinaryEqual"
+	case *ExprBinaryGreater:
+		return "ExprBinaryGreater"
+	case *ExprBinaryGreaterOrEqual:
+		return "ExprBinaryGreaterOrEqual"
+	case *ExprBinaryIdentical:
+		return "ExprBinaryIdentical"
+	case *ExprBinaryLogicalAnd:
+		return "ExprBinaryLogicalAnd"
+	case *ExprBinaryLogicalOr:
+		return "ExprBinaryLogicalOr"
+	case *ExprBinaryLogicalXor:
+		return "ExprBinaryLogicalXor"
+	case *ExprBinaryMinus:
+		return "ExprBinaryMinus"
+	case *ExprBinaryMod:
+		return "ExprBinaryMod"
+	case *ExprBinaryMul:
+		return "ExprBinaryMul"
+	case *ExprBinaryNotEqual:
+		return "ExprBinaryNotEqual"
+	case *ExprBinaryNotIdentical:
+		return "ExprBinaryNotIdentical"
+	case *ExprBinaryPlus:
+		return "ExprBinaryPlus"
+	case *ExprBinaryPow:
+		return "ExprBinaryPow"
+	case *ExprBinaryShiftLeft:
+		return "ExprBinaryShiftLeft"
+	case *ExprBinaryShiftRight:
+		return "ExprBinaryShiftRight"
+	case *ExprBinarySmaller:
+		return "ExprBinarySmaller"
+	case *ExprBinarySmallerOrEqual:
+		return "ExprBinarySmallerOrEqual"
+	case *ExprBinarySpaceship:
+		return "ExprBinarySpaceship"
+	case *Name:
+		return "Name"
+	case *NameFullyQualified:
+		return "NameFullyQualified"
+	case *NameRelative:
+		return "NameRelative"
+	case *NamePart:
+		return "NamePart"
+	}
+
+	return ""
+}
+
+// NewNode returns an empty node of the kind, or nil if the kind is unknown
+func NewNode(kind string) Vertex {
+	switch kind {
+	case "Root":
+		return &Root{}
+	case "Nullable":
+		return &Nullable{}
+	case "Parameter":
+		return &Parameter{}
+	case "Identifier":
+		return &Identifier{}
+	case "Argument":
+		return &Argument{}
+	case "Attribute":
+		return &Attribute{}
+	case "AttributeGroup":
+		return &AttributeGroup{}
+	case "Union":
+		return &Union{}
+	case "Intersection":
+		return &Intersection{}
+	case "MatchArm":
+		return &MatchArm{}
+	case "ScalarDnumber":
+		return &ScalarDnumber{}
+	case "ScalarEncapsed":
+		return &ScalarEncapsed{}
+	case "ScalarEncapsedStringPart":
+		return &ScalarEncapsedStringPart{}
+	case "ScalarEncapsedStringVar":
+		return &ScalarEncapsedStringVar{}
+	case "ScalarEncapsedStringBrackets":
+		return &ScalarEncapsedStringBrackets{}
+	case "ScalarHeredoc":
+		return &ScalarHeredoc{}
+	case "ScalarLnumber":
+		return &ScalarLnumber{}
+	case "ScalarMagicConstant":
+		return &ScalarMagicConstant{}
+	case "ScalarString":
+		return &ScalarString{}
+	case "BadStmt":
+		return &BadStmt{}
+	case "StmtBreak":
+		return &StmtBreak{}
+	case "StmtCase":
+		return &StmtCase{}
+	case "StmtCatch":
+		return &StmtCatch{}
+	case "StmtClass":
+		return &StmtClass{}
+	case "StmtClassConstList":
+		return &StmtClassConstList{}
+	case "StmtClassMethod":
+		return &StmtClassMethod{}
+	case "StmtConstList":
+		return &StmtConstList{}
+	case "StmtConstant":
+		return &StmtConstant{}
+	case "StmtContinue":
+		return &StmtContinue{}
+	case "StmtDeclare":
+		return &StmtDeclare{}
+	case "StmtDefault":
+		return &StmtDefault{}
+	case "StmtDo":
+		return &StmtDo{}
+	case "StmtEcho":
+		return &StmtEcho{}
+	case "StmtElse":
+		return &StmtElse{}
+	case "StmtElseIf":
+		return &StmtElseIf{}
+	case "StmtEnum":
+		return &StmtEnum{}
+	case "StmtEnumCase":
+		return &StmtEnumCase{}
+	case "StmtExpression":
+		return &StmtExpression{}
+	case "StmtFinally":
+		return &StmtFinally{}
+	case "StmtFor":
+		return &StmtFor{}
+	case "StmtForeach":
+		return &StmtForeach{}
+	case "StmtFunction":
+		return &StmtFunction{}
+	case "StmtGlobal":
+		return &StmtGlobal{}
+	case "StmtGoto":
+		return &StmtGoto{}
+	case "StmtHaltCompiler":
+		return &StmtHaltCompiler{}
+	case "StmtIf":
+		return &StmtIf{}
+	case "StmtInlineHtml":
+		return &StmtInlineHtml{}
+	case "StmtInterface":
+		return &StmtInterface{}
+	case "StmtLabel":
+		return &StmtLabel{}
+	case "StmtNamespace":
+		return &StmtNamespace{}
+	case "StmtNop":
+		return &StmtNop{}
+	case "StmtProperty":
+		return &StmtProperty{}
+	case "StmtPropertyList":
+		return &StmtPropertyList{}
+	case "StmtReturn":
+		return &StmtReturn{}
+	case "StmtStatic":
+		return &StmtStatic{}
+	case "StmtStaticVar":
+		return &StmtStaticVar{}
+	case "StmtStmtList":
+		return &StmtStmtList{}
+	case "StmtSwitch":
+		return &StmtSwitch{}
+	case "StmtThrow":
+		return &StmtThrow{}
+	case "StmtTrait":
+		return &StmtTrait{}
+	case "StmtTraitUse":
+		return &StmtTraitUse{}
+	case "StmtTraitUseAlias":
+		return &StmtTraitUseAlias{}
+	case "StmtTraitUsePrecedence":
+		return &StmtTraitUsePrecedence{}
+	case "StmtTry":
+		return &StmtTry{}
+	case "StmtUnset":
+		return &StmtUnset{}
+	case "StmtUseList":
+		return &StmtUseList{}
+	case "StmtGroupUseList":
+		return &StmtGroupUseList{}
+	case "StmtUse":
+		return &StmtUse{}
+	case "StmtWhile":
+		return &StmtWhile{}
+	case "BadExpr":
+		return &BadExpr{}
+	case "ExprArray":
+		return &ExprArray{}
+	case "ExprArrayDimFetch":
+		return &ExprArrayDimFetch{}
+	case "ExprArrayItem":
+		return &ExprArrayItem{}
+	case "ExprArrowFunction":
+		return &ExprArrowFunction{}
+	case "ExprBitwiseNot":
+		return &ExprBitwiseNot{}
+	case "ExprBooleanNot":
+		return &ExprBooleanNot{}
+	case "ExprBrackets":
+		return &ExprBrackets{}
+	case "ExprClassConstFetch":
+		return &ExprClassConstFetch{}
+	case "ExprClone":
+		return &ExprClone{}
+	case "ExprClosure":
+		return &ExprClosure{}
+	case "ExprClosureUse":
+		return &ExprClosureUse{}
+	case "ExprConstFetch":
+		return &ExprConstFetch{}
+	case "ExprEmpty":
+		return &ExprEmpty{}
+	case "ExprErrorSuppress":
+		return &ExprErrorSuppress{}
+	case "ExprEval":
+		return &ExprEval{}
+	case "ExprExit":
+		return &ExprExit{}
+	case "ExprFunctionCall":
+		return &ExprFunctionCall{}
+	case "ExprInclude":
+		return &ExprInclude{}
+	case "ExprIncludeOnce":
+		return &ExprIncludeOnce{}
+	case "ExprInstanceOf":
+		return &ExprInstanceOf{}
+	case "ExprIsset":
+		return &ExprIsset{}
+	case "ExprList":
+		return &ExprList{}
+	case "ExprMatch":
+		return &ExprMatch{}
+	case "ExprMethodCall":
+		return &ExprMethodCall{}
+	case "ExprNew":
+		return &ExprNew{}
+	case "ExprNullsafeMethodCall":
+		return &ExprNullsafeMethodCall{}
+	case "ExprNullsafePropertyFetch":
+		return &ExprNullsafePropertyFetch{}
+	case "ExprPostDec":
+		return &ExprPostDec{}
+	case "ExprPostInc":
+		return &ExprPostInc{}
+	case "ExprPreDec":
+		return &ExprPreDec{}
+	case "ExprPreInc":
+		return &ExprPreInc{}
+	case "ExprPrint":
+		return &ExprPrint{}
+	case "ExprPropertyFetch":
+		return &ExprPropertyFetch{}
+	case "ExprRequire":
+		return &ExprRequire{}
+	case "ExprRequireOnce":
+		return &ExprRequireOnce{}
+	case "ExprShellExec":
+		return &ExprShellExec{}
+	case "ExprStaticCall":
+		return &ExprStaticCall{}
+	case "ExprStaticPropertyFetch":
+		return &ExprStaticPropertyFetch{}
+	case "ExprTernary":
+		return &ExprTernary{}
+	case "ExprThrow":
+		return &ExprThrow{}
+	case "ExprUnaryMinus":
+		return &ExprUnaryMinus{}
+	case "ExprUnaryPlus":
+		return &ExprUnaryPlus{}
+	case "ExprVariable":
+		return &ExprVariable{}
+	case "ExprYield":
+		return &ExprYield{}
+	case "ExprYieldFrom":
+		return &ExprYieldFrom{}
+	case "ExprCastArray":
+		return &ExprCastArray{}
+	case "ExprCastBool":
+		return &ExprCastBool{}
+	case "ExprCastDouble":
+		return &ExprCastDouble{}
+	case "ExprCastInt":
+		return &ExprCastInt{}
+	case "ExprCastObject":
+		return &ExprCastObject{}
+	case "ExprCastString":
+		return &ExprCastString{}
+	case "ExprCastUnset":
+		return &ExprCastUnset{}
+	case "ExprAssign":
+		return &ExprAssign{}
+	case "ExprAssignReference":
+		return &ExprAssignReference{}
+	case "ExprAssignBitwiseAnd":
+		return &ExprAssignBitwiseAnd{}
+	case "ExprAssignBitwiseOr":
+		return &ExprAssignBitwiseOr{}
+	case "ExprAssignBitwiseXor":
+		return &ExprAssignBitwiseXor{}
+	case "ExprAssignCoalesce":
+		return &ExprAssignCoalesce{}
+	case "ExprAssignConcat":
+		return &ExprAssignConcat{}
+	case "ExprAssignDiv":
+		return &ExprAssignDiv{}
+	case "ExprAssignMinus":
+		return &ExprAssignMinus{}
+	case "ExprAssignMod":
+		return &ExprAssignMod{}
+	case "ExprAssignMul":
+		return &ExprAssignMul{}
+	case "ExprAssignPlus":
+		return &ExprAssignPlus{}
+	case "ExprAssignPow":
+		return &ExprAssignPow{}
+	case "ExprAssignShiftLeft":
+		return &ExprAssignShiftLeft{}
+	case "ExprAssignShiftRight":
+		return &ExprAssignShiftRight{}
+	case "ExprBinaryBitwiseAnd":
+		return &ExprBinaryBitwiseAnd{}
+	case "ExprBinaryBitwiseOr":
+		return &ExprBinaryBitwiseOr{}
+	case "ExprBinaryBitwiseXor":
+		return &ExprBinaryBitwiseXor{}
+	case "ExprBinaryBooleanAnd":
+		return &ExprBinaryBooleanAnd{}
+	case "ExprBinaryBooleanOr":
+		return &ExprBinaryBooleanOr{}
+	case "ExprBinaryCoalesce":
+		return &ExprBinaryCoalesce{}
+	case "ExprBinaryConcat":
+		return &ExprBinaryConcat{}
+	case "ExprBinaryDiv":
+		return &ExprBinaryDiv{}
+	case "ExprBinaryEqual":
+		return &ExprBinaryEqual{}
+	case "ExprBinaryGreater":
+		return &ExprBinaryGreater{}
+	case "ExprBinaryGreaterOrEqual":
+		return &ExprBinaryGreaterOrEqual{}
+	case "ExprBinaryIdentical":
+		return &ExprBinaryIdentical{}
+	case "ExprBinaryLogicalAnd":
+		return &ExprBinaryLogicalAnd{}
+	case "ExprBinaryLogicalOr":
+		return &ExprBinaryLogicalOr{}
+	case "ExprBinaryLogicalXor":
+		return &ExprBinaryLogicalXor{}
+	case "ExprBinaryMinus":
+		return &ExprBinaryMinus{}
+	case "ExprBinaryMod":
+		return &ExprBinaryMod{}
+	case "ExprBinaryMul":
+		return &ExprBinaryMul{}
+	case "ExprBinaryNotEqual":
+		return &ExprBinaryNotEqual{}
+	case "ExprBinaryNotIdentical":
+		return &ExprBinaryNotIdentical{}
+	case "ExprBinaryPlus":
+		return &ExprBinaryPlus{}
+	case "ExprBinaryPow":
+		return &ExprBinaryPow{}
+	case "ExprBinaryShiftLeft":
+		return &ExprBinaryShiftLeft{}
+	case "ExprBinaryShiftRight":
+		return &ExprBinaryShiftRight{}
+	case "ExprBinarySmaller":
+		return &ExprBinarySmaller{}
+	case "ExprBinarySmallerOrEqual":
+		return &ExprBinarySmallerOrEqual{}
+	case "ExprBinarySpaceship":
+		return &ExprBinarySpaceship{}
+	case "Name":
+		return &Name{}
+	case "NameFullyQualified":
+		return &NameFullyQualified{}
+	case "NameRelative":
+		return &NameRelative{}
+	case "NamePart":
+		return &NamePart{}
+	}
+
+	return nil
+}
+
+func cloneNode(c *cloner, n Vertex) Vertex {
+	switch n := n.(type) {
+	case *Root:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Stmts = c.nodes(n.Stmts)
+		x.EndTkn = c.token(n.EndTkn)
+		return &x
+	case *Nullable:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.QuestionTkn = c.token(n.QuestionTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *Parameter:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.Modifiers = c.nodes(n.Modifiers)
+		x.Type = c.node(n.Type)
+		x.AmpersandTkn = c.token(n.AmpersandTkn)
+		x.VariadicTkn = c.token(n.VariadicTkn)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.DefaultValue = c.node(n.DefaultValue)
+		return &x
+	case *Identifier:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.IdentifierTkn = c.token(n.IdentifierTkn)
+		x.Value = c.bytes(n.Value)
+		return &x
+	case *Argument:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Name = c.node(n.Name)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.VariadicTkn = c.token(n.VariadicTkn)
+		x.AmpersandTkn = c.token(n.AmpersandTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *Attribute:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Name = c.node(n.Name)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Args = c.nodes(n.Args)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *AttributeGroup:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.OpenAttributeTkn = c.token(n.OpenAttributeTkn)
+		x.Attrs = c.nodes(n.Attrs)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseAttributeTkn = c.token(n.CloseAttributeTkn)
+		return &x
+	case *Union:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Types = c.nodes(n.Types)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		return &x
+	case *Intersection:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Types = c.nodes(n.Types)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		return &x
+	case *MatchArm:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.DefaultTkn = c.token(n.DefaultTkn)
+		x.DefaultCommaTkn = c.token(n.DefaultCommaTkn)
+		x.Exprs = c.nodes(n.Exprs)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.DoubleArrowTkn = c.token(n.DoubleArrowTkn)
+		x.ReturnExpr = c.node(n.ReturnExpr)
+		return &x
+	case *ScalarDnumber:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.NumberTkn = c.token(n.NumberTkn)
+		x.Value = c.bytes(n.Value)
+		return &x
+	case *ScalarEncapsed:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.OpenQuoteTkn = c.token(n.OpenQuoteTkn)
+		x.Parts = c.nodes(n.Parts)
+		x.CloseQuoteTkn = c.token(n.CloseQuoteTkn)
+		return &x
+	case *ScalarEncapsedStringPart:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.EncapsedStrTkn = c.token(n.EncapsedStrTkn)
+		x.Value = c.bytes(n.Value)
+		return &x
+	case *ScalarEncapsedStringVar:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.DollarOpenCurlyBracketTkn = c.token(n.DollarOpenCurlyBracketTkn)
+		x.Name = c.node(n.Name)
+		x.OpenSquareBracketTkn = c.token(n.OpenSquareBracketTkn)
+		x.Dim = c.node(n.Dim)
+		x.CloseSquareBracketTkn = c.token(n.CloseSquareBracketTkn)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *ScalarEncapsedStringBrackets:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Var = c.node(n.Var)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *ScalarHeredoc:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.OpenHeredocTkn = c.token(n.OpenHeredocTkn)
+		x.Parts = c.nodes(n.Parts)
+		x.CloseHeredocTkn = c.token(n.CloseHeredocTkn)
+		return &x
+	case *ScalarLnumber:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.NumberTkn = c.token(n.NumberTkn)
+		x.Value = c.bytes(n.Value)
+		return &x
+	case *ScalarMagicConstant:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.MagicConstTkn = c.token(n.MagicConstTkn)
+		x.Value = c.bytes(n.Value)
+		return &x
+	case *ScalarString:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.MinusTkn = c.token(n.MinusTkn)
+		x.StringTkn = c.token(n.StringTkn)
+		x.Value = c.bytes(n.Value)
+		return &x
+	case *BadStmt:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.SkippedTkns = c.tokens(n.SkippedTkns)
+		return &x
+	case *StmtBreak:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.BreakTkn = c.token(n.BreakTkn)
+		x.Expr = c.node(n.Expr)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtCase:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.CaseTkn = c.token(n.CaseTkn)
+		x.Cond = c.node(n.Cond)
+		x.CaseSeparatorTkn = c.token(n.CaseSeparatorTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		return &x
+	case *StmtCatch:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.CatchTkn = c.token(n.CatchTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Types = c.nodes(n.Types)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.Var = c.node(n.Var)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *StmtClass:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.Modifiers = c.nodes(n.Modifiers)
+		x.ClassTkn = c.token(n.ClassTkn)
+		x.Name = c.node(n.Name)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Args = c.nodes(n.Args)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ExtendsTkn = c.token(n.ExtendsTkn)
+		x.Extends = c.node(n.Extends)
+		x.ImplementsTkn = c.token(n.ImplementsTkn)
+		x.Implements = c.nodes(n.Implements)
+		x.ImplementsSeparatorTkns = c.tokens(n.ImplementsSeparatorTkns)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *StmtClassConstList:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.Modifiers = c.nodes(n.Modifiers)
+		x.ConstTkn = c.token(n.ConstTkn)
+		x.Type = c.node(n.Type)
+		x.Consts = c.nodes(n.Consts)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtClassMethod:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.Modifiers = c.nodes(n.Modifiers)
+		x.FunctionTkn = c.token(n.FunctionTkn)
+		x.AmpersandTkn = c.token(n.AmpersandTkn)
+		x.Name = c.node(n.Name)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Params = c.nodes(n.Params)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.ReturnType = c.node(n.ReturnType)
+		x.Stmt = c.node(n.Stmt)
+		return &x
+	case *StmtConstList:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ConstTkn = c.token(n.ConstTkn)
+		x.Consts = c.nodes(n.Consts)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtConstant:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Name = c.node(n.Name)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *StmtContinue:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ContinueTkn = c.token(n.ContinueTkn)
+		x.Expr = c.node(n.Expr)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtDeclare:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.DeclareTkn = c.token(n.DeclareTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Consts = c.nodes(n.Consts)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.Stmt = c.node(n.Stmt)
+		x.EndDeclareTkn = c.token(n.EndDeclareTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtDefault:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.DefaultTkn = c.token(n.DefaultTkn)
+		x.CaseSeparatorTkn = c.token(n.CaseSeparatorTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		return &x
+	case *StmtDo:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.DoTkn = c.token(n.DoTkn)
+		x.Stmt = c.node(n.Stmt)
+		x.WhileTkn = c.token(n.WhileTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Cond = c.node(n.Cond)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtEcho:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.EchoTkn = c.token(n.EchoTkn)
+		x.Exprs = c.nodes(n.Exprs)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtElse:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ElseTkn = c.token(n.ElseTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.Stmt = c.node(n.Stmt)
+		return &x
+	case *StmtElseIf:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ElseIfTkn = c.token(n.ElseIfTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Cond = c.node(n.Cond)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.Stmt = c.node(n.Stmt)
+		return &x
+	case *StmtEnum:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.EnumTkn = c.token(n.EnumTkn)
+		x.Name = c.node(n.Name)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.Type = c.node(n.Type)
+		x.ImplementsTkn = c.token(n.ImplementsTkn)
+		x.Implements = c.nodes(n.Implements)
+		x.ImplementsSeparatorTkns = c.tokens(n.ImplementsSeparatorTkns)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *StmtEnumCase:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.CaseTkn = c.token(n.CaseTkn)
+		x.Name = c.node(n.Name)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtExpression:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Expr = c.node(n.Expr)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtFinally:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.FinallyTkn = c.token(n.FinallyTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *StmtFor:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ForTkn = c.token(n.ForTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Init = c.nodes(n.Init)
+		x.InitSeparatorTkns = c.tokens(n.InitSeparatorTkns)
+		x.InitSemiColonTkn = c.token(n.InitSemiColonTkn)
+		x.Cond = c.nodes(n.Cond)
+		x.CondSeparatorTkns = c.tokens(n.CondSeparatorTkns)
+		x.CondSemiColonTkn = c.token(n.CondSemiColonTkn)
+		x.Loop = c.nodes(n.Loop)
+		x.LoopSeparatorTkns = c.tokens(n.LoopSeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.Stmt = c.node(n.Stmt)
+		x.EndForTkn = c.token(n.EndForTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtForeach:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ForeachTkn = c.token(n.ForeachTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Expr = c.node(n.Expr)
+		x.AsTkn = c.token(n.AsTkn)
+		x.Key = c.node(n.Key)
+		x.DoubleArrowTkn = c.token(n.DoubleArrowTkn)
+		x.AmpersandTkn = c.token(n.AmpersandTkn)
+		x.Var = c.node(n.Var)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.Stmt = c.node(n.Stmt)
+		x.EndForeachTkn = c.token(n.EndForeachTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtFunction:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.FunctionTkn = c.token(n.FunctionTkn)
+		x.AmpersandTkn = c.token(n.AmpersandTkn)
+		x.Name = c.node(n.Name)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Params = c.nodes(n.Params)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.ReturnType = c.node(n.ReturnType)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *StmtGlobal:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.GlobalTkn = c.token(n.GlobalTkn)
+		x.Vars = c.nodes(n.Vars)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtGoto:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.GotoTkn = c.token(n.GotoTkn)
+		x.Label = c.node(n.Label)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtHaltCompiler:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.HaltCompilerTkn = c.token(n.HaltCompilerTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtIf:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.IfTkn = c.token(n.IfTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Cond = c.node(n.Cond)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.Stmt = c.node(n.Stmt)
+		x.ElseIf = c.nodes(n.ElseIf)
+		x.Else = c.node(n.Else)
+		x.EndIfTkn = c.token(n.EndIfTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtInlineHtml:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.InlineHtmlTkn = c.token(n.InlineHtmlTkn)
+		x.Value = c.bytes(n.Value)
+		return &x
+	case *StmtInterface:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.InterfaceTkn = c.token(n.InterfaceTkn)
+		x.Name = c.node(n.Name)
+		x.ExtendsTkn = c.token(n.ExtendsTkn)
+		x.Extends = c.nodes(n.Extends)
+		x.ExtendsSeparatorTkns = c.tokens(n.ExtendsSeparatorTkns)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *StmtLabel:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Name = c.node(n.Name)
+		x.ColonTkn = c.token(n.ColonTkn)
+		return &x
+	case *StmtNamespace:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.NsTkn = c.token(n.NsTkn)
+		x.Name = c.node(n.Name)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtNop:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtProperty:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *StmtPropertyList:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.Modifiers = c.nodes(n.Modifiers)
+		x.Type = c.node(n.Type)
+		x.Props = c.nodes(n.Props)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtReturn:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ReturnTkn = c.token(n.ReturnTkn)
+		x.Expr = c.node(n.Expr)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtStatic:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.StaticTkn = c.token(n.StaticTkn)
+		x.Vars = c.nodes(n.Vars)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtStaticVar:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *StmtStmtList:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *StmtSwitch:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.SwitchTkn = c.token(n.SwitchTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Cond = c.node(n.Cond)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.CaseSeparatorTkn = c.token(n.CaseSeparatorTkn)
+		x.Cases = c.nodes(n.Cases)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		x.EndSwitchTkn = c.token(n.EndSwitchTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtThrow:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ThrowTkn = c.token(n.ThrowTkn)
+		x.Expr = c.node(n.Expr)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtTrait:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.TraitTkn = c.token(n.TraitTkn)
+		x.Name = c.node(n.Name)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *StmtTraitUse:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.UseTkn = c.token(n.UseTkn)
+		x.Traits = c.nodes(n.Traits)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Adaptations = c.nodes(n.Adaptations)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtTraitUseAlias:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Trait = c.node(n.Trait)
+		x.DoubleColonTkn = c.token(n.DoubleColonTkn)
+		x.Method = c.node(n.Method)
+		x.AsTkn = c.token(n.AsTkn)
+		x.Modifier = c.node(n.Modifier)
+		x.Alias = c.node(n.Alias)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtTraitUsePrecedence:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Trait = c.node(n.Trait)
+		x.DoubleColonTkn = c.token(n.DoubleColonTkn)
+		x.Method = c.node(n.Method)
+		x.InsteadofTkn = c.token(n.InsteadofTkn)
+		x.Insteadof = c.nodes(n.Insteadof)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtTry:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.TryTkn = c.token(n.TryTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		x.Catches = c.nodes(n.Catches)
+		x.Finally = c.node(n.Finally)
+		return &x
+	case *StmtUnset:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.UnsetTkn = c.token(n.UnsetTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Vars = c.nodes(n.Vars)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtUseList:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.UseTkn = c.token(n.UseTkn)
+		x.Type = c.node(n.Type)
+		x.Uses = c.nodes(n.Uses)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtGroupUseList:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.UseTkn = c.token(n.UseTkn)
+		x.Type = c.node(n.Type)
+		x.LeadingNsSeparatorTkn = c.token(n.LeadingNsSeparatorTkn)
+		x.Prefix = c.node(n.Prefix)
+		x.NsSeparatorTkn = c.token(n.NsSeparatorTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Uses = c.nodes(n.Uses)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *StmtUse:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Type = c.node(n.Type)
+		x.NsSeparatorTkn = c.token(n.NsSeparatorTkn)
+		x.Use = c.node(n.Use)
+		x.AsTkn = c.token(n.AsTkn)
+		x.Alias = c.node(n.Alias)
+		return &x
+	case *StmtWhile:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.WhileTkn = c.token(n.WhileTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Cond = c.node(n.Cond)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.Stmt = c.node(n.Stmt)
+		x.EndWhileTkn = c.token(n.EndWhileTkn)
+		x.SemiColonTkn = c.token(n.SemiColonTkn)
+		return &x
+	case *BadExpr:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.SkippedTkns = c.tokens(n.SkippedTkns)
+		return &x
+	case *ExprArray:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ArrayTkn = c.token(n.ArrayTkn)
+		x.OpenBracketTkn = c.token(n.OpenBracketTkn)
+		x.Items = c.nodes(n.Items)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseBracketTkn = c.token(n.CloseBracketTkn)
+		return &x
+	case *ExprArrayDimFetch:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.OpenBracketTkn = c.token(n.OpenBracketTkn)
+		x.Dim = c.node(n.Dim)
+		x.CloseBracketTkn = c.token(n.CloseBracketTkn)
+		return &x
+	case *ExprArrayItem:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.EllipsisTkn = c.token(n.EllipsisTkn)
+		x.Key = c.node(n.Key)
+		x.DoubleArrowTkn = c.token(n.DoubleArrowTkn)
+		x.AmpersandTkn = c.token(n.AmpersandTkn)
+		x.Val = c.node(n.Val)
+		return &x
+	case *ExprArrowFunction:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.StaticTkn = c.token(n.StaticTkn)
+		x.FnTkn = c.token(n.FnTkn)
+		x.AmpersandTkn = c.token(n.AmpersandTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Params = c.nodes(n.Params)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.ReturnType = c.node(n.ReturnType)
+		x.DoubleArrowTkn = c.token(n.DoubleArrowTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprBitwiseNot:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.TildaTkn = c.token(n.TildaTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprBooleanNot:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ExclamationTkn = c.token(n.ExclamationTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprBrackets:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Expr = c.node(n.Expr)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *ExprClassConstFetch:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Class = c.node(n.Class)
+		x.DoubleColonTkn = c.token(n.DoubleColonTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Const = c.node(n.Const)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *ExprClone:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.CloneTkn = c.token(n.CloneTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprClosure:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AttrGroups = c.nodes(n.AttrGroups)
+		x.StaticTkn = c.token(n.StaticTkn)
+		x.FunctionTkn = c.token(n.FunctionTkn)
+		x.AmpersandTkn = c.token(n.AmpersandTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Params = c.nodes(n.Params)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.UseTkn = c.token(n.UseTkn)
+		x.UseOpenParenthesisTkn = c.token(n.UseOpenParenthesisTkn)
+		x.Uses = c.nodes(n.Uses)
+		x.UseSeparatorTkns = c.tokens(n.UseSeparatorTkns)
+		x.UseCloseParenthesisTkn = c.token(n.UseCloseParenthesisTkn)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.ReturnType = c.node(n.ReturnType)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Stmts = c.nodes(n.Stmts)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *ExprClosureUse:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AmpersandTkn = c.token(n.AmpersandTkn)
+		x.Var = c.node(n.Var)
+		return &x
+	case *ExprConstFetch:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Const = c.node(n.Const)
+		return &x
+	case *ExprEmpty:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.EmptyTkn = c.token(n.EmptyTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Expr = c.node(n.Expr)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *ExprErrorSuppress:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.AtTkn = c.token(n.AtTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprEval:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.EvalTkn = c.token(n.EvalTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Expr = c.node(n.Expr)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *ExprExit:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ExitTkn = c.token(n.ExitTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Expr = c.node(n.Expr)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *ExprFunctionCall:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Function = c.node(n.Function)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Args = c.nodes(n.Args)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *ExprInclude:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.IncludeTkn = c.token(n.IncludeTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprIncludeOnce:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.IncludeOnceTkn = c.token(n.IncludeOnceTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprInstanceOf:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Expr = c.node(n.Expr)
+		x.InstanceOfTkn = c.token(n.InstanceOfTkn)
+		x.Class = c.node(n.Class)
+		return &x
+	case *ExprIsset:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.IssetTkn = c.token(n.IssetTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Vars = c.nodes(n.Vars)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *ExprList:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ListTkn = c.token(n.ListTkn)
+		x.OpenBracketTkn = c.token(n.OpenBracketTkn)
+		x.Items = c.nodes(n.Items)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseBracketTkn = c.token(n.CloseBracketTkn)
+		return &x
+	case *ExprMatch:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.MatchTkn = c.token(n.MatchTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Expr = c.node(n.Expr)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Arms = c.nodes(n.Arms)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *ExprMethodCall:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.ObjectOperatorTkn = c.token(n.ObjectOperatorTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Method = c.node(n.Method)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Args = c.nodes(n.Args)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *ExprNew:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.NewTkn = c.token(n.NewTkn)
+		x.Class = c.node(n.Class)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Args = c.nodes(n.Args)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *ExprNullsafeMethodCall:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.ObjectOperatorTkn = c.token(n.ObjectOperatorTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Method = c.node(n.Method)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Args = c.nodes(n.Args)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *ExprNullsafePropertyFetch:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.ObjectOperatorTkn = c.token(n.ObjectOperatorTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Prop = c.node(n.Prop)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *ExprPostDec:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.DecTkn = c.token(n.DecTkn)
+		return &x
+	case *ExprPostInc:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.IncTkn = c.token(n.IncTkn)
+		return &x
+	case *ExprPreDec:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.DecTkn = c.token(n.DecTkn)
+		x.Var = c.node(n.Var)
+		return &x
+	case *ExprPreInc:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.IncTkn = c.token(n.IncTkn)
+		x.Var = c.node(n.Var)
+		return &x
+	case *ExprPrint:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.PrintTkn = c.token(n.PrintTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprPropertyFetch:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.ObjectOperatorTkn = c.token(n.ObjectOperatorTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Prop = c.node(n.Prop)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *ExprRequire:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.RequireTkn = c.token(n.RequireTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprRequireOnce:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.RequireOnceTkn = c.token(n.RequireOnceTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprShellExec:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.OpenBacktickTkn = c.token(n.OpenBacktickTkn)
+		x.Parts = c.nodes(n.Parts)
+		x.CloseBacktickTkn = c.token(n.CloseBacktickTkn)
+		return &x
+	case *ExprStaticCall:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Class = c.node(n.Class)
+		x.DoubleColonTkn = c.token(n.DoubleColonTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Call = c.node(n.Call)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		x.OpenParenthesisTkn = c.token(n.OpenParenthesisTkn)
+		x.Args = c.nodes(n.Args)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		x.CloseParenthesisTkn = c.token(n.CloseParenthesisTkn)
+		return &x
+	case *ExprStaticPropertyFetch:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Class = c.node(n.Class)
+		x.DoubleColonTkn = c.token(n.DoubleColonTkn)
+		x.Prop = c.node(n.Prop)
+		return &x
+	case *ExprTernary:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Cond = c.node(n.Cond)
+		x.QuestionTkn = c.token(n.QuestionTkn)
+		x.IfTrue = c.node(n.IfTrue)
+		x.ColonTkn = c.token(n.ColonTkn)
+		x.IfFalse = c.node(n.IfFalse)
+		return &x
+	case *ExprThrow:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.ThrowTkn = c.token(n.ThrowTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprUnaryMinus:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.MinusTkn = c.token(n.MinusTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprUnaryPlus:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.PlusTkn = c.token(n.PlusTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprVariable:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.DollarTkn = c.token(n.DollarTkn)
+		x.OpenCurlyBracketTkn = c.token(n.OpenCurlyBracketTkn)
+		x.Name = c.node(n.Name)
+		x.CloseCurlyBracketTkn = c.token(n.CloseCurlyBracketTkn)
+		return &x
+	case *ExprYield:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.YieldTkn = c.token(n.YieldTkn)
+		x.Key = c.node(n.Key)
+		x.DoubleArrowTkn = c.token(n.DoubleArrowTkn)
+		x.Val = c.node(n.Val)
+		return &x
+	case *ExprYieldFrom:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.YieldFromTkn = c.token(n.YieldFromTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprCastArray:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.CastTkn = c.token(n.CastTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprCastBool:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.CastTkn = c.token(n.CastTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprCastDouble:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.CastTkn = c.token(n.CastTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprCastInt:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.CastTkn = c.token(n.CastTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprCastObject:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.CastTkn = c.token(n.CastTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprCastString:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.CastTkn = c.token(n.CastTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprCastUnset:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.CastTkn = c.token(n.CastTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssign:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignReference:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.AmpersandTkn = c.token(n.AmpersandTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignBitwiseAnd:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignBitwiseOr:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignBitwiseXor:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignCoalesce:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignConcat:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignDiv:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignMinus:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignMod:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignMul:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignPlus:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignPow:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignShiftLeft:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprAssignShiftRight:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Var = c.node(n.Var)
+		x.EqualTkn = c.token(n.EqualTkn)
+		x.Expr = c.node(n.Expr)
+		return &x
+	case *ExprBinaryBitwiseAnd:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryBitwiseOr:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryBitwiseXor:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryBooleanAnd:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryBooleanOr:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryCoalesce:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryConcat:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryDiv:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryEqual:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryGreater:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryGreaterOrEqual:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryIdentical:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryLogicalAnd:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryLogicalOr:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryLogicalXor:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryMinus:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryMod:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryMul:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryNotEqual:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryNotIdentical:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryPlus:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryPow:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryShiftLeft:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinaryShiftRight:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinarySmaller:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinarySmallerOrEqual:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *ExprBinarySpaceship:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Left = c.node(n.Left)
+		x.OpTkn = c.token(n.OpTkn)
+		x.Right = c.node(n.Right)
+		return &x
+	case *Name:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.Parts = c.nodes(n.Parts)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		return &x
+	case *NameFullyQualified:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.NsSeparatorTkn = c.token(n.NsSeparatorTkn)
+		x.Parts = c.nodes(n.Parts)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		return &x
+	case *NameRelative:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.NsTkn = c.token(n.NsTkn)
+		x.NsSeparatorTkn = c.token(n.NsSeparatorTkn)
+		x.Parts = c.nodes(n.Parts)
+		x.SeparatorTkns = c.tokens(n.SeparatorTkns)
+		return &x
+	case *NamePart:
+		x := *n
+		x.Position = c.position(n.Position)
+		x.StringTkn = c.token(n.StringTkn)
+		x.Value = c.bytes(n.Value)
+		return &x
+	}
+
+	return nil
+}
+
+func equalNode(e *equality, a, b Vertex) bool {
+	switch a := a.(type) {
+	case *Root:
+		b, ok := b.(*Root)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.EndTkn, b.EndTkn, false)
+	case *Nullable:
+		b, ok := b.(*Nullable)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.QuestionTkn, b.QuestionTkn, false) &&
+			e.name(a.Expr, b.Expr)
+	case *Parameter:
+		b, ok := b.(*Parameter)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.nodes(a.Modifiers, b.Modifiers) &&
+			e.name(a.Type, b.Type) &&
+			e.token(a.AmpersandTkn, b.AmpersandTkn, false) &&
+			e.token(a.VariadicTkn, b.VariadicTkn, false) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.DefaultValue, b.DefaultValue)
+	case *Identifier:
+		b, ok := b.(*Identifier)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.IdentifierTkn, b.IdentifierTkn, e.fold) &&
+			e.bytes(a.Value, b.Value, e.fold)
+	case *Argument:
+		b, ok := b.(*Argument)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Name, b.Name) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.token(a.VariadicTkn, b.VariadicTkn, false) &&
+			e.token(a.AmpersandTkn, b.AmpersandTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *Attribute:
+		b, ok := b.(*Attribute)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.name(a.Name, b.Name) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Args, b.Args) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *AttributeGroup:
+		b, ok := b.(*AttributeGroup)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.OpenAttributeTkn, b.OpenAttributeTkn, false) &&
+			e.nodes(a.Attrs, b.Attrs) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseAttributeTkn, b.CloseAttributeTkn, false)
+	case *Union:
+		b, ok := b.(*Union)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.names(a.Types, b.Types) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns)
+	case *Intersection:
+		b, ok := b.(*Intersection)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.names(a.Types, b.Types) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns)
+	case *MatchArm:
+		b, ok := b.(*MatchArm)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.DefaultTkn, b.DefaultTkn, false) &&
+			e.token(a.DefaultCommaTkn, b.DefaultCommaTkn, false) &&
+			e.nodes(a.Exprs, b.Exprs) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.DoubleArrowTkn, b.DoubleArrowTkn, false) &&
+			e.node(a.ReturnExpr, b.ReturnExpr)
+	case *ScalarDnumber:
+		b, ok := b.(*ScalarDnumber)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.NumberTkn, b.NumberTkn, false) &&
+			e.bytes(a.Value, b.Value, false)
+	case *ScalarEncapsed:
+		b, ok := b.(*ScalarEncapsed)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.OpenQuoteTkn, b.OpenQuoteTkn, false) &&
+			e.nodes(a.Parts, b.Parts) &&
+			e.token(a.CloseQuoteTkn, b.CloseQuoteTkn, false)
+	case *ScalarEncapsedStringPart:
+		b, ok := b.(*ScalarEncapsedStringPart)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.EncapsedStrTkn, b.EncapsedStrTkn, false) &&
+			e.bytes(a.Value, b.Value, false)
+	case *ScalarEncapsedStringVar:
+		b, ok := b.(*ScalarEncapsedStringVar)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.DollarOpenCurlyBracketTkn, b.DollarOpenCurlyBracketTkn, false) &&
+			e.node(a.Name, b.Name) &&
+			e.token(a.OpenSquareBracketTkn, b.OpenSquareBracketTkn, false) &&
+			e.node(a.Dim, b.Dim) &&
+			e.token(a.CloseSquareBracketTkn, b.CloseSquareBracketTkn, false) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *ScalarEncapsedStringBrackets:
+		b, ok := b.(*ScalarEncapsedStringBrackets)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *ScalarHeredoc:
+		b, ok := b.(*ScalarHeredoc)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.OpenHeredocTkn, b.OpenHeredocTkn, false) &&
+			e.nodes(a.Parts, b.Parts) &&
+			e.token(a.CloseHeredocTkn, b.CloseHeredocTkn, false)
+	case *ScalarLnumber:
+		b, ok := b.(*ScalarLnumber)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.NumberTkn, b.NumberTkn, false) &&
+			e.bytes(a.Value, b.Value, false)
+	case *ScalarMagicConstant:
+		b, ok := b.(*ScalarMagicConstant)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.MagicConstTkn, b.MagicConstTkn, false) &&
+			e.bytes(a.Value, b.Value, false)
+	case *ScalarString:
+		b, ok := b.(*ScalarString)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.MinusTkn, b.MinusTkn, false) &&
+			e.token(a.StringTkn, b.StringTkn, false) &&
+			e.bytes(a.Value, b.Value, false)
+	case *BadStmt:
+		b, ok := b.(*BadStmt)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.tokens(a.SkippedTkns, b.SkippedTkns)
+	case *StmtBreak:
+		b, ok := b.(*StmtBreak)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.BreakTkn, b.BreakTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtCase:
+		b, ok := b.(*StmtCase)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.CaseTkn, b.CaseTkn, false) &&
+			e.node(a.Cond, b.Cond) &&
+			e.token(a.CaseSeparatorTkn, b.CaseSeparatorTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts)
+	case *StmtCatch:
+		b, ok := b.(*StmtCatch)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.CatchTkn, b.CatchTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.names(a.Types, b.Types) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *StmtClass:
+		b, ok := b.(*StmtClass)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.nodes(a.Modifiers, b.Modifiers) &&
+			e.token(a.ClassTkn, b.ClassTkn, false) &&
+			e.name(a.Name, b.Name) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Args, b.Args) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ExtendsTkn, b.ExtendsTkn, false) &&
+			e.name(a.Extends, b.Extends) &&
+			e.token(a.ImplementsTkn, b.ImplementsTkn, false) &&
+			e.names(a.Implements, b.Implements) &&
+			e.tokens(a.ImplementsSeparatorTkns, b.ImplementsSeparatorTkns) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *StmtClassConstList:
+		b, ok := b.(*StmtClassConstList)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.nodes(a.Modifiers, b.Modifiers) &&
+			e.token(a.ConstTkn, b.ConstTkn, false) &&
+			e.name(a.Type, b.Type) &&
+			e.nodes(a.Consts, b.Consts) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtClassMethod:
+		b, ok := b.(*StmtClassMethod)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.nodes(a.Modifiers, b.Modifiers) &&
+			e.token(a.FunctionTkn, b.FunctionTkn, false) &&
+			e.token(a.AmpersandTkn, b.AmpersandTkn, false) &&
+			e.name(a.Name, b.Name) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Params, b.Params) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.name(a.ReturnType, b.ReturnType) &&
+			e.node(a.Stmt, b.Stmt)
+	case *StmtConstList:
+		b, ok := b.(*StmtConstList)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ConstTkn, b.ConstTkn, false) &&
+			e.nodes(a.Consts, b.Consts) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtConstant:
+		b, ok := b.(*StmtConstant)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Name, b.Name) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *StmtContinue:
+		b, ok := b.(*StmtContinue)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ContinueTkn, b.ContinueTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtDeclare:
+		b, ok := b.(*StmtDeclare)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.DeclareTkn, b.DeclareTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Consts, b.Consts) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.node(a.Stmt, b.Stmt) &&
+			e.token(a.EndDeclareTkn, b.EndDeclareTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtDefault:
+		b, ok := b.(*StmtDefault)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.DefaultTkn, b.DefaultTkn, false) &&
+			e.token(a.CaseSeparatorTkn, b.CaseSeparatorTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts)
+	case *StmtDo:
+		b, ok := b.(*StmtDo)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.DoTkn, b.DoTkn, false) &&
+			e.node(a.Stmt, b.Stmt) &&
+			e.token(a.WhileTkn, b.WhileTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Cond, b.Cond) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtEcho:
+		b, ok := b.(*StmtEcho)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.EchoTkn, b.EchoTkn, false) &&
+			e.nodes(a.Exprs, b.Exprs) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtElse:
+		b, ok := b.(*StmtElse)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ElseTkn, b.ElseTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.node(a.Stmt, b.Stmt)
+	case *StmtElseIf:
+		b, ok := b.(*StmtElseIf)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ElseIfTkn, b.ElseIfTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Cond, b.Cond) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.node(a.Stmt, b.Stmt)
+	case *StmtEnum:
+		b, ok := b.(*StmtEnum)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.token(a.EnumTkn, b.EnumTkn, false) &&
+			e.name(a.Name, b.Name) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.name(a.Type, b.Type) &&
+			e.token(a.ImplementsTkn, b.ImplementsTkn, false) &&
+			e.names(a.Implements, b.Implements) &&
+			e.tokens(a.ImplementsSeparatorTkns, b.ImplementsSeparatorTkns) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *StmtEnumCase:
+		b, ok := b.(*StmtEnumCase)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.token(a.CaseTkn, b.CaseTkn, false) &&
+			e.node(a.Name, b.Name) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtExpression:
+		b, ok := b.(*StmtExpression)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtFinally:
+		b, ok := b.(*StmtFinally)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.FinallyTkn, b.FinallyTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *StmtFor:
+		b, ok := b.(*StmtFor)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ForTkn, b.ForTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Init, b.Init) &&
+			e.tokens(a.InitSeparatorTkns, b.InitSeparatorTkns) &&
+			e.token(a.InitSemiColonTkn, b.InitSemiColonTkn, false) &&
+			e.nodes(a.Cond, b.Cond) &&
+			e.tokens(a.CondSeparatorTkns, b.CondSeparatorTkns) &&
+			e.token(a.CondSemiColonTkn, b.CondSemiColonTkn, false) &&
+			e.nodes(a.Loop, b.Loop) &&
+			e.tokens(a.LoopSeparatorTkns, b.LoopSeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.node(a.Stmt, b.Stmt) &&
+			e.token(a.EndForTkn, b.EndForTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtForeach:
+		b, ok := b.(*StmtForeach)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ForeachTkn, b.ForeachTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.AsTkn, b.AsTkn, false) &&
+			e.node(a.Key, b.Key) &&
+			e.token(a.DoubleArrowTkn, b.DoubleArrowTkn, false) &&
+			e.token(a.AmpersandTkn, b.AmpersandTkn, false) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.node(a.Stmt, b.Stmt) &&
+			e.token(a.EndForeachTkn, b.EndForeachTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtFunction:
+		b, ok := b.(*StmtFunction)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.token(a.FunctionTkn, b.FunctionTkn, false) &&
+			e.token(a.AmpersandTkn, b.AmpersandTkn, false) &&
+			e.name(a.Name, b.Name) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Params, b.Params) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.name(a.ReturnType, b.ReturnType) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *StmtGlobal:
+		b, ok := b.(*StmtGlobal)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.GlobalTkn, b.GlobalTkn, false) &&
+			e.nodes(a.Vars, b.Vars) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtGoto:
+		b, ok := b.(*StmtGoto)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.GotoTkn, b.GotoTkn, false) &&
+			e.node(a.Label, b.Label) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtHaltCompiler:
+		b, ok := b.(*StmtHaltCompiler)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.HaltCompilerTkn, b.HaltCompilerTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtIf:
+		b, ok := b.(*StmtIf)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.IfTkn, b.IfTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Cond, b.Cond) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.node(a.Stmt, b.Stmt) &&
+			e.nodes(a.ElseIf, b.ElseIf) &&
+			e.node(a.Else, b.Else) &&
+			e.token(a.EndIfTkn, b.EndIfTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtInlineHtml:
+		b, ok := b.(*StmtInlineHtml)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.InlineHtmlTkn, b.InlineHtmlTkn, false) &&
+			e.bytes(a.Value, b.Value, false)
+	case *StmtInterface:
+		b, ok := b.(*StmtInterface)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.token(a.InterfaceTkn, b.InterfaceTkn, false) &&
+			e.name(a.Name, b.Name) &&
+			e.token(a.ExtendsTkn, b.ExtendsTkn, false) &&
+			e.names(a.Extends, b.Extends) &&
+			e.tokens(a.ExtendsSeparatorTkns, b.ExtendsSeparatorTkns) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *StmtLabel:
+		b, ok := b.(*StmtLabel)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Name, b.Name) &&
+			e.token(a.ColonTkn, b.ColonTkn, false)
+	case *StmtNamespace:
+		b, ok := b.(*StmtNamespace)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.NsTkn, b.NsTkn, false) &&
+			e.name(a.Name, b.Name) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtNop:
+		b, ok := b.(*StmtNop)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtProperty:
+		b, ok := b.(*StmtProperty)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *StmtPropertyList:
+		b, ok := b.(*StmtPropertyList)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.nodes(a.Modifiers, b.Modifiers) &&
+			e.name(a.Type, b.Type) &&
+			e.nodes(a.Props, b.Props) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtReturn:
+		b, ok := b.(*StmtReturn)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ReturnTkn, b.ReturnTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtStatic:
+		b, ok := b.(*StmtStatic)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.StaticTkn, b.StaticTkn, false) &&
+			e.nodes(a.Vars, b.Vars) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtStaticVar:
+		b, ok := b.(*StmtStaticVar)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *StmtStmtList:
+		b, ok := b.(*StmtStmtList)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *StmtSwitch:
+		b, ok := b.(*StmtSwitch)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.SwitchTkn, b.SwitchTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Cond, b.Cond) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.token(a.CaseSeparatorTkn, b.CaseSeparatorTkn, false) &&
+			e.nodes(a.Cases, b.Cases) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false) &&
+			e.token(a.EndSwitchTkn, b.EndSwitchTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtThrow:
+		b, ok := b.(*StmtThrow)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ThrowTkn, b.ThrowTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtTrait:
+		b, ok := b.(*StmtTrait)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.token(a.TraitTkn, b.TraitTkn, false) &&
+			e.name(a.Name, b.Name) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *StmtTraitUse:
+		b, ok := b.(*StmtTraitUse)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.UseTkn, b.UseTkn, false) &&
+			e.names(a.Traits, b.Traits) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Adaptations, b.Adaptations) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtTraitUseAlias:
+		b, ok := b.(*StmtTraitUseAlias)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.name(a.Trait, b.Trait) &&
+			e.token(a.DoubleColonTkn, b.DoubleColonTkn, false) &&
+			e.name(a.Method, b.Method) &&
+			e.token(a.AsTkn, b.AsTkn, false) &&
+			e.node(a.Modifier, b.Modifier) &&
+			e.name(a.Alias, b.Alias) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtTraitUsePrecedence:
+		b, ok := b.(*StmtTraitUsePrecedence)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.name(a.Trait, b.Trait) &&
+			e.token(a.DoubleColonTkn, b.DoubleColonTkn, false) &&
+			e.name(a.Method, b.Method) &&
+			e.token(a.InsteadofTkn, b.InsteadofTkn, false) &&
+			e.names(a.Insteadof, b.Insteadof) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtTry:
+		b, ok := b.(*StmtTry)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.TryTkn, b.TryTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false) &&
+			e.nodes(a.Catches, b.Catches) &&
+			e.node(a.Finally, b.Finally)
+	case *StmtUnset:
+		b, ok := b.(*StmtUnset)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.UnsetTkn, b.UnsetTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Vars, b.Vars) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtUseList:
+		b, ok := b.(*StmtUseList)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.UseTkn, b.UseTkn, false) &&
+			e.node(a.Type, b.Type) &&
+			e.nodes(a.Uses, b.Uses) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtGroupUseList:
+		b, ok := b.(*StmtGroupUseList)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.UseTkn, b.UseTkn, false) &&
+			e.node(a.Type, b.Type) &&
+			e.token(a.LeadingNsSeparatorTkn, b.LeadingNsSeparatorTkn, false) &&
+			e.name(a.Prefix, b.Prefix) &&
+			e.token(a.NsSeparatorTkn, b.NsSeparatorTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Uses, b.Uses) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *StmtUse:
+		b, ok := b.(*StmtUse)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Type, b.Type) &&
+			e.token(a.NsSeparatorTkn, b.NsSeparatorTkn, false) &&
+			e.name(a.Use, b.Use) &&
+			e.token(a.AsTkn, b.AsTkn, false) &&
+			e.name(a.Alias, b.Alias)
+	case *StmtWhile:
+		b, ok := b.(*StmtWhile)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.WhileTkn, b.WhileTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Cond, b.Cond) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.node(a.Stmt, b.Stmt) &&
+			e.token(a.EndWhileTkn, b.EndWhileTkn, false) &&
+			e.token(a.SemiColonTkn, b.SemiColonTkn, false)
+	case *BadExpr:
+		b, ok := b.(*BadExpr)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.tokens(a.SkippedTkns, b.SkippedTkns)
+	case *ExprArray:
+		b, ok := b.(*ExprArray)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ArrayTkn, b.ArrayTkn, false) &&
+			e.token(a.OpenBracketTkn, b.OpenBracketTkn, false) &&
+			e.nodes(a.Items, b.Items) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseBracketTkn, b.CloseBracketTkn, false)
+	case *ExprArrayDimFetch:
+		b, ok := b.(*ExprArrayDimFetch)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.OpenBracketTkn, b.OpenBracketTkn, false) &&
+			e.node(a.Dim, b.Dim) &&
+			e.token(a.CloseBracketTkn, b.CloseBracketTkn, false)
+	case *ExprArrayItem:
+		b, ok := b.(*ExprArrayItem)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.EllipsisTkn, b.EllipsisTkn, false) &&
+			e.node(a.Key, b.Key) &&
+			e.token(a.DoubleArrowTkn, b.DoubleArrowTkn, false) &&
+			e.token(a.AmpersandTkn, b.AmpersandTkn, false) &&
+			e.node(a.Val, b.Val)
+	case *ExprArrowFunction:
+		b, ok := b.(*ExprArrowFunction)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.token(a.StaticTkn, b.StaticTkn, false) &&
+			e.token(a.FnTkn, b.FnTkn, false) &&
+			e.token(a.AmpersandTkn, b.AmpersandTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Params, b.Params) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.name(a.ReturnType, b.ReturnType) &&
+			e.token(a.DoubleArrowTkn, b.DoubleArrowTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprBitwiseNot:
+		b, ok := b.(*ExprBitwiseNot)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.TildaTkn, b.TildaTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprBooleanNot:
+		b, ok := b.(*ExprBooleanNot)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ExclamationTkn, b.ExclamationTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprBrackets:
+		b, ok := b.(*ExprBrackets)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *ExprClassConstFetch:
+		b, ok := b.(*ExprClassConstFetch)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.name(a.Class, b.Class) &&
+			e.token(a.DoubleColonTkn, b.DoubleColonTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.node(a.Const, b.Const) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *ExprClone:
+		b, ok := b.(*ExprClone)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.CloneTkn, b.CloneTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprClosure:
+		b, ok := b.(*ExprClosure)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.AttrGroups, b.AttrGroups) &&
+			e.token(a.StaticTkn, b.StaticTkn, false) &&
+			e.token(a.FunctionTkn, b.FunctionTkn, false) &&
+			e.token(a.AmpersandTkn, b.AmpersandTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Params, b.Params) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.UseTkn, b.UseTkn, false) &&
+			e.token(a.UseOpenParenthesisTkn, b.UseOpenParenthesisTkn, false) &&
+			e.nodes(a.Uses, b.Uses) &&
+			e.tokens(a.UseSeparatorTkns, b.UseSeparatorTkns) &&
+			e.token(a.UseCloseParenthesisTkn, b.UseCloseParenthesisTkn, false) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.name(a.ReturnType, b.ReturnType) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Stmts, b.Stmts) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *ExprClosureUse:
+		b, ok := b.(*ExprClosureUse)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.AmpersandTkn, b.AmpersandTkn, false) &&
+			e.node(a.Var, b.Var)
+	case *ExprConstFetch:
+		b, ok := b.(*ExprConstFetch)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Const, b.Const)
+	case *ExprEmpty:
+		b, ok := b.(*ExprEmpty)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.EmptyTkn, b.EmptyTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *ExprErrorSuppress:
+		b, ok := b.(*ExprErrorSuppress)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.AtTkn, b.AtTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprEval:
+		b, ok := b.(*ExprEval)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.EvalTkn, b.EvalTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *ExprExit:
+		b, ok := b.(*ExprExit)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ExitTkn, b.ExitTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *ExprFunctionCall:
+		b, ok := b.(*ExprFunctionCall)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.name(a.Function, b.Function) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Args, b.Args) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *ExprInclude:
+		b, ok := b.(*ExprInclude)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.IncludeTkn, b.IncludeTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprIncludeOnce:
+		b, ok := b.(*ExprIncludeOnce)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.IncludeOnceTkn, b.IncludeOnceTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprInstanceOf:
+		b, ok := b.(*ExprInstanceOf)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.InstanceOfTkn, b.InstanceOfTkn, false) &&
+			e.name(a.Class, b.Class)
+	case *ExprIsset:
+		b, ok := b.(*ExprIsset)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.IssetTkn, b.IssetTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Vars, b.Vars) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *ExprList:
+		b, ok := b.(*ExprList)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ListTkn, b.ListTkn, false) &&
+			e.token(a.OpenBracketTkn, b.OpenBracketTkn, false) &&
+			e.nodes(a.Items, b.Items) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseBracketTkn, b.CloseBracketTkn, false)
+	case *ExprMatch:
+		b, ok := b.(*ExprMatch)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.MatchTkn, b.MatchTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.node(a.Expr, b.Expr) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.nodes(a.Arms, b.Arms) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *ExprMethodCall:
+		b, ok := b.(*ExprMethodCall)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.ObjectOperatorTkn, b.ObjectOperatorTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.name(a.Method, b.Method) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Args, b.Args) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *ExprNew:
+		b, ok := b.(*ExprNew)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.NewTkn, b.NewTkn, false) &&
+			e.name(a.Class, b.Class) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Args, b.Args) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *ExprNullsafeMethodCall:
+		b, ok := b.(*ExprNullsafeMethodCall)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.ObjectOperatorTkn, b.ObjectOperatorTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.name(a.Method, b.Method) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Args, b.Args) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *ExprNullsafePropertyFetch:
+		b, ok := b.(*ExprNullsafePropertyFetch)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.ObjectOperatorTkn, b.ObjectOperatorTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.node(a.Prop, b.Prop) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *ExprPostDec:
+		b, ok := b.(*ExprPostDec)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.DecTkn, b.DecTkn, false)
+	case *ExprPostInc:
+		b, ok := b.(*ExprPostInc)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.IncTkn, b.IncTkn, false)
+	case *ExprPreDec:
+		b, ok := b.(*ExprPreDec)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.DecTkn, b.DecTkn, false) &&
+			e.node(a.Var, b.Var)
+	case *ExprPreInc:
+		b, ok := b.(*ExprPreInc)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.IncTkn, b.IncTkn, false) &&
+			e.node(a.Var, b.Var)
+	case *ExprPrint:
+		b, ok := b.(*ExprPrint)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.PrintTkn, b.PrintTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprPropertyFetch:
+		b, ok := b.(*ExprPropertyFetch)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.ObjectOperatorTkn, b.ObjectOperatorTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.node(a.Prop, b.Prop) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *ExprRequire:
+		b, ok := b.(*ExprRequire)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.RequireTkn, b.RequireTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprRequireOnce:
+		b, ok := b.(*ExprRequireOnce)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.RequireOnceTkn, b.RequireOnceTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprShellExec:
+		b, ok := b.(*ExprShellExec)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.OpenBacktickTkn, b.OpenBacktickTkn, false) &&
+			e.nodes(a.Parts, b.Parts) &&
+			e.token(a.CloseBacktickTkn, b.CloseBacktickTkn, false)
+	case *ExprStaticCall:
+		b, ok := b.(*ExprStaticCall)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.name(a.Class, b.Class) &&
+			e.token(a.DoubleColonTkn, b.DoubleColonTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.name(a.Call, b.Call) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false) &&
+			e.token(a.OpenParenthesisTkn, b.OpenParenthesisTkn, false) &&
+			e.nodes(a.Args, b.Args) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns) &&
+			e.token(a.CloseParenthesisTkn, b.CloseParenthesisTkn, false)
+	case *ExprStaticPropertyFetch:
+		b, ok := b.(*ExprStaticPropertyFetch)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.name(a.Class, b.Class) &&
+			e.token(a.DoubleColonTkn, b.DoubleColonTkn, false) &&
+			e.node(a.Prop, b.Prop)
+	case *ExprTernary:
+		b, ok := b.(*ExprTernary)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Cond, b.Cond) &&
+			e.token(a.QuestionTkn, b.QuestionTkn, false) &&
+			e.node(a.IfTrue, b.IfTrue) &&
+			e.token(a.ColonTkn, b.ColonTkn, false) &&
+			e.node(a.IfFalse, b.IfFalse)
+	case *ExprThrow:
+		b, ok := b.(*ExprThrow)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.ThrowTkn, b.ThrowTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprUnaryMinus:
+		b, ok := b.(*ExprUnaryMinus)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.MinusTkn, b.MinusTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprUnaryPlus:
+		b, ok := b.(*ExprUnaryPlus)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.PlusTkn, b.PlusTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprVariable:
+		b, ok := b.(*ExprVariable)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.DollarTkn, b.DollarTkn, false) &&
+			e.token(a.OpenCurlyBracketTkn, b.OpenCurlyBracketTkn, false) &&
+			e.node(a.Name, b.Name) &&
+			e.token(a.CloseCurlyBracketTkn, b.CloseCurlyBracketTkn, false)
+	case *ExprYield:
+		b, ok := b.(*ExprYield)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.YieldTkn, b.YieldTkn, false) &&
+			e.node(a.Key, b.Key) &&
+			e.token(a.DoubleArrowTkn, b.DoubleArrowTkn, false) &&
+			e.node(a.Val, b.Val)
+	case *ExprYieldFrom:
+		b, ok := b.(*ExprYieldFrom)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.YieldFromTkn, b.YieldFromTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprCastArray:
+		b, ok := b.(*ExprCastArray)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.CastTkn, b.CastTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprCastBool:
+		b, ok := b.(*ExprCastBool)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.CastTkn, b.CastTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprCastDouble:
+		b, ok := b.(*ExprCastDouble)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.CastTkn, b.CastTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprCastInt:
+		b, ok := b.(*ExprCastInt)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.CastTkn, b.CastTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprCastObject:
+		b, ok := b.(*ExprCastObject)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.CastTkn, b.CastTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprCastString:
+		b, ok := b.(*ExprCastString)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.CastTkn, b.CastTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprCastUnset:
+		b, ok := b.(*ExprCastUnset)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.CastTkn, b.CastTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssign:
+		b, ok := b.(*ExprAssign)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignReference:
+		b, ok := b.(*ExprAssignReference)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.token(a.AmpersandTkn, b.AmpersandTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignBitwiseAnd:
+		b, ok := b.(*ExprAssignBitwiseAnd)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignBitwiseOr:
+		b, ok := b.(*ExprAssignBitwiseOr)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignBitwiseXor:
+		b, ok := b.(*ExprAssignBitwiseXor)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignCoalesce:
+		b, ok := b.(*ExprAssignCoalesce)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignConcat:
+		b, ok := b.(*ExprAssignConcat)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignDiv:
+		b, ok := b.(*ExprAssignDiv)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignMinus:
+		b, ok := b.(*ExprAssignMinus)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignMod:
+		b, ok := b.(*ExprAssignMod)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignMul:
+		b, ok := b.(*ExprAssignMul)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignPlus:
+		b, ok := b.(*ExprAssignPlus)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignPow:
+		b, ok := b.(*ExprAssignPow)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignShiftLeft:
+		b, ok := b.(*ExprAssignShiftLeft)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprAssignShiftRight:
+		b, ok := b.(*ExprAssignShiftRight)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Var, b.Var) &&
+			e.token(a.EqualTkn, b.EqualTkn, false) &&
+			e.node(a.Expr, b.Expr)
+	case *ExprBinaryBitwiseAnd:
+		b, ok := b.(*ExprBinaryBitwiseAnd)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryBitwiseOr:
+		b, ok := b.(*ExprBinaryBitwiseOr)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryBitwiseXor:
+		b, ok := b.(*ExprBinaryBitwiseXor)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryBooleanAnd:
+		b, ok := b.(*ExprBinaryBooleanAnd)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryBooleanOr:
+		b, ok := b.(*ExprBinaryBooleanOr)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryCoalesce:
+		b, ok := b.(*ExprBinaryCoalesce)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryConcat:
+		b, ok := b.(*ExprBinaryConcat)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryDiv:
+		b, ok := b.(*ExprBinaryDiv)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryEqual:
+		b, ok := b.(*ExprBinaryEqual)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryGreater:
+		b, ok := b.(*ExprBinaryGreater)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryGreaterOrEqual:
+		b, ok := b.(*ExprBinaryGreaterOrEqual)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryIdentical:
+		b, ok := b.(*ExprBinaryIdentical)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryLogicalAnd:
+		b, ok := b.(*ExprBinaryLogicalAnd)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryLogicalOr:
+		b, ok := b.(*ExprBinaryLogicalOr)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryLogicalXor:
+		b, ok := b.(*ExprBinaryLogicalXor)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryMinus:
+		b, ok := b.(*ExprBinaryMinus)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryMod:
+		b, ok := b.(*ExprBinaryMod)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryMul:
+		b, ok := b.(*ExprBinaryMul)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryNotEqual:
+		b, ok := b.(*ExprBinaryNotEqual)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryNotIdentical:
+		b, ok := b.(*ExprBinaryNotIdentical)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryPlus:
+		b, ok := b.(*ExprBinaryPlus)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryPow:
+		b, ok := b.(*ExprBinaryPow)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryShiftLeft:
+		b, ok := b.(*ExprBinaryShiftLeft)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinaryShiftRight:
+		b, ok := b.(*ExprBinaryShiftRight)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinarySmaller:
+		b, ok := b.(*ExprBinarySmaller)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinarySmallerOrEqual:
+		b, ok := b.(*ExprBinarySmallerOrEqual)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *ExprBinarySpaceship:
+		b, ok := b.(*ExprBinarySpaceship)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.node(a.Left, b.Left) &&
+			e.token(a.OpTkn, b.OpTkn, false) &&
+			e.node(a.Right, b.Right)
+	case *Name:
+		b, ok := b.(*Name)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.nodes(a.Parts, b.Parts) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns)
+	case *NameFullyQualified:
+		b, ok := b.(*NameFullyQualified)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.NsSeparatorTkn, b.NsSeparatorTkn, false) &&
+			e.nodes(a.Parts, b.Parts) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns)
+	case *NameRelative:
+		b, ok := b.(*NameRelative)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.NsTkn, b.NsTkn, false) &&
+			e.token(a.NsSeparatorTkn, b.NsSeparatorTkn, false) &&
+			e.nodes(a.Parts, b.Parts) &&
+			e.tokens(a.SeparatorTkns, b.SeparatorTkns)
+	case *NamePart:
+		b, ok := b.(*NamePart)
+		if !ok {
+			return false
+		}
+		return e.position(a.Position, b.Position) &&
+			e.token(a.StringTkn, b.StringTkn, e.fold) &&
+			e.bytes(a.Value, b.Value, e.fold)
+	}
+
+	return false
+}
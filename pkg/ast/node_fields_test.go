@@ -0,0 +1,86 @@
+package ast_test
+
+import (
+	"reflect"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+var kinds = map[reflect.Type]ast.FieldKind{
+	reflect.TypeOf((*position.Position)(nil)): ast.FieldPosition,
+	reflect.TypeOf((*token.Token)(nil)):       ast.FieldToken,
+	reflect.TypeOf([]*token.Token(nil)):       ast.FieldTokenList,
+	reflect.TypeOf((*ast.Vertex)(nil)).Elem(): ast.FieldNode,
+	reflect.TypeOf([]ast.Vertex(nil)):         ast.FieldNodeList,
+	reflect.TypeOf([]byte(nil)):               ast.FieldValue,
+}
+
+// nodes returns an empty node for each method of ast.Visitor
+func nodes() []ast.Vertex {
+	var nodes []ast.Vertex
+
+	visitor := reflect.TypeOf((*ast.Visitor)(nil)).Elem()
+	for i := 0; i < visitor.NumMethod(); i++ {
+		t := visitor.Method(i).Type.In(0)
+		nodes = append(nodes, reflect.New(t.Elem()).Interface().(ast.Vertex))
+	}
+
+	return nodes
+}
+
+func TestFields(t *testing.T) {
+	for _, n := range nodes() {
+		var expected []ast.Field
+
+		s := reflect.TypeOf(n).Elem()
+		for i := 0; i < s.NumField(); i++ {
+			f := s.Field(i)
+			kind, ok := kinds[f.Type]
+			assert.Assert(t, ok, "%s.%s", s.Name(), f.Name)
+
+			expected = append(expected, ast.Field{Name: f.Name, Kind: kind})
+		}
+
+		assert.DeepEqual(t, expected, ast.Fields(n))
+	}
+}
+
+func TestChildren(t *testing.T) {
+	a := &ast.ExprVariable{Name: &ast.Identifier{Value: []byte("$a")}}
+	b := &ast.ExprVariable{Name: &ast.Identifier{Value: []byte("$b")}}
+	class := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("Foo")}}}
+
+	n := &ast.ExprStaticCall{
+		Class: class,
+		Args: []ast.Vertex{
+			a,
+			nil,
+			b,
+		},
+	}
+
+	expected := []ast.Child{
+		{Field: "Class", Index: -1, Node: class},
+		{Field: "Args", Index: 0, Node: a},
+		{Field: "Args", Index: 2, Node: b},
+	}
+
+	assert.DeepEqual(t, expected, ast.Children(n))
+	assert.Assert(t, ast.Children(&ast.Identifier{}) == nil)
+}
+
+func TestKind(t *testing.T) {
+	for _, n := range nodes() {
+		kind := ast.Kind(n)
+		assert.Equal(t, reflect.TypeOf(n).Elem().Name(), kind)
+		assert.Equal(t, reflect.TypeOf(n), reflect.TypeOf(ast.NewNode(kind)))
+	}
+
+	assert.Equal(t, "", ast.Kind(nil))
+	assert.Assert(t, ast.NewNode("Foo") == nil)
+}
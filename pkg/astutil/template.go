@@ -0,0 +1,498 @@
+// Package astutil builds the AST from PHP source templates.
+//
+// A template is a PHP fragment without the open tag where each %s is replaced by the argument.
+// The string arguments are inserted as source text, the ast.Vertex arguments are spliced
+// into the parsed tree in place of the expression, name, identifier or variable at the %s:
+//
+//	call := astutil.MustExpr("$%s->save(%s)", "user", argNode)
+//
+// Where the grammar requires a variable, like in front of ->, write $%s. A statement
+// placeholder is written as %s; and may be replaced by any statement or expression.
+// The spliced nodes are put into the tree as is, so pass a clone to keep the original.
+// A []ast.Vertex argument replaces the statement, argument or array item with the list.
+// Use %% for the percent sign.
+//
+// The package functions parse the templates as PHP 8.3, NewTemplates parses them with another config:
+//
+//	tpl := astutil.NewTemplates(conf.Config{Version: &version.Version{Major: 7, Minor: 4}})
+//	call, err := tpl.Expr("$%s->save(%s)", "user", argNode)
+package astutil
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+var (
+	// ErrArgCount is returned if the number of the arguments differs from the number of %s
+	ErrArgCount = errors.New("the number of the arguments does not match the template")
+
+	// ErrPlaceholder is returned if the node argument is used where the tree has no node to replace,
+	// like inside a string literal
+	ErrPlaceholder = errors.New("the placeholder can not be replaced by the node")
+)
+
+// Templates parses the templates with its own config, the package functions
+// parse them as PHP 8.3
+type Templates struct {
+	config conf.Config
+}
+
+// NewTemplates returns the templates parsed with the config
+func NewTemplates(config conf.Config) *Templates {
+	return &Templates{config: config}
+}
+
+var defaultTemplates = NewTemplates(conf.Config{
+	Version: &version.Version{Major: 8, Minor: 3},
+})
+
+const placeholderPrefix = "__astutil_"
+
+// Expr parses the expression template
+func Expr(template string, args ...interface{}) (ast.Vertex, error) {
+	return defaultTemplates.Expr(template, args...)
+}
+
+// Stmts parses the statement list template
+func Stmts(template string, args ...interface{}) ([]ast.Vertex, error) {
+	return defaultTemplates.Stmts(template, args...)
+}
+
+// Stmt parses the template of a single statement
+func Stmt(template string, args ...interface{}) (ast.Vertex, error) {
+	return defaultTemplates.Stmt(template, args...)
+}
+
+// ClassMember parses the class member template
+func ClassMember(template string, args ...interface{}) (ast.Vertex, error) {
+	return defaultTemplates.ClassMember(template, args...)
+}
+
+// Expr parses the expression template
+func (t *Templates) Expr(template string, args ...interface{}) (ast.Vertex, error) {
+	src, s, err := expand(template, args)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := parser.ParseExpr(src, nil, t.config)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.splice(n)
+}
+
+// Stmts parses the statement list template
+func (t *Templates) Stmts(template string, args ...interface{}) ([]ast.Vertex, error) {
+	src, s, err := expand(template, args)
+	if err != nil {
+		return nil, err
+	}
+
+	stmts, err := parser.ParseStmts(src, nil, t.config)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := s.splice(&ast.Root{Stmts: stmts})
+	if err != nil {
+		return nil, err
+	}
+
+	return root.(*ast.Root).Stmts, nil
+}
+
+// Stmt parses the template of a single statement
+func (t *Templates) Stmt(template string, args ...interface{}) (ast.Vertex, error) {
+	stmts, err := t.Stmts(template, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("the template has %d statements", len(stmts))
+	}
+
+	return stmts[0], nil
+}
+
+// ClassMember parses the class member template
+func (t *Templates) ClassMember(template string, args ...interface{}) (ast.Vertex, error) {
+	src, s, err := expand(template, args)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := parser.ParseClassMember(src, nil, t.config)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.splice(n)
+}
+
+// MustExpr is like Expr but panics on error
+func MustExpr(template string, args ...interface{}) ast.Vertex {
+	n, err := Expr(template, args...)
+	if err != nil {
+		panic(err)
+	}
+
+	return n
+}
+
+// MustStmts is like Stmts but panics on error
+func MustStmts(template string, args ...interface{}) []ast.Vertex {
+	stmts, err := Stmts(template, args...)
+	if err != nil {
+		panic(err)
+	}
+
+	return stmts
+}
+
+// MustStmt is like Stmt but panics on error
+func MustStmt(template string, args ...interface{}) ast.Vertex {
+	n, err := Stmt(template, args...)
+	if err != nil {
+		panic(err)
+	}
+
+	return n
+}
+
+// MustClassMember is like ClassMember but panics on error
+func MustClassMember(template string, args ...interface{}) ast.Vertex {
+	n, err := ClassMember(template, args...)
+	if err != nil {
+		panic(err)
+	}
+
+	return n
+}
+
+// expand replaces %s with the string arguments and the placeholder names for the node arguments
+func expand(template string, args []interface{}) ([]byte, *splicer, error) {
+	s := &splicer{
+		args: map[string]interface{}{},
+	}
+
+	buf := bytes.Buffer{}
+	i := 0
+
+	for p := 0; p < len(template); p++ {
+		if template[p] != '%' {
+			buf.WriteByte(template[p])
+			continue
+		}
+
+		p++
+		if p == len(template) {
+			return nil, nil, fmt.Errorf("the template ends with %%")
+		}
+
+		switch template[p] {
+		case '%':
+			buf.WriteByte('%')
+			continue
+		case 's':
+		default:
+			return nil, nil, fmt.Errorf("unknown verb %%%c", template[p])
+		}
+
+		if i == len(args) {
+			return nil, nil, ErrArgCount
+		}
+
+		switch arg := args[i].(type) {
+		case string:
+			buf.WriteString(arg)
+		case ast.Vertex, []ast.Vertex:
+			name := fmt.Sprintf("%s%d", placeholderPrefix, i)
+			s.args[name] = arg
+			buf.WriteString(name)
+		default:
+			return nil, nil, fmt.Errorf("unsupported argument %d of type %T", i, arg)
+		}
+
+		i++
+	}
+
+	if i != len(args) {
+		return nil, nil, ErrArgCount
+	}
+
+	return buf.Bytes(), s, nil
+}
+
+// splicer replaces the placeholders of the parsed template with the arguments
+type splicer struct {
+	args map[string]interface{}
+	used int
+}
+
+func (s *splicer) splice(n ast.Vertex) (ast.Vertex, error) {
+	n = s.node(n)
+
+	if s.used != len(s.args) {
+		return nil, ErrPlaceholder
+	}
+
+	return n, nil
+}
+
+// node returns the node replacing n
+func (s *splicer) node(n ast.Vertex) ast.Vertex {
+	if n == nil {
+		return nil
+	}
+
+	if arg, ok := s.placeholder(n); ok {
+		if v, ok := arg.(ast.Vertex); ok {
+			s.used++
+			return moveFreeFloating(n, s.replace(n, v))
+		}
+	}
+
+	if w, arg, ok := s.wrapped(n); ok {
+		if v, ok := arg.(ast.Vertex); ok {
+			s.used++
+			return moveFreeFloating(w, wrap(w, v))
+		}
+	}
+
+	v := reflect.ValueOf(n).Elem()
+	fields := ast.Fields(n)
+
+	for i, f := range fields {
+		switch f.Kind {
+		case ast.FieldNode:
+			c, _ := v.Field(i).Interface().(ast.Vertex)
+			if c != nil {
+				v.Field(i).Set(reflect.ValueOf(s.node(c)))
+			}
+		case ast.FieldNodeList:
+			list, counts := s.list(v.Field(i).Interface().([]ast.Vertex))
+			v.Field(i).Set(reflect.ValueOf(list))
+
+			// the separators follow the list they separate
+			if i+1 < len(fields) && strings.HasSuffix(fields[i+1].Name, "SeparatorTkns") {
+				seps := v.Field(i + 1).Interface().([]*token.Token)
+				v.Field(i + 1).Set(reflect.ValueOf(separators(seps, counts, len(list))))
+			}
+		}
+	}
+
+	return n
+}
+
+// list replaces the placeholders of the list items, the list arguments are expanded,
+// counts holds the number of the nodes each item is replaced by
+func (s *splicer) list(list []ast.Vertex) (out []ast.Vertex, counts []int) {
+	if list == nil {
+		return nil, nil
+	}
+
+	out = make([]ast.Vertex, 0, len(list))
+	counts = make([]int, 0, len(list))
+
+	for _, n := range list {
+		w, arg, ok := s.wrapped(n)
+		if !ok {
+			arg, ok = s.placeholder(n)
+		}
+
+		nodes, isList := arg.([]ast.Vertex)
+		if !ok || !isList {
+			out = append(out, s.node(n))
+			counts = append(counts, 1)
+			continue
+		}
+
+		counts = append(counts, len(nodes))
+		if len(nodes) > 0 {
+			moveFreeFloating(n, nodes[0])
+		}
+
+		s.used++
+		for i, nn := range nodes {
+			if w == nil {
+				out = append(out, nn)
+				continue
+			}
+
+			// the first item keeps the template tokens
+			if i > 0 {
+				w = reflect.New(reflect.TypeOf(w).Elem()).Interface().(ast.Vertex)
+			}
+			out = append(out, wrap(w, nn))
+		}
+	}
+
+	return out, counts
+}
+
+// separators returns the separator tokens of the expanded list,
+// the expanded items are separated by the new commas
+func separators(seps []*token.Token, counts []int, n int) []*token.Token {
+	if seps == nil && n < 2 {
+		return seps
+	}
+
+	out := make([]*token.Token, 0, n)
+
+	for i, c := range counts {
+		for j := 1; j < c; j++ {
+			out = append(out, &token.Token{ID: ',', Value: []byte(",")})
+		}
+
+		if i < len(seps) && c > 0 {
+			out = append(out, seps[i])
+		}
+	}
+
+	// keep the trailing separator only if the template has one
+	if len(seps) < len(counts) && len(out) >= n && n > 0 {
+		out = out[:n-1]
+	}
+
+	return out
+}
+
+// placeholder returns the argument of the placeholder node
+func (s *splicer) placeholder(n ast.Vertex) (interface{}, bool) {
+	var name []byte
+
+	switch n := n.(type) {
+	case *ast.ExprConstFetch:
+		return s.placeholder(n.Const)
+	case *ast.Name:
+		if len(n.Parts) == 1 {
+			name = n.Parts[0].(*ast.NamePart).Value
+		}
+	case *ast.Identifier:
+		name = n.Value
+	case *ast.ExprVariable:
+		if id, ok := n.Name.(*ast.Identifier); ok {
+			name = bytes.TrimPrefix(id.Value, []byte("$"))
+		}
+	}
+
+	if !bytes.HasPrefix(name, []byte(placeholderPrefix)) {
+		return nil, false
+	}
+
+	arg, ok := s.args[string(name)]
+
+	return arg, ok
+}
+
+// wrapped returns the statement, argument or array item holding just the placeholder
+func (s *splicer) wrapped(n ast.Vertex) (ast.Vertex, interface{}, bool) {
+	var expr ast.Vertex
+
+	switch n := n.(type) {
+	case *ast.StmtExpression:
+		expr = n.Expr
+	case *ast.Argument:
+		if n.Name != nil || n.VariadicTkn != nil || n.AmpersandTkn != nil {
+			return nil, nil, false
+		}
+		expr = n.Expr
+	case *ast.ExprArrayItem:
+		if n.Key != nil || n.EllipsisTkn != nil || n.AmpersandTkn != nil {
+			return nil, nil, false
+		}
+		expr = n.Val
+	default:
+		return nil, nil, false
+	}
+
+	if _, ok := expr.(*ast.ExprConstFetch); !ok {
+		return nil, nil, false
+	}
+
+	arg, ok := s.placeholder(expr)
+
+	return n, arg, ok
+}
+
+// replace returns the argument node put in place of the placeholder node
+func (s *splicer) replace(n ast.Vertex, arg ast.Vertex) ast.Vertex {
+	v, ok := n.(*ast.ExprVariable)
+	if !ok {
+		return arg
+	}
+
+	switch arg := arg.(type) {
+	case *ast.ExprVariable:
+		return arg
+	case *ast.Identifier:
+		// keep the template token to keep the formatting
+		id := v.Name.(*ast.Identifier)
+		id.Value = []byte("$" + strings.TrimPrefix(string(arg.Value), "$"))
+		if id.IdentifierTkn != nil {
+			id.IdentifierTkn.Value = id.Value
+		}
+		return v
+	}
+
+	// variable variable ${expr}
+	v.DollarTkn = &token.Token{ID: '$', Value: []byte("$")}
+	if t := ast.FirstToken(v.Name); t != nil {
+		v.DollarTkn.FreeFloating = t.FreeFloating
+	}
+	v.OpenCurlyBracketTkn = &token.Token{ID: '{', Value: []byte("{")}
+	v.Name = arg
+	v.CloseCurlyBracketTkn = &token.Token{ID: '}', Value: []byte("}")}
+
+	return v
+}
+
+// wrap puts the node into the statement, argument or array item,
+// the node is returned as is if it is of the same kind or a statement
+func wrap(w ast.Vertex, n ast.Vertex) ast.Vertex {
+	if ast.Kind(n) == ast.Kind(w) {
+		return n
+	}
+
+	switch w := w.(type) {
+	case *ast.StmtExpression:
+		if strings.HasPrefix(ast.Kind(n), "Stmt") {
+			return n
+		}
+		w.Expr = n
+	case *ast.Argument:
+		w.Expr = n
+	case *ast.ExprArrayItem:
+		w.Val = n
+	}
+
+	return w
+}
+
+// moveFreeFloating gives the whitespace and comments in front of the placeholder
+// to the node replacing it unless the node has its own
+func moveFreeFloating(from, to ast.Vertex) ast.Vertex {
+	if from == to {
+		return to
+	}
+
+	f, t := ast.FirstToken(from), ast.FirstToken(to)
+	if f != nil && t != nil && t.FreeFloating == nil {
+		t.FreeFloating, f.FreeFloating = f.FreeFloating, nil
+	}
+
+	return to
+}
@@ -0,0 +1,130 @@
+package astutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/astutil"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+)
+
+func print(nodes ...ast.Vertex) string {
+	o := bytes.NewBufferString("")
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	for _, n := range nodes {
+		n.Accept(p)
+	}
+
+	return o.String()
+}
+
+func TestExpr(t *testing.T) {
+	v := astutil.MustExpr("$user")
+	arg := astutil.MustExpr("[1, 2]")
+
+	n := astutil.MustExpr("$%s->save(%s, true)", v, arg)
+
+	call, ok := n.(*ast.ExprMethodCall)
+	assert.Assert(t, ok)
+	assert.Assert(t, call.Var == v)
+	assert.Assert(t, call.Args[0].(*ast.Argument).Expr == arg)
+	assert.Equal(t, "$user->save([1, 2], true)", print(n))
+}
+
+func TestExprPlaceholders(t *testing.T) {
+	name := &ast.Identifier{Value: []byte("user")}
+	class := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("Foo")}}}
+	method := &ast.Identifier{Value: []byte("save")}
+
+	tests := []struct {
+		template string
+		args     []interface{}
+		expected string
+	}{
+		{"$%s->%s()", []interface{}{name, method}, "$user->save()"},
+		{"new %s(%s)", []interface{}{class, astutil.MustExpr("$a")}, "new Foo($a)"},
+		{"%s::%s", []interface{}{class, "BAR"}, "Foo::BAR"},
+		{"$%s", []interface{}{astutil.MustExpr("$a . 'b'")}, "${$a . 'b'}"},
+		{"f(%s, 3)", []interface{}{[]ast.Vertex{astutil.MustExpr("1"), astutil.MustExpr("2")}}, "f(1,2, 3)"},
+		{"[%s]", []interface{}{[]ast.Vertex{}}, "[]"},
+		{"[1, %s]", []interface{}{[]ast.Vertex{}}, "[1]"},
+		{"[%s,]", []interface{}{[]ast.Vertex{astutil.MustExpr("1"), astutil.MustExpr("2")}}, "[1,2,]"},
+		{"%d %% 2", nil, ""},
+		{"%s %% 2", []interface{}{"$a"}, "$a % 2"},
+	}
+
+	for _, tt := range tests {
+		n, err := astutil.Expr(tt.template, tt.args...)
+		if tt.expected == "" {
+			assert.ErrorContains(t, err, "unknown verb")
+			continue
+		}
+
+		assert.NilError(t, err, tt.template)
+		assert.Equal(t, tt.expected, print(n))
+	}
+}
+
+func TestStmts(t *testing.T) {
+	body := astutil.MustStmts("$a = 1;\n$b = 2;")
+	cond := astutil.MustExpr("$x > 0")
+
+	stmts := astutil.MustStmts("if (%s) {\n    %s;\n}\nreturn %s;", cond, body, astutil.MustExpr("$a"))
+
+	assert.Equal(t, 2, len(stmts))
+	assert.Equal(t, "if ($x > 0) {\n    $a = 1;\n$b = 2;\n}\nreturn $a;", print(stmts...))
+
+	stmt := astutil.MustStmt("%s;", astutil.MustStmt("echo 1;"))
+	assert.Equal(t, "echo 1;", print(stmt))
+}
+
+func TestClassMember(t *testing.T) {
+	n := astutil.MustClassMember("public function %s() { return %s; }", "getName", astutil.MustExpr("$this->name"))
+
+	method, ok := n.(*ast.StmtClassMethod)
+	assert.Assert(t, ok)
+	assert.Equal(t, "public function getName() { return $this->name; }", print(method))
+}
+
+func TestTemplatesConfig(t *testing.T) {
+	tpl := astutil.NewTemplates(conf.Config{Version: &version.Version{Major: 7, Minor: 4}})
+
+	_, err := tpl.Expr("$%s?->save()", "user")
+	assert.ErrorContains(t, err, "syntax error")
+
+	n, err := astutil.Expr("$%s?->save()", "user")
+	assert.NilError(t, err)
+	assert.Equal(t, "$user?->save()", print(n))
+
+	n, err = tpl.Stmt("$%s->save();", "user")
+	assert.NilError(t, err)
+	assert.Equal(t, "$user->save();", print(n))
+}
+
+func TestErrors(t *testing.T) {
+	_, err := astutil.Expr("%s + %s", astutil.MustExpr("1"))
+	assert.Equal(t, astutil.ErrArgCount, err)
+
+	_, err = astutil.Expr("1", astutil.MustExpr("1"))
+	assert.Equal(t, astutil.ErrArgCount, err)
+
+	_, err = astutil.Expr("'%s'", astutil.MustExpr("1"))
+	assert.Equal(t, astutil.ErrPlaceholder, err)
+
+	_, err = astutil.Expr("%s +", "1")
+	assert.ErrorContains(t, err, "syntax error")
+
+	_, err = astutil.Expr("%s", 1)
+	assert.ErrorContains(t, err, "unsupported argument 0 of type int")
+
+	assert.Assert(t, func() (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		astutil.MustExpr("+")
+		return false
+	}())
+}
@@ -4,27 +4,134 @@ import (
 	"fmt"
 
 	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
 )
 
+// Severity of the Error
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+// String returns lowercase name of the severity
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
 // Error parsing error
 type Error struct {
-	Msg string
-	Pos *position.Position
+	Msg      string
+	Pos      *position.Position
+	Severity Severity
+
+	// TokenID and TokenValue describe the unexpected token of a syntax error
+	TokenID    token.ID
+	TokenValue []byte
+
+	// Expected lists the tokens the parser could accept instead
+	Expected []token.ID
+
+	// Line and Column are 1-based, Column is counted in bytes
+	Line   int
+	Column int
 }
 
 // NewError creates and returns new Error
 func NewError(msg string, p *position.Position) *Error {
-	return &Error{
+	e := &Error{
 		Msg: msg,
 		Pos: p,
 	}
+
+	if p != nil {
+		e.Line = p.StartLine
+	}
+
+	return e
 }
 
+// NewSyntaxError creates and returns new Error for the unexpected token t
+func NewSyntaxError(msg string, t *token.Token, expected []token.ID) *Error {
+	e := NewError(msg, t.Position)
+	e.TokenID = t.ID
+	e.TokenValue = t.Value
+	e.Expected = expected
+
+	return e
+}
+
+// String returns the message followed by the line and column
 func (e *Error) String() string {
 	atLine := ""
-	if e.Pos != nil {
-		atLine = fmt.Sprintf(" at line %d", e.Pos.StartLine)
+	if e.Line > 0 {
+		atLine = fmt.Sprintf(" at line %d", e.Line)
+	}
+
+	if e.Line > 0 && e.Column > 0 {
+		atLine += fmt.Sprintf(", column %d", e.Column)
 	}
 
 	return fmt.Sprintf("%s%s", e.Msg, atLine)
 }
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return e.String()
+}
+
+// ErrorList is a list of errors reported while parsing
+type ErrorList []*Error
+
+// Error implements the error interface
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// Err returns an error equivalent to this error list,
+// if the list has no errors of SeverityError, Err returns nil
+func (l ErrorList) Err() error {
+	for _, e := range l {
+		if e.Severity == SeverityError {
+			return l
+		}
+	}
+
+	return nil
+}
+
+// Errors returns the errors of SeverityError
+func (l ErrorList) Errors() ErrorList {
+	return l.filter(SeverityError)
+}
+
+// Warnings returns the errors of SeverityWarning
+func (l ErrorList) Warnings() ErrorList {
+	return l.filter(SeverityWarning)
+}
+
+func (l ErrorList) filter(s Severity) ErrorList {
+	var result ErrorList
+	for _, e := range l {
+		if e.Severity == s {
+			result = append(result, e)
+		}
+	}
+
+	return result
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/z7zmey/php-parser/pkg/errors"
 	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
 )
 
 func TestConstructor(t *testing.T) {
@@ -15,8 +16,9 @@ func TestConstructor(t *testing.T) {
 	actual := errors.NewError("message", pos)
 
 	expected := &errors.Error{
-		Msg: "message",
-		Pos: pos,
+		Msg:  "message",
+		Pos:  pos,
+		Line: 1,
 	}
 
 	assert.DeepEqual(t, expected, actual)
@@ -43,3 +45,73 @@ func TestPrintWithotPos(t *testing.T) {
 
 	assert.DeepEqual(t, expected, actual)
 }
+
+func TestSyntaxErrorConstructor(t *testing.T) {
+	pos := position.NewPosition(2, 2, 10, 11)
+	tkn := &token.Token{
+		ID:       token.ID(';'),
+		Value:    []byte(";"),
+		Position: pos,
+	}
+
+	actual := errors.NewSyntaxError("message", tkn, []token.ID{token.T_STRING})
+
+	expected := &errors.Error{
+		Msg:        "message",
+		Pos:        pos,
+		TokenID:    token.ID(';'),
+		TokenValue: []byte(";"),
+		Expected:   []token.ID{token.T_STRING},
+		Line:       2,
+	}
+
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestPrintWithColumn(t *testing.T) {
+	Error := errors.NewError("message", position.NewPosition(1, 2, 3, 4))
+	Error.Column = 4
+
+	actual := Error.Error()
+
+	expected := "message at line 1, column 4"
+
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestSeverity(t *testing.T) {
+	assert.Equal(t, "error", errors.SeverityError.String())
+	assert.Equal(t, "warning", errors.SeverityWarning.String())
+	assert.Equal(t, "Severity(5)", errors.Severity(5).String())
+}
+
+func TestErrorList(t *testing.T) {
+	var list errors.ErrorList
+
+	assert.NilError(t, list.Err())
+
+	list = append(list, errors.NewError("first", position.NewPosition(1, 1, 0, 1)))
+
+	assert.Error(t, list.Err(), "first at line 1")
+
+	list = append(list, errors.NewError("second", nil), errors.NewError("third", nil))
+
+	assert.Error(t, list.Err(), "first at line 1 (and 2 more errors)")
+}
+
+func TestErrorListWarnings(t *testing.T) {
+	warning := errors.NewError("warning", nil)
+	warning.Severity = errors.SeverityWarning
+
+	list := errors.ErrorList{warning}
+
+	assert.NilError(t, list.Err())
+	assert.Equal(t, 0, len(list.Errors()))
+	assert.DeepEqual(t, errors.ErrorList{warning}, list.Warnings())
+
+	e := errors.NewError("error", nil)
+	list = append(list, e)
+
+	assert.Error(t, list.Err(), "warning (and 1 more errors)")
+	assert.DeepEqual(t, errors.ErrorList{e}, list.Errors())
+}
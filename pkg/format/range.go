@@ -0,0 +1,240 @@
+package format
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+
+	"github.com/z7zmey/php-parser/internal/indentation"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/visitor/formatter"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+)
+
+// ErrInvalidRange is returned if the range is out of the source
+var ErrInvalidRange = errors.New("the range is out of the source")
+
+// Range formats the statements and the class members of the tree parsed from src
+// that intersect the start:end byte range and returns the edits of the source.
+//
+// If the range is inside of the single statement the nested statements intersecting
+// the range are formatted instead. Every statement is formatted at the indentation
+// of its source line, the whitespace around the statements is kept.
+// The tree is not changed, parser.Reparse applies the edits and updates it.
+func Range(root ast.Vertex, src []byte, start, end int, style formatter.Style) ([]parser.Edit, error) {
+	if start < 0 || start > end || end > len(src) {
+		return nil, ErrInvalidRange
+	}
+
+	var edits []parser.Edit
+	for _, s := range intersecting(stmtLists(root, nil), start, end) {
+		if e, ok := formatNode(s, src, style); ok {
+			edits = append(edits, e)
+		}
+	}
+
+	return edits, nil
+}
+
+// Lines is Range for the lines from startLine to endLine, the lines are counted from 1
+// and end with \n, \r\n or \r as the parser counts them
+func Lines(root ast.Vertex, src []byte, startLine, endLine int, style formatter.Style) ([]parser.Edit, error) {
+	if startLine < 1 || startLine > endLine {
+		return nil, ErrInvalidRange
+	}
+
+	start, end := -1, -1
+	line, offset := 1, 0
+	for line <= endLine {
+		if line == startLine {
+			start = offset
+		}
+
+		n := bytes.IndexAny(src[offset:], "\r\n")
+		if n < 0 {
+			if line == endLine {
+				end = len(src)
+			}
+			break
+		}
+
+		if line == endLine {
+			end = offset + n
+		}
+
+		offset += n + 1
+		if src[offset-1] == '\r' && offset < len(src) && src[offset] == '\n' {
+			offset++
+		}
+		line++
+	}
+
+	if start < 0 || end < 0 {
+		return nil, ErrInvalidRange
+	}
+
+	return Range(root, src, start, end, style)
+}
+
+// stmtList is the statement list and the node it belongs to
+type stmtList struct {
+	owner ast.Vertex
+	stmts []ast.Vertex
+}
+
+// stmt is the statement of the list
+type stmt struct {
+	node ast.Vertex
+	list stmtList
+}
+
+// intersecting returns the statements of the lists intersecting the range
+func intersecting(lists []stmtList, start, end int) []stmt {
+	var result []stmt
+	for _, list := range lists {
+		for _, n := range list.stmts {
+			pos := n.GetPosition()
+			if pos == nil || pos.StartPos > end || pos.EndPos <= start {
+				continue
+			}
+
+			result = append(result, stmt{n, list})
+		}
+	}
+
+	if len(result) == 1 {
+		pos := result[0].node.GetPosition()
+		if start > pos.StartPos || end < pos.EndPos {
+			if nested := intersecting(stmtLists(result[0].node, nil), start, end); len(nested) > 0 {
+				return nested
+			}
+		}
+	}
+
+	return result
+}
+
+// stmtLists returns the statement lists of the node and its children,
+// the lists nested in the statements of the found lists are skipped
+func stmtLists(n ast.Vertex, lists []stmtList) []stmtList {
+	v := reflect.ValueOf(n).Elem()
+	for i, f := range ast.Fields(n) {
+		if f.Name == "Stmts" && f.Kind == ast.FieldNodeList {
+			lists = append(lists, stmtList{n, v.Field(i).Interface().([]ast.Vertex)})
+		}
+	}
+
+	for _, c := range ast.Children(n) {
+		if c.Field != "Stmts" {
+			lists = stmtLists(c.Node, lists)
+		}
+	}
+
+	return lists
+}
+
+// formatNode formats the copy of the node at the indentation of its source line
+// and returns the edit replacing the node source
+func formatNode(s stmt, src []byte, style formatter.Style) (parser.Edit, bool) {
+	n := s.node
+	if _, ok := n.(*ast.StmtInlineHtml); ok {
+		return parser.Edit{}, false
+	}
+
+	pos := n.GetPosition()
+	if pos.StartPos < 0 || pos.StartPos > pos.EndPos || pos.EndPos > len(src) {
+		return parser.Edit{}, false
+	}
+
+	indent := indentation.Line(src, pos.StartPos)
+
+	c := ast.Clone(n)
+	c.Accept(formatter.NewFormatter().
+		WithStyle(inferStyle(src, s, indent, style)).
+		WithState(formatter.FormatterStatePHP))
+
+	// the whitespace in front of the node is not replaced
+	if t := ast.FirstToken(c); t != nil {
+		t.FreeFloating = nil
+	}
+
+	// the lines are indented as the node line even if it is not a whole count of levels
+	indentation.Reindent(c, indent)
+
+	o := bytes.NewBufferString("")
+	c.Accept(printer.NewPrinter(o).WithState(printer.PrinterStatePHP))
+
+	if bytes.Equal(o.Bytes(), src[pos.StartPos:pos.EndPos]) {
+		return parser.Edit{}, false
+	}
+
+	return parser.Edit{Start: pos.StartPos, End: pos.EndPos, Text: o.Bytes()}, true
+}
+
+// inferStyle takes the indentation of the surrounding code: the step from the line
+// of the list owner to the lines of the other statements or of the statement itself,
+// or else the nested lines of the statement or of its siblings.
+// The style indentation is used if there are none.
+func inferStyle(src []byte, s stmt, indent []byte, style formatter.Style) formatter.Style {
+	var unit []byte
+	if pos := s.list.owner.GetPosition(); pos != nil && pos.StartPos <= len(src) {
+		owner := indentation.Line(src, pos.StartPos)
+		for _, n := range s.list.stmts {
+			if pos := n.GetPosition(); n != s.node && pos != nil && pos.StartPos <= len(src) {
+				unit = step(owner, indentation.Line(src, pos.StartPos))
+			}
+			if unit != nil {
+				break
+			}
+		}
+
+		if unit == nil {
+			unit = step(owner, indent)
+		}
+	}
+
+	nodes := append([]ast.Vertex{s.node}, s.list.stmts...)
+	for i := 0; unit == nil && i < len(nodes); i++ {
+		if pos := nodes[i].GetPosition(); pos != nil && pos.StartPos <= pos.EndPos && pos.EndPos <= len(src) {
+			unit = nestedStep(src[pos.StartPos:pos.EndPos], indentation.Line(src, pos.StartPos))
+		}
+	}
+
+	switch {
+	case unit != nil && unit[0] == '\t':
+		style.UseTabs = true
+	case unit != nil:
+		style.UseTabs = false
+		style.IndentWidth = len(unit)
+	case len(indent) > 0 && indent[0] == '\t':
+		style.UseTabs = true
+	}
+
+	return style
+}
+
+// nestedStep returns the step to the first nested line of the node source
+func nestedStep(src, indent []byte) []byte {
+	for _, line := range bytes.Split(src, []byte("\n"))[1:] {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		if unit := step(indent, indentation.Line(line, len(line))); unit != nil {
+			return unit
+		}
+	}
+
+	return nil
+}
+
+// step returns the indentation the nested line adds to the outer one,
+// nil if the line is not nested
+func step(outer, nested []byte) []byte {
+	if len(nested) <= len(outer) || !bytes.HasPrefix(nested, outer) {
+		return nil
+	}
+
+	return nested[len(outer):]
+}
@@ -0,0 +1,262 @@
+package format_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/format"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor/formatter"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+)
+
+var config = conf.Config{Version: &version.Version{Major: 8, Minor: 3}}
+
+const src = "<?php\n" +
+	"namespace App;\n" +
+	"\n" +
+	"class Foo\n" +
+	"{\n" +
+	"\tprivate $a=[1,2];\n" +
+	"\n" +
+	"\tpublic function bar($x){\n" +
+	"\t\t$y=$x+1;\n" +
+	"\t\tif($y){return   $y;}\n" +
+	"\t\treturn foo( $x,$y );\n" +
+	"\t}\n" +
+	"}\n" +
+	"$z=1;\n"
+
+func parse(t *testing.T) ast.Vertex {
+	root, err := parser.Parse([]byte(src), config)
+	assert.NilError(t, err)
+
+	return root
+}
+
+func apply(t *testing.T, root ast.Vertex, edits []parser.Edit) string {
+	_, newSrc, err := parser.Reparse(root, []byte(src), edits, config)
+	assert.NilError(t, err)
+
+	return string(newSrc)
+}
+
+func TestLinesInsideMethod(t *testing.T) {
+	root := parse(t)
+
+	edits, err := format.Lines(root, []byte(src), 10, 10, formatter.DefaultStyle())
+	assert.NilError(t, err)
+	assert.Equal(t, len(edits), 1)
+
+	expected := "<?php\n" +
+		"namespace App;\n" +
+		"\n" +
+		"class Foo\n" +
+		"{\n" +
+		"\tprivate $a=[1,2];\n" +
+		"\n" +
+		"\tpublic function bar($x){\n" +
+		"\t\t$y=$x+1;\n" +
+		"\t\tif ($y) {\n" +
+		"\t\t\treturn $y;\n" +
+		"\t\t}\n" +
+		"\t\treturn foo( $x,$y );\n" +
+		"\t}\n" +
+		"}\n" +
+		"$z=1;\n"
+
+	assert.Equal(t, expected, apply(t, root, edits))
+}
+
+func TestLinesMembers(t *testing.T) {
+	root := parse(t)
+
+	edits, err := format.Lines(root, []byte(src), 6, 8, formatter.DefaultStyle())
+	assert.NilError(t, err)
+	assert.Equal(t, len(edits), 2)
+
+	expected := "<?php\n" +
+		"namespace App;\n" +
+		"\n" +
+		"class Foo\n" +
+		"{\n" +
+		"\tprivate $a = array(1, 2);\n" +
+		"\n" +
+		"\tpublic function bar($x) {\n" +
+		"\t\t$y = $x + 1;\n" +
+		"\t\tif ($y) {\n" +
+		"\t\t\treturn $y;\n" +
+		"\t\t}\n" +
+		"\t\treturn foo($x, $y);\n" +
+		"\t}\n" +
+		"}\n" +
+		"$z=1;\n"
+
+	assert.Equal(t, expected, apply(t, root, edits))
+}
+
+func TestRangeTopLevel(t *testing.T) {
+	root := parse(t)
+
+	edits, err := format.Range(root, []byte(src), 0, len(src), formatter.DefaultStyle())
+	assert.NilError(t, err)
+
+	// the namespace is formatted already
+	assert.Equal(t, len(edits), 2)
+
+	expected := "<?php\n" +
+		"namespace App;\n" +
+		"\n" +
+		"class Foo {\n" +
+		"\tprivate $a = array(1, 2);\n" +
+		"\tpublic function bar($x) {\n" +
+		"\t\t$y = $x + 1;\n" +
+		"\t\tif ($y) {\n" +
+		"\t\t\treturn $y;\n" +
+		"\t\t}\n" +
+		"\t\treturn foo($x, $y);\n" +
+		"\t}\n" +
+		"}\n" +
+		"$z = 1;\n"
+
+	assert.Equal(t, expected, apply(t, root, edits))
+}
+
+func TestRangeKeepsTree(t *testing.T) {
+	root := parse(t)
+
+	_, err := format.Range(root, []byte(src), 0, len(src), formatter.DefaultStyle())
+	assert.NilError(t, err)
+
+	o := bytes.NewBufferString("")
+	root.Accept(printer.NewPrinter(o))
+	assert.Equal(t, src, o.String())
+}
+
+func TestRangeInvalid(t *testing.T) {
+	root := parse(t)
+
+	_, err := format.Range(root, []byte(src), 10, 5, formatter.DefaultStyle())
+	assert.Equal(t, err, format.ErrInvalidRange)
+
+	_, err = format.Range(root, []byte(src), 0, len(src)+1, formatter.DefaultStyle())
+	assert.Equal(t, err, format.ErrInvalidRange)
+
+	_, err = format.Lines(root, []byte(src), 20, 21, formatter.DefaultStyle())
+	assert.Equal(t, err, format.ErrInvalidRange)
+}
+
+func formatLine(t *testing.T, src string, line int) string {
+	root, err := parser.Parse([]byte(src), config)
+	assert.NilError(t, err)
+
+	edits, err := format.Lines(root, []byte(src), line, line, formatter.DefaultStyle())
+	assert.NilError(t, err)
+
+	_, newSrc, err := parser.Reparse(root, []byte(src), edits, config)
+	assert.NilError(t, err)
+
+	return string(newSrc)
+}
+
+func TestLinesSurroundingIndent(t *testing.T) {
+	src := "<?php\n" +
+		"class Foo {\n" +
+		"  public function bar() {\n" +
+		"    if($a&&$b){return   1;}\n" +
+		"  }\n" +
+		"}\n"
+
+	expected := "<?php\n" +
+		"class Foo {\n" +
+		"  public function bar() {\n" +
+		"    if ($a && $b) {\n" +
+		"      return 1;\n" +
+		"    }\n" +
+		"  }\n" +
+		"}\n"
+
+	assert.Equal(t, expected, formatLine(t, src, 4))
+}
+
+func TestLinesPartialIndent(t *testing.T) {
+	src := "<?php\n" +
+		"function bar() {\n" +
+		"    $a = 1;\n" +
+		"      if($a){return   1;}\n" +
+		"}\n"
+
+	expected := "<?php\n" +
+		"function bar() {\n" +
+		"    $a = 1;\n" +
+		"      if ($a) {\n" +
+		"          return 1;\n" +
+		"      }\n" +
+		"}\n"
+
+	assert.Equal(t, expected, formatLine(t, src, 4))
+}
+
+func TestRangeAfterStmt(t *testing.T) {
+	root := parse(t)
+
+	// the range starts right after the closing bracket of the class
+	start := len(src) - len("\n$z=1;\n")
+
+	edits, err := format.Range(root, []byte(src), start, len(src), formatter.DefaultStyle())
+	assert.NilError(t, err)
+	assert.Equal(t, len(edits), 1)
+	assert.Equal(t, "$z = 1;", string(edits[0].Text))
+}
+
+func TestLinesCRLF(t *testing.T) {
+	src := "<?php\r\n" +
+		"function bar() {\r\n" +
+		"  $a=1;\r\n" +
+		"  $b=2;\r\n" +
+		"}\r\n"
+
+	expected := "<?php\r\n" +
+		"function bar() {\r\n" +
+		"  $a = 1;\r\n" +
+		"  $b=2;\r\n" +
+		"}\r\n"
+
+	assert.Equal(t, expected, formatLine(t, src, 3))
+}
+
+func TestLinesBlankLinesNotIndented(t *testing.T) {
+	src := "<?php\n" +
+		"namespace App {\n" +
+		"\tclass Foo {public $a;public $b;}\n" +
+		"}\n"
+
+	root, err := parser.Parse([]byte(src), config)
+	assert.NilError(t, err)
+
+	style := formatter.DefaultStyle()
+	style.UseTabs = true
+	style.BlankLinesBetweenMembers = 1
+
+	edits, err := format.Lines(root, []byte(src), 3, 3, style)
+	assert.NilError(t, err)
+
+	_, newSrc, err := parser.Reparse(root, []byte(src), edits, config)
+	assert.NilError(t, err)
+
+	expected := "<?php\n" +
+		"namespace App {\n" +
+		"\tclass Foo {\n" +
+		"\t\tpublic $a;\n" +
+		"\n" +
+		"\t\tpublic $b;\n" +
+		"\t}\n" +
+		"}\n"
+
+	assert.Equal(t, expected, string(newSrc))
+}
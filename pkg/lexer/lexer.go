@@ -0,0 +1,99 @@
+// Package lexer splits PHP source code into tokens without building the AST
+package lexer
+
+import (
+	"github.com/z7zmey/php-parser/internal/scanner"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+// Lexer returns tokens of the PHP source code one by one
+type Lexer struct {
+	scanner *scanner.Lexer
+
+	withFreeFloatingTokens bool
+	queue                  []*token.Token
+	state                  State
+}
+
+// State is a snapshot of the lexer state between tokens
+type State = scanner.State
+
+// NewLexer creates and returns new Lexer
+func NewLexer(data []byte, config conf.Config) *Lexer {
+	return &Lexer{
+		scanner: scanner.NewLexer(data, config),
+	}
+}
+
+// WithFreeFloatingTokens makes Lex return whitespace, comments and open tags
+// as standalone tokens instead of collecting them into the FreeFloating of the next token
+func (l *Lexer) WithFreeFloatingTokens() *Lexer {
+	l.withFreeFloatingTokens = true
+	return l
+}
+
+// Lex returns the next token. The token with ID 0 marks the end of the input,
+// it holds the trailing FreeFloating tokens unless they are returned as standalone tokens.
+func (l *Lexer) Lex() *token.Token {
+	if len(l.queue) > 0 {
+		tkn := l.queue[0]
+		l.queue = l.queue[1:]
+		if len(l.queue) > 0 {
+			l.state = scanner.FreeFloatingState(l.state, tkn)
+		}
+		return tkn
+	}
+
+	if !l.withFreeFloatingTokens {
+		return l.lex()
+	}
+
+	// the state of the standalone tokens is the state in front of the token
+	state := l.scanner.State()
+	tkn := l.lex()
+	if len(tkn.FreeFloating) == 0 {
+		return tkn
+	}
+
+	l.queue = append(tkn.FreeFloating[1:], tkn)
+	first := tkn.FreeFloating[0]
+	tkn.FreeFloating = nil
+	l.state = scanner.FreeFloatingState(state, first)
+
+	return first
+}
+
+func (l *Lexer) lex() *token.Token {
+	tkn := l.scanner.Lex()
+	if tkn.ID == 0 {
+		tkn.Value = nil
+	}
+
+	return tkn
+}
+
+// State returns the snapshot of the lexer state after the last returned token.
+// Snapshots of the lexer with free floating tokens are taken at the standalone tokens boundaries.
+func (l *Lexer) State() State {
+	if len(l.queue) > 0 {
+		return l.state
+	}
+
+	return l.scanner.State()
+}
+
+// Restore continues lexing from the offset in the saved state. The offset is
+// the end of the token the state was taken after, it may be shifted by an edit
+// of the source the lexer was created with.
+func (l *Lexer) Restore(state State, offset int) {
+	l.queue = nil
+	l.scanner.Restore(state, offset)
+}
+
+// RestoreAfterEdit is Restore after the edit replacing the bytes between start and end
+// of the source the state was taken from, the lines in front of the edit are not counted again
+func (l *Lexer) RestoreAfterEdit(state State, offset, start, end int) {
+	l.queue = nil
+	l.scanner.RestoreAfterEdit(state, offset, start, end)
+}
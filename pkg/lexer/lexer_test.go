@@ -0,0 +1,289 @@
+package lexer_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/lexer"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+func config(t *testing.T, ver string) conf.Config {
+	v, err := version.New(ver)
+	assert.NilError(t, err)
+
+	return conf.Config{Version: v}
+}
+
+type tkn struct {
+	ID    token.ID
+	Value string
+}
+
+func lexAll(l *lexer.Lexer) []tkn {
+	var tkns []tkn
+	for {
+		t := l.Lex()
+		for _, ff := range t.FreeFloating {
+			tkns = append(tkns, tkn{ff.ID, "ff:" + string(ff.Value)})
+		}
+		tkns = append(tkns, tkn{t.ID, string(t.Value)})
+
+		if t.ID == 0 {
+			return tkns
+		}
+	}
+}
+
+func TestLex(t *testing.T) {
+	src := "<?php\n$a = 1; // c\n"
+
+	expected := []tkn{
+		{token.T_OPEN_TAG, "ff:<?php"},
+		{token.T_WHITESPACE, "ff:\n"},
+		{token.T_VARIABLE, "$a"},
+		{token.T_WHITESPACE, "ff: "},
+		{'=', "="},
+		{token.T_WHITESPACE, "ff: "},
+		{token.T_LNUMBER, "1"},
+		{';', ";"},
+		{token.T_WHITESPACE, "ff: "},
+		{token.T_COMMENT, "ff:// c\n"},
+		{0, ""},
+	}
+
+	assert.DeepEqual(t, expected, lexAll(lexer.NewLexer([]byte(src), config(t, "8.3"))))
+}
+
+func TestLexWithFreeFloatingTokens(t *testing.T) {
+	src := "<?php\n$a = 1; // c\n"
+
+	expected := []tkn{
+		{token.T_OPEN_TAG, "<?php"},
+		{token.T_WHITESPACE, "\n"},
+		{token.T_VARIABLE, "$a"},
+		{token.T_WHITESPACE, " "},
+		{'=', "="},
+		{token.T_WHITESPACE, " "},
+		{token.T_LNUMBER, "1"},
+		{';', ";"},
+		{token.T_WHITESPACE, " "},
+		{token.T_COMMENT, "// c\n"},
+		{0, ""},
+	}
+
+	l := lexer.NewLexer([]byte(src), config(t, "8.3")).WithFreeFloatingTokens()
+	assert.DeepEqual(t, expected, lexAll(l))
+}
+
+func TestLexIsLossless(t *testing.T) {
+	tests := []struct {
+		file    string
+		version string
+	}{
+		{"../../internal/php5/test.php", "5.6"},
+		{"../../internal/php7/test.php", "7.4"},
+		{"../../internal/php8/test.php", "8.3"},
+	}
+
+	for _, tt := range tests {
+		src, err := ioutil.ReadFile(tt.file)
+		assert.NilError(t, err)
+
+		buf := bytes.Buffer{}
+		l := lexer.NewLexer(src, config(t, tt.version)).WithFreeFloatingTokens()
+		for tkn := l.Lex(); tkn.ID != 0; tkn = l.Lex() {
+			assert.Assert(t, tkn.FreeFloating == nil)
+			buf.Write(tkn.Value)
+		}
+		assert.Equal(t, string(src), buf.String(), tt.file)
+
+		buf.Reset()
+		for _, tkn := range lexer.TokenGetAll(src, config(t, tt.version)) {
+			buf.WriteString(tkn.Text)
+		}
+		assert.Equal(t, string(src), buf.String(), tt.file)
+	}
+}
+
+const tokenGetAllSrc = "<?php\n// c\n$a = \\Foo\\bar(namespace\\x, A\\B\\C) & $b; ?>\nhtml<?= 1 ?>\n<?php __halt_compiler();raw"
+
+func TestTokenGetAllPhp74(t *testing.T) {
+	expected := []lexer.PHPToken{
+		{Name: "T_OPEN_TAG", Text: "<?php\n", Line: 1},
+		{Name: "T_COMMENT", Text: "// c\n", Line: 2},
+		{Name: "T_VARIABLE", Text: "$a", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Text: "=", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Name: "T_NS_SEPARATOR", Text: "\\", Line: 3},
+		{Name: "T_STRING", Text: "Foo", Line: 3},
+		{Name: "T_NS_SEPARATOR", Text: "\\", Line: 3},
+		{Name: "T_STRING", Text: "bar", Line: 3},
+		{Text: "(", Line: 3},
+		{Name: "T_NAMESPACE", Text: "namespace", Line: 3},
+		{Name: "T_NS_SEPARATOR", Text: "\\", Line: 3},
+		{Name: "T_STRING", Text: "x", Line: 3},
+		{Text: ",", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Name: "T_STRING", Text: "A", Line: 3},
+		{Name: "T_NS_SEPARATOR", Text: "\\", Line: 3},
+		{Name: "T_STRING", Text: "B", Line: 3},
+		{Name: "T_NS_SEPARATOR", Text: "\\", Line: 3},
+		{Name: "T_STRING", Text: "C", Line: 3},
+		{Text: ")", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Text: "&", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Name: "T_VARIABLE", Text: "$b", Line: 3},
+		{Text: ";", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Name: "T_CLOSE_TAG", Text: "?>\n", Line: 3},
+		{Name: "T_INLINE_HTML", Text: "html", Line: 4},
+		{Name: "T_OPEN_TAG_WITH_ECHO", Text: "<?=", Line: 4},
+		{Name: "T_WHITESPACE", Text: " ", Line: 4},
+		{Name: "T_LNUMBER", Text: "1", Line: 4},
+		{Name: "T_WHITESPACE", Text: " ", Line: 4},
+		{Name: "T_CLOSE_TAG", Text: "?>\n", Line: 4},
+		{Name: "T_OPEN_TAG", Text: "<?php ", Line: 5},
+		{Name: "T_HALT_COMPILER", Text: "__halt_compiler", Line: 5},
+		{Text: "(", Line: 5},
+		{Text: ")", Line: 5},
+		{Text: ";", Line: 5},
+		{Name: "T_INLINE_HTML", Text: "raw", Line: 5},
+	}
+
+	assert.DeepEqual(t, expected, lexer.TokenGetAll([]byte(tokenGetAllSrc), config(t, "7.4")))
+}
+
+func TestTokenGetAllPhp83(t *testing.T) {
+	expected := []lexer.PHPToken{
+		{Name: "T_OPEN_TAG", Text: "<?php\n", Line: 1},
+		{Name: "T_COMMENT", Text: "// c", Line: 2},
+		{Name: "T_WHITESPACE", Text: "\n", Line: 2},
+		{Name: "T_VARIABLE", Text: "$a", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Text: "=", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Name: "T_NAME_FULLY_QUALIFIED", Text: "\\Foo\\bar", Line: 3},
+		{Text: "(", Line: 3},
+		{Name: "T_NAME_RELATIVE", Text: "namespace\\x", Line: 3},
+		{Text: ",", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Name: "T_NAME_QUALIFIED", Text: "A\\B\\C", Line: 3},
+		{Text: ")", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Name: "T_AMPERSAND_FOLLOWED_BY_VAR_OR_VARARG", Text: "&", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Name: "T_VARIABLE", Text: "$b", Line: 3},
+		{Text: ";", Line: 3},
+		{Name: "T_WHITESPACE", Text: " ", Line: 3},
+		{Name: "T_CLOSE_TAG", Text: "?>\n", Line: 3},
+		{Name: "T_INLINE_HTML", Text: "html", Line: 4},
+		{Name: "T_OPEN_TAG_WITH_ECHO", Text: "<?=", Line: 4},
+		{Name: "T_WHITESPACE", Text: " ", Line: 4},
+		{Name: "T_LNUMBER", Text: "1", Line: 4},
+		{Name: "T_WHITESPACE", Text: " ", Line: 4},
+		{Name: "T_CLOSE_TAG", Text: "?>\n", Line: 4},
+		{Name: "T_OPEN_TAG", Text: "<?php ", Line: 5},
+		{Name: "T_HALT_COMPILER", Text: "__halt_compiler", Line: 5},
+		{Text: "(", Line: 5},
+		{Text: ")", Line: 5},
+		{Text: ";", Line: 5},
+		{Name: "T_INLINE_HTML", Text: "raw", Line: 5},
+	}
+
+	assert.DeepEqual(t, expected, lexer.TokenGetAll([]byte(tokenGetAllSrc), config(t, "8.3")))
+}
+
+func TestTokenGetAllShebang(t *testing.T) {
+	expected := []lexer.PHPToken{
+		{Name: "T_INLINE_HTML", Text: "#!/usr/bin/env php\n", Line: 1},
+		{Name: "T_OPEN_TAG", Text: "<?php\r\n", Line: 2},
+		{Name: "T_WHITESPACE", Text: "\r\n", Line: 3},
+		{Name: "T_ECHO", Text: "echo", Line: 4},
+		{Text: ";", Line: 4},
+	}
+
+	src := "#!/usr/bin/env php\n<?php\r\n\r\necho;"
+	assert.DeepEqual(t, expected, lexer.TokenGetAll([]byte(src), config(t, "8.3")))
+}
+
+func TestRestore(t *testing.T) {
+	src := "#!/usr/bin/env php\nhtml<?php /* c */ $a = <<<EOT\n  foo $b\n  EOT;\n?>\nhtml<?= 1 ?>"
+
+	for _, standalone := range []bool{false, true} {
+		newLexer := func() *lexer.Lexer {
+			l := lexer.NewLexer([]byte(src), config(t, "8.3"))
+			if standalone {
+				l.WithFreeFloatingTokens()
+			}
+			return l
+		}
+
+		l := newLexer()
+
+		var offsets []int
+		var states []lexer.State
+		var expected []tkn
+
+		offset := 0
+		for {
+			offsets = append(offsets, offset)
+			states = append(states, l.State())
+
+			t := l.Lex()
+			expected = append(expected, tkn{t.ID, string(t.Value)})
+			if t.ID == 0 {
+				break
+			}
+
+			offset = t.Position.EndPos
+		}
+
+		for i := range states {
+			l := newLexer()
+			l.Restore(states[i], offsets[i])
+
+			var actual []tkn
+			for {
+				t := l.Lex()
+				actual = append(actual, tkn{t.ID, string(t.Value)})
+				if t.ID == 0 {
+					break
+				}
+			}
+
+			assert.DeepEqual(t, expected[i:], actual)
+		}
+	}
+}
+
+func TestRestoreAfterEdit(t *testing.T) {
+	l := lexer.NewLexer([]byte("<?php $a = <<<EOT\nfoo\nEOT;\n"), config(t, "8.3"))
+	for l.Lex().ID != token.T_START_HEREDOC {
+	}
+
+	state := l.State()
+	l.Lex()
+	assert.Assert(t, !state.Equal(l.State()))
+
+	l = lexer.NewLexer([]byte("<?php $a = <<<EOT\n$x\nEOT;\n"), config(t, "8.3"))
+	l.Restore(state, len("<?php $a = <<<EOT\n"))
+
+	expected := []tkn{
+		{token.T_VARIABLE, "$x"},
+		{token.T_ENCAPSED_AND_WHITESPACE, "\n"},
+		{token.T_END_HEREDOC, "EOT"},
+		{';', ";"},
+		{token.T_WHITESPACE, "ff:\n"},
+		{0, ""},
+	}
+
+	assert.DeepEqual(t, expected, lexAll(l))
+}
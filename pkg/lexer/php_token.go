@@ -0,0 +1,192 @@
+package lexer
+
+import (
+	"bytes"
+
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+var (
+	php80 = &version.Version{Major: 8}
+	php81 = &version.Version{Major: 8, Minor: 1}
+)
+
+// PHPToken is the token in the token_get_all format. Name is the token name like "T_VARIABLE",
+// it is empty for the single character tokens that token_get_all returns as strings.
+type PHPToken struct {
+	Name string
+	Text string
+	Line int
+}
+
+// TokenGetAll splits the source code into tokens the way token_get_all of the configured
+// PHP version does. Concatenation of the token texts is the source code.
+func TokenGetAll(data []byte, config conf.Config) []PHPToken {
+	since80 := config.Version != nil && config.Version.GreaterOrEqual(php80)
+	since81 := config.Version != nil && config.Version.GreaterOrEqual(php81)
+
+	var tkns []PHPToken
+	halted := false
+
+	lexer := NewLexer(data, config).WithFreeFloatingTokens()
+	for {
+		t := lexer.Lex()
+		if t.ID == 0 {
+			break
+		}
+
+		name := tokenName(t.ID)
+		text := string(t.Value)
+		line := t.Position.StartLine
+
+		switch {
+		case t.ID == token.T_COMMENT && t.Position.StartPos == 0 && bytes.HasPrefix(t.Value, []byte("#!")):
+			name = "T_INLINE_HTML"
+		case t.ID == token.T_HALT_COMPILER && halted:
+			// the data after __halt_compiler();
+			name = "T_INLINE_HTML"
+		case t.ID == token.T_HALT_COMPILER:
+			halted = true
+		case t.ID == token.T_ECHO && text == "<?=":
+			name = "T_OPEN_TAG_WITH_ECHO"
+		case t.ID == token.ID(';') && len(text) > 1:
+			// the scanner returns "?>" and "; ?>" as a semicolon
+			if text[0] == ';' {
+				tkns = append(tkns, PHPToken{Text: ";", Line: line})
+				text = text[1:]
+			}
+			if ws := len(text) - len(bytes.TrimLeft([]byte(text), " \t\r\n")); ws > 0 {
+				tkns = appendWhitespace(tkns, text[:ws], line)
+				line += countLines(text[:ws])
+				text = text[ws:]
+			}
+			name = "T_CLOSE_TAG"
+		case t.ID == token.ID('&') && since81:
+			name = "T_AMPERSAND_FOLLOWED_BY_VAR_OR_VARARG"
+		}
+
+		switch {
+		case name == "T_WHITESPACE":
+			tkns = appendWhitespace(tkns, text, line)
+			continue
+		case name == "T_COMMENT" && since80 && !bytes.HasPrefix(t.Value, []byte("/*")):
+			// since PHP 8.0 single line comments do not include the newline
+			comment := bytes.TrimRight(t.Value, "\r\n")
+			tkns = append(tkns, PHPToken{Name: name, Text: string(comment), Line: line})
+			if len(comment) < len(t.Value) {
+				tkns = appendWhitespace(tkns, text[len(comment):], line)
+			}
+			continue
+		}
+
+		tkns = append(tkns, PHPToken{Name: name, Text: text, Line: line})
+
+		if since80 {
+			tkns = mergeName(tkns)
+		}
+	}
+
+	return tkns
+}
+
+func tokenName(id token.ID) string {
+	if id < token.T_INCLUDE {
+		return ""
+	}
+
+	return id.String()
+}
+
+func countLines(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\n' || s[i] == '\r' && (i+1 == len(s) || s[i+1] != '\n') {
+			n++
+		}
+	}
+
+	return n
+}
+
+// appendWhitespace appends whitespace merging it with the previous whitespace token,
+// a single whitespace character after "<?php" belongs to the open tag
+func appendWhitespace(tkns []PHPToken, text string, line int) []PHPToken {
+	if len(tkns) > 0 {
+		prev := &tkns[len(tkns)-1]
+
+		if prev.Name == "T_WHITESPACE" {
+			prev.Text += text
+			return tkns
+		}
+
+		if prev.Name == "T_OPEN_TAG" && len(prev.Text) == 5 {
+			n := 1
+			if len(text) > 1 && text[0] == '\r' && text[1] == '\n' {
+				n = 2
+			}
+
+			prev.Text += text[:n]
+			line += countLines(text[:n])
+			text = text[n:]
+			if text == "" {
+				return tkns
+			}
+		}
+	}
+
+	return append(tkns, PHPToken{Name: "T_WHITESPACE", Text: text, Line: line})
+}
+
+// mergeName joins the last tokens into T_NAME_QUALIFIED, T_NAME_FULLY_QUALIFIED
+// or T_NAME_RELATIVE the way the PHP 8 lexer does
+func mergeName(tkns []PHPToken) []PHPToken {
+	l := len(tkns)
+	if l < 2 || !isLabel(tkns[l-1].Text) || tkns[l-2].Name != "T_NS_SEPARATOR" {
+		return tkns
+	}
+
+	if l >= 3 && (isName(tkns[l-3].Name) || isLabel(tkns[l-3].Text)) {
+		prev := tkns[l-3]
+		switch {
+		case isName(prev.Name):
+		case prev.Name == "T_NAMESPACE":
+			prev.Name = "T_NAME_RELATIVE"
+		default:
+			prev.Name = "T_NAME_QUALIFIED"
+		}
+		prev.Text += tkns[l-2].Text + tkns[l-1].Text
+
+		return append(tkns[:l-3], prev)
+	}
+
+	return append(tkns[:l-2], PHPToken{
+		Name: "T_NAME_FULLY_QUALIFIED",
+		Text: tkns[l-2].Text + tkns[l-1].Text,
+		Line: tkns[l-2].Line,
+	})
+}
+
+func isName(name string) bool {
+	return name == "T_NAME_QUALIFIED" || name == "T_NAME_FULLY_QUALIFIED" || name == "T_NAME_RELATIVE"
+}
+
+// isLabel reports whether s is an identifier or a keyword
+func isLabel(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80:
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -20,21 +20,18 @@ Package usage example:
 	func main() {
 		src := []byte(`<? echo "Hello world";`)
 
-		// Error handler
-
-		var parserErrors []*errors.Error
-		errorHandler := func(e *errors.Error) {
-			parsmakeerErrors = append(parserErrors, e)
-		}
-
 		// Parse
 
 		rootNode, err := parser.Parse(src, conf.Config{
-			Version:          &version.Version{Major: 5, Minor: 6},
-			ErrorHandlerFunc: errorHandler,
+			Version: &version.Version{Major: 5, Minor: 6},
 		})
 
-		if err != nil {
+		if errList, ok := err.(errors.ErrorList); ok {
+			// syntax errors, the recovered tree is still returned
+			for _, e := range errList {
+				log.Println(e)
+			}
+		} else if err != nil {
 			log.Fatal("Error:" + err.Error())
 		}
 
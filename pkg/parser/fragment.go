@@ -0,0 +1,117 @@
+package parser
+
+import (
+	"github.com/z7zmey/php-parser/internal/scanner"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	phperrors "github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+type fragmentParser interface {
+	Parser
+	ParseFragment(start token.ID) int
+}
+
+// ParseExpr parses the source holding a single expression without the open tag.
+//
+// The positions are relative to the source start, or to the offset if it is not nil.
+// The offset is the start of the fragment in the enclosing source.
+func ParseExpr(src []byte, offset *position.Position, config conf.Config) (ast.Vertex, error) {
+	root, err := parseFragment(src, token.T_FRAGMENT_EXPR, offset, config)
+	if root == nil || len(root.Stmts) == 0 {
+		return nil, err
+	}
+
+	return root.Stmts[0], err
+}
+
+// ParseStmts parses the source holding a list of statements without the open tag,
+// the positions are the same as of ParseExpr
+func ParseStmts(src []byte, offset *position.Position, config conf.Config) ([]ast.Vertex, error) {
+	root, err := parseFragment(src, token.T_FRAGMENT_STMTS, offset, config)
+	if root == nil {
+		return nil, err
+	}
+
+	return root.Stmts, err
+}
+
+// ParseClassMember parses the source holding a single property, constant, method
+// or trait use of a class body, the positions are the same as of ParseExpr
+func ParseClassMember(src []byte, offset *position.Position, config conf.Config) (ast.Vertex, error) {
+	root, err := parseFragment(src, token.T_FRAGMENT_CLASS_MEMBER, offset, config)
+	if root == nil || len(root.Stmts) == 0 {
+		return nil, err
+	}
+
+	return root.Stmts[0], err
+}
+
+// parseFragment lexes the source from the php state and parses it from the start token,
+// the errors are passed to the config handler after they are moved to the offset
+func parseFragment(src []byte, start token.ID, offset *position.Position, config conf.Config) (*ast.Root, error) {
+	if config.Version == nil {
+		config.Version = php7RangeEnd
+	}
+
+	var errorList phperrors.ErrorList
+	errHandlerFunc := config.ErrorHandlerFunc
+	config.ErrorHandlerFunc = func(e *phperrors.Error) {
+		errorList = append(errorList, e)
+	}
+
+	lexer := scanner.NewLexer(src, config)
+	lexer.Restore(scanner.PHPState(), 0)
+
+	parser := newParser(lexer, config)
+	if parser == nil {
+		return nil, ErrVersionOutOfRange
+	}
+
+	parser.(fragmentParser).ParseFragment(start)
+	root := parser.GetRootNode().(*ast.Root)
+
+	if offset != nil {
+		s := newOffsetShifter(offset)
+		s.node(root)
+
+		for _, e := range errorList {
+			s.error(e)
+		}
+	}
+
+	if errHandlerFunc != nil {
+		for _, e := range errorList {
+			errHandlerFunc(e)
+		}
+	}
+
+	return root, errorList.Errors().Err()
+}
+
+// newOffsetShifter returns the shifter moving the positions of the whole tree
+// from the source start to the offset
+func newOffsetShifter(offset *position.Position) *shifter {
+	return &shifter{
+		oldStart:  -1,
+		endLine:   1,
+		dPos:      offset.StartPos,
+		dLine:     offset.StartLine - 1,
+		dCol:      offset.StartCol - 1,
+		dColUTF16: offset.StartColUTF16 - 1,
+		seen:      map[*position.Position]bool{},
+	}
+}
+
+func (s *shifter) error(e *phperrors.Error) {
+	s.position(e.Pos)
+
+	if e.Line == 0 {
+		return
+	}
+
+	pos, colUTF16 := 0, 0
+	s.shift(&pos, &e.Line, &e.Column, &colUTF16)
+}
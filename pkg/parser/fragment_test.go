@@ -0,0 +1,90 @@
+package parser_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/position"
+)
+
+func TestParseExpr(t *testing.T) {
+	for _, v := range []string{"5.6", "7.4", "8.3"} {
+		n, err := parser.ParseExpr([]byte("$a->b($c)"), nil, config(t, v))
+		assert.NilError(t, err)
+
+		call, ok := n.(*ast.ExprMethodCall)
+		assert.Assert(t, ok, v)
+		assert.DeepEqual(t, &position.Position{
+			StartLine: 1, EndLine: 1, StartPos: 0, EndPos: 9,
+			StartCol: 1, EndCol: 10, StartColUTF16: 1, EndColUTF16: 10,
+		}, call.Position)
+	}
+}
+
+func TestParseStmts(t *testing.T) {
+	for _, v := range []string{"5.6", "7.4", "8.3"} {
+		stmts, err := parser.ParseStmts([]byte("$a = 1;\nfunction f() {}\n"), nil, config(t, v))
+		assert.NilError(t, err)
+
+		assert.Equal(t, 2, len(stmts))
+		assert.Equal(t, 2, stmts[1].(*ast.StmtFunction).Position.StartLine)
+	}
+}
+
+func TestParseClassMember(t *testing.T) {
+	for _, v := range []string{"5.6", "7.4", "8.3"} {
+		n, err := parser.ParseClassMember([]byte("public function x() {}"), nil, config(t, v))
+		assert.NilError(t, err)
+
+		method, ok := n.(*ast.StmtClassMethod)
+		assert.Assert(t, ok, v)
+		assert.Equal(t, "x", string(method.Name.(*ast.Identifier).Value))
+		assert.Equal(t, 0, method.Position.StartPos)
+
+		// the method body ends the fragment, the eof token is read after the reduction
+		body := method.Stmt.(*ast.StmtStmtList)
+		assert.Equal(t, "}", string(body.CloseCurlyBracketTkn.Value), v)
+	}
+}
+
+func TestParseFragmentOffset(t *testing.T) {
+	offset := &position.Position{StartLine: 3, StartPos: 20, StartCol: 5, StartColUTF16: 4}
+
+	stmts, err := parser.ParseStmts([]byte("$a = 1;\n$b;"), offset, config(t, "8.3"))
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, &position.Position{
+		StartLine: 3, EndLine: 3, StartPos: 20, EndPos: 27,
+		StartCol: 5, EndCol: 12, StartColUTF16: 4, EndColUTF16: 11,
+	}, stmts[0].GetPosition())
+
+	assert.DeepEqual(t, &position.Position{
+		StartLine: 4, EndLine: 4, StartPos: 28, EndPos: 31,
+		StartCol: 1, EndCol: 4, StartColUTF16: 1, EndColUTF16: 4,
+	}, stmts[1].GetPosition())
+}
+
+func TestParseFragmentErrors(t *testing.T) {
+	var handled []*errors.Error
+	config := conf.Config{
+		Version: config(t, "8.3").Version,
+		ErrorHandlerFunc: func(e *errors.Error) {
+			handled = append(handled, e)
+		},
+	}
+
+	offset := &position.Position{StartLine: 2, StartPos: 10, StartCol: 3, StartColUTF16: 3}
+
+	_, err := parser.ParseExpr([]byte("$a + ;"), offset, config)
+	assert.Error(t, err, "syntax error: unexpected ';' at line 2, column 8")
+	assert.Equal(t, 1, len(handled))
+	assert.Equal(t, 15, handled[0].Pos.StartPos)
+
+	_, err = parser.ParseExpr([]byte("$a;"), nil, config)
+	assert.Error(t, err, "syntax error: unexpected ';' at line 1, column 3")
+}
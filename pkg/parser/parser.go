@@ -5,9 +5,12 @@ import (
 
 	"github.com/z7zmey/php-parser/internal/php5"
 	"github.com/z7zmey/php-parser/internal/php7"
+	"github.com/z7zmey/php-parser/internal/php8"
 	"github.com/z7zmey/php-parser/internal/scanner"
 	"github.com/z7zmey/php-parser/pkg/ast"
 	"github.com/z7zmey/php-parser/pkg/conf"
+	phperrors "github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/token"
 	"github.com/z7zmey/php-parser/pkg/version"
 )
 
@@ -20,6 +23,9 @@ var (
 
 	php7RangeStart = &version.Version{Major: 7}
 	php7RangeEnd   = &version.Version{Major: 7, Minor: 4}
+
+	php8RangeStart = &version.Version{Major: 8}
+	php8RangeEnd   = &version.Version{Major: 8, Minor: 3}
 )
 
 // Parser interface
@@ -28,26 +34,58 @@ type Parser interface {
 	GetRootNode() ast.Vertex
 }
 
+// Parse parses the source and returns the root node.
+// If the source has errors, the returned error is errors.ErrorList
+// holding every reported error, the partially recovered tree is returned anyway.
+// The warnings do not fail the parsing, ParseWithWarnings returns them.
 func Parse(src []byte, config conf.Config) (ast.Vertex, error) {
-	var parser Parser
+	root, _, err := ParseWithWarnings(src, config)
+
+	return root, err
+}
 
+// ParseWithWarnings is Parse that also returns the warnings like the unexpected characters
+// skipped by the lexer, the warnings are not included in the returned error
+func ParseWithWarnings(src []byte, config conf.Config) (ast.Vertex, phperrors.ErrorList, error) {
 	if config.Version == nil {
 		config.Version = php7RangeEnd
 	}
 
-	if config.Version.InRange(php5RangeStart, php5RangeEnd) {
-		lexer := scanner.NewLexer(src, config)
-		parser = php5.NewParser(lexer, config)
-		parser.Parse()
-		return parser.GetRootNode(), nil
+	var errorList phperrors.ErrorList
+	errHandlerFunc := config.ErrorHandlerFunc
+	config.ErrorHandlerFunc = func(e *phperrors.Error) {
+		errorList = append(errorList, e)
+
+		if errHandlerFunc != nil {
+			errHandlerFunc(e)
+		}
+	}
+
+	parser := newParser(scanner.NewLexer(src, config), config)
+	if parser == nil {
+		return nil, nil, ErrVersionOutOfRange
 	}
 
-	if config.Version.InRange(php7RangeStart, php7RangeEnd) {
-		lexer := scanner.NewLexer(src, config)
-		parser = php7.NewParser(lexer, config)
-		parser.Parse()
-		return parser.GetRootNode(), nil
+	parser.Parse()
+
+	return parser.GetRootNode(), errorList.Warnings(), errorList.Errors().Err()
+}
+
+type lexer interface {
+	Lex() *token.Token
+	TokenLocation(t *token.Token) (int, int)
+}
+
+// newParser returns the parser of the config version or nil if the version is not supported
+func newParser(lexer lexer, config conf.Config) Parser {
+	switch {
+	case config.Version.InRange(php5RangeStart, php5RangeEnd):
+		return php5.NewParser(lexer, config)
+	case config.Version.InRange(php7RangeStart, php7RangeEnd):
+		return php7.NewParser(lexer, config)
+	case config.Version.InRange(php8RangeStart, php8RangeEnd):
+		return php8.NewParser(lexer, config)
 	}
 
-	return nil, ErrVersionOutOfRange
+	return nil
 }
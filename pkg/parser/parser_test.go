@@ -0,0 +1,175 @@
+package parser_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+func TestParse(t *testing.T) {
+	for _, v := range []string{"5.6", "7.4", "8.3"} {
+		ver, err := version.New(v)
+		assert.NilError(t, err)
+
+		rootNode, err := parser.Parse([]byte("<?php echo 1;"), conf.Config{Version: ver})
+
+		assert.NilError(t, err)
+		assert.Equal(t, 1, len(rootNode.(*ast.Root).Stmts))
+	}
+}
+
+func TestParseErrorList(t *testing.T) {
+	var handled []*errors.Error
+
+	for _, v := range []string{"5.6", "7.4", "8.3"} {
+		ver, err := version.New(v)
+		assert.NilError(t, err)
+
+		rootNode, err := parser.Parse([]byte("<?php $a = ; echo 1;"), conf.Config{
+			Version: ver,
+			ErrorHandlerFunc: func(e *errors.Error) {
+				handled = append(handled, e)
+			},
+		})
+
+		errorList, ok := err.(errors.ErrorList)
+		assert.Assert(t, ok)
+		assert.Equal(t, 1, len(errorList))
+		assert.Equal(t, "syntax error: unexpected ';' at line 1, column 12", errorList.Error())
+		assert.Equal(t, errorList[0], handled[len(handled)-1])
+		assert.Equal(t, 3, len(rootNode.(*ast.Root).Stmts))
+	}
+}
+
+func TestParseWarnings(t *testing.T) {
+	src := []byte("<?php echo 1; \x01 echo 2;")
+
+	rootNode, err := parser.Parse(src, conf.Config{Version: &version.Version{Major: 8}})
+
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(rootNode.(*ast.Root).Stmts))
+
+	_, warnings, err := parser.ParseWithWarnings(src, conf.Config{Version: &version.Version{Major: 8}})
+
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(warnings))
+	assert.Equal(t, errors.SeverityWarning, warnings[0].Severity)
+}
+
+func TestParseVersionOutOfRange(t *testing.T) {
+	_, err := parser.Parse([]byte("<?php"), conf.Config{Version: &version.Version{Major: 4}})
+
+	assert.Equal(t, parser.ErrVersionOutOfRange, err)
+}
+
+func TestParseFeatureRequiresVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		src      string
+		expected []string
+	}{
+		{
+			"7.0",
+			"<?php $a ??= 1_000; $f = fn() => 1; class A { public int $a; }",
+			[]string{
+				"numeric literal separator requires PHP 7.4 at line 1, column 14",
+				"null coalescing assignment operator requires PHP 7.4 at line 1, column 10",
+				"arrow function requires PHP 7.4 at line 1, column 26",
+				"typed property requires PHP 7.4 at line 1, column 54",
+			},
+		},
+		{
+			"7.0",
+			"<?php [$a, [$b]] = $c; list('k' => $d) = $e; foo($a,);",
+			[]string{
+				"short list syntax requires PHP 7.1 at line 1, column 7",
+				"short list syntax requires PHP 7.1 at line 1, column 12",
+				"list with keys requires PHP 7.1 at line 1, column 29",
+				"trailing comma in call requires PHP 7.3 at line 1, column 52",
+			},
+		},
+		{
+			"5.3",
+			"<?php $a = [1, 2 ** 3]; $b = 0b1;",
+			[]string{
+				"exponentiation operator requires PHP 5.6 at line 1, column 18",
+				"short array syntax requires PHP 5.4 at line 1, column 12",
+				"binary number literal requires PHP 5.4 at line 1, column 30",
+			},
+		},
+		{
+			"8.1",
+			"<?php readonly class A { const int B = 1; } A::{$b}; function f(null $a): int|true {}",
+			[]string{
+				"readonly class requires PHP 8.2 at line 1, column 7",
+				"typed class constant requires PHP 8.3 at line 1, column 32",
+				"dynamic class constant fetch requires PHP 8.3 at line 1, column 48",
+				"standalone null, false and true type requires PHP 8.2 at line 1, column 65",
+				"standalone null, false and true type requires PHP 8.2 at line 1, column 79",
+			},
+		},
+		{
+			"8.0",
+			"<?php strlen(...); function f(): int|false {}",
+			[]string{
+				"first-class callable syntax requires PHP 8.1 at line 1, column 14",
+			},
+		},
+		{
+			"8.0",
+			"<?php class A { private Foo $f = new Foo; } function f($a = new A) { static $s = new S(1); } const C = new C;",
+			[]string{
+				"new in initializer requires PHP 8.1 at line 1, column 34",
+				"new in initializer requires PHP 8.1 at line 1, column 61",
+				"new in initializer requires PHP 8.1 at line 1, column 82",
+				"new in initializer requires PHP 8.1 at line 1, column 104",
+			},
+		},
+		{
+			"5.2",
+			"<?php namespace A; $f = function() { yield 1; };",
+			[]string{
+				"namespace requires PHP 5.3 at line 1, column 7",
+				"yield requires PHP 5.5 at line 1, column 38",
+				"closure requires PHP 5.3 at line 1, column 25",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		var actual []string
+		_, _ = parser.Parse([]byte(tt.src), conf.Config{
+			Version: config(t, tt.version).Version,
+			ErrorHandlerFunc: func(e *errors.Error) {
+				actual = append(actual, e.String())
+			},
+		})
+
+		assert.DeepEqual(t, tt.expected, actual)
+	}
+}
+
+func TestParseFeatureSupported(t *testing.T) {
+	tests := []struct {
+		version string
+		src     string
+	}{
+		{"7.4", "<?php $a ??= 1_000; $f = fn() => 1; class A { public int $a; }"},
+		{"7.3", "<?php [$a, [$b]] = $c; list('k' => $d) = $e; foo($a,);"},
+		{"5.6", "<?php $a = [1, 2 ** 3]; $b = 0b1;"},
+		{"5.5", "<?php namespace A; $f = function() { yield 1; };"},
+		{"8.3", "<?php readonly class A { const int B = 1; } A::{$b}; function f(null $a): int|true {} strlen(...);"},
+		{"8.1", "<?php function f($a = new A) { static $s = new S(1); } const C = new C;"},
+	}
+
+	for _, tt := range tests {
+		_, err := parser.Parse([]byte(tt.src), config(t, tt.version))
+		assert.NilError(t, err, tt.src)
+	}
+}
@@ -0,0 +1,444 @@
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"sort"
+
+	"github.com/z7zmey/php-parser/internal/scanner"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	phperrors "github.com/z7zmey/php-parser/pkg/errors"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+var (
+	// ErrInvalidEdit is returned if the edits are out of the source or overlap
+	ErrInvalidEdit = errors.New("the edits are out of the source range or overlap")
+
+	// ErrReparseMismatch is returned by ReparseAndVerify if the reparsed tree differs from the full parse
+	ErrReparseMismatch = errors.New("the reparsed tree differs from the full parse")
+)
+
+// Edit replaces the Start:End byte range of the source with the Text
+type Edit struct {
+	Start int
+	End   int
+	Text  []byte
+}
+
+// Reparse applies the edits to the source and returns the tree of the edited source
+// along with the source. The edit offsets refer to the source before any of the edits.
+//
+// The root must be the tree of the source parsed without errors, it is updated in place:
+// the smallest statement or class member enclosing the edits is reparsed and the rest
+// of the nodes are kept with their positions shifted. The source is parsed fully
+// if the edits change the code outside of any statement.
+func Reparse(root ast.Vertex, src []byte, edits []Edit, config conf.Config) (ast.Vertex, []byte, error) {
+	if config.Version == nil {
+		config.Version = php7RangeEnd
+	}
+
+	newSrc, lo, hi, err := applyEdits(src, edits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if r, ok := root.(*ast.Root); ok && len(edits) > 0 {
+		cc := findCandidates(r, lo, hi, nil)
+		for i := len(cc) - 1; i >= 0; i-- {
+			if reparseCandidate(r, cc[i], src, newSrc, config) {
+				return root, newSrc, nil
+			}
+		}
+	}
+
+	tree, err := Parse(newSrc, config)
+
+	return tree, newSrc, err
+}
+
+// ReparseAndVerify calls Reparse and compares the result with the full parse of the edited source.
+// It returns ErrReparseMismatch if the trees differ.
+func ReparseAndVerify(root ast.Vertex, src []byte, edits []Edit, config conf.Config) (ast.Vertex, []byte, error) {
+	tree, newSrc, err := Reparse(root, src, edits, config)
+	if err != nil {
+		return tree, newSrc, err
+	}
+
+	full, err := Parse(newSrc, config)
+	if err != nil {
+		return tree, newSrc, err
+	}
+
+	if !ast.Equal(tree, full, ast.EqualOptions{}) {
+		return tree, newSrc, ErrReparseMismatch
+	}
+
+	return tree, newSrc, nil
+}
+
+// applyEdits returns the edited source and the lo:hi range of the source changed by the edits
+func applyEdits(src []byte, edits []Edit) ([]byte, int, int, error) {
+	sorted := make([]Edit, len(edits))
+	copy(sorted, edits)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Start < sorted[j].Start
+	})
+
+	var buf bytes.Buffer
+	lo, hi, p := len(src), 0, 0
+
+	for _, e := range sorted {
+		if e.Start < p || e.End < e.Start || e.End > len(src) {
+			return nil, 0, 0, ErrInvalidEdit
+		}
+
+		buf.Write(src[p:e.Start])
+		buf.Write(e.Text)
+		p = e.End
+
+		if e.Start < lo {
+			lo = e.Start
+		}
+		if e.End > hi {
+			hi = e.End
+		}
+	}
+	buf.Write(src[p:])
+
+	return buf.Bytes(), lo, hi, nil
+}
+
+// candidate is the statement or class member that can be reparsed
+type candidate struct {
+	owner  ast.Vertex
+	list   []ast.Vertex
+	index  int
+	member bool
+	start  int
+}
+
+// findCandidates returns the candidates enclosing the lo:hi range, the outermost first.
+// The range must be strictly inside of the candidate including its free floating tokens,
+// so the tokens around the candidate stay the same.
+func findCandidates(n ast.Vertex, lo, hi int, cc []candidate) []candidate {
+	for _, c := range ast.Children(n) {
+		pos := c.Node.GetPosition()
+		if pos == nil || pos.EndPos <= hi {
+			continue
+		}
+
+		if c.Field == "Stmts" {
+			if start, ok := fragmentStart(c.Node); ok && start < lo {
+				cc = append(cc, candidate{
+					owner:  n,
+					list:   stmts(n),
+					index:  c.Index,
+					member: isClassLike(n),
+					start:  start,
+				})
+			}
+		}
+
+		if pos.StartPos < lo {
+			return findCandidates(c.Node, lo, hi, cc)
+		}
+	}
+
+	return cc
+}
+
+func stmts(n ast.Vertex) []ast.Vertex {
+	return reflect.ValueOf(n).Elem().FieldByName("Stmts").Interface().([]ast.Vertex)
+}
+
+func isClassLike(n ast.Vertex) bool {
+	switch n.(type) {
+	case *ast.StmtClass, *ast.StmtInterface, *ast.StmtTrait, *ast.StmtEnum:
+		return true
+	}
+
+	return false
+}
+
+// fragmentStart returns the start of the first free floating token of the node,
+// the node must start in the php code
+func fragmentStart(n ast.Vertex) (int, bool) {
+	tkn := ast.FirstToken(n)
+	if tkn == nil || tkn.ID == token.T_INLINE_HTML || bytes.HasPrefix(tkn.Value, []byte("<?")) {
+		return 0, false
+	}
+
+	for _, ff := range tkn.FreeFloating {
+		if ff.ID == token.T_OPEN_TAG {
+			return 0, false
+		}
+	}
+
+	if len(tkn.FreeFloating) > 0 {
+		return tkn.FreeFloating[0].Position.StartPos, true
+	}
+
+	return tkn.Position.StartPos, true
+}
+
+// reparseCandidate parses the edited candidate and puts it into the tree
+func reparseCandidate(root *ast.Root, c candidate, src, newSrc []byte, config conf.Config) bool {
+	old := c.list[c.index]
+	oldPos := old.GetPosition()
+	end := oldPos.EndPos + len(newSrc) - len(src)
+
+	errorsCount := 0
+	config.ErrorHandlerFunc = func(_ *phperrors.Error) {
+		errorsCount++
+	}
+
+	oldState, ok := lexFragment(src, c.start, oldPos.EndPos, config)
+	if !ok {
+		return false
+	}
+
+	l := newFragmentLexer(newSrc, c.start, end, config)
+	if c.member {
+		l.prefix = []*token.Token{
+			syntheticToken(token.T_CLASS, "class"),
+			syntheticToken(token.T_STRING, "X"),
+			syntheticToken('{', "{"),
+		}
+		l.suffix = []*token.Token{
+			syntheticToken('}', "}"),
+		}
+	}
+
+	parser := newParser(l, config)
+	if parser == nil {
+		return false
+	}
+
+	parser.Parse()
+
+	if errorsCount > 0 || !l.done || !l.lexer.State().Equal(oldState) {
+		return false
+	}
+
+	n := fragmentNode(parser.GetRootNode(), c)
+	if n == nil {
+		return false
+	}
+
+	newShifter(old, oldPos, n.GetPosition()).node(root)
+	c.list[c.index] = n
+
+	return true
+}
+
+// fragmentNode returns the single statement or class member of the fragment tree
+func fragmentNode(tree ast.Vertex, c candidate) ast.Vertex {
+	root := tree.(*ast.Root)
+	if len(root.Stmts) != 1 {
+		return nil
+	}
+
+	n := root.Stmts[0]
+
+	if c.member {
+		class, ok := n.(*ast.StmtClass)
+		if !ok || len(class.Stmts) != 1 {
+			return nil
+		}
+
+		return class.Stmts[0]
+	}
+
+	switch c.owner.(type) {
+	case *ast.Root, *ast.StmtNamespace:
+		return n
+	}
+
+	// top statements are not allowed in the nested statement lists
+	switch n.(type) {
+	case *ast.StmtNamespace, *ast.StmtUseList, *ast.StmtGroupUseList, *ast.StmtConstList, *ast.StmtHaltCompiler:
+		return nil
+	}
+
+	return n
+}
+
+// lexFragment lexes the start:end range of the source and returns the lexer state after it
+func lexFragment(src []byte, start, end int, config conf.Config) (scanner.State, bool) {
+	l := newFragmentLexer(src, start, end, config)
+	for l.Lex().ID != 0 {
+	}
+
+	return l.lexer.State(), l.done
+}
+
+// fragmentLexer returns tokens of the start:end source range lexed from the php state
+// surrounded by the prefix and suffix tokens
+type fragmentLexer struct {
+	lexer  *scanner.Lexer
+	end    int
+	done   bool
+	failed bool
+	prefix []*token.Token
+	suffix []*token.Token
+}
+
+func newFragmentLexer(src []byte, start, end int, config conf.Config) *fragmentLexer {
+	l := &fragmentLexer{
+		lexer: scanner.NewLexer(src, config),
+		end:   end,
+	}
+	l.lexer.Restore(scanner.PHPState(), start)
+
+	return l
+}
+
+func (l *fragmentLexer) Lex() *token.Token {
+	if len(l.prefix) > 0 {
+		tkn := l.prefix[0]
+		l.prefix = l.prefix[1:]
+		return tkn
+	}
+
+	if !l.done && !l.failed {
+		tkn := l.lexer.Lex()
+
+		switch {
+		case tkn.ID == 0 || tkn.Position.EndPos > l.end:
+			l.failed = true
+		case tkn.Position.EndPos == l.end:
+			l.done = true
+			return tkn
+		default:
+			return tkn
+		}
+	}
+
+	if len(l.suffix) > 0 {
+		tkn := l.suffix[0]
+		l.suffix = l.suffix[1:]
+		return tkn
+	}
+
+	return syntheticToken(0, "")
+}
+
+func (l *fragmentLexer) TokenLocation(t *token.Token) (int, int) {
+	return l.lexer.TokenLocation(t)
+}
+
+func syntheticToken(id token.ID, value string) *token.Token {
+	return &token.Token{
+		ID:       id,
+		Value:    []byte(value),
+		Position: &position.Position{},
+	}
+}
+
+// shifter moves the positions after the reparsed node by the size of the edits
+type shifter struct {
+	skip     ast.Vertex
+	oldStart int
+	oldEnd   int
+	endLine  int
+	newStart *position.Position
+
+	dPos, dLine, dCol, dColUTF16 int
+
+	seen map[*position.Position]bool
+}
+
+func newShifter(old ast.Vertex, oldPos, newPos *position.Position) *shifter {
+	return &shifter{
+		skip:      old,
+		oldStart:  oldPos.StartPos,
+		oldEnd:    oldPos.EndPos,
+		endLine:   oldPos.EndLine,
+		newStart:  newPos,
+		dPos:      newPos.EndPos - oldPos.EndPos,
+		dLine:     newPos.EndLine - oldPos.EndLine,
+		dCol:      newPos.EndCol - oldPos.EndCol,
+		dColUTF16: newPos.EndColUTF16 - oldPos.EndColUTF16,
+		seen:      map[*position.Position]bool{},
+	}
+}
+
+// node shifts positions of the subtree, the nodes before the reparsed one are skipped
+func (s *shifter) node(n ast.Vertex) {
+	if n == nil || n == s.skip {
+		return
+	}
+
+	// the end of some alternative syntax nodes is unknown and set to -1
+	if pos := n.GetPosition(); pos != nil && pos.EndPos >= 0 && pos.EndPos < s.oldStart {
+		return
+	}
+
+	v := reflect.ValueOf(n).Elem()
+
+	for i, f := range ast.Fields(n) {
+		switch f.Kind {
+		case ast.FieldPosition:
+			s.position(v.Field(i).Interface().(*position.Position))
+		case ast.FieldToken:
+			s.token(v.Field(i).Interface().(*token.Token))
+		case ast.FieldTokenList:
+			for _, t := range v.Field(i).Interface().([]*token.Token) {
+				s.token(t)
+			}
+		case ast.FieldNode:
+			c, _ := v.Field(i).Interface().(ast.Vertex)
+			s.node(c)
+		case ast.FieldNodeList:
+			for _, c := range v.Field(i).Interface().([]ast.Vertex) {
+				s.node(c)
+			}
+		}
+	}
+}
+
+func (s *shifter) token(t *token.Token) {
+	if t == nil {
+		return
+	}
+
+	s.position(t.Position)
+	for _, ff := range t.FreeFloating {
+		s.position(ff.Position)
+	}
+}
+
+func (s *shifter) position(p *position.Position) {
+	if p == nil || s.seen[p] {
+		return
+	}
+	s.seen[p] = true
+
+	switch {
+	case p.StartPos >= s.oldEnd:
+		s.shift(&p.StartPos, &p.StartLine, &p.StartCol, &p.StartColUTF16)
+	case p.StartPos == s.oldStart:
+		// the parent node starts with the reparsed one
+		p.StartPos, p.StartLine = s.newStart.StartPos, s.newStart.StartLine
+		p.StartCol, p.StartColUTF16 = s.newStart.StartCol, s.newStart.StartColUTF16
+	}
+
+	if p.EndPos >= s.oldEnd {
+		s.shift(&p.EndPos, &p.EndLine, &p.EndCol, &p.EndColUTF16)
+	}
+}
+
+func (s *shifter) shift(pos, line, col, colUTF16 *int) {
+	*pos += s.dPos
+
+	if *line == s.endLine {
+		*col += s.dCol
+		*colUTF16 += s.dColUTF16
+	}
+
+	*line += s.dLine
+}
@@ -0,0 +1,153 @@
+package parser_test
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+func config(t *testing.T, ver string) conf.Config {
+	v, err := version.New(ver)
+	assert.NilError(t, err)
+
+	return conf.Config{Version: v}
+}
+
+func edit(src, old, text string) parser.Edit {
+	for i := 0; i+len(old) <= len(src); i++ {
+		if src[i:i+len(old)] == old {
+			return parser.Edit{Start: i, End: i + len(old), Text: []byte(text)}
+		}
+	}
+
+	panic("not found: " + old)
+}
+
+func TestReparseStatement(t *testing.T) {
+	src := "<?php\nfunction f() {\n\t$a = 1;\n\t$b = 2;\n}\n$c = 3;\n"
+	config := config(t, "8.3")
+
+	root, err := parser.Parse([]byte(src), config)
+	assert.NilError(t, err)
+
+	fn := root.(*ast.Root).Stmts[0].(*ast.StmtFunction)
+	a, b, c := fn.Stmts[0], fn.Stmts[1], root.(*ast.Root).Stmts[1]
+
+	tree, newSrc, err := parser.ReparseAndVerify(root, []byte(src), []parser.Edit{edit(src, "1", "foo(\n)")}, config)
+	assert.NilError(t, err)
+
+	assert.Equal(t, "<?php\nfunction f() {\n\t$a = foo(\n);\n\t$b = 2;\n}\n$c = 3;\n", string(newSrc))
+	assert.Assert(t, tree == root)
+	assert.Assert(t, fn.Stmts[0] != a, "the statement is reparsed")
+	assert.Assert(t, fn.Stmts[1] == b, "the statement is reused")
+	assert.Assert(t, root.(*ast.Root).Stmts[1] == c, "the statement is reused")
+	assert.Equal(t, 5, b.GetPosition().StartLine)
+	assert.Equal(t, 7, c.GetPosition().StartLine)
+}
+
+func TestReparseClassMember(t *testing.T) {
+	src := "<?php\nclass Foo {\n\tpublic $a = 1;\n\tfunction f() {}\n}\n"
+	config := config(t, "8.3")
+
+	root, err := parser.Parse([]byte(src), config)
+	assert.NilError(t, err)
+
+	class := root.(*ast.Root).Stmts[0].(*ast.StmtClass)
+	prop, method := class.Stmts[0], class.Stmts[1]
+
+	edits := []parser.Edit{
+		edit(src, "public", "protected"),
+		edit(src, "1", "[1, 2]"),
+	}
+	_, newSrc, err := parser.ReparseAndVerify(root, []byte(src), edits, config)
+	assert.NilError(t, err)
+
+	assert.Equal(t, "<?php\nclass Foo {\n\tprotected $a = [1, 2];\n\tfunction f() {}\n}\n", string(newSrc))
+	assert.Assert(t, class.Stmts[0] != prop, "the member is reparsed")
+	assert.Assert(t, class.Stmts[1] == method, "the member is reused")
+	assert.Equal(t, 2, method.GetPosition().StartCol)
+}
+
+func TestReparseFullParse(t *testing.T) {
+	tests := []struct {
+		src  string
+		edit parser.Edit
+	}{
+		{"<?php $a = 1; $b = 2;", edit("<?php $a = 1; $b = 2;", " $b", "/* $b")},
+		{"<?php $a = 1; $b = 2;", edit("<?php $a = 1; $b = 2;", "1", "1; $c")},
+		{"<?php $a = 1; $b = 2;", edit("<?php $a = 1; $b = 2;", "1", "'1")},
+		{"<?php $a = 1; $b = 2;", edit("<?php $a = 1; $b = 2;", "1", "1 ?>")},
+		{"<?php function f() { $a = 1; }", edit("<?php function f() { $a = 1; }", "$a = 1", "use A")},
+		{"<?php $a = 1; $b = 2;", edit("<?php $a = 1; $b = 2;", " $b", " $c = 3; $b")},
+	}
+
+	for _, tt := range tests {
+		root, err := parser.Parse([]byte(tt.src), config(t, "8.3"))
+		assert.NilError(t, err)
+
+		tree, newSrc, _ := parser.Reparse(root, []byte(tt.src), []parser.Edit{tt.edit}, config(t, "8.3"))
+		full, _ := parser.Parse(newSrc, config(t, "8.3"))
+
+		assert.Assert(t, ast.Equal(tree, full, ast.EqualOptions{}), string(newSrc))
+	}
+}
+
+func TestReparseInvalidEdit(t *testing.T) {
+	src := []byte("<?php $a;")
+
+	for _, edits := range [][]parser.Edit{
+		{{Start: 3, End: 2}},
+		{{Start: 0, End: 10}},
+		{{Start: 1, End: 3}, {Start: 2, End: 4}},
+	} {
+		_, _, err := parser.Reparse(nil, src, edits, config(t, "8.3"))
+		assert.Equal(t, parser.ErrInvalidEdit, err)
+	}
+}
+
+var replacements = []string{"", " ", "\n", "1", "a", "$x", "(", ";", "}", "/*", "\"", "'", "?>", "<<<A\n"}
+
+func TestReparseAndVerifyRandomEdits(t *testing.T) {
+	tests := []struct {
+		file    string
+		version string
+	}{
+		{"../../internal/php5/test.php", "5.6"},
+		{"../../internal/php7/test.php", "7.4"},
+		{"../../internal/php8/test.php", "8.3"},
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, tt := range tests {
+		src, err := ioutil.ReadFile(tt.file)
+		assert.NilError(t, err)
+
+		for i := 0; i < 300; i++ {
+			root, err := parser.Parse(src, config(t, tt.version))
+			assert.NilError(t, err)
+
+			start := rnd.Intn(len(src))
+			e := parser.Edit{
+				Start: start,
+				End:   start + rnd.Intn(3),
+				Text:  []byte(replacements[rnd.Intn(len(replacements))]),
+			}
+			if e.End > len(src) {
+				e.End = len(src)
+			}
+
+			_, newSrc, err := parser.ReparseAndVerify(root, src, []parser.Edit{e}, config(t, tt.version))
+			if err == parser.ErrReparseMismatch {
+				t.Errorf("%s: %+v %q", tt.file, e, newSrc)
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package position
+
+import "unicode/utf8"
+
+// Lines is the table of the line starts of the source,
+// it converts the offsets to the lines and the columns
+type Lines struct {
+	data []int
+
+	// last computed UTF-16 column, positions are mostly requested in increasing order
+	utf16Pos int
+	utf16Col int
+}
+
+// NewLines returns the lines of the source, "\n", "\r\n" and "\r" break the lines
+func NewLines(src []byte) *Lines {
+	l := &Lines{}
+
+	for i := 0; i < len(src); i++ {
+		switch {
+		case src[i] == '\n':
+			l.Append(i + 1)
+		case src[i] == '\r' && (i+1 == len(src) || src[i+1] != '\n'):
+			l.Append(i + 1)
+		}
+	}
+
+	return l
+}
+
+// Append adds the start of the next line
+func (l *Lines) Append(p int) {
+	if len(l.data) == 0 || l.data[len(l.data)-1] < p {
+		l.data = append(l.data, p)
+	}
+}
+
+// Cut returns the lines starting up to the offset p, the table is shared
+// and copied on the next Append
+func (l *Lines) Cut(p int) *Lines {
+	n := len(l.data)
+	for n > 0 && l.data[n-1] > p {
+		n--
+	}
+
+	return &Lines{data: l.data[:n:n]}
+}
+
+// Edit returns the lines of the new source src after the bytes between start and end
+// of the old source are replaced with delta more bytes. The line starts in front of
+// the edit are kept, the replaced text is scanned again and the line starts after it
+// are moved by delta.
+func (l *Lines) Edit(src []byte, start, end, delta int) *Lines {
+	if start == end && delta == 0 {
+		return &Lines{data: l.data[:len(l.data):len(l.data)]}
+	}
+
+	e := &Lines{}
+	for _, p := range l.data {
+		if p < start {
+			e.data = append(e.data, p)
+		}
+	}
+
+	// the line break in front of the edit may be joined with the inserted one
+	from := start - 1
+	if from < 0 {
+		from = 0
+	}
+
+	for i := from; i < end+delta && i < len(src); i++ {
+		switch {
+		case src[i] == '\n':
+			e.Append(i + 1)
+		case src[i] == '\r' && (i+1 == len(src) || src[i+1] != '\n'):
+			e.Append(i + 1)
+		}
+	}
+
+	for _, p := range l.data {
+		if p > end {
+			e.Append(p + delta)
+		}
+	}
+
+	return e
+}
+
+// GetLine returns 1-based line of the offset p
+func (l *Lines) GetLine(p int) int {
+	line := len(l.data) + 1
+
+	for i := len(l.data) - 1; i >= 0; i-- {
+		if p < l.data[i] {
+			line = i + 1
+		} else {
+			break
+		}
+	}
+
+	return line
+}
+
+// GetColumn returns 1-based byte column of the offset p
+func (l *Lines) GetColumn(p int) int {
+	return p - l.LineStart(l.GetLine(p)) + 1
+}
+
+// GetLineColumn converts the offset p to 1-based line and byte column
+func (l *Lines) GetLineColumn(p int) (int, int) {
+	line := l.GetLine(p)
+
+	return line, p - l.LineStart(line) + 1
+}
+
+// GetColumnUTF16 returns 1-based column of the offset p counted in UTF-16 code units
+func (l *Lines) GetColumnUTF16(src []byte, p int) int {
+	return l.ColumnUTF16(src, l.GetLine(p), p)
+}
+
+// LineStart returns the offset of the 1-based line
+func (l *Lines) LineStart(line int) int {
+	if line < 2 {
+		return 0
+	}
+
+	return l.data[line-2]
+}
+
+// ColumnUTF16 counts UTF-16 code units from the start of the line up to the offset p,
+// p may point right after the line end
+func (l *Lines) ColumnUTF16(src []byte, line int, p int) int {
+	start := l.LineStart(line)
+
+	i, col := start, 1
+	if l.utf16Pos > start && l.utf16Pos <= p {
+		i, col = l.utf16Pos, l.utf16Col
+	}
+
+	for i < p {
+		if src[i] < utf8.RuneSelf {
+			i++
+			col++
+			continue
+		}
+
+		r, size := utf8.DecodeRune(src[i:p])
+		i += size
+		col++
+
+		if r >= 0x10000 {
+			col++
+		}
+	}
+
+	l.utf16Pos, l.utf16Col = p, col
+
+	return col
+}
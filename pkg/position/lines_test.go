@@ -0,0 +1,63 @@
+package position_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/position"
+)
+
+func TestLinesColumns(t *testing.T) {
+	src := []byte("a\nä\U0001F600b\r\nc")
+
+	l := position.NewLines(src)
+
+	line, col := l.GetLineColumn(8)
+	assert.Equal(t, 2, line)
+	assert.Equal(t, 7, col)
+	assert.Equal(t, 4, l.GetColumnUTF16(src, 8))
+
+	line, col = l.GetLineColumn(11)
+	assert.Equal(t, 3, line)
+	assert.Equal(t, 1, col)
+	assert.Equal(t, 1, l.GetColumnUTF16(src, 11))
+
+	assert.Equal(t, 1, l.GetColumn(0))
+	assert.Equal(t, 1, l.GetColumnUTF16(src, 0))
+	assert.Equal(t, 2, l.GetColumnUTF16(src, 4))
+}
+
+func TestLinesBreaks(t *testing.T) {
+	l := position.NewLines([]byte("a\rb\r\nc\nd"))
+
+	assert.Equal(t, 1, l.GetLine(0))
+	assert.Equal(t, 2, l.GetLine(2))
+	assert.Equal(t, 2, l.GetLine(4))
+	assert.Equal(t, 3, l.GetLine(5))
+	assert.Equal(t, 4, l.GetLine(7))
+	assert.Equal(t, 5, l.LineStart(3))
+}
+
+func TestLinesCutEdit(t *testing.T) {
+	l := position.NewLines([]byte("a\nb\nc\nd"))
+
+	c := l.Cut(4)
+	assert.Equal(t, 3, c.GetLine(4))
+	assert.Equal(t, 3, c.GetLine(6))
+
+	c.Append(5)
+	assert.Equal(t, 4, c.GetLine(5))
+	assert.Equal(t, 3, l.GetLine(5), "the appended line does not change the cut table")
+
+	// "a\nb\nc" to "a\nxx\nb\nc", the line of b is moved and a new line is added
+	s := l.Cut(4).Edit([]byte("a\nxx\nb\nc\nd"), 2, 2, 3)
+	assert.Equal(t, 3, s.GetLine(5))
+	assert.Equal(t, 4, s.GetLine(7))
+	assert.Equal(t, 1, s.GetColumn(7))
+
+	// "a\nb\nc" to "a\nbyy\nc", the line starts in front of the edit are kept
+	s = l.Cut(4).Edit([]byte("a\nbyy\nc\nd"), 3, 3, 2)
+	assert.Equal(t, 2, s.GetLine(5))
+	assert.Equal(t, 3, s.GetLine(6))
+}
@@ -6,6 +6,14 @@ type Position struct {
 	EndLine   int
 	StartPos  int
 	EndPos    int
+
+	// 1-based byte columns, EndCol points right after the last byte on the EndLine
+	StartCol int
+	EndCol   int
+
+	// the same columns counted in UTF-16 code units
+	StartColUTF16 int
+	EndColUTF16   int
 }
 
 // NewPosition Position constructor
@@ -144,6 +144,18 @@ const (
 	T_IS_NOT_EQUAL
 	T_IS_SMALLER_OR_EQUAL
 	T_IS_GREATER_OR_EQUAL
+	T_NULLSAFE_OBJECT_OPERATOR
+	T_MATCH
+	T_ATTRIBUTE
+	T_ENUM
+	T_READONLY
+	T_AMPERSAND_NOT_FOLLOWED_BY_VAR_OR_VARARG
+
+	// the fragment tokens are never returned by the scanner,
+	// the parser starts with one of them to parse the fragment
+	T_FRAGMENT_EXPR
+	T_FRAGMENT_STMTS
+	T_FRAGMENT_CLASS_MEMBER
 )
 
 type Token struct {
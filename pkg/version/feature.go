@@ -0,0 +1,183 @@
+package version
+
+import "fmt"
+
+// Feature is a language construct introduced in some PHP version
+type Feature int
+
+const (
+	FeatureNamespace Feature = iota
+	FeatureClosure
+	FeatureGoto
+	FeatureNowdoc
+	FeatureShortTernary
+
+	FeatureShortArray
+	FeatureTrait
+	FeatureCallableType
+	FeatureBinaryLiteral
+
+	FeatureGenerator
+	FeatureFinally
+
+	FeatureExponentiation
+	FeatureVariadic
+	FeatureArgumentUnpacking
+	FeatureUseFunction
+
+	FeatureNullCoalescing
+	FeatureSpaceship
+	FeatureScalarType
+	FeatureReturnType
+	FeatureAnonymousClass
+	FeatureGroupUse
+	FeatureYieldFrom
+
+	FeatureNullableType
+	FeatureShortList
+	FeatureKeyedList
+	FeatureClassConstVisibility
+	FeatureMultiCatch
+	FeatureVoidType
+	FeatureIterableType
+
+	FeatureObjectType
+	FeatureGroupUseTrailingComma
+
+	FeatureFlexibleHeredoc
+	FeatureCallTrailingComma
+	FeatureListReference
+
+	FeatureNullCoalescingAssignment
+	FeatureArrowFunction
+	FeatureTypedProperty
+	FeatureNumericLiteralSeparator
+	FeatureArraySpread
+
+	FeatureAttribute
+	FeatureMatch
+	FeatureNullsafeOperator
+	FeatureUnionType
+	FeatureMixedType
+	FeatureStaticReturnType
+	FeatureNamedArgument
+	FeatureConstructorPromotion
+	FeatureThrowExpression
+	FeatureCatchWithoutVariable
+
+	FeatureEnum
+	FeatureReadonlyProperty
+	FeatureIntersectionType
+	FeatureNeverType
+	FeatureFirstClassCallable
+	FeatureNewInInitializer
+
+	FeatureReadonlyClass
+	FeatureStandaloneType
+
+	FeatureTypedClassConstant
+	FeatureDynamicClassConstFetch
+)
+
+var features = [...]struct {
+	name  string
+	since Version
+}{
+	FeatureNamespace:    {"namespace", Version{5, 3}},
+	FeatureClosure:      {"closure", Version{5, 3}},
+	FeatureGoto:         {"goto", Version{5, 3}},
+	FeatureNowdoc:       {"nowdoc", Version{5, 3}},
+	FeatureShortTernary: {"short ternary operator", Version{5, 3}},
+
+	FeatureShortArray:    {"short array syntax", Version{5, 4}},
+	FeatureTrait:         {"trait", Version{5, 4}},
+	FeatureCallableType:  {"callable type", Version{5, 4}},
+	FeatureBinaryLiteral: {"binary number literal", Version{5, 4}},
+
+	FeatureGenerator: {"yield", Version{5, 5}},
+	FeatureFinally:   {"finally", Version{5, 5}},
+
+	FeatureExponentiation:    {"exponentiation operator", Version{5, 6}},
+	FeatureVariadic:          {"variadic parameter", Version{5, 6}},
+	FeatureArgumentUnpacking: {"argument unpacking", Version{5, 6}},
+	FeatureUseFunction:       {"use function and use const", Version{5, 6}},
+
+	FeatureNullCoalescing: {"null coalescing operator", Version{7, 0}},
+	FeatureSpaceship:      {"spaceship operator", Version{7, 0}},
+	FeatureScalarType:     {"scalar type", Version{7, 0}},
+	FeatureReturnType:     {"return type", Version{7, 0}},
+	FeatureAnonymousClass: {"anonymous class", Version{7, 0}},
+	FeatureGroupUse:       {"group use", Version{7, 0}},
+	FeatureYieldFrom:      {"yield from", Version{7, 0}},
+
+	FeatureNullableType:         {"nullable type", Version{7, 1}},
+	FeatureShortList:            {"short list syntax", Version{7, 1}},
+	FeatureKeyedList:            {"list with keys", Version{7, 1}},
+	FeatureClassConstVisibility: {"class constant modifier", Version{7, 1}},
+	FeatureMultiCatch:           {"catching multiple exception types", Version{7, 1}},
+	FeatureVoidType:             {"void type", Version{7, 1}},
+	FeatureIterableType:         {"iterable type", Version{7, 1}},
+
+	FeatureObjectType:            {"object type", Version{7, 2}},
+	FeatureGroupUseTrailingComma: {"trailing comma in group use", Version{7, 2}},
+
+	FeatureFlexibleHeredoc:   {"flexible heredoc", Version{7, 3}},
+	FeatureCallTrailingComma: {"trailing comma in call", Version{7, 3}},
+	FeatureListReference:     {"list reference assignment", Version{7, 3}},
+
+	FeatureNullCoalescingAssignment: {"null coalescing assignment operator", Version{7, 4}},
+	FeatureArrowFunction:            {"arrow function", Version{7, 4}},
+	FeatureTypedProperty:            {"typed property", Version{7, 4}},
+	FeatureNumericLiteralSeparator:  {"numeric literal separator", Version{7, 4}},
+	FeatureArraySpread:              {"spread operator in array", Version{7, 4}},
+
+	FeatureAttribute:            {"attribute", Version{8, 0}},
+	FeatureMatch:                {"match expression", Version{8, 0}},
+	FeatureNullsafeOperator:     {"nullsafe operator", Version{8, 0}},
+	FeatureUnionType:            {"union type", Version{8, 0}},
+	FeatureMixedType:            {"mixed type", Version{8, 0}},
+	FeatureStaticReturnType:     {"static return type", Version{8, 0}},
+	FeatureNamedArgument:        {"named argument", Version{8, 0}},
+	FeatureConstructorPromotion: {"constructor property promotion", Version{8, 0}},
+	FeatureThrowExpression:      {"throw expression", Version{8, 0}},
+	FeatureCatchWithoutVariable: {"catch without variable", Version{8, 0}},
+
+	FeatureEnum:               {"enum", Version{8, 1}},
+	FeatureReadonlyProperty:   {"readonly property", Version{8, 1}},
+	FeatureIntersectionType:   {"intersection type", Version{8, 1}},
+	FeatureNeverType:          {"never type", Version{8, 1}},
+	FeatureFirstClassCallable: {"first-class callable syntax", Version{8, 1}},
+	FeatureNewInInitializer:   {"new in initializer", Version{8, 1}},
+
+	FeatureReadonlyClass:  {"readonly class", Version{8, 2}},
+	FeatureStandaloneType: {"standalone null, false and true type", Version{8, 2}},
+
+	FeatureTypedClassConstant:     {"typed class constant", Version{8, 3}},
+	FeatureDynamicClassConstFetch: {"dynamic class constant fetch", Version{8, 3}},
+}
+
+// String returns the feature name
+func (f Feature) String() string {
+	if f < 0 || int(f) >= len(features) {
+		return fmt.Sprintf("Feature(%d)", int(f))
+	}
+
+	return features[f].name
+}
+
+// Since returns the version the feature is introduced in
+func (f Feature) Since() *Version {
+	v := features[f].since
+	return &v
+}
+
+// Requirement returns the message of the error reported when the feature
+// is used with an older version
+func (f Feature) Requirement() string {
+	return fmt.Sprintf("%s requires PHP %s", f, f.Since())
+}
+
+// Supports tests if the feature is available in the version
+func (v *Version) Supports(f Feature) bool {
+	return v.GreaterOrEqual(f.Since())
+}
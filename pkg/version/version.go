@@ -2,6 +2,7 @@ package version
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 )
@@ -22,6 +23,9 @@ var (
 
 	php7RangeStart = &Version{Major: 7}
 	php7RangeEnd   = &Version{Major: 7, Minor: 4}
+
+	php8RangeStart = &Version{Major: 8}
+	php8RangeEnd   = &Version{Major: 8, Minor: 3}
 )
 
 func New(v string) (*Version, error) {
@@ -47,8 +51,13 @@ func New(v string) (*Version, error) {
 	return ver, nil
 }
 
+// String returns the version in the "major.minor" form
+func (v *Version) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
 func (v *Version) Validate() error {
-	if !v.InRange(php5RangeStart, php5RangeEnd) && !v.InRange(php7RangeStart, php7RangeEnd) {
+	if !v.InRange(php5RangeStart, php5RangeEnd) && !v.InRange(php7RangeStart, php7RangeEnd) && !v.InRange(php8RangeStart, php8RangeEnd) {
 		return ErrUnsupportedVer
 	}
 
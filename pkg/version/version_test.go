@@ -46,3 +46,28 @@ func TestInRange(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Assert(t, ver.InRange(s, e))
 }
+
+func TestValidate(t *testing.T) {
+	for _, v := range []string{"5.6", "7.0", "7.4", "8.0", "8.3"} {
+		ver, err := version.New(v)
+		assert.NilError(t, err)
+		assert.NilError(t, ver.Validate())
+	}
+
+	for _, v := range []string{"4.4", "7.5", "8.4"} {
+		ver, err := version.New(v)
+		assert.NilError(t, err)
+		assert.Equal(t, ver.Validate(), version.ErrUnsupportedVer)
+	}
+}
+
+func TestFeature(t *testing.T) {
+	ver, err := version.New("7.3")
+	assert.NilError(t, err)
+
+	assert.Assert(t, ver.Supports(version.FeatureFlexibleHeredoc))
+	assert.Assert(t, !ver.Supports(version.FeatureArrowFunction))
+	assert.Equal(t, "7.4", version.FeatureArrowFunction.Since().String())
+	assert.Equal(t, "arrow function requires PHP 7.4", version.FeatureArrowFunction.Requirement())
+	assert.Equal(t, "Feature(-1)", version.Feature(-1).String())
+}
@@ -0,0 +1,254 @@
+// Package compat finds the code that parses under PHP 7 but breaks or changes meaning in PHP 8
+package compat
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor"
+)
+
+var (
+	php80 = &version.Version{Major: 8, Minor: 0}
+	php81 = &version.Version{Major: 8, Minor: 1}
+)
+
+// removedFunctions are the functions removed in PHP 8.0
+var removedFunctions = []string{
+	"each",
+	"create_function",
+	"money_format",
+	"ezmlm_hash",
+	"restore_include_path",
+	"get_magic_quotes_gpc",
+	"get_magic_quotes_runtime",
+	"fgetss",
+	"gzgetss",
+	"hebrevc",
+	"convert_cyr_string",
+	"is_real",
+	"image2wbmp",
+	"ldap_sort",
+}
+
+// Diagnostic is a construct that breaks in the Version
+type Diagnostic struct {
+	Version  *version.Version
+	Msg      string
+	Position *position.Position
+}
+
+// Group holds the diagnostics of the version
+type Group struct {
+	Version     *version.Version
+	Diagnostics []Diagnostic
+}
+
+// Checker visitor records the constructs that break in the newer php versions,
+// use it with traverser.NewNodeTraverser
+type Checker struct {
+	visitor.Null
+	Diagnostics []Diagnostic
+
+	namespaced bool
+}
+
+// NewChecker Checker type constructor
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// Groups returns the diagnostics grouped by the version ordered from the oldest one
+func (c *Checker) Groups() []Group {
+	var groups []Group
+
+	for _, d := range c.Diagnostics {
+		i := 0
+		for i < len(groups) && groups[i].Version.Compare(d.Version) != 0 {
+			i++
+		}
+
+		if i == len(groups) {
+			groups = append(groups, Group{Version: d.Version})
+		}
+
+		groups[i].Diagnostics = append(groups[i].Diagnostics, d)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Version.Less(groups[j].Version)
+	})
+
+	return groups
+}
+
+// EnterNode checks the node
+func (c *Checker) EnterNode(n ast.Vertex, _ ast.Vertex, _ string) bool {
+	n.Accept(c)
+
+	return true
+}
+
+// LeaveNode leaves the braced namespace
+func (c *Checker) LeaveNode(n ast.Vertex, _ ast.Vertex, _ string) {
+	if ns, ok := n.(*ast.StmtNamespace); ok && ns.OpenCurlyBracketTkn != nil {
+		c.namespaced = false
+	}
+}
+
+func (c *Checker) add(v *version.Version, msg string, pos *position.Position) {
+	c.Diagnostics = append(c.Diagnostics, Diagnostic{Version: v, Msg: msg, Position: pos})
+}
+
+func (c *Checker) StmtNamespace(n *ast.StmtNamespace) {
+	c.namespaced = n.Name != nil
+}
+
+func (c *Checker) StmtClass(n *ast.StmtClass) {
+	if c.namespaced || n.Name == nil {
+		return
+	}
+
+	var ctor *ast.StmtClassMethod
+	for _, s := range n.Stmts {
+		m, ok := s.(*ast.StmtClassMethod)
+		if !ok {
+			continue
+		}
+
+		name := m.Name.(*ast.Identifier).Value
+		if bytes.EqualFold(name, []byte("__construct")) {
+			return
+		}
+
+		if bytes.EqualFold(name, n.Name.(*ast.Identifier).Value) {
+			ctor = m
+		}
+	}
+
+	if ctor != nil {
+		c.add(php80, "method with the class name is no longer a constructor", ctor.Name.GetPosition())
+	}
+}
+
+func (c *Checker) StmtFunction(n *ast.StmtFunction) {
+	name := n.Name.(*ast.Identifier).Value
+
+	if bytes.EqualFold(name, []byte("__autoload")) {
+		c.add(php80, "__autoload is no longer called, use spl_autoload_register", n.Name.GetPosition())
+	}
+
+	if bytes.EqualFold(name, []byte("match")) {
+		c.add(php80, "match is a reserved keyword", n.Name.GetPosition())
+	}
+}
+
+func (c *Checker) ExprArrayDimFetch(n *ast.ExprArrayDimFetch) {
+	if n.OpenBracketTkn != nil && bytes.Equal(n.OpenBracketTkn.Value, []byte("{")) {
+		c.add(php80, "array and string offset access syntax with curly braces is removed", n.OpenBracketTkn.Position)
+	}
+}
+
+func (c *Checker) ExprFunctionCall(n *ast.ExprFunctionCall) {
+	var parts []ast.Vertex
+	switch name := n.Function.(type) {
+	case *ast.Name:
+		parts = name.Parts
+	case *ast.NameFullyQualified:
+		parts = name.Parts
+	}
+
+	if len(parts) != 1 {
+		return
+	}
+
+	name := bytes.ToLower(parts[0].(*ast.NamePart).Value)
+
+	if string(name) == "match" {
+		c.add(php80, "match is a reserved keyword", n.Function.GetPosition())
+		return
+	}
+
+	for _, f := range removedFunctions {
+		if string(name) == f {
+			c.add(php80, f+"() is removed", n.Function.GetPosition())
+			return
+		}
+	}
+}
+
+func (c *Checker) ExprTernary(n *ast.ExprTernary) {
+	cond, ok := n.Cond.(*ast.ExprTernary)
+	if !ok || cond.IfTrue == nil && n.IfTrue == nil {
+		return
+	}
+
+	c.add(php80, "nested ternary operators require explicit parentheses", n.QuestionTkn.Position)
+}
+
+func (c *Checker) ExprCastDouble(n *ast.ExprCastDouble) {
+	if bytes.Contains(bytes.ToLower(n.CastTkn.Value), []byte("real")) {
+		c.add(php80, "(real) cast is removed, use (float)", n.CastTkn.Position)
+	}
+}
+
+func (c *Checker) ExprCastUnset(n *ast.ExprCastUnset) {
+	c.add(php80, "(unset) cast is removed", n.CastTkn.Position)
+}
+
+func (c *Checker) ExprAssign(n *ast.ExprAssign) {
+	c.globals(n.Var)
+}
+
+func (c *Checker) ExprAssignReference(n *ast.ExprAssignReference) {
+	c.globals(n.Var)
+}
+
+// globals reports the write to the whole $GLOBALS array
+func (c *Checker) globals(n ast.Vertex) {
+	v, ok := n.(*ast.ExprVariable)
+	if !ok {
+		return
+	}
+
+	if name, ok := v.Name.(*ast.Identifier); ok && bytes.Equal(name.Value, []byte("$GLOBALS")) {
+		c.add(php81, "$GLOBALS can no longer be written as a whole", v.Position)
+	}
+}
+
+func (c *Checker) ExprBinaryMinus(n *ast.ExprBinaryMinus) {
+	c.concat(n.Left, n.OpTkn.Position)
+}
+
+func (c *Checker) ExprBinaryPlus(n *ast.ExprBinaryPlus) {
+	c.concat(n.Left, n.OpTkn.Position)
+}
+
+func (c *Checker) ExprBinaryShiftLeft(n *ast.ExprBinaryShiftLeft) {
+	c.shift(n.Left, n.Right, n.OpTkn.Position)
+}
+
+func (c *Checker) ExprBinaryShiftRight(n *ast.ExprBinaryShiftRight) {
+	c.shift(n.Left, n.Right, n.OpTkn.Position)
+}
+
+// concat reports the unparenthesized concatenation on the left side of + and -,
+// the arithmetic takes precedence over the concatenation since PHP 8.0
+func (c *Checker) concat(left ast.Vertex, pos *position.Position) {
+	if _, ok := left.(*ast.ExprBinaryConcat); ok {
+		c.add(php80, "arithmetic operator takes precedence over concatenation", pos)
+	}
+}
+
+// shift reports the unparenthesized concatenation on either side of << and >>,
+// the shift takes precedence over the concatenation since PHP 8.0
+func (c *Checker) shift(left, right ast.Vertex, pos *position.Position) {
+	_, l := left.(*ast.ExprBinaryConcat)
+	_, r := right.(*ast.ExprBinaryConcat)
+	if l || r {
+		c.add(php80, "shift operator takes precedence over concatenation", pos)
+	}
+}
@@ -0,0 +1,92 @@
+package compat_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor/compat"
+	"github.com/z7zmey/php-parser/pkg/visitor/traverser"
+)
+
+type diagnostic struct {
+	Msg  string
+	Line int
+	Col  int
+}
+
+func check(t *testing.T, src string) []compat.Group {
+	root, err := parser.Parse([]byte(src), conf.Config{Version: &version.Version{Major: 7, Minor: 4}})
+	assert.NilError(t, err, src)
+
+	c := compat.NewChecker()
+	traverser.NewNodeTraverser(c).Traverse(root)
+
+	return c.Groups()
+}
+
+func diagnostics(g compat.Group) []diagnostic {
+	var d []diagnostic
+	for _, dd := range g.Diagnostics {
+		d = append(d, diagnostic{dd.Msg, dd.Position.StartLine, dd.Position.StartCol})
+	}
+
+	return d
+}
+
+func TestCheck(t *testing.T) {
+	src := `<?php
+$GLOBALS = [];
+$a{0};
+$b = (real) $a . (unset) $a;
+$c = $a ? 1 : $b ? 2 : 3;
+$d = $a ?: $b ?: 3;
+$e = "a" . $a + 1;
+$f = $a << $b . $c;
+each($a);
+\create_function('', '');
+Foo\each($a);
+class A { function A() {} }
+class B { function B() {} function __construct() {} }
+function __autoload($c) {}
+`
+
+	groups := check(t, src)
+	assert.Equal(t, 2, len(groups))
+
+	assert.Equal(t, "8.0", groups[0].Version.String())
+	assert.DeepEqual(t, []diagnostic{
+		{"array and string offset access syntax with curly braces is removed", 3, 3},
+		{"(real) cast is removed, use (float)", 4, 6},
+		{"(unset) cast is removed", 4, 18},
+		{"nested ternary operators require explicit parentheses", 5, 18},
+		{"arithmetic operator takes precedence over concatenation", 7, 15},
+		{"shift operator takes precedence over concatenation", 8, 9},
+		{"each() is removed", 9, 1},
+		{"create_function() is removed", 10, 1},
+		{"method with the class name is no longer a constructor", 12, 20},
+		{"__autoload is no longer called, use spl_autoload_register", 14, 10},
+	}, diagnostics(groups[0]))
+
+	assert.Equal(t, "8.1", groups[1].Version.String())
+	assert.DeepEqual(t, []diagnostic{
+		{"$GLOBALS can no longer be written as a whole", 2, 1},
+	}, diagnostics(groups[1]))
+}
+
+func TestCheckNamespacedConstructor(t *testing.T) {
+	groups := check(t, "<?php namespace Foo; class A { function A() {} }")
+	assert.Equal(t, 0, len(groups))
+
+	groups = check(t, "<?php namespace Foo { class A {} } namespace { class B { function B() {} } }")
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, 1, len(groups[0].Diagnostics))
+}
+
+func TestCheckParenthesized(t *testing.T) {
+	groups := check(t, "<?php $a = ($a ? 1 : $b) ? 2 : 3; $b = ('a' . $a) + 1; $c = $a << ($b . $c); $c['a']; $GLOBALS['a'] = 1;")
+	assert.Equal(t, 0, len(groups))
+}
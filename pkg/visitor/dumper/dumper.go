@@ -145,10 +145,14 @@ func (v *Dumper) dumpPosition(pos *position.Position) {
 	v.print(v.indent, "Position: &position.Position{\n")
 	v.indent++
 
-	v.print(v.indent, "StartLine: "+strconv.Itoa(pos.StartLine)+",\n")
-	v.print(v.indent, "EndLine:   "+strconv.Itoa(pos.EndLine)+",\n")
-	v.print(v.indent, "StartPos:  "+strconv.Itoa(pos.StartPos)+",\n")
-	v.print(v.indent, "EndPos:    "+strconv.Itoa(pos.EndPos)+",\n")
+	v.print(v.indent, "StartLine:     "+strconv.Itoa(pos.StartLine)+",\n")
+	v.print(v.indent, "EndLine:       "+strconv.Itoa(pos.EndLine)+",\n")
+	v.print(v.indent, "StartPos:      "+strconv.Itoa(pos.StartPos)+",\n")
+	v.print(v.indent, "EndPos:        "+strconv.Itoa(pos.EndPos)+",\n")
+	v.print(v.indent, "StartCol:      "+strconv.Itoa(pos.StartCol)+",\n")
+	v.print(v.indent, "EndCol:        "+strconv.Itoa(pos.EndCol)+",\n")
+	v.print(v.indent, "StartColUTF16: "+strconv.Itoa(pos.StartColUTF16)+",\n")
+	v.print(v.indent, "EndColUTF16:   "+strconv.Itoa(pos.EndColUTF16)+",\n")
 
 	v.indent--
 	v.print(v.indent, "},\n")
@@ -192,6 +196,8 @@ func (v *Dumper) Parameter(n *ast.Parameter) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
+	v.dumpVertexList("Modifiers", n.Modifiers)
 	v.dumpVertex("Type", n.Type)
 	v.dumpToken("AmpersandTkn", n.AmpersandTkn)
 	v.dumpToken("VariadicTkn", n.VariadicTkn)
@@ -220,6 +226,8 @@ func (v *Dumper) Argument(n *ast.Argument) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertex("Name", n.Name)
+	v.dumpToken("ColonTkn", n.ColonTkn)
 	v.dumpToken("AmpersandTkn", n.AmpersandTkn)
 	v.dumpToken("VariadicTkn", n.VariadicTkn)
 	v.dumpVertex("Expr", n.Expr)
@@ -228,6 +236,86 @@ func (v *Dumper) Argument(n *ast.Argument) {
 	v.print(v.indent, "},\n")
 }
 
+func (v *Dumper) Attribute(n *ast.Attribute) {
+	v.print(0, "&ast.Attribute{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpVertex("Name", n.Name)
+	v.dumpToken("OpenParenthesisTkn", n.OpenParenthesisTkn)
+	v.dumpVertexList("Args", n.Args)
+	v.dumpTokenList("SeparatorTkns", n.SeparatorTkns)
+	v.dumpToken("CloseParenthesisTkn", n.CloseParenthesisTkn)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
+func (v *Dumper) AttributeGroup(n *ast.AttributeGroup) {
+	v.print(0, "&ast.AttributeGroup{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpToken("OpenAttributeTkn", n.OpenAttributeTkn)
+	v.dumpVertexList("Attrs", n.Attrs)
+	v.dumpTokenList("SeparatorTkns", n.SeparatorTkns)
+	v.dumpToken("CloseAttributeTkn", n.CloseAttributeTkn)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
+func (v *Dumper) Union(n *ast.Union) {
+	v.print(0, "&ast.Union{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpVertexList("Types", n.Types)
+	v.dumpTokenList("SeparatorTkns", n.SeparatorTkns)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
+func (v *Dumper) Intersection(n *ast.Intersection) {
+	v.print(0, "&ast.Intersection{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpVertexList("Types", n.Types)
+	v.dumpTokenList("SeparatorTkns", n.SeparatorTkns)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
+func (v *Dumper) MatchArm(n *ast.MatchArm) {
+	v.print(0, "&ast.MatchArm{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpToken("DefaultTkn", n.DefaultTkn)
+	v.dumpToken("DefaultCommaTkn", n.DefaultCommaTkn)
+	v.dumpVertexList("Exprs", n.Exprs)
+	v.dumpTokenList("SeparatorTkns", n.SeparatorTkns)
+	v.dumpToken("DoubleArrowTkn", n.DoubleArrowTkn)
+	v.dumpVertex("ReturnExpr", n.ReturnExpr)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
+func (v *Dumper) BadStmt(n *ast.BadStmt) {
+	v.print(0, "&ast.BadStmt{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpTokenList("SkippedTkns", n.SkippedTkns)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
 func (v *Dumper) StmtBreak(n *ast.StmtBreak) {
 	v.print(0, "&ast.StmtBreak{\n")
 	v.indent++
@@ -279,6 +367,7 @@ func (v *Dumper) StmtClass(n *ast.StmtClass) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
 	v.dumpVertexList("Modifiers", n.Modifiers)
 	v.dumpToken("ClassTkn", n.ClassTkn)
 	v.dumpVertex("Name", n.Name)
@@ -304,8 +393,10 @@ func (v *Dumper) StmtClassConstList(n *ast.StmtClassConstList) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
 	v.dumpVertexList("Modifiers", n.Modifiers)
 	v.dumpToken("ConstTkn", n.ConstTkn)
+	v.dumpVertex("Type", n.Type)
 	v.dumpVertexList("Consts", n.Consts)
 	v.dumpTokenList("SeparatorTkns", n.SeparatorTkns)
 	v.dumpToken("SemiColonTkn", n.SemiColonTkn)
@@ -319,6 +410,7 @@ func (v *Dumper) StmtClassMethod(n *ast.StmtClassMethod) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
 	v.dumpVertexList("Modifiers", n.Modifiers)
 	v.dumpToken("FunctionTkn", n.FunctionTkn)
 	v.dumpToken("AmpersandTkn", n.AmpersandTkn)
@@ -468,6 +560,43 @@ func (v *Dumper) StmtElseIf(n *ast.StmtElseIf) {
 	v.print(v.indent, "},\n")
 }
 
+func (v *Dumper) StmtEnum(n *ast.StmtEnum) {
+	v.print(0, "&ast.StmtEnum{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
+	v.dumpToken("EnumTkn", n.EnumTkn)
+	v.dumpVertex("Name", n.Name)
+	v.dumpToken("ColonTkn", n.ColonTkn)
+	v.dumpVertex("Type", n.Type)
+	v.dumpToken("ImplementsTkn", n.ImplementsTkn)
+	v.dumpVertexList("Implements", n.Implements)
+	v.dumpTokenList("ImplementsSeparatorTkns", n.ImplementsSeparatorTkns)
+	v.dumpToken("OpenCurlyBracketTkn", n.OpenCurlyBracketTkn)
+	v.dumpVertexList("Stmts", n.Stmts)
+	v.dumpToken("CloseCurlyBracketTkn", n.CloseCurlyBracketTkn)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
+func (v *Dumper) StmtEnumCase(n *ast.StmtEnumCase) {
+	v.print(0, "&ast.StmtEnumCase{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
+	v.dumpToken("CaseTkn", n.CaseTkn)
+	v.dumpVertex("Name", n.Name)
+	v.dumpToken("EqualTkn", n.EqualTkn)
+	v.dumpVertex("Expr", n.Expr)
+	v.dumpToken("SemiColonTkn", n.SemiColonTkn)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
 func (v *Dumper) StmtExpression(n *ast.StmtExpression) {
 	v.print(0, "&ast.StmtExpression{\n")
 	v.indent++
@@ -547,6 +676,7 @@ func (v *Dumper) StmtFunction(n *ast.StmtFunction) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
 	v.dumpToken("FunctionTkn", n.FunctionTkn)
 	v.dumpToken("AmpersandTkn", n.AmpersandTkn)
 	v.dumpVertex("Name", n.Name)
@@ -642,6 +772,7 @@ func (v *Dumper) StmtInterface(n *ast.StmtInterface) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
 	v.dumpToken("InterfaceTkn", n.InterfaceTkn)
 	v.dumpVertex("Name", n.Name)
 	v.dumpToken("ExtendsTkn", n.ExtendsTkn)
@@ -712,6 +843,7 @@ func (v *Dumper) StmtPropertyList(n *ast.StmtPropertyList) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
 	v.dumpVertexList("Modifiers", n.Modifiers)
 	v.dumpVertex("Type", n.Type)
 	v.dumpVertexList("Props", n.Props)
@@ -814,6 +946,7 @@ func (v *Dumper) StmtTrait(n *ast.StmtTrait) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
 	v.dumpToken("TraitTkn", n.TraitTkn)
 	v.dumpVertex("Name", n.Name)
 	v.dumpToken("OpenCurlyBracketTkn", n.OpenCurlyBracketTkn)
@@ -975,6 +1108,17 @@ func (v *Dumper) StmtWhile(n *ast.StmtWhile) {
 	v.print(v.indent, "},\n")
 }
 
+func (v *Dumper) BadExpr(n *ast.BadExpr) {
+	v.print(0, "&ast.BadExpr{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpTokenList("SkippedTkns", n.SkippedTkns)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
 func (v *Dumper) ExprArray(n *ast.ExprArray) {
 	v.print(0, "&ast.ExprArray{\n")
 	v.indent++
@@ -1024,6 +1168,7 @@ func (v *Dumper) ExprArrowFunction(n *ast.ExprArrowFunction) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
 	v.dumpToken("StaticTkn", n.StaticTkn)
 	v.dumpToken("FnTkn", n.FnTkn)
 	v.dumpToken("AmpersandTkn", n.AmpersandTkn)
@@ -1084,7 +1229,9 @@ func (v *Dumper) ExprClassConstFetch(n *ast.ExprClassConstFetch) {
 	v.dumpPosition(n.Position)
 	v.dumpVertex("Class", n.Class)
 	v.dumpToken("DoubleColonTkn", n.DoubleColonTkn)
+	v.dumpToken("OpenCurlyBracketTkn", n.OpenCurlyBracketTkn)
 	v.dumpVertex("Const", n.Const)
+	v.dumpToken("CloseCurlyBracketTkn", n.CloseCurlyBracketTkn)
 
 	v.indent--
 	v.print(v.indent, "},\n")
@@ -1107,6 +1254,7 @@ func (v *Dumper) ExprClosure(n *ast.ExprClosure) {
 	v.indent++
 
 	v.dumpPosition(n.Position)
+	v.dumpVertexList("AttrGroups", n.AttrGroups)
 	v.dumpToken("StaticTkn", n.StaticTkn)
 	v.dumpToken("FunctionTkn", n.FunctionTkn)
 	v.dumpToken("AmpersandTkn", n.AmpersandTkn)
@@ -1288,6 +1436,24 @@ func (v *Dumper) ExprList(n *ast.ExprList) {
 	v.print(v.indent, "},\n")
 }
 
+func (v *Dumper) ExprMatch(n *ast.ExprMatch) {
+	v.print(0, "&ast.ExprMatch{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpToken("MatchTkn", n.MatchTkn)
+	v.dumpToken("OpenParenthesisTkn", n.OpenParenthesisTkn)
+	v.dumpVertex("Expr", n.Expr)
+	v.dumpToken("CloseParenthesisTkn", n.CloseParenthesisTkn)
+	v.dumpToken("OpenCurlyBracketTkn", n.OpenCurlyBracketTkn)
+	v.dumpVertexList("Arms", n.Arms)
+	v.dumpTokenList("SeparatorTkns", n.SeparatorTkns)
+	v.dumpToken("CloseCurlyBracketTkn", n.CloseCurlyBracketTkn)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
 func (v *Dumper) ExprMethodCall(n *ast.ExprMethodCall) {
 	v.print(0, "&ast.ExprMethodCall{\n")
 	v.indent++
@@ -1323,6 +1489,40 @@ func (v *Dumper) ExprNew(n *ast.ExprNew) {
 	v.print(v.indent, "},\n")
 }
 
+func (v *Dumper) ExprNullsafeMethodCall(n *ast.ExprNullsafeMethodCall) {
+	v.print(0, "&ast.ExprNullsafeMethodCall{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpVertex("Var", n.Var)
+	v.dumpToken("ObjectOperatorTkn", n.ObjectOperatorTkn)
+	v.dumpToken("OpenCurlyBracketTkn", n.OpenCurlyBracketTkn)
+	v.dumpVertex("Method", n.Method)
+	v.dumpToken("CloseCurlyBracketTkn", n.CloseCurlyBracketTkn)
+	v.dumpToken("OpenParenthesisTkn", n.OpenParenthesisTkn)
+	v.dumpVertexList("Args", n.Args)
+	v.dumpTokenList("SeparatorTkns", n.SeparatorTkns)
+	v.dumpToken("CloseParenthesisTkn", n.CloseParenthesisTkn)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
+func (v *Dumper) ExprNullsafePropertyFetch(n *ast.ExprNullsafePropertyFetch) {
+	v.print(0, "&ast.ExprNullsafePropertyFetch{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpVertex("Var", n.Var)
+	v.dumpToken("ObjectOperatorTkn", n.ObjectOperatorTkn)
+	v.dumpToken("OpenCurlyBracketTkn", n.OpenCurlyBracketTkn)
+	v.dumpVertex("Prop", n.Prop)
+	v.dumpToken("CloseCurlyBracketTkn", n.CloseCurlyBracketTkn)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
 func (v *Dumper) ExprPostDec(n *ast.ExprPostDec) {
 	v.print(0, "&ast.ExprPostDec{\n")
 	v.indent++
@@ -1482,6 +1682,18 @@ func (v *Dumper) ExprTernary(n *ast.ExprTernary) {
 	v.print(v.indent, "},\n")
 }
 
+func (v *Dumper) ExprThrow(n *ast.ExprThrow) {
+	v.print(0, "&ast.ExprThrow{\n")
+	v.indent++
+
+	v.dumpPosition(n.Position)
+	v.dumpToken("ThrowTkn", n.ThrowTkn)
+	v.dumpVertex("Expr", n.Expr)
+
+	v.indent--
+	v.print(v.indent, "},\n")
+}
+
 func (v *Dumper) ExprUnaryMinus(n *ast.ExprUnaryMinus) {
 	v.print(0, "&ast.ExprUnaryMinus{\n")
 	v.indent++
@@ -16,10 +16,14 @@ func TestDumper_root(t *testing.T) {
 	p := dumper.NewDumper(o).WithTokens().WithPositions()
 	n := &ast.Root{
 		Position: &position.Position{
-			StartLine: 1,
-			EndLine:   2,
-			StartPos:  3,
-			EndPos:    4,
+			StartLine:     1,
+			EndLine:       2,
+			StartPos:      3,
+			EndPos:        4,
+			StartCol:      4,
+			EndCol:        1,
+			StartColUTF16: 4,
+			EndColUTF16:   1,
 		},
 		Stmts: []ast.Vertex{
 			&ast.StmtNop{},
@@ -30,10 +34,14 @@ func TestDumper_root(t *testing.T) {
 					ID:    token.T_WHITESPACE,
 					Value: []byte(" "),
 					Position: &position.Position{
-						StartLine: 1,
-						EndLine:   2,
-						StartPos:  3,
-						EndPos:    4,
+						StartLine:     1,
+						EndLine:       2,
+						StartPos:      3,
+						EndPos:        4,
+						StartCol:      4,
+						EndCol:        1,
+						StartColUTF16: 4,
+						EndColUTF16:   1,
 					},
 				},
 			},
@@ -43,10 +51,14 @@ func TestDumper_root(t *testing.T) {
 
 	expected := `&ast.Root{
 	Position: &position.Position{
-		StartLine: 1,
-		EndLine:   2,
-		StartPos:  3,
-		EndPos:    4,
+		StartLine:     1,
+		EndLine:       2,
+		StartPos:      3,
+		EndPos:        4,
+		StartCol:      4,
+		EndCol:        1,
+		StartColUTF16: 4,
+		EndColUTF16:   1,
 	},
 	Stmts: []ast.Vertex{
 		&ast.StmtNop{
@@ -58,10 +70,14 @@ func TestDumper_root(t *testing.T) {
 				ID: token.T_WHITESPACE,
 				Val: []byte(" "),
 				Position: &position.Position{
-					StartLine: 1,
-					EndLine:   2,
-					StartPos:  3,
-					EndPos:    4,
+					StartLine:     1,
+					EndLine:       2,
+					StartPos:      3,
+					EndPos:        4,
+					StartCol:      4,
+					EndCol:        1,
+					StartColUTF16: 4,
+					EndColUTF16:   1,
 				},
 			},
 		},
@@ -0,0 +1,303 @@
+package formatter
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+// comment is a comment taken from the free floating of a token
+type comment struct {
+	token *token.Token
+	// trailing is true if the comment is on the line of the previous token
+	trailing bool
+	// newline is true if the comment is followed by a line break
+	newline bool
+	// spaceBefore and spaceAfter are true if the comment is separated by whitespace
+	spaceBefore bool
+	spaceAfter  bool
+}
+
+// fieldComments are the comments in front of the token of the node field,
+// index is the position in the token list or -1
+type fieldComments struct {
+	field    int
+	index    int
+	comments []comment
+}
+
+// accept formats the child node and puts the comments of its tokens back
+// in front of the formatted tokens
+func (f *formatter) accept(n ast.Vertex) {
+	comments := takeComments(n)
+	n.Accept(f)
+	f.restoreComments(n, comments)
+}
+
+// takeComments removes the comments from the own tokens of the node
+func takeComments(n ast.Vertex) []fieldComments {
+	var result []fieldComments
+
+	v := reflect.ValueOf(n).Elem()
+	for i, fld := range ast.Fields(n) {
+		switch fld.Kind {
+		case ast.FieldToken:
+			t, _ := v.Field(i).Interface().(*token.Token)
+			if c := takeTokenComments(t); c != nil {
+				result = append(result, fieldComments{i, -1, c})
+			}
+		case ast.FieldTokenList:
+			for j, t := range v.Field(i).Interface().([]*token.Token) {
+				if c := takeTokenComments(t); c != nil {
+					result = append(result, fieldComments{i, j, c})
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func takeTokenComments(t *token.Token) []comment {
+	if t == nil {
+		return nil
+	}
+
+	var comments []comment
+	var freeFloating []*token.Token
+	newline := false
+
+	for i, ff := range t.FreeFloating {
+		if ff.ID != token.T_COMMENT && ff.ID != token.T_DOC_COMMENT {
+			freeFloating = append(freeFloating, ff)
+			newline = newline || bytes.IndexByte(ff.Value, '\n') >= 0
+			continue
+		}
+
+		c := comment{
+			token:       ff,
+			trailing:    !newline,
+			newline:     isLineComment(ff),
+			spaceBefore: i > 0 && t.FreeFloating[i-1].ID == token.T_WHITESPACE,
+		}
+		if i+1 < len(t.FreeFloating) && t.FreeFloating[i+1].ID == token.T_WHITESPACE {
+			c.spaceAfter = true
+			c.newline = c.newline || bytes.IndexByte(t.FreeFloating[i+1].Value, '\n') >= 0
+		}
+
+		comments = append(comments, c)
+		newline = newline || bytes.IndexByte(ff.Value, '\n') >= 0
+	}
+
+	if comments != nil {
+		t.FreeFloating = freeFloating
+	}
+
+	return comments
+}
+
+// restoreComments puts the comments in front of the tokens of the same fields,
+// the comments of the removed tokens go to the next token
+func (f *formatter) restoreComments(n ast.Vertex, list []fieldComments) {
+	if len(list) == 0 {
+		return
+	}
+
+	v := reflect.ValueOf(n).Elem()
+
+	var targets []*token.Token
+	comments := map[*token.Token][]comment{}
+
+	for _, fc := range list {
+		t := fieldToken(v, fc.field, fc.index)
+		if t == nil {
+			t = nextFieldToken(n, v, fc.field, fc.index)
+		}
+
+		if t == nil {
+			f.comments = append(f.comments, fc.comments...)
+			continue
+		}
+
+		if _, ok := comments[t]; !ok {
+			targets = append(targets, t)
+		}
+		comments[t] = append(comments[t], fc.comments...)
+	}
+
+	for _, t := range targets {
+		t.FreeFloating = f.withComments(t.FreeFloating, comments[t])
+	}
+}
+
+func fieldToken(v reflect.Value, field, index int) *token.Token {
+	if index < 0 {
+		t, _ := v.Field(field).Interface().(*token.Token)
+		return t
+	}
+
+	list := v.Field(field).Interface().([]*token.Token)
+	if index < len(list) {
+		return list[index]
+	}
+
+	return nil
+}
+
+// nextFieldToken returns the first own token of the node after the field
+func nextFieldToken(n ast.Vertex, v reflect.Value, field, index int) *token.Token {
+	for i, fld := range ast.Fields(n) {
+		if i < field {
+			continue
+		}
+
+		switch fld.Kind {
+		case ast.FieldToken:
+			if i == field {
+				continue
+			}
+			if t := fieldToken(v, i, -1); t != nil {
+				return t
+			}
+		case ast.FieldTokenList:
+			for j, t := range v.Field(i).Interface().([]*token.Token) {
+				if t != nil && (i > field || j > index) {
+					return t
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// withComments inserts the comments into the whitespace in front of a token.
+// The trailing comments stay at the end of the previous line, the other ones
+// go on their own lines if the token starts a line and inline otherwise.
+func (f *formatter) withComments(ff []*token.Token, comments []comment) []*token.Token {
+	if len(comments) == 0 {
+		return ff
+	}
+
+	var result []*token.Token
+	add := func(id token.ID, val []byte) {
+		result = append(result, &token.Token{ID: id, Value: val})
+	}
+
+	if hasNewline(ff) {
+		if len(ff) == 0 || ff[0].ID != token.T_OPEN_TAG {
+			for len(comments) > 0 && comments[0].trailing {
+				add(token.T_WHITESPACE, []byte(" "))
+				result = append(result, trimComment(comments[0].token))
+				comments = comments[1:]
+			}
+		}
+
+		result = append(result, ff...)
+		indent := lastLine(ff)
+
+		for _, c := range comments {
+			result = append(result, reindentComment(trimComment(c.token), indent))
+			if c.newline {
+				add(token.T_WHITESPACE, append([]byte("\n"), indent...))
+			} else {
+				add(token.T_WHITESPACE, []byte(" "))
+			}
+		}
+
+		return result
+	}
+
+	result = append(result, ff...)
+
+	for _, c := range comments {
+		if c.spaceBefore && !endsWithSpace(result) {
+			add(token.T_WHITESPACE, []byte(" "))
+		}
+
+		result = append(result, trimComment(c.token))
+
+		switch {
+		case isLineComment(c.token):
+			add(token.T_WHITESPACE, append([]byte("\n"), f.style.indentation(f.indent+1)...))
+		case c.spaceAfter:
+			add(token.T_WHITESPACE, []byte(" "))
+		}
+	}
+
+	return result
+}
+
+func isLineComment(t *token.Token) bool {
+	return bytes.HasPrefix(t.Value, []byte("//")) || bytes.HasPrefix(t.Value, []byte("#"))
+}
+
+// trimComment removes the line break that ends the line comment
+func trimComment(t *token.Token) *token.Token {
+	if !isLineComment(t) {
+		return t
+	}
+
+	return &token.Token{
+		ID:       t.ID,
+		Value:    bytes.TrimRight(t.Value, "\r\n"),
+		Position: t.Position,
+	}
+}
+
+// reindentComment aligns the * lines of the multi-line doc comment
+// with the comment start at the indent
+func reindentComment(t *token.Token, indent []byte) *token.Token {
+	if t.ID != token.T_DOC_COMMENT || bytes.IndexByte(t.Value, '\n') < 0 {
+		return t
+	}
+
+	lines := bytes.Split(t.Value, []byte("\n"))
+	for i, line := range lines[1:] {
+		line = bytes.TrimLeft(line, " \t")
+		if len(line) > 0 && line[0] == '*' {
+			lines[i+1] = append(append(append([]byte{}, indent...), ' '), line...)
+		}
+	}
+
+	return &token.Token{
+		ID:       t.ID,
+		Value:    bytes.Join(lines, []byte("\n")),
+		Position: t.Position,
+	}
+}
+
+func hasNewline(ff []*token.Token) bool {
+	for _, t := range ff {
+		if t.ID != token.T_COMMENT && t.ID != token.T_DOC_COMMENT && bytes.IndexByte(t.Value, '\n') >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func endsWithSpace(ff []*token.Token) bool {
+	if len(ff) == 0 {
+		return false
+	}
+
+	v := ff[len(ff)-1].Value
+	return len(v) > 0 && (v[len(v)-1] == ' ' || v[len(v)-1] == '\n' || v[len(v)-1] == '\t')
+}
+
+// lastLine returns the whitespace after the last line break
+func lastLine(ff []*token.Token) []byte {
+	var line []byte
+	for i := len(ff) - 1; i >= 0; i-- {
+		v := ff[i].Value
+		if n := bytes.LastIndexByte(v, '\n'); n >= 0 {
+			return append(append([]byte{}, v[n+1:]...), line...)
+		}
+		line = append(append([]byte{}, v...), line...)
+	}
+
+	return line
+}
@@ -0,0 +1,158 @@
+package formatter_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/internal/scanner"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/visitor/formatter"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+)
+
+// comments returns the sorted comments of the source without the line breaks
+func comments(src []byte) []string {
+	var result []string
+
+	lexer := scanner.NewLexer(src, config)
+	for {
+		tkn := lexer.Lex()
+		for _, ff := range tkn.FreeFloating {
+			if ff.ID == token.T_COMMENT || ff.ID == token.T_DOC_COMMENT {
+				result = append(result, string(bytes.TrimRight(ff.Value, "\r\n")))
+			}
+		}
+
+		if tkn.ID == 0 {
+			break
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// addComments puts a comment in front of every token outside the strings
+func addComments(n ast.Vertex, counter *int) {
+	switch n.(type) {
+	case *ast.ScalarEncapsed, *ast.ScalarHeredoc, *ast.StmtInlineHtml:
+		return
+	}
+
+	for _, t := range ast.Tokens(n) {
+		*counter++
+
+		var c *token.Token
+		switch *counter % 4 {
+		case 0:
+			c = &token.Token{ID: token.T_COMMENT, Value: []byte(fmt.Sprintf("/* b%d */", *counter))}
+		case 1:
+			c = &token.Token{ID: token.T_COMMENT, Value: []byte(fmt.Sprintf("// l%d\n", *counter))}
+		case 2:
+			c = &token.Token{ID: token.T_DOC_COMMENT, Value: []byte(fmt.Sprintf("/** d%d */", *counter))}
+		case 3:
+			c = &token.Token{ID: token.T_COMMENT, Value: []byte(fmt.Sprintf("# h%d\n", *counter))}
+		}
+
+		t.FreeFloating = append(t.FreeFloating, c, &token.Token{ID: token.T_WHITESPACE, Value: []byte(" ")})
+	}
+
+	for _, c := range ast.Children(n) {
+		addComments(c.Node, counter)
+	}
+}
+
+func TestFormatterKeepsComments(t *testing.T) {
+	src, err := ioutil.ReadFile("formatter_test.go")
+	assert.NilError(t, err)
+
+	cases := 0
+	for _, m := range regexp.MustCompile("expected := `([^`]*)`").FindAllSubmatch(src, -1) {
+		code := string(m[1])
+		if !strings.HasPrefix(code, "<?php") {
+			code = "<?php " + code
+		}
+
+		root, err := parser.Parse([]byte(code), config)
+		if err != nil {
+			code += ";"
+			root, err = parser.Parse([]byte(code), config)
+		}
+
+		// skip the fragments that are not valid php on their own
+		o := bytes.NewBufferString("")
+		root.Accept(printer.NewPrinter(o))
+		if err != nil || o.String() != code {
+			continue
+		}
+
+		counter := 0
+		addComments(root, &counter)
+
+		o.Reset()
+		root.Accept(printer.NewPrinter(o))
+		commented := o.Bytes()
+
+		actual := format(t, string(commented), formatter.DefaultStyle())
+
+		assert.DeepEqual(t, comments(commented), comments([]byte(actual)))
+		cases++
+	}
+
+	assert.Assert(t, cases > 100, "%d cases", cases)
+}
+
+func TestFormatterCommentsPlacement(t *testing.T) {
+	src := `<?php
+// file comment
+namespace App; // trailing namespace
+class Foo {
+/**
+ * Doc comment
+ */
+public function bar($a) { // after brace
+$a = 1; // one
+# hash
+foo(/* a */ $x, $y /* b */);
+return $a; /* last */
+}
+}
+`
+
+	expected := `<?php
+
+// file comment
+namespace App; // trailing namespace
+class Foo {
+    /**
+     * Doc comment
+     */
+    public function bar($a) { // after brace
+        $a = 1; // one
+        # hash
+        foo(/* a */ $x, $y /* b */);
+        return $a; /* last */
+    }
+}
+`
+
+	assert.Equal(t, expected, format(t, src, formatter.DefaultStyle()))
+}
+
+func TestFormatterDocCommentIndent(t *testing.T) {
+	src := "<?php\nclass Foo {\n/** doc\n   * more */\npublic function bar() {\n        /**\n         * var\n         */\n$a = 1;\n}\n}\n"
+
+	expected := "<?php\n\nclass Foo\n{\n    /** doc\n     * more */\n    public function bar()\n    {\n        /**\n         * var\n         */\n        $a = 1;\n    }\n}\n"
+
+	assert.Equal(t, expected, format(t, src, formatter.PSR12Style()))
+}
@@ -14,15 +14,19 @@ const (
 )
 
 type formatter struct {
+	style        Style
 	state        formatterState
 	indent       int
 	freeFloating []*token.Token
+	comments     []comment
 
 	lastSemiColon *token.Token
 }
 
 func NewFormatter() *formatter {
-	return &formatter{}
+	return &formatter{
+		style: DefaultStyle(),
+	}
 }
 
 func (f *formatter) WithState(state formatterState) *formatter {
@@ -30,6 +34,12 @@ func (f *formatter) WithState(state formatterState) *formatter {
 	return f
 }
 
+// WithStyle sets the code style, see DefaultStyle and PSR12Style
+func (f *formatter) WithStyle(style Style) *formatter {
+	f.style = style
+	return f
+}
+
 func (f *formatter) WithIndent(indent int) *formatter {
 	f.indent = indent
 	return f
@@ -49,10 +59,55 @@ func (f *formatter) addIndent() {
 
 	f.freeFloating = append(f.freeFloating, &token.Token{
 		ID:    token.T_WHITESPACE,
-		Value: bytes.Repeat([]byte("    "), f.indent),
+		Value: f.style.indentation(f.indent),
 	})
 }
 
+// addSpace adds the space if it is required by the style
+func (f *formatter) addSpace(space bool) {
+	if space {
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	}
+}
+
+// addBraceSpace adds the whitespace in front of the opening curly bracket
+func (f *formatter) addBraceSpace(brace BracePlacement) {
+	if brace == BraceNextLine {
+		f.addFreeFloating(token.T_WHITESPACE, []byte("\n"))
+		f.addIndent()
+		return
+	}
+
+	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+}
+
+// addBodySpace adds the whitespace in front of the statement of the control structure
+func (f *formatter) addBodySpace(stmt ast.Vertex) {
+	if isBlock(stmt) {
+		f.addBraceSpace(f.style.ControlBrace)
+		return
+	}
+
+	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+}
+
+// addClauseSpace adds the whitespace in front of the else, elseif, catch, finally
+// or while clause, braced is true if the clause follows the closing curly bracket
+func (f *formatter) addClauseSpace(braced bool) {
+	if braced && f.style.ControlBrace == BraceNextLine {
+		f.addFreeFloating(token.T_WHITESPACE, []byte("\n"))
+		f.addIndent()
+		return
+	}
+
+	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+}
+
+func isBlock(stmt ast.Vertex) bool {
+	_, ok := stmt.(*ast.StmtStmtList)
+	return ok
+}
+
 func (f *formatter) resetFreeFloating() {
 	f.freeFloating = nil
 }
@@ -61,15 +116,24 @@ func (f *formatter) getFreeFloating() []*token.Token {
 	defer f.resetFreeFloating()
 
 	if f.state == FormatterStateHTML {
+		// the open tag ends with the whitespace if none follows it
 		t := &token.Token{
 			ID:    token.T_OPEN_TAG,
 			Value: []byte("<?php "),
 		}
+		if len(f.freeFloating) > 0 && f.freeFloating[0].ID == token.T_WHITESPACE {
+			t.Value = []byte("<?php")
+		}
 		f.freeFloating = append([]*token.Token{t}, f.freeFloating...)
 
 		f.state = FormatterStatePHP
 	}
 
+	if len(f.comments) > 0 {
+		f.freeFloating = f.withComments(f.freeFloating, f.comments)
+		f.comments = nil
+	}
+
 	return f.freeFloating
 }
 
@@ -82,25 +146,73 @@ func (f *formatter) newToken(id token.ID, val []byte) *token.Token {
 }
 
 func (f *formatter) formatList(nodes []ast.Vertex, separator byte) []*token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+
 	separatorTkns := make([]*token.Token, len(nodes)-1)
 	for i, v := range nodes {
-		v.Accept(f)
+		f.accept(v)
 
 		if i != len(nodes)-1 {
 			separatorTkns[i] = f.newToken(token.ID(separator), []byte{separator})
-			f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+			f.addSpace(f.style.SpaceAfterComma)
 		}
 	}
 
 	return separatorTkns
 }
 
+func (f *formatter) formatAttrGroups(groups []ast.Vertex, inline bool) {
+	for _, g := range groups {
+		f.accept(g)
+
+		if inline {
+			f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+			continue
+		}
+
+		f.addFreeFloating(token.T_WHITESPACE, []byte("\n"))
+		f.addIndent()
+	}
+}
+
+// formatSkippedTkns keeps tokens that failed to parse as they are,
+// only the leading free floating of the first one is replaced
+func (f *formatter) formatSkippedTkns(tkns []*token.Token) {
+	if len(tkns) == 0 {
+		return
+	}
+
+	tkns[0].FreeFloating = f.getFreeFloating()
+}
+
 func (f *formatter) formatStmts(list *[]ast.Vertex) {
+	f.formatLines(list, 0)
+}
+
+// formatMembers formats the class members separated by the blank lines of the style
+func (f *formatter) formatMembers(list *[]ast.Vertex) {
+	f.formatLines(list, f.style.BlankLinesBetweenMembers)
+}
+
+func (f *formatter) formatLines(list *[]ast.Vertex, blankLines int) {
 	var insertCounter int
+	var prev ast.Vertex
 
 	for i, stmt := range *list {
 		f.lastSemiColon = nil
 
+		lines := blankLines
+		if f.style.BlankLineAfterHeader && lines == 0 && prev != nil && header(prev) != "" && header(prev) != header(stmt) {
+			lines = 1
+		}
+
+		if i > 0 && lines > 0 {
+			f.addFreeFloating(token.T_WHITESPACE, bytes.Repeat([]byte("\n"), lines))
+		}
+		prev = stmt
+
 		if _, ok := stmt.(*ast.StmtInlineHtml); ok {
 			if f.lastSemiColon != nil {
 				f.lastSemiColon.Value = append(f.lastSemiColon.Value, '?', '>')
@@ -117,7 +229,11 @@ func (f *formatter) formatStmts(list *[]ast.Vertex) {
 			f.addIndent()
 		}
 
-		stmt.Accept(f)
+		f.accept(stmt)
+
+		if f.style.LineWidth > 0 {
+			f.wrap(stmt)
+		}
 	}
 }
 
@@ -126,13 +242,9 @@ func (f *formatter) newSemicolonTkn() *token.Token {
 	return f.lastSemiColon
 }
 
+// insert returns the copy of the slice with the nodes inserted at k,
+// the list is ranged over while the nodes are inserted so it is never changed in place
 func insert(s []ast.Vertex, k int, vs ...ast.Vertex) []ast.Vertex {
-	if n := len(s) + len(vs); n <= cap(s) {
-		s2 := s[:n]
-		copy(s2[k+len(vs):], s[k:])
-		copy(s2[k:], vs)
-		return s2
-	}
 	s2 := make([]ast.Vertex, len(s)+len(vs))
 	copy(s2, s[:k])
 	copy(s2[k:], vs)
@@ -145,16 +257,32 @@ func (f *formatter) Root(n *ast.Root) {
 	f.addIndent()
 
 	f.formatStmts(&n.Stmts)
+
+	if len(f.comments) > 0 {
+		if n.EndTkn == nil {
+			n.EndTkn = &token.Token{}
+		}
+
+		f.addFreeFloating(token.T_WHITESPACE, []byte("\n"))
+		n.EndTkn.FreeFloating = append(f.getFreeFloating(), n.EndTkn.FreeFloating...)
+	}
 }
 
 func (f *formatter) Nullable(n *ast.Nullable) {
 	n.QuestionTkn = f.newToken('?', []byte("?"))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) Parameter(n *ast.Parameter) {
+	f.formatAttrGroups(n.AttrGroups, true)
+
+	for _, m := range n.Modifiers {
+		f.accept(m)
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	}
+
 	if n.Type != nil {
-		n.Type.Accept(f)
+		f.accept(n.Type)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
@@ -166,13 +294,13 @@ func (f *formatter) Parameter(n *ast.Parameter) {
 		n.VariadicTkn = f.newToken(token.T_ELLIPSIS, []byte("..."))
 	}
 
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
 	if n.DefaultValue != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.EqualTkn = f.newToken('=', []byte("="))
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.DefaultValue.Accept(f)
+		f.accept(n.DefaultValue)
 	}
 }
 
@@ -185,6 +313,13 @@ func (f *formatter) Identifier(n *ast.Identifier) {
 }
 
 func (f *formatter) Argument(n *ast.Argument) {
+	n.ColonTkn = nil
+	if n.Name != nil {
+		f.accept(n.Name)
+		n.ColonTkn = f.newToken(':', []byte(":"))
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	}
+
 	if n.VariadicTkn != nil {
 		n.VariadicTkn = f.newToken(token.T_ELLIPSIS, []byte("..."))
 	}
@@ -193,7 +328,70 @@ func (f *formatter) Argument(n *ast.Argument) {
 		n.AmpersandTkn = f.newToken('&', []byte("&"))
 	}
 
-	n.Expr.Accept(f)
+	if n.Expr != nil {
+		f.accept(n.Expr)
+	}
+}
+
+func (f *formatter) Attribute(n *ast.Attribute) {
+	f.accept(n.Name)
+
+	n.OpenParenthesisTkn = nil
+	n.SeparatorTkns = nil
+	n.CloseParenthesisTkn = nil
+	if len(n.Args) > 0 {
+		n.OpenParenthesisTkn = f.newToken('(', []byte("("))
+		n.SeparatorTkns = f.formatList(n.Args, ',')
+		n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
+	}
+}
+
+func (f *formatter) AttributeGroup(n *ast.AttributeGroup) {
+	n.OpenAttributeTkn = f.newToken(token.T_ATTRIBUTE, []byte("#["))
+	n.SeparatorTkns = f.formatList(n.Attrs, ',')
+	n.CloseAttributeTkn = f.newToken(']', []byte("]"))
+}
+
+func (f *formatter) Union(n *ast.Union) {
+	n.SeparatorTkns = make([]*token.Token, len(n.Types)-1)
+	for i, t := range n.Types {
+		f.accept(t)
+
+		if i != len(n.Types)-1 {
+			n.SeparatorTkns[i] = f.newToken('|', []byte("|"))
+		}
+	}
+}
+
+func (f *formatter) Intersection(n *ast.Intersection) {
+	n.SeparatorTkns = make([]*token.Token, len(n.Types)-1)
+	for i, t := range n.Types {
+		f.accept(t)
+
+		if i != len(n.Types)-1 {
+			n.SeparatorTkns[i] = f.newToken('&', []byte("&"))
+		}
+	}
+}
+
+func (f *formatter) MatchArm(n *ast.MatchArm) {
+	n.DefaultCommaTkn = nil
+	n.SeparatorTkns = nil
+	if n.DefaultTkn != nil {
+		n.DefaultTkn = f.newToken(token.T_DEFAULT, []byte("default"))
+	} else {
+		n.SeparatorTkns = f.formatList(n.Exprs, ',')
+	}
+
+	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	n.DoubleArrowTkn = f.newToken(token.T_DOUBLE_ARROW, []byte("=>"))
+	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+
+	f.accept(n.ReturnExpr)
+}
+
+func (f *formatter) BadStmt(n *ast.BadStmt) {
+	f.formatSkippedTkns(n.SkippedTkns)
 }
 
 func (f *formatter) StmtBreak(n *ast.StmtBreak) {
@@ -201,7 +399,7 @@ func (f *formatter) StmtBreak(n *ast.StmtBreak) {
 
 	if n.Expr != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.Expr.Accept(f)
+		f.accept(n.Expr)
 	}
 
 	n.SemiColonTkn = f.newSemicolonTkn()
@@ -211,7 +409,7 @@ func (f *formatter) StmtCase(n *ast.StmtCase) {
 	n.CaseTkn = f.newToken(token.T_CASE, []byte("case"))
 
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Cond.Accept(f)
+	f.accept(n.Cond)
 
 	n.CaseSeparatorTkn = f.newToken(':', []byte(":"))
 
@@ -228,7 +426,7 @@ func (f *formatter) StmtCatch(n *ast.StmtCatch) {
 
 	n.SeparatorTkns = make([]*token.Token, len(n.Types)-1)
 	for i, t := range n.Types {
-		t.Accept(f)
+		f.accept(t)
 
 		if i != len(n.Types)-1 {
 			f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
@@ -239,11 +437,11 @@ func (f *formatter) StmtCatch(n *ast.StmtCatch) {
 
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addBraceSpace(f.style.ControlBrace)
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
 
 	if len(n.Stmts) > 0 {
@@ -259,15 +457,17 @@ func (f *formatter) StmtCatch(n *ast.StmtCatch) {
 }
 
 func (f *formatter) StmtClass(n *ast.StmtClass) {
+	f.formatAttrGroups(n.AttrGroups, false)
+
 	for _, m := range n.Modifiers {
-		m.Accept(f)
+		f.accept(m)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
 	n.ClassTkn = f.newToken(token.T_CLASS, []byte("class"))
 
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Name.Accept(f)
+	f.accept(n.Name)
 
 	n.OpenParenthesisTkn = nil
 	n.CloseParenthesisTkn = nil
@@ -279,24 +479,26 @@ func (f *formatter) StmtClass(n *ast.StmtClass) {
 		n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 	}
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	if n.Extends != nil {
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.ExtendsTkn = f.newToken(token.T_EXTENDS, []byte("extends"))
-		n.Extends.Accept(f)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+		f.accept(n.Extends)
 	}
 
 	if n.Implements != nil {
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.ImplementsTkn = f.newToken(token.T_IMPLEMENTS, []byte("implements"))
-		n.ImplementsSeparatorTkns = f.formatList(n.Implements, ',')
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+		n.ImplementsSeparatorTkns = f.formatList(n.Implements, ',')
 	}
 
+	f.addBraceSpace(f.style.ClassBrace)
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
 
 	if len(n.Stmts) > 0 {
 		f.indent++
-		f.formatStmts(&n.Stmts)
+		f.formatMembers(&n.Stmts)
 		f.indent--
 
 		f.addFreeFloating(token.T_WHITESPACE, []byte("\n"))
@@ -307,22 +509,31 @@ func (f *formatter) StmtClass(n *ast.StmtClass) {
 }
 
 func (f *formatter) StmtClassConstList(n *ast.StmtClassConstList) {
+	f.formatAttrGroups(n.AttrGroups, false)
+
 	for _, m := range n.Modifiers {
-		m.Accept(f)
+		f.accept(m)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
 	n.ConstTkn = f.newToken(token.T_CONST, []byte("const"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
+	if n.Type != nil {
+		f.accept(n.Type)
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	}
+
 	n.SeparatorTkns = f.formatList(n.Consts, ',')
 
 	n.SemiColonTkn = f.newSemicolonTkn()
 }
 
 func (f *formatter) StmtClassMethod(n *ast.StmtClassMethod) {
+	f.formatAttrGroups(n.AttrGroups, false)
+
 	for _, m := range n.Modifiers {
-		m.Accept(f)
+		f.accept(m)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
@@ -333,7 +544,7 @@ func (f *formatter) StmtClassMethod(n *ast.StmtClassMethod) {
 		n.AmpersandTkn = f.newToken('&', []byte("&"))
 	}
 
-	n.Name.Accept(f)
+	f.accept(n.Name)
 
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
 
@@ -348,11 +559,15 @@ func (f *formatter) StmtClassMethod(n *ast.StmtClassMethod) {
 		n.ColonTkn = f.newToken(':', []byte(":"))
 
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.ReturnType.Accept(f)
+		f.accept(n.ReturnType)
 	}
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Stmt.Accept(f)
+	if _, ok := n.Stmt.(*ast.StmtNop); ok {
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	} else {
+		f.addBraceSpace(f.style.FunctionBrace)
+	}
+	f.accept(n.Stmt)
 }
 
 func (f *formatter) StmtConstList(n *ast.StmtConstList) {
@@ -365,13 +580,13 @@ func (f *formatter) StmtConstList(n *ast.StmtConstList) {
 }
 
 func (f *formatter) StmtConstant(n *ast.StmtConstant) {
-	n.Name.Accept(f)
+	f.accept(n.Name)
 
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	n.EqualTkn = f.newToken('=', []byte("="))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) StmtContinue(n *ast.StmtContinue) {
@@ -379,7 +594,7 @@ func (f *formatter) StmtContinue(n *ast.StmtContinue) {
 
 	if n.Expr != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.Expr.Accept(f)
+		f.accept(n.Expr)
 	}
 
 	n.SemiColonTkn = f.newSemicolonTkn()
@@ -400,8 +615,8 @@ func (f *formatter) StmtDeclare(n *ast.StmtDeclare) {
 
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Stmt.Accept(f)
+	f.addBodySpace(n.Stmt)
+	f.accept(n.Stmt)
 }
 
 func (f *formatter) StmtDefault(n *ast.StmtDefault) {
@@ -417,14 +632,15 @@ func (f *formatter) StmtDefault(n *ast.StmtDefault) {
 func (f *formatter) StmtDo(n *ast.StmtDo) {
 	n.DoTkn = f.newToken(token.T_DO, []byte("do"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Stmt.Accept(f)
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addBodySpace(n.Stmt)
+	f.accept(n.Stmt)
+	f.addClauseSpace(isBlock(n.Stmt))
 
 	n.WhileTkn = f.newToken(token.T_WHILE, []byte("while"))
+	f.addSpace(f.style.SpaceAfterKeyword)
 
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
-	n.Cond.Accept(f)
+	f.accept(n.Cond)
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 
 	n.SemiColonTkn = f.newSemicolonTkn()
@@ -447,32 +663,89 @@ func (f *formatter) StmtElse(n *ast.StmtElse) {
 
 	n.ElseTkn = f.newToken(token.T_ELSE, []byte("else"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Stmt.Accept(f)
+	f.addBodySpace(n.Stmt)
+	f.accept(n.Stmt)
 }
 
 func (f *formatter) StmtElseIf(n *ast.StmtElseIf) {
 	n.ColonTkn = nil
 
 	n.ElseIfTkn = f.newToken(token.T_ELSEIF, []byte("elseif"))
+	f.addSpace(f.style.SpaceAfterKeyword)
 
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
-	n.Cond.Accept(f)
+	f.accept(n.Cond)
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 
+	f.addBodySpace(n.Stmt)
+	f.accept(n.Stmt)
+}
+
+func (f *formatter) StmtEnum(n *ast.StmtEnum) {
+	f.formatAttrGroups(n.AttrGroups, false)
+
+	n.EnumTkn = f.newToken(token.T_ENUM, []byte("enum"))
+
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Stmt.Accept(f)
+	f.accept(n.Name)
+
+	n.ColonTkn = nil
+	if n.Type != nil {
+		n.ColonTkn = f.newToken(':', []byte(":"))
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+		f.accept(n.Type)
+	}
+
+	if n.Implements != nil {
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+		n.ImplementsTkn = f.newToken(token.T_IMPLEMENTS, []byte("implements"))
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+		n.ImplementsSeparatorTkns = f.formatList(n.Implements, ',')
+	}
+
+	f.addBraceSpace(f.style.ClassBrace)
+	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
+
+	if len(n.Stmts) > 0 {
+		f.indent++
+		f.formatMembers(&n.Stmts)
+		f.indent--
+
+		f.addFreeFloating(token.T_WHITESPACE, []byte("\n"))
+		f.addIndent()
+	}
+
+	n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
+}
+
+func (f *formatter) StmtEnumCase(n *ast.StmtEnumCase) {
+	f.formatAttrGroups(n.AttrGroups, false)
+
+	n.CaseTkn = f.newToken(token.T_CASE, []byte("case"))
+	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+
+	f.accept(n.Name)
+
+	n.EqualTkn = nil
+	if n.Expr != nil {
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+		n.EqualTkn = f.newToken('=', []byte("="))
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+		f.accept(n.Expr)
+	}
+
+	n.SemiColonTkn = f.newSemicolonTkn()
 }
 
 func (f *formatter) StmtExpression(n *ast.StmtExpression) {
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 	n.SemiColonTkn = f.newSemicolonTkn()
 }
 
 func (f *formatter) StmtFinally(n *ast.StmtFinally) {
 	n.FinallyTkn = f.newToken(token.T_FINALLY, []byte("finally"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addBraceSpace(f.style.ControlBrace)
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
 
 	if len(n.Stmts) > 0 {
@@ -493,6 +766,7 @@ func (f *formatter) StmtFor(n *ast.StmtFor) {
 	n.SemiColonTkn = nil
 
 	n.ForTkn = f.newToken(token.T_FOR, []byte("for"))
+	f.addSpace(f.style.SpaceAfterKeyword)
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
 
 	n.InitSeparatorTkns = nil
@@ -518,8 +792,8 @@ func (f *formatter) StmtFor(n *ast.StmtFor) {
 
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Stmt.Accept(f)
+	f.addBodySpace(n.Stmt)
+	f.accept(n.Stmt)
 }
 
 func (f *formatter) StmtForeach(n *ast.StmtForeach) {
@@ -528,17 +802,18 @@ func (f *formatter) StmtForeach(n *ast.StmtForeach) {
 	n.SemiColonTkn = nil
 
 	n.ForeachTkn = f.newToken(token.T_FOREACH, []byte("foreach"))
+	f.addSpace(f.style.SpaceAfterKeyword)
 
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	n.AsTkn = f.newToken(token.T_AS, []byte("as"))
 
 	if n.Key != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.Key.Accept(f)
+		f.accept(n.Key)
 
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.DoubleArrowTkn = f.newToken(token.T_DOUBLE_ARROW, []byte("=>"))
@@ -548,15 +823,17 @@ func (f *formatter) StmtForeach(n *ast.StmtForeach) {
 	if n.AmpersandTkn != nil {
 		n.AmpersandTkn = f.newToken('&', []byte("&"))
 	}
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Stmt.Accept(f)
+	f.addBodySpace(n.Stmt)
+	f.accept(n.Stmt)
 }
 
 func (f *formatter) StmtFunction(n *ast.StmtFunction) {
+	f.formatAttrGroups(n.AttrGroups, false)
+
 	n.FunctionTkn = f.newToken(token.T_FUNCTION, []byte("function"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
@@ -564,7 +841,7 @@ func (f *formatter) StmtFunction(n *ast.StmtFunction) {
 		n.AmpersandTkn = f.newToken('&', []byte("&"))
 	}
 
-	n.Name.Accept(f)
+	f.accept(n.Name)
 
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
 
@@ -579,11 +856,10 @@ func (f *formatter) StmtFunction(n *ast.StmtFunction) {
 		n.ColonTkn = f.newToken(':', []byte(":"))
 
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.ReturnType.Accept(f)
+		f.accept(n.ReturnType)
 	}
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-
+	f.addBraceSpace(f.style.FunctionBrace)
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
 
 	if len(n.Stmts) > 0 {
@@ -614,7 +890,7 @@ func (f *formatter) StmtGoto(n *ast.StmtGoto) {
 	n.GotoTkn = f.newToken(token.T_GOTO, []byte("goto"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Label.Accept(f)
+	f.accept(n.Label)
 
 	n.SemiColonTkn = f.newSemicolonTkn()
 }
@@ -635,20 +911,22 @@ func (f *formatter) StmtIf(n *ast.StmtIf) {
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
-	n.Cond.Accept(f)
+	f.accept(n.Cond)
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Stmt.Accept(f)
+	f.addBodySpace(n.Stmt)
+	f.accept(n.Stmt)
 
-	if len(n.ElseIf) > 0 {
-		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		f.formatList(n.ElseIf, ' ')
+	prev := n.Stmt
+	for _, elseIf := range n.ElseIf {
+		f.addClauseSpace(isBlock(prev))
+		f.accept(elseIf)
+		prev = elseIf.(*ast.StmtElseIf).Stmt
 	}
 
 	if n.Else != nil {
-		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.Else.Accept(f)
+		f.addClauseSpace(isBlock(prev))
+		f.accept(n.Else)
 	}
 }
 
@@ -658,23 +936,26 @@ func (f *formatter) StmtInlineHtml(n *ast.StmtInlineHtml) {
 }
 
 func (f *formatter) StmtInterface(n *ast.StmtInterface) {
+	f.formatAttrGroups(n.AttrGroups, false)
+
 	n.InterfaceTkn = f.newToken(token.T_INTERFACE, []byte("interface"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Name.Accept(f)
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.accept(n.Name)
 
 	if n.Extends != nil {
+		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.ExtendsTkn = f.newToken(token.T_EXTENDS, []byte("extends"))
-		n.ExtendsSeparatorTkns = f.formatList(n.Extends, ',')
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+		n.ExtendsSeparatorTkns = f.formatList(n.Extends, ',')
 	}
 
+	f.addBraceSpace(f.style.ClassBrace)
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
 
 	if len(n.Stmts) > 0 {
 		f.indent++
-		f.formatStmts(&n.Stmts)
+		f.formatMembers(&n.Stmts)
 		f.indent--
 
 		f.addFreeFloating(token.T_WHITESPACE, []byte("\n"))
@@ -685,7 +966,7 @@ func (f *formatter) StmtInterface(n *ast.StmtInterface) {
 }
 
 func (f *formatter) StmtLabel(n *ast.StmtLabel) {
-	n.Name.Accept(f)
+	f.accept(n.Name)
 	n.ColonTkn = f.newToken(':', []byte(":"))
 }
 
@@ -698,11 +979,11 @@ func (f *formatter) StmtNamespace(n *ast.StmtNamespace) {
 
 	if n.Name != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.Name.Accept(f)
+		f.accept(n.Name)
 	}
 
 	if len(n.Stmts) > 0 {
-		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+		f.addBraceSpace(f.style.ClassBrace)
 		n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
 		if len(n.Stmts) > 0 {
 			f.indent++
@@ -724,25 +1005,27 @@ func (f *formatter) StmtNop(n *ast.StmtNop) {
 }
 
 func (f *formatter) StmtProperty(n *ast.StmtProperty) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
 	if n.Expr != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.EqualTkn = f.newToken('=', []byte("="))
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-		n.Expr.Accept(f)
+		f.accept(n.Expr)
 	}
 }
 
 func (f *formatter) StmtPropertyList(n *ast.StmtPropertyList) {
+	f.formatAttrGroups(n.AttrGroups, false)
+
 	for _, m := range n.Modifiers {
-		m.Accept(f)
+		f.accept(m)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
 	if n.Type != nil {
-		n.Type.Accept(f)
+		f.accept(n.Type)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
@@ -756,7 +1039,7 @@ func (f *formatter) StmtReturn(n *ast.StmtReturn) {
 
 	if n.Expr != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.Expr.Accept(f)
+		f.accept(n.Expr)
 	}
 
 	n.SemiColonTkn = f.newSemicolonTkn()
@@ -775,14 +1058,14 @@ func (f *formatter) StmtStatic(n *ast.StmtStatic) {
 }
 
 func (f *formatter) StmtStaticVar(n *ast.StmtStaticVar) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
 	if n.Expr != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.EqualTkn = f.newToken('=', []byte("="))
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-		n.Expr.Accept(f)
+		f.accept(n.Expr)
 	}
 }
 
@@ -808,12 +1091,13 @@ func (f *formatter) StmtSwitch(n *ast.StmtSwitch) {
 	n.SemiColonTkn = nil
 
 	n.SwitchTkn = f.newToken(token.T_SWITCH, []byte("switch"))
+	f.addSpace(f.style.SpaceAfterKeyword)
 
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
-	n.Cond.Accept(f)
+	f.accept(n.Cond)
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addBraceSpace(f.style.ControlBrace)
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
 
 	if len(n.Cases) > 0 {
@@ -832,23 +1116,25 @@ func (f *formatter) StmtThrow(n *ast.StmtThrow) {
 	n.ThrowTkn = f.newToken(token.T_THROW, []byte("throw"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 
 	n.SemiColonTkn = f.newSemicolonTkn()
 }
 
 func (f *formatter) StmtTrait(n *ast.StmtTrait) {
+	f.formatAttrGroups(n.AttrGroups, false)
+
 	n.TraitTkn = f.newToken(token.T_TRAIT, []byte("trait"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Name.Accept(f)
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.accept(n.Name)
+	f.addBraceSpace(f.style.ClassBrace)
 
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
 
 	if len(n.Stmts) > 0 {
 		f.indent++
-		f.formatStmts(&n.Stmts)
+		f.formatMembers(&n.Stmts)
 		f.indent--
 
 		f.addFreeFloating(token.T_WHITESPACE, []byte("\n"))
@@ -889,22 +1175,22 @@ func (f *formatter) StmtTraitUse(n *ast.StmtTraitUse) {
 
 func (f *formatter) StmtTraitUseAlias(n *ast.StmtTraitUseAlias) {
 	if n.Trait != nil {
-		n.Trait.Accept(f)
+		f.accept(n.Trait)
 		n.DoubleColonTkn = f.newToken(token.T_PAAMAYIM_NEKUDOTAYIM, []byte("::"))
 	}
 
-	n.Method.Accept(f)
+	f.accept(n.Method)
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	n.AsTkn = f.newToken(token.T_AS, []byte("as"))
 
 	if n.Modifier != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.Modifier.Accept(f)
+		f.accept(n.Modifier)
 	}
 
 	if n.Alias != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.Alias.Accept(f)
+		f.accept(n.Alias)
 	}
 
 	n.SemiColonTkn = f.newSemicolonTkn()
@@ -912,11 +1198,11 @@ func (f *formatter) StmtTraitUseAlias(n *ast.StmtTraitUseAlias) {
 
 func (f *formatter) StmtTraitUsePrecedence(n *ast.StmtTraitUsePrecedence) {
 	if n.Trait != nil {
-		n.Trait.Accept(f)
+		f.accept(n.Trait)
 		n.DoubleColonTkn = f.newToken(token.T_PAAMAYIM_NEKUDOTAYIM, []byte("::"))
 	}
 
-	n.Method.Accept(f)
+	f.accept(n.Method)
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	n.InsteadofTkn = f.newToken(token.T_INSTEADOF, []byte("insteadof"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
@@ -929,7 +1215,7 @@ func (f *formatter) StmtTraitUsePrecedence(n *ast.StmtTraitUsePrecedence) {
 func (f *formatter) StmtTry(n *ast.StmtTry) {
 	n.TryTkn = f.newToken(token.T_TRY, []byte("try"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addBraceSpace(f.style.ControlBrace)
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
 
 	if len(n.Stmts) > 0 {
@@ -944,13 +1230,13 @@ func (f *formatter) StmtTry(n *ast.StmtTry) {
 	n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
 
 	for _, catch := range n.Catches {
-		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		catch.Accept(f)
+		f.addClauseSpace(true)
+		f.accept(catch)
 	}
 
 	if n.Finally != nil {
-		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.Finally.Accept(f)
+		f.addClauseSpace(true)
+		f.accept(n.Finally)
 	}
 }
 
@@ -969,7 +1255,7 @@ func (f *formatter) StmtUse(n *ast.StmtUseList) {
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
 	if n.Type != nil {
-		n.Type.Accept(f)
+		f.accept(n.Type)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
@@ -983,13 +1269,13 @@ func (f *formatter) StmtGroupUse(n *ast.StmtGroupUseList) {
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
 	if n.Type != nil {
-		n.Type.Accept(f)
+		f.accept(n.Type)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
 	n.LeadingNsSeparatorTkn = nil
 
-	n.Prefix.Accept(f)
+	f.accept(n.Prefix)
 	n.NsSeparatorTkn = f.newToken(token.T_NS_SEPARATOR, []byte("\\"))
 
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
@@ -1001,19 +1287,19 @@ func (f *formatter) StmtGroupUse(n *ast.StmtGroupUseList) {
 
 func (f *formatter) StmtUseDeclaration(n *ast.StmtUse) {
 	if n.Type != nil {
-		n.Type.Accept(f)
+		f.accept(n.Type)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
 	n.NsSeparatorTkn = nil
 
-	n.Use.Accept(f)
+	f.accept(n.Use)
 
 	if n.Alias != nil {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.AsTkn = f.newToken(token.T_AS, []byte("as"))
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.Alias.Accept(f)
+		f.accept(n.Alias)
 	}
 }
 
@@ -1023,12 +1309,17 @@ func (f *formatter) StmtWhile(n *ast.StmtWhile) {
 	n.SemiColonTkn = nil
 
 	n.WhileTkn = f.newToken(token.T_WHILE, []byte("while"))
+	f.addSpace(f.style.SpaceAfterKeyword)
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
-	n.Cond.Accept(f)
+	f.accept(n.Cond)
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Stmt.Accept(f)
+	f.addBodySpace(n.Stmt)
+	f.accept(n.Stmt)
+}
+
+func (f *formatter) BadExpr(n *ast.BadExpr) {
+	f.formatSkippedTkns(n.SkippedTkns)
 }
 
 func (f *formatter) ExprArray(n *ast.ExprArray) {
@@ -1039,9 +1330,11 @@ func (f *formatter) ExprArray(n *ast.ExprArray) {
 }
 
 func (f *formatter) ExprArrayDimFetch(n *ast.ExprArrayDimFetch) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 	n.OpenBracketTkn = f.newToken('[', []byte("["))
-	n.Dim.Accept(f)
+	if n.Dim != nil {
+		f.accept(n.Dim)
+	}
 	n.CloseBracketTkn = f.newToken(']', []byte("]"))
 }
 
@@ -1051,22 +1344,27 @@ func (f *formatter) ExprArrayItem(n *ast.ExprArrayItem) {
 	}
 
 	if n.Key != nil {
-		n.Key.Accept(f)
+		f.accept(n.Key)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.DoubleArrowTkn = f.newToken(token.T_DOUBLE_ARROW, []byte("=>"))
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
-	n.Val.Accept(f)
+	if n.Val != nil {
+		f.accept(n.Val)
+	}
 }
 
 func (f *formatter) ExprArrowFunction(n *ast.ExprArrowFunction) {
+	f.formatAttrGroups(n.AttrGroups, true)
+
 	if n.StaticTkn != nil {
 		n.StaticTkn = f.newToken(token.T_STATIC, []byte("static"))
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
 	n.FnTkn = f.newToken(token.T_FN, []byte("fn"))
+	f.addSpace(f.style.SpaceAfterKeyword)
 
 	if n.AmpersandTkn != nil {
 		n.AmpersandTkn = f.newToken('&', []byte("&"))
@@ -1084,51 +1382,64 @@ func (f *formatter) ExprArrowFunction(n *ast.ExprArrowFunction) {
 		n.ColonTkn = f.newToken(':', []byte(":"))
 
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.ReturnType.Accept(f)
+		f.accept(n.ReturnType)
 	}
 
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	n.DoubleArrowTkn = f.newToken(token.T_DOUBLE_ARROW, []byte("=>"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprBitwiseNot(n *ast.ExprBitwiseNot) {
 	n.TildaTkn = f.newToken('~', []byte("~"))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprBooleanNot(n *ast.ExprBooleanNot) {
 	n.ExclamationTkn = f.newToken('!', []byte("!"))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprBrackets(n *ast.ExprBrackets) {
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 }
 
 func (f *formatter) ExprClassConstFetch(n *ast.ExprClassConstFetch) {
-	n.Class.Accept(f)
+	f.accept(n.Class)
 	n.DoubleColonTkn = f.newToken(token.T_PAAMAYIM_NEKUDOTAYIM, []byte("::"))
-	n.Const.Accept(f)
+
+	n.OpenCurlyBracketTkn = nil
+	n.CloseCurlyBracketTkn = nil
+	if _, ok := n.Const.(*ast.Identifier); !ok {
+		n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
+		f.accept(n.Const)
+		n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
+		return
+	}
+
+	f.accept(n.Const)
 }
 
 func (f *formatter) ExprClone(n *ast.ExprClone) {
 	n.CloneTkn = f.newToken(token.T_CLONE, []byte("clone"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprClosure(n *ast.ExprClosure) {
+	f.formatAttrGroups(n.AttrGroups, true)
+
 	if n.StaticTkn != nil {
 		n.StaticTkn = f.newToken(token.T_STATIC, []byte("static"))
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
-	n.FunctionTkn = f.newToken(token.T_FN, []byte("function"))
+	n.FunctionTkn = f.newToken(token.T_FUNCTION, []byte("function"))
+	f.addSpace(f.style.SpaceAfterKeyword)
 
 	if n.AmpersandTkn != nil {
 		n.AmpersandTkn = f.newToken('&', []byte("&"))
@@ -1148,9 +1459,10 @@ func (f *formatter) ExprClosure(n *ast.ExprClosure) {
 	if len(n.Uses) > 0 {
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.UseTkn = f.newToken(token.T_USE, []byte("use"))
-		n.OpenParenthesisTkn = f.newToken('(', []byte("("))
-		n.SeparatorTkns = f.formatList(n.Uses, ',')
-		n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
+		f.addSpace(f.style.SpaceAfterKeyword)
+		n.UseOpenParenthesisTkn = f.newToken('(', []byte("("))
+		n.UseSeparatorTkns = f.formatList(n.Uses, ',')
+		n.UseCloseParenthesisTkn = f.newToken(')', []byte(")"))
 	}
 
 	n.ColonTkn = nil
@@ -1158,10 +1470,10 @@ func (f *formatter) ExprClosure(n *ast.ExprClosure) {
 		n.ColonTkn = f.newToken(':', []byte(":"))
 
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.ReturnType.Accept(f)
+		f.accept(n.ReturnType)
 	}
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addBraceSpace(f.style.ClosureBrace)
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
 	if len(n.Stmts) > 0 {
 		f.indent++
@@ -1179,29 +1491,29 @@ func (f *formatter) ExprClosureUse(n *ast.ExprClosureUse) {
 		n.AmpersandTkn = f.newToken('&', []byte("&"))
 	}
 
-	n.Var.Accept(f)
+	f.accept(n.Var)
 }
 
 func (f *formatter) ExprConstFetch(n *ast.ExprConstFetch) {
-	n.Const.Accept(f)
+	f.accept(n.Const)
 }
 
 func (f *formatter) ExprEmpty(n *ast.ExprEmpty) {
 	n.EmptyTkn = f.newToken(token.T_EMPTY, []byte("empty"))
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 }
 
 func (f *formatter) ExprErrorSuppress(n *ast.ExprErrorSuppress) {
 	n.AtTkn = f.newToken('@', []byte("@"))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprEval(n *ast.ExprEval) {
 	n.EvalTkn = f.newToken(token.T_EVAL, []byte("eval"))
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 }
 
@@ -1212,13 +1524,13 @@ func (f *formatter) ExprExit(n *ast.ExprExit) {
 	n.CloseParenthesisTkn = nil
 	if n.Expr != nil {
 		n.OpenParenthesisTkn = f.newToken('(', []byte("("))
-		n.Expr.Accept(f)
+		f.accept(n.Expr)
 		n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
 	}
 }
 
 func (f *formatter) ExprFunctionCall(n *ast.ExprFunctionCall) {
-	n.Function.Accept(f)
+	f.accept(n.Function)
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
 	n.SeparatorTkns = nil
 	if len(n.Args) > 0 {
@@ -1230,23 +1542,23 @@ func (f *formatter) ExprFunctionCall(n *ast.ExprFunctionCall) {
 func (f *formatter) ExprInclude(n *ast.ExprInclude) {
 	n.IncludeTkn = f.newToken(token.T_INCLUDE, []byte("include"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprIncludeOnce(n *ast.ExprIncludeOnce) {
 	n.IncludeOnceTkn = f.newToken(token.T_INCLUDE_ONCE, []byte("include_once"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprInstanceOf(n *ast.ExprInstanceOf) {
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	n.InstanceOfTkn = f.newToken(token.T_INSTANCEOF, []byte("instanceof"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Class.Accept(f)
+	f.accept(n.Class)
 }
 
 func (f *formatter) ExprIsset(n *ast.ExprIsset) {
@@ -1263,8 +1575,38 @@ func (f *formatter) ExprList(n *ast.ExprList) {
 	n.CloseBracketTkn = f.newToken(')', []byte(")"))
 }
 
+func (f *formatter) ExprMatch(n *ast.ExprMatch) {
+	n.MatchTkn = f.newToken(token.T_MATCH, []byte("match"))
+	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
+	f.accept(n.Expr)
+	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
+	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+
+	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
+
+	n.SeparatorTkns = nil
+	if len(n.Arms) > 0 {
+		f.indent++
+		for i, arm := range n.Arms {
+			f.addFreeFloating(token.T_WHITESPACE, []byte("\n"))
+			f.addIndent()
+			f.accept(arm)
+			if i < len(n.Arms)-1 || f.style.TrailingComma {
+				n.SeparatorTkns = append(n.SeparatorTkns, f.newToken(',', []byte(",")))
+			}
+		}
+		f.indent--
+
+		f.addFreeFloating(token.T_WHITESPACE, []byte("\n"))
+		f.addIndent()
+	}
+
+	n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
+}
+
 func (f *formatter) ExprMethodCall(n *ast.ExprMethodCall) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 	n.ObjectOperatorTkn = f.newToken(token.T_OBJECT_OPERATOR, []byte("->"))
 
 	n.OpenCurlyBracketTkn = nil
@@ -1277,7 +1619,7 @@ func (f *formatter) ExprMethodCall(n *ast.ExprMethodCall) {
 		n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
 	}
 
-	n.Method.Accept(f)
+	f.accept(n.Method)
 
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
 	n.SeparatorTkns = nil
@@ -1291,7 +1633,7 @@ func (f *formatter) ExprNew(n *ast.ExprNew) {
 	n.NewTkn = f.newToken(token.T_NEW, []byte("new"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Class.Accept(f)
+	f.accept(n.Class)
 
 	n.SeparatorTkns = nil
 	n.OpenParenthesisTkn = nil
@@ -1303,35 +1645,76 @@ func (f *formatter) ExprNew(n *ast.ExprNew) {
 	}
 }
 
+func (f *formatter) ExprNullsafeMethodCall(n *ast.ExprNullsafeMethodCall) {
+	f.accept(n.Var)
+	n.ObjectOperatorTkn = f.newToken(token.T_NULLSAFE_OBJECT_OPERATOR, []byte("?->"))
+
+	n.OpenCurlyBracketTkn = nil
+	n.CloseCurlyBracketTkn = nil
+	switch n.Method.(type) {
+	case *ast.Identifier:
+	case *ast.ExprVariable:
+	default:
+		n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
+		n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
+	}
+
+	f.accept(n.Method)
+
+	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
+	n.SeparatorTkns = nil
+	if len(n.Args) > 0 {
+		n.SeparatorTkns = f.formatList(n.Args, ',')
+	}
+	n.CloseParenthesisTkn = f.newToken(')', []byte(")"))
+}
+
+func (f *formatter) ExprNullsafePropertyFetch(n *ast.ExprNullsafePropertyFetch) {
+	f.accept(n.Var)
+	n.ObjectOperatorTkn = f.newToken(token.T_NULLSAFE_OBJECT_OPERATOR, []byte("?->"))
+
+	n.OpenCurlyBracketTkn = nil
+	n.CloseCurlyBracketTkn = nil
+	switch n.Prop.(type) {
+	case *ast.Identifier:
+	case *ast.ExprVariable:
+	default:
+		n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
+		n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
+	}
+
+	f.accept(n.Prop)
+}
+
 func (f *formatter) ExprPostDec(n *ast.ExprPostDec) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 	n.DecTkn = f.newToken(token.T_DEC, []byte("--"))
 }
 
 func (f *formatter) ExprPostInc(n *ast.ExprPostInc) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 	n.IncTkn = f.newToken(token.T_INC, []byte("++"))
 }
 
 func (f *formatter) ExprPreDec(n *ast.ExprPreDec) {
 	n.DecTkn = f.newToken(token.T_DEC, []byte("--"))
-	n.Var.Accept(f)
+	f.accept(n.Var)
 }
 
 func (f *formatter) ExprPreInc(n *ast.ExprPreInc) {
 	n.IncTkn = f.newToken(token.T_INC, []byte("++"))
-	n.Var.Accept(f)
+	f.accept(n.Var)
 }
 
 func (f *formatter) ExprPrint(n *ast.ExprPrint) {
 	n.PrintTkn = f.newToken(token.T_PRINT, []byte("print"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprPropertyFetch(n *ast.ExprPropertyFetch) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 	n.ObjectOperatorTkn = f.newToken(token.T_OBJECT_OPERATOR, []byte("->"))
 
 	n.OpenCurlyBracketTkn = nil
@@ -1344,31 +1727,31 @@ func (f *formatter) ExprPropertyFetch(n *ast.ExprPropertyFetch) {
 		n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
 	}
 
-	n.Prop.Accept(f)
+	f.accept(n.Prop)
 }
 
 func (f *formatter) ExprRequire(n *ast.ExprRequire) {
 	n.RequireTkn = f.newToken(token.T_REQUIRE, []byte("require"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprRequireOnce(n *ast.ExprRequireOnce) {
 	n.RequireOnceTkn = f.newToken(token.T_REQUIRE_ONCE, []byte("require_once"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprShellExec(n *ast.ExprShellExec) {
 	n.OpenBacktickTkn = f.newToken('`', []byte("`"))
 	for _, p := range n.Parts {
-		p.Accept(f)
+		f.accept(p)
 	}
 	n.CloseBacktickTkn = f.newToken('`', []byte("`"))
 }
 
 func (f *formatter) ExprStaticCall(n *ast.ExprStaticCall) {
-	n.Class.Accept(f)
+	f.accept(n.Class)
 	n.DoubleColonTkn = f.newToken(token.T_PAAMAYIM_NEKUDOTAYIM, []byte("::"))
 
 	n.OpenCurlyBracketTkn = nil
@@ -1381,7 +1764,7 @@ func (f *formatter) ExprStaticCall(n *ast.ExprStaticCall) {
 		n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
 	}
 
-	n.Call.Accept(f)
+	f.accept(n.Call)
 
 	n.OpenParenthesisTkn = f.newToken('(', []byte("("))
 	n.SeparatorTkns = nil
@@ -1392,33 +1775,40 @@ func (f *formatter) ExprStaticCall(n *ast.ExprStaticCall) {
 }
 
 func (f *formatter) ExprStaticPropertyFetch(n *ast.ExprStaticPropertyFetch) {
-	n.Class.Accept(f)
+	f.accept(n.Class)
 	n.DoubleColonTkn = f.newToken(token.T_PAAMAYIM_NEKUDOTAYIM, []byte("::"))
-	n.Prop.Accept(f)
+	f.accept(n.Prop)
 }
 
 func (f *formatter) ExprTernary(n *ast.ExprTernary) {
-	n.Cond.Accept(f)
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.accept(n.Cond)
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.QuestionTkn = f.newToken('?', []byte("?"))
 	if n.IfTrue != nil {
-		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-		n.IfTrue.Accept(f)
-		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+		f.addSpace(f.style.SpaceAroundOperators)
+		f.accept(n.IfTrue)
+		f.addSpace(f.style.SpaceAroundOperators)
 	}
 	n.ColonTkn = f.newToken(':', []byte(":"))
+	f.addSpace(f.style.SpaceAroundOperators)
+	f.accept(n.IfFalse)
+}
+
+func (f *formatter) ExprThrow(n *ast.ExprThrow) {
+	n.ThrowTkn = f.newToken(token.T_THROW, []byte("throw"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
-	n.IfFalse.Accept(f)
+
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprUnaryMinus(n *ast.ExprUnaryMinus) {
 	n.MinusTkn = f.newToken('-', []byte("-"))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprUnaryPlus(n *ast.ExprUnaryPlus) {
 	n.PlusTkn = f.newToken('+', []byte("+"))
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprVariable(n *ast.ExprVariable) {
@@ -1436,7 +1826,7 @@ func (f *formatter) ExprVariable(n *ast.ExprVariable) {
 		n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
 	}
 
-	n.Name.Accept(f)
+	f.accept(n.Name)
 }
 
 func (f *formatter) ExprYield(n *ast.ExprYield) {
@@ -1444,476 +1834,489 @@ func (f *formatter) ExprYield(n *ast.ExprYield) {
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
 	if n.Key != nil {
-		n.Key.Accept(f)
+		f.accept(n.Key)
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 		n.DoubleArrowTkn = f.newToken(token.T_DOUBLE_ARROW, []byte("=>"))
 		f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	}
 
-	n.Val.Accept(f)
+	f.accept(n.Val)
 }
 
 func (f *formatter) ExprYieldFrom(n *ast.ExprYieldFrom) {
 	n.YieldFromTkn = f.newToken(token.T_YIELD_FROM, []byte("yield from"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssign(n *ast.ExprAssign) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken('=', []byte("="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignReference(n *ast.ExprAssignReference) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken('=', []byte("="))
 	n.AmpersandTkn = f.newToken('&', []byte("&"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignBitwiseAnd(n *ast.ExprAssignBitwiseAnd) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_AND_EQUAL, []byte("&="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignBitwiseOr(n *ast.ExprAssignBitwiseOr) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_OR_EQUAL, []byte("|="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignBitwiseXor(n *ast.ExprAssignBitwiseXor) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_XOR_EQUAL, []byte("^="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignCoalesce(n *ast.ExprAssignCoalesce) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_COALESCE_EQUAL, []byte("??="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignConcat(n *ast.ExprAssignConcat) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_CONCAT_EQUAL, []byte(".="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignDiv(n *ast.ExprAssignDiv) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_DIV_EQUAL, []byte("/="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignMinus(n *ast.ExprAssignMinus) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_MINUS_EQUAL, []byte("-="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignMod(n *ast.ExprAssignMod) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_MOD_EQUAL, []byte("%="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignMul(n *ast.ExprAssignMul) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_MUL_EQUAL, []byte("*="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignPlus(n *ast.ExprAssignPlus) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_PLUS_EQUAL, []byte("+="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignPow(n *ast.ExprAssignPow) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_POW_EQUAL, []byte("**="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignShiftLeft(n *ast.ExprAssignShiftLeft) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_SL_EQUAL, []byte("<<="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprAssignShiftRight(n *ast.ExprAssignShiftRight) {
-	n.Var.Accept(f)
+	f.accept(n.Var)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.EqualTkn = f.newToken(token.T_SR_EQUAL, []byte(">>="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Expr.Accept(f)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprBinaryBitwiseAnd(n *ast.ExprBinaryBitwiseAnd) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken('&', []byte("&"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryBitwiseOr(n *ast.ExprBinaryBitwiseOr) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken('|', []byte("|"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryBitwiseXor(n *ast.ExprBinaryBitwiseXor) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken('^', []byte("^"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryBooleanAnd(n *ast.ExprBinaryBooleanAnd) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_BOOLEAN_AND, []byte("&&"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryBooleanOr(n *ast.ExprBinaryBooleanOr) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_BOOLEAN_OR, []byte("||"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryCoalesce(n *ast.ExprBinaryCoalesce) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_COALESCE, []byte("??"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryConcat(n *ast.ExprBinaryConcat) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	space := f.style.SpaceAroundConcat || endsWith(n.Left, isNumber) || startsWith(n.Right, isNumber)
+
+	f.addSpace(space)
 	n.OpTkn = f.newToken('.', []byte("."))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(space)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryDiv(n *ast.ExprBinaryDiv) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken('/', []byte("/"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryEqual(n *ast.ExprBinaryEqual) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_IS_EQUAL, []byte("=="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryGreater(n *ast.ExprBinaryGreater) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken('>', []byte(">"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryGreaterOrEqual(n *ast.ExprBinaryGreaterOrEqual) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_IS_GREATER_OR_EQUAL, []byte(">="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryIdentical(n *ast.ExprBinaryIdentical) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_IS_IDENTICAL, []byte("==="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryLogicalAnd(n *ast.ExprBinaryLogicalAnd) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	n.OpTkn = f.newToken(token.T_LOGICAL_AND, []byte("and"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryLogicalOr(n *ast.ExprBinaryLogicalOr) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	n.OpTkn = f.newToken(token.T_LOGICAL_OR, []byte("or"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryLogicalXor(n *ast.ExprBinaryLogicalXor) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 	n.OpTkn = f.newToken(token.T_LOGICAL_XOR, []byte("xor"))
 	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryMinus(n *ast.ExprBinaryMinus) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	space := f.style.SpaceAroundOperators || startsWith(n.Right, isMinus)
+
+	f.addSpace(space)
 	n.OpTkn = f.newToken('-', []byte("-"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(space)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryMod(n *ast.ExprBinaryMod) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken('%', []byte("%"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryMul(n *ast.ExprBinaryMul) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken('*', []byte("*"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryNotEqual(n *ast.ExprBinaryNotEqual) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_IS_NOT_EQUAL, []byte("!="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryNotIdentical(n *ast.ExprBinaryNotIdentical) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_IS_NOT_IDENTICAL, []byte("!=="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryPlus(n *ast.ExprBinaryPlus) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	space := f.style.SpaceAroundOperators || startsWith(n.Right, isPlus)
+
+	f.addSpace(space)
 	n.OpTkn = f.newToken('+', []byte("+"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(space)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryPow(n *ast.ExprBinaryPow) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_POW, []byte("**"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryShiftLeft(n *ast.ExprBinaryShiftLeft) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_SL, []byte("<<"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinaryShiftRight(n *ast.ExprBinaryShiftRight) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_SR, []byte(">>"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinarySmaller(n *ast.ExprBinarySmaller) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken('<', []byte("<"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinarySmallerOrEqual(n *ast.ExprBinarySmallerOrEqual) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_IS_SMALLER_OR_EQUAL, []byte("<="))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprBinarySpaceship(n *ast.ExprBinarySpaceship) {
-	n.Left.Accept(f)
+	f.accept(n.Left)
 
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 	n.OpTkn = f.newToken(token.T_SPACESHIP, []byte("<=>"))
-	f.addFreeFloating(token.T_WHITESPACE, []byte(" "))
+	f.addSpace(f.style.SpaceAroundOperators)
 
-	n.Right.Accept(f)
+	f.accept(n.Right)
 }
 
 func (f *formatter) ExprCastArray(n *ast.ExprCastArray) {
 	n.CastTkn = f.newToken(token.T_ARRAY_CAST, []byte("(array)"))
-	n.Expr.Accept(f)
+	f.addSpace(f.style.SpaceAfterCast)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprCastBool(n *ast.ExprCastBool) {
 	n.CastTkn = f.newToken(token.T_BOOL_CAST, []byte("(bool)"))
-	n.Expr.Accept(f)
+	f.addSpace(f.style.SpaceAfterCast)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprCastDouble(n *ast.ExprCastDouble) {
 	n.CastTkn = f.newToken(token.T_DOUBLE_CAST, []byte("(float)"))
-	n.Expr.Accept(f)
+	f.addSpace(f.style.SpaceAfterCast)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprCastInt(n *ast.ExprCastInt) {
 	n.CastTkn = f.newToken(token.T_INT_CAST, []byte("(int)"))
-	n.Expr.Accept(f)
+	f.addSpace(f.style.SpaceAfterCast)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprCastObject(n *ast.ExprCastObject) {
 	n.CastTkn = f.newToken(token.T_OBJECT_CAST, []byte("(object)"))
-	n.Expr.Accept(f)
+	f.addSpace(f.style.SpaceAfterCast)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprCastString(n *ast.ExprCastString) {
 	n.CastTkn = f.newToken(token.T_STRING_CAST, []byte("(string)"))
-	n.Expr.Accept(f)
+	f.addSpace(f.style.SpaceAfterCast)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ExprCastUnset(n *ast.ExprCastUnset) {
 	n.CastTkn = f.newToken(token.T_UNSET_CAST, []byte("(unset)"))
-	n.Expr.Accept(f)
+	f.addSpace(f.style.SpaceAfterCast)
+	f.accept(n.Expr)
 }
 
 func (f *formatter) ScalarDnumber(n *ast.ScalarDnumber) {
@@ -1927,7 +2330,7 @@ func (f *formatter) ScalarDnumber(n *ast.ScalarDnumber) {
 func (f *formatter) ScalarEncapsed(n *ast.ScalarEncapsed) {
 	n.OpenQuoteTkn = f.newToken('"', []byte("\""))
 	for _, p := range n.Parts {
-		p.Accept(f)
+		f.accept(p)
 	}
 	n.CloseQuoteTkn = f.newToken('"', []byte("\""))
 }
@@ -1942,13 +2345,13 @@ func (f *formatter) ScalarEncapsedStringPart(n *ast.ScalarEncapsedStringPart) {
 
 func (f *formatter) ScalarEncapsedStringVar(n *ast.ScalarEncapsedStringVar) {
 	n.DollarOpenCurlyBracketTkn = f.newToken(token.T_DOLLAR_OPEN_CURLY_BRACES, []byte("${"))
-	n.Name.Accept(f)
+	f.accept(n.Name)
 
 	n.OpenSquareBracketTkn = nil
 	n.CloseSquareBracketTkn = nil
 	if n.Dim != nil {
 		n.OpenSquareBracketTkn = f.newToken('[', []byte("["))
-		n.Dim.Accept(f)
+		f.accept(n.Dim)
 		n.CloseSquareBracketTkn = f.newToken(']', []byte("]"))
 	}
 
@@ -1957,14 +2360,14 @@ func (f *formatter) ScalarEncapsedStringVar(n *ast.ScalarEncapsedStringVar) {
 
 func (f *formatter) ScalarEncapsedStringBrackets(n *ast.ScalarEncapsedStringBrackets) {
 	n.OpenCurlyBracketTkn = f.newToken('{', []byte("{"))
-	n.Var.Accept(f)
+	f.accept(n.Var)
 	n.CloseCurlyBracketTkn = f.newToken('}', []byte("}"))
 }
 
 func (f *formatter) ScalarHeredoc(n *ast.ScalarHeredoc) {
 	n.OpenHeredocTkn = f.newToken(token.T_START_HEREDOC, []byte("<<<EOT\n"))
 	for _, p := range n.Parts {
-		p.Accept(f)
+		f.accept(p)
 	}
 	n.CloseHeredocTkn = f.newToken(token.T_START_HEREDOC, []byte("EOT"))
 }
@@ -1996,7 +2399,7 @@ func (f *formatter) ScalarString(n *ast.ScalarString) {
 func (f *formatter) NameName(n *ast.Name) {
 	separatorTkns := make([]*token.Token, len(n.Parts)-1)
 	for i, v := range n.Parts {
-		v.Accept(f)
+		f.accept(v)
 
 		if i != len(n.Parts)-1 {
 			separatorTkns[i] = f.newToken(token.T_NS_SEPARATOR, []byte("\\"))
@@ -2009,7 +2412,7 @@ func (f *formatter) NameFullyQualified(n *ast.NameFullyQualified) {
 
 	separatorTkns := make([]*token.Token, len(n.Parts)-1)
 	for i, v := range n.Parts {
-		v.Accept(f)
+		f.accept(v)
 
 		if i != len(n.Parts)-1 {
 			separatorTkns[i] = f.newToken(token.T_NS_SEPARATOR, []byte("\\"))
@@ -2023,7 +2426,7 @@ func (f *formatter) NameRelative(n *ast.NameRelative) {
 
 	separatorTkns := make([]*token.Token, len(n.Parts)-1)
 	for i, v := range n.Parts {
-		v.Accept(f)
+		f.accept(v)
 
 		if i != len(n.Parts)-1 {
 			separatorTkns[i] = f.newToken(token.T_NS_SEPARATOR, []byte("\\"))
@@ -25,7 +25,7 @@ func TestFormatter_Root(t *testing.T) {
 	p := printer.NewPrinter(o)
 	n.Accept(p)
 
-	expected := `<?php 
+	expected := `<?php
 
 ;`
 	actual := o.String()
@@ -191,6 +191,51 @@ func TestFormatter_Parameter_Default(t *testing.T) {
 	}
 }
 
+func TestFormatter_Parameter_Promoted(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.Parameter{
+		AttrGroups: []ast.Vertex{
+			&ast.AttributeGroup{
+				Attrs: []ast.Vertex{
+					&ast.Attribute{
+						Name: &ast.Name{
+							Parts: []ast.Vertex{
+								&ast.NamePart{
+									Value: []byte("Foo"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Modifiers: []ast.Vertex{
+			&ast.Identifier{
+				Value: []byte("public"),
+			},
+		},
+		Var: &ast.ExprVariable{
+			Name: &ast.Identifier{
+				Value: []byte("$foo"),
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `#[Foo] public $foo`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestFormatter_Identifier(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -289,6 +334,223 @@ func TestFormatter_Argument_Variadic(t *testing.T) {
 	}
 }
 
+func TestFormatter_Argument_VariadicPlaceholder(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.Argument{
+		VariadicTkn: &token.Token{},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `...`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestFormatter_Argument_Named(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.Argument{
+		Name: &ast.Identifier{
+			Value: []byte("foo"),
+		},
+		Expr: &ast.ExprVariable{
+			Name: &ast.Identifier{
+				Value: []byte("$foo"),
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `foo: $foo`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestFormatter_AttributeGroup(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.AttributeGroup{
+		Attrs: []ast.Vertex{
+			&ast.Attribute{
+				Name: &ast.Name{
+					Parts: []ast.Vertex{
+						&ast.NamePart{
+							Value: []byte("Foo"),
+						},
+					},
+				},
+			},
+			&ast.Attribute{
+				Name: &ast.Name{
+					Parts: []ast.Vertex{
+						&ast.NamePart{
+							Value: []byte("Bar"),
+						},
+					},
+				},
+				Args: []ast.Vertex{
+					&ast.Argument{
+						Expr: &ast.ExprVariable{
+							Name: &ast.Identifier{
+								Value: []byte("$a"),
+							},
+						},
+					},
+					&ast.Argument{
+						Expr: &ast.ExprVariable{
+							Name: &ast.Identifier{
+								Value: []byte("$b"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `#[Foo, Bar($a, $b)]`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestFormatter_Union(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.Union{
+		Types: []ast.Vertex{
+			&ast.Identifier{
+				Value: []byte("array"),
+			},
+			&ast.Identifier{
+				Value: []byte("callable"),
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `array|callable`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestFormatter_Intersection(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.Intersection{
+		Types: []ast.Vertex{
+			&ast.Name{
+				Parts: []ast.Vertex{
+					&ast.NamePart{
+						Value: []byte("Countable"),
+					},
+				},
+			},
+			&ast.Name{
+				Parts: []ast.Vertex{
+					&ast.NamePart{
+						Value: []byte("Iterator"),
+					},
+				},
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `Countable&Iterator`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestFormatter_BadStmt(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.StmtStmtList{
+		Stmts: []ast.Vertex{
+			&ast.BadStmt{
+				SkippedTkns: []*token.Token{
+					{
+						ID:    token.T_VARIABLE,
+						Value: []byte("$a"),
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte("\n\n\t"),
+							},
+						},
+					},
+					{
+						ID:    token.ID('='),
+						Value: []byte("="),
+						FreeFloating: []*token.Token{
+							{
+								ID:    token.T_WHITESPACE,
+								Value: []byte("  "),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP).WithIndent(1)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `{
+        $a  =
+    }`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestFormatter_StmtBreak(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -666,6 +928,43 @@ func TestFormatter_StmtClassConstList_Modifier(t *testing.T) {
 	}
 }
 
+func TestFormatter_StmtClassConstList_Type(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.StmtClassConstList{
+		Type: &ast.Name{
+			Parts: []ast.Vertex{
+				&ast.NamePart{
+					Value: []byte("string"),
+				},
+			},
+		},
+		Consts: []ast.Vertex{
+			&ast.StmtConstant{
+				Name: &ast.Identifier{
+					Value: []byte("foo"),
+				},
+				Expr: &ast.ScalarString{
+					Value: []byte("'foo'"),
+				},
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP).WithIndent(1)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `const string foo = 'foo';`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestFormatter_ClassMethod(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -1414,6 +1713,50 @@ func TestFormatter_StmtFunction(t *testing.T) {
 	}
 }
 
+func TestFormatter_StmtFunction_AttrGroups(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.StmtFunction{
+		AttrGroups: []ast.Vertex{
+			&ast.AttributeGroup{
+				Attrs: []ast.Vertex{
+					&ast.Attribute{
+						Name: &ast.Name{
+							Parts: []ast.Vertex{
+								&ast.NamePart{
+									Value: []byte("Foo"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Name: &ast.Identifier{
+			Value: []byte("foo"),
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtNop{},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP).WithIndent(1)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `#[Foo]
+    function foo() {
+        ;
+    }`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestFormatter_StmtFunction_Ref(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -1766,11 +2109,11 @@ func TestFormatter_StmtInlineHtml(t *testing.T) {
 	p := printer.NewPrinter(o)
 	n.Accept(p)
 
-	expected := `<?php 
+	expected := `<?php
 
 {
-    ;?><div></div><?php 
-    echo $foo;?><div></div><?php 
+    ;?><div></div><?php
+    echo $foo;?><div></div><?php
     ;
 }`
 	actual := o.String()
@@ -1780,6 +2123,63 @@ func TestFormatter_StmtInlineHtml(t *testing.T) {
 	}
 }
 
+func TestFormatter_StmtEnum(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.StmtEnum{
+		Name: &ast.Identifier{
+			Value: []byte("Suit"),
+		},
+		Type: &ast.Name{
+			Parts: []ast.Vertex{
+				&ast.NamePart{
+					Value: []byte("string"),
+				},
+			},
+		},
+		Implements: []ast.Vertex{
+			&ast.Name{
+				Parts: []ast.Vertex{
+					&ast.NamePart{
+						Value: []byte("bar"),
+					},
+				},
+			},
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtEnumCase{
+				Name: &ast.Identifier{
+					Value: []byte("Hearts"),
+				},
+				Expr: &ast.ScalarString{
+					Value: []byte("'H'"),
+				},
+			},
+			&ast.StmtEnumCase{
+				Name: &ast.Identifier{
+					Value: []byte("Spades"),
+				},
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP).WithIndent(1)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `enum Suit: string implements bar {
+        case Hearts = 'H';
+        case Spades;
+    }`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestFormatter_StmtInterface(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -2249,7 +2649,7 @@ func TestFormatter_StmtStmtList(t *testing.T) {
 	p := printer.NewPrinter(o)
 	n.Accept(p)
 
-	expected := `<?php 
+	expected := `<?php
 
 {
     {
@@ -3148,6 +3548,50 @@ func TestFormatter_ExprArrayDimFetch(t *testing.T) {
 	}
 }
 
+func TestFormatter_ExprArrayDimFetch_Empty(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.ExprArrayDimFetch{
+		Var: &ast.ExprVariable{
+			Name: &ast.Identifier{
+				Value: []byte("$foo"),
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP).WithIndent(1)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `$foo[]`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestFormatter_ExprArrayItem_Empty(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.ExprArrayItem{}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP).WithIndent(1)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := ``
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestFormatter_ExprArrayItem(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -3456,6 +3900,36 @@ func TestFormatter_ExprClassConstFetch(t *testing.T) {
 	}
 }
 
+func TestFormatter_ExprClassConstFetch_Dynamic(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.ExprClassConstFetch{
+		Class: &ast.ExprVariable{
+			Name: &ast.Identifier{
+				Value: []byte("$foo"),
+			},
+		},
+		Const: &ast.ExprVariable{
+			Name: &ast.Identifier{
+				Value: []byte("$bar"),
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP).WithIndent(1)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `$foo::{$bar}`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestFormatter_ExprClone(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -4145,6 +4619,62 @@ func TestFormatter_ExprMethodCall_Arguments(t *testing.T) {
 	}
 }
 
+func TestFormatter_ExprNullsafeMethodCall(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.ExprNullsafeMethodCall{
+		Var: &ast.ExprVariable{
+			Name: &ast.Identifier{
+				Value: []byte("$foo"),
+			},
+		},
+		Method: &ast.Identifier{
+			Value: []byte("bar"),
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `$foo?->bar()`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestFormatter_ExprNullsafePropertyFetch(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.ExprNullsafePropertyFetch{
+		Var: &ast.ExprVariable{
+			Name: &ast.Identifier{
+				Value: []byte("$foo"),
+			},
+		},
+		Prop: &ast.Identifier{
+			Value: []byte("bar"),
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `$foo?->bar`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestFormatter_ExprNew(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -4657,6 +5187,86 @@ func TestFormatter_ExprStaticPropertyFetch(t *testing.T) {
 	}
 }
 
+func TestFormatter_ExprMatch(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.ExprMatch{
+		Expr: &ast.ExprVariable{
+			Name: &ast.Identifier{
+				Value: []byte("$a"),
+			},
+		},
+		Arms: []ast.Vertex{
+			&ast.MatchArm{
+				Exprs: []ast.Vertex{
+					&ast.ScalarLnumber{
+						Value: []byte("1"),
+					},
+					&ast.ScalarLnumber{
+						Value: []byte("2"),
+					},
+				},
+				ReturnExpr: &ast.ExprVariable{
+					Name: &ast.Identifier{
+						Value: []byte("$b"),
+					},
+				},
+			},
+			&ast.MatchArm{
+				DefaultTkn: &token.Token{
+					Value: []byte("default"),
+				},
+				ReturnExpr: &ast.ExprVariable{
+					Name: &ast.Identifier{
+						Value: []byte("$c"),
+					},
+				},
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `match ($a) {
+    1, 2 => $b,
+    default => $c,
+}`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestFormatter_ExprThrow(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	n := &ast.ExprThrow{
+		Expr: &ast.ExprVariable{
+			Name: &ast.Identifier{
+				Value: []byte("$e"),
+			},
+		},
+	}
+
+	f := formatter.NewFormatter().WithState(formatter.FormatterStatePHP)
+	n.Accept(f)
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n.Accept(p)
+
+	expected := `throw $e`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestFormatter_ExprTernary(t *testing.T) {
 	o := bytes.NewBufferString("")
 
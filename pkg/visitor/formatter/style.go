@@ -0,0 +1,192 @@
+package formatter
+
+import (
+	"bytes"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+)
+
+// BracePlacement is the place of the opening curly bracket
+type BracePlacement int
+
+const (
+	// BraceSameLine puts the bracket at the end of the declaration line
+	BraceSameLine BracePlacement = iota
+	// BraceNextLine puts the bracket on its own line at the declaration indentation
+	BraceNextLine
+)
+
+// Style configures the whitespace added by the formatter.
+// The zero value is not usable, start with DefaultStyle or PSR12Style.
+type Style struct {
+	// UseTabs indents with a tab per level instead of IndentWidth spaces
+	UseTabs     bool
+	IndentWidth int
+
+	// ClassBrace is for classes, interfaces, traits, enums and braced namespaces
+	ClassBrace BracePlacement
+	// FunctionBrace is for functions and methods
+	FunctionBrace BracePlacement
+	// ControlBrace is for if, else, loops, switch, try, catch, finally and declare,
+	// else, catch, finally and the while of do-while follow the closing bracket
+	// on the next line when it is BraceNextLine
+	ControlBrace BracePlacement
+	// ClosureBrace is for closures
+	ClosureBrace BracePlacement
+
+	// BlankLinesBetweenMembers is the count of empty lines between class members
+	BlankLinesBetweenMembers int
+	// BlankLineAfterHeader puts an empty line after the declare statement,
+	// the namespace declaration and every group of the use imports of the same type
+	BlankLineAfterHeader bool
+
+	// SpaceAroundOperators puts spaces around the binary, assignment and ternary operators,
+	// the keyword operators like instanceof and and are always spaced
+	SpaceAroundOperators bool
+	// SpaceAroundConcat puts spaces around the concatenation operator
+	SpaceAroundConcat bool
+	// SpaceAfterCast puts a space between the type cast and the expression
+	SpaceAfterCast bool
+	// SpaceAfterComma puts a space after the comma of the inline lists
+	SpaceAfterComma bool
+	// SpaceAfterKeyword puts a space between the parenthesis and the elseif, for,
+	// foreach, while, switch, function, fn and use keywords, if and catch always have it
+	SpaceAfterKeyword bool
+
+	// TrailingComma adds a comma after the last item of the multiline lists
+	TrailingComma bool
+
+	// LineWidth is the maximum length of the line, the argument, parameter and array lists,
+	// the method chains and the boolean conditions that do not fit are broken into lines.
+	// Zero disables the wrapping.
+	LineWidth int
+}
+
+// DefaultStyle returns the style of NewFormatter
+func DefaultStyle() Style {
+	return Style{
+		IndentWidth:          4,
+		SpaceAroundOperators: true,
+		SpaceAroundConcat:    true,
+		SpaceAfterComma:      true,
+		TrailingComma:        true,
+	}
+}
+
+// PSR12Style returns the style of the PSR-12 extended coding style guide
+func PSR12Style() Style {
+	return Style{
+		IndentWidth:          4,
+		ClassBrace:           BraceNextLine,
+		FunctionBrace:        BraceNextLine,
+		ControlBrace:         BraceSameLine,
+		ClosureBrace:         BraceSameLine,
+		BlankLineAfterHeader: true,
+		SpaceAroundOperators: true,
+		SpaceAroundConcat:    true,
+		SpaceAfterCast:       true,
+		SpaceAfterComma:      true,
+		SpaceAfterKeyword:    true,
+		TrailingComma:        true,
+		LineWidth:            120,
+	}
+}
+
+// indentation returns the whitespace of the indentation level
+func (s Style) indentation(level int) []byte {
+	if s.UseTabs {
+		return bytes.Repeat([]byte("\t"), level)
+	}
+
+	return bytes.Repeat([]byte(" "), level*s.IndentWidth)
+}
+
+// startsWith reports whether the node or its leftmost descendant matches
+func startsWith(n ast.Vertex, match func(ast.Vertex) bool) bool {
+	for n != nil {
+		if match(n) {
+			return true
+		}
+
+		c := ast.Children(n)
+		if len(c) == 0 {
+			return false
+		}
+		n = c[0].Node
+	}
+
+	return false
+}
+
+// endsWith reports whether the node or its rightmost descendant matches
+func endsWith(n ast.Vertex, match func(ast.Vertex) bool) bool {
+	for n != nil {
+		if match(n) {
+			return true
+		}
+
+		c := ast.Children(n)
+		if len(c) == 0 {
+			return false
+		}
+		n = c[len(c)-1].Node
+	}
+
+	return false
+}
+
+// header returns the kind of the file header statement,
+// the empty string if the statement is not in the header
+func header(n ast.Vertex) string {
+	switch n := n.(type) {
+	case *ast.StmtDeclare:
+		if _, ok := n.Stmt.(*ast.StmtNop); ok {
+			return "declare"
+		}
+	case *ast.StmtNamespace:
+		if len(n.Stmts) == 0 {
+			return "namespace"
+		}
+	case *ast.StmtUseList:
+		return "use" + useType(n.Type)
+	case *ast.StmtGroupUseList:
+		return "use" + useType(n.Type)
+	}
+
+	return ""
+}
+
+func useType(n ast.Vertex) string {
+	if id, ok := n.(*ast.Identifier); ok {
+		return " " + string(bytes.ToLower(id.Value))
+	}
+
+	return ""
+}
+
+func isNumber(n ast.Vertex) bool {
+	switch n.(type) {
+	case *ast.ScalarLnumber, *ast.ScalarDnumber:
+		return true
+	}
+
+	return false
+}
+
+func isMinus(n ast.Vertex) bool {
+	switch n.(type) {
+	case *ast.ExprUnaryMinus, *ast.ExprPreDec:
+		return true
+	}
+
+	return false
+}
+
+func isPlus(n ast.Vertex) bool {
+	switch n.(type) {
+	case *ast.ExprUnaryPlus, *ast.ExprPreInc:
+		return true
+	}
+
+	return false
+}
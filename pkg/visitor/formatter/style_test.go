@@ -0,0 +1,188 @@
+package formatter_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor/formatter"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+)
+
+const styleSrc = `<?php
+namespace App;
+final class Foo extends Bar implements Countable {
+const A = 1;
+private $items = [];
+public function count(): int {
+foreach ($this->items as $k => $v) { if ($v) { continue; } elseif (!$k) { break; } else { $n = (int)$v . 'x'; } }
+try { $f = function ($a) use ($b) { return $a + $b; }; } catch (E $e) {} finally {}
+do { $i++; } while ($i < 10);
+return match($a) { 1 => 'a', default => 'b' };
+}
+}
+function bar($a, $b) { switch ($a) { case 1: return $a - -$b; } return 1 . 2; }
+`
+
+// config is the version of the formatted sources
+var config = conf.Config{Version: &version.Version{Major: 8, Minor: 3}}
+
+// format parses the source, formats it in the style and checks that the printed code parses
+func format(t *testing.T, src string, style formatter.Style) string {
+	root, err := parser.Parse([]byte(src), config)
+	assert.NilError(t, err, src)
+
+	root.Accept(formatter.NewFormatter().WithStyle(style))
+
+	o := bytes.NewBufferString("")
+	root.Accept(printer.NewPrinter(o))
+
+	_, err = parser.Parse(o.Bytes(), config)
+	assert.NilError(t, err, o.String())
+
+	return o.String()
+}
+
+func TestStylePSR12(t *testing.T) {
+	expected := `<?php
+
+namespace App;
+
+final class Foo extends Bar implements Countable
+{
+    const A = 1;
+    private $items = array();
+    public function count(): int
+    {
+        foreach ($this->items as $k => $v) {
+            if ($v) {
+                continue;
+            } elseif (!$k) {
+                break;
+            } else {
+                $n = (int) $v . 'x';
+            }
+        }
+        try {
+            $f = function ($a) use ($b) {
+                return $a + $b;
+            };
+        } catch (E $e) {} finally {}
+        do {
+            $i++;
+        } while ($i < 10);
+        return match ($a) {
+            1 => 'a',
+            default => 'b',
+        };
+    }
+}
+function bar($a, $b)
+{
+    switch ($a) {
+        case 1:
+            return $a - -$b;
+    }
+    return 1 . 2;
+}
+`
+
+	assert.Equal(t, expected, format(t, styleSrc, formatter.PSR12Style()))
+}
+
+func TestStyleCustom(t *testing.T) {
+	style := formatter.DefaultStyle()
+	style.UseTabs = true
+	style.ControlBrace = formatter.BraceNextLine
+	style.BlankLinesBetweenMembers = 1
+	style.SpaceAroundOperators = false
+	style.SpaceAroundConcat = false
+	style.SpaceAfterComma = false
+	style.TrailingComma = false
+
+	expected := "<?php\n\n" +
+		"namespace App;\n" +
+		"final class Foo extends Bar implements Countable {\n" +
+		"\tconst A = 1;\n" +
+		"\n" +
+		"\tprivate $items = array();\n" +
+		"\n" +
+		"\tpublic function count(): int {\n" +
+		"\t\tforeach($this->items as $k => $v)\n" +
+		"\t\t{\n" +
+		"\t\t\tif ($v)\n" +
+		"\t\t\t{\n" +
+		"\t\t\t\tcontinue;\n" +
+		"\t\t\t}\n" +
+		"\t\t\telseif(!$k)\n" +
+		"\t\t\t{\n" +
+		"\t\t\t\tbreak;\n" +
+		"\t\t\t}\n" +
+		"\t\t\telse\n" +
+		"\t\t\t{\n" +
+		"\t\t\t\t$n=(int)$v.'x';\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t\ttry\n" +
+		"\t\t{\n" +
+		"\t\t\t$f=function($a) use($b) {\n" +
+		"\t\t\t\treturn $a+$b;\n" +
+		"\t\t\t};\n" +
+		"\t\t}\n" +
+		"\t\tcatch (E $e)\n" +
+		"\t\t{}\n" +
+		"\t\tfinally\n" +
+		"\t\t{}\n" +
+		"\t\tdo\n" +
+		"\t\t{\n" +
+		"\t\t\t$i++;\n" +
+		"\t\t}\n" +
+		"\t\twhile($i<10);\n" +
+		"\t\treturn match ($a) {\n" +
+		"\t\t\t1 => 'a',\n" +
+		"\t\t\tdefault => 'b'\n" +
+		"\t\t};\n" +
+		"\t}\n" +
+		"}\n" +
+		"function bar($a,$b) {\n" +
+		"\tswitch($a)\n" +
+		"\t{\n" +
+		"\t\tcase 1:\n" +
+		"\t\t\treturn $a - -$b;\n" +
+		"\t}\n" +
+		"\treturn 1 . 2;\n" +
+		"}\n"
+
+	assert.Equal(t, expected, format(t, styleSrc, style))
+}
+
+func TestStylePSR12Header(t *testing.T) {
+	src := `<?php
+namespace App;
+use A\B;
+use C\{D, E};
+use function f;
+use const X;
+$a = 1;
+`
+
+	expected := `<?php
+
+namespace App;
+
+use A\B;
+use C\{D, E};
+
+use function f;
+
+use const X;
+
+$a = 1;
+`
+
+	assert.Equal(t, expected, format(t, src, formatter.PSR12Style()))
+}
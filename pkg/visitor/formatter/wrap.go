@@ -0,0 +1,446 @@
+package formatter
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+// span is the place of the node in the measured output
+type span struct {
+	startLine, startCol int
+	endLine, endCol     int
+}
+
+// measure renders the tokens in the printing order and tracks the lines and the node spans
+type measure struct {
+	tabWidth int
+	text     []byte
+	line     int
+	col      int
+	lines    []int
+	spans    map[ast.Vertex]*span
+	pending  []*span
+}
+
+func newMeasure(n ast.Vertex, tabWidth int) *measure {
+	m := &measure{
+		tabWidth: tabWidth,
+		spans:    map[ast.Vertex]*span{},
+	}
+
+	m.node(n)
+	m.lines = append(m.lines, m.col)
+
+	return m
+}
+
+func (m *measure) node(n ast.Vertex) {
+	if n == nil || reflect.ValueOf(n).IsNil() {
+		return
+	}
+
+	s := &span{startLine: -1}
+	m.spans[n] = s
+	m.pending = append(m.pending, s)
+
+	v := reflect.ValueOf(n).Elem()
+	fields := ast.Fields(n)
+	for i := 0; i < len(fields); i++ {
+		switch fields[i].Kind {
+		case ast.FieldToken:
+			t, _ := v.Field(i).Interface().(*token.Token)
+			m.token(t)
+		case ast.FieldTokenList:
+			for _, t := range v.Field(i).Interface().([]*token.Token) {
+				m.token(t)
+			}
+		case ast.FieldNode:
+			c, _ := v.Field(i).Interface().(ast.Vertex)
+			m.node(c)
+		case ast.FieldNodeList:
+			nodes := v.Field(i).Interface().([]ast.Vertex)
+
+			// the separators are printed between the list items
+			var separators []*token.Token
+			if i+1 < len(fields) && fields[i+1].Kind == ast.FieldTokenList {
+				separators = v.Field(i + 1).Interface().([]*token.Token)
+				i++
+			}
+
+			for k, c := range nodes {
+				m.node(c)
+				if k < len(separators) {
+					m.token(separators[k])
+				}
+			}
+
+			for k := len(nodes); k < len(separators); k++ {
+				m.token(separators[k])
+			}
+		}
+	}
+
+	if s.startLine < 0 {
+		s.startLine, s.startCol = m.line, m.col
+		m.pending = m.pending[:len(m.pending)-1]
+	}
+	s.endLine, s.endCol = m.line, m.col
+}
+
+func (m *measure) token(t *token.Token) {
+	if t == nil {
+		return
+	}
+
+	for _, ff := range t.FreeFloating {
+		m.write(ff.Value)
+	}
+
+	for _, s := range m.pending {
+		s.startLine, s.startCol = m.line, m.col
+	}
+	m.pending = m.pending[:0]
+
+	m.write(t.Value)
+}
+
+func (m *measure) write(b []byte) {
+	m.text = append(m.text, b...)
+
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		b = b[size:]
+
+		switch r {
+		case '\n':
+			m.lines = append(m.lines, m.col)
+			m.line++
+			m.col = 0
+		case '\t':
+			m.col += m.tabWidth
+		default:
+			m.col++
+		}
+	}
+}
+
+// indentation returns the whitespace at the start of the line
+func (m *measure) indentation(line int) []byte {
+	text := m.text
+	for i := 0; i < line; i++ {
+		text = text[bytes.IndexByte(text, '\n')+1:]
+	}
+
+	end := 0
+	for end < len(text) && (text[end] == ' ' || text[end] == '\t') {
+		end++
+	}
+
+	return text[:end]
+}
+
+// overflows reports whether the node is on the too long line
+// and its line break would shorten it
+func (m *measure) overflows(n ast.Vertex, width int) bool {
+	s := m.spans[n]
+	if s == nil {
+		return false
+	}
+
+	if m.lines[s.startLine] > width && (s.endLine > s.startLine || s.endCol > width) {
+		return true
+	}
+
+	return s.endLine > s.startLine && m.lines[s.endLine] > width && s.endCol > width
+}
+
+type wrapKind int
+
+const (
+	wrapList wrapKind = iota
+	wrapChain
+	wrapBoolean
+)
+
+// candidate is the construct that can be broken into lines
+type candidate struct {
+	node ast.Vertex
+	kind wrapKind
+}
+
+// wrap breaks the lists, the method chains and the boolean conditions of the statement
+// that do not fit in the line width, the outer constructs are broken first
+func (f *formatter) wrap(stmt ast.Vertex) {
+	tabWidth := f.style.IndentWidth
+	if tabWidth == 0 {
+		tabWidth = 4
+	}
+
+	candidates := wrapCandidates(stmt, stmt, nil, nil)
+	broken := map[candidate]bool{}
+
+	for {
+		m := newMeasure(stmt, tabWidth)
+
+		next := -1
+		for i, c := range candidates {
+			if !broken[c] && m.overflows(c.node, f.style.LineWidth) {
+				next = i
+				break
+			}
+		}
+
+		if next < 0 {
+			return
+		}
+
+		c := candidates[next]
+		broken[c] = true
+		f.breakLines(c, m.indentation(m.spans[c.node].startLine))
+	}
+}
+
+// wrapCandidates collects the breakable constructs in the pre-order,
+// the nested statements are wrapped on their own
+func wrapCandidates(stmt, n, parent ast.Vertex, candidates []candidate) []candidate {
+	if n != stmt && isStmt(n) {
+		return candidates
+	}
+
+	switch {
+	case isBoolean(n):
+		// the nested operations are broken together with the outer one
+		if parent == nil || !isBoolean(parent) {
+			candidates = append(candidates, candidate{n, wrapBoolean})
+		}
+	case len(chainCalls(n)) > 1:
+		// the inner calls are the part of the outer chain
+		if parent == nil || chainVar(parent) != n {
+			candidates = append(candidates, candidate{n, wrapChain})
+		}
+	}
+
+	if nodeList(n) != nil {
+		candidates = append(candidates, candidate{n, wrapList})
+	}
+
+	for _, c := range ast.Children(n) {
+		candidates = wrapCandidates(stmt, c.Node, n, candidates)
+	}
+
+	return candidates
+}
+
+func isStmt(n ast.Vertex) bool {
+	return strings.HasPrefix(reflect.TypeOf(n).Elem().Name(), "Stmt")
+}
+
+func isBoolean(n ast.Vertex) bool {
+	switch n.(type) {
+	case *ast.ExprBinaryBooleanAnd, *ast.ExprBinaryBooleanOr, *ast.ExprBinaryLogicalAnd, *ast.ExprBinaryLogicalOr:
+		return true
+	}
+
+	return false
+}
+
+// list is the bracketed list of the arguments, the parameters or the array items
+type list struct {
+	open, close *token.Token
+	items       []ast.Vertex
+	separators  *[]*token.Token
+}
+
+func nodeList(n ast.Vertex) *list {
+	var l list
+	switch n := n.(type) {
+	case *ast.ExprFunctionCall:
+		l = list{n.OpenParenthesisTkn, n.CloseParenthesisTkn, n.Args, &n.SeparatorTkns}
+	case *ast.ExprMethodCall:
+		l = list{n.OpenParenthesisTkn, n.CloseParenthesisTkn, n.Args, &n.SeparatorTkns}
+	case *ast.ExprNullsafeMethodCall:
+		l = list{n.OpenParenthesisTkn, n.CloseParenthesisTkn, n.Args, &n.SeparatorTkns}
+	case *ast.ExprStaticCall:
+		l = list{n.OpenParenthesisTkn, n.CloseParenthesisTkn, n.Args, &n.SeparatorTkns}
+	case *ast.ExprNew:
+		l = list{n.OpenParenthesisTkn, n.CloseParenthesisTkn, n.Args, &n.SeparatorTkns}
+	case *ast.StmtFunction:
+		l = list{n.OpenParenthesisTkn, n.CloseParenthesisTkn, n.Params, &n.SeparatorTkns}
+	case *ast.StmtClassMethod:
+		l = list{n.OpenParenthesisTkn, n.CloseParenthesisTkn, n.Params, &n.SeparatorTkns}
+	case *ast.ExprClosure:
+		l = list{n.OpenParenthesisTkn, n.CloseParenthesisTkn, n.Params, &n.SeparatorTkns}
+	case *ast.ExprArrowFunction:
+		l = list{n.OpenParenthesisTkn, n.CloseParenthesisTkn, n.Params, &n.SeparatorTkns}
+	case *ast.ExprArray:
+		l = list{n.OpenBracketTkn, n.CloseBracketTkn, n.Items, &n.SeparatorTkns}
+	case *ast.ExprList:
+		l = list{n.OpenBracketTkn, n.CloseBracketTkn, n.Items, &n.SeparatorTkns}
+	default:
+		return nil
+	}
+
+	if l.open == nil || l.close == nil || len(l.items) == 0 {
+		return nil
+	}
+
+	return &l
+}
+
+// chainVar returns the object of the method call or the property fetch
+func chainVar(n ast.Vertex) ast.Vertex {
+	switch n := n.(type) {
+	case *ast.ExprMethodCall:
+		return n.Var
+	case *ast.ExprNullsafeMethodCall:
+		return n.Var
+	case *ast.ExprPropertyFetch:
+		return n.Var
+	case *ast.ExprNullsafePropertyFetch:
+		return n.Var
+	}
+
+	return nil
+}
+
+// chainCalls returns the method calls of the chain ending with the node
+func chainCalls(n ast.Vertex) []ast.Vertex {
+	var calls []ast.Vertex
+	for ; chainVar(n) != nil; n = chainVar(n) {
+		switch n.(type) {
+		case *ast.ExprMethodCall, *ast.ExprNullsafeMethodCall:
+			calls = append(calls, n)
+		}
+	}
+
+	return calls
+}
+
+// breakLines puts the parts of the construct on their own lines,
+// indent is the indentation of the line the construct starts on
+func (f *formatter) breakLines(c candidate, indent []byte) {
+	unit := f.style.indentation(1)
+	inner := append(append([]byte{}, indent...), unit...)
+
+	switch c.kind {
+	case wrapList:
+		l := nodeList(c.node)
+
+		items := l.items
+		trailingComma := false
+		if item, ok := items[len(items)-1].(*ast.ExprArrayItem); ok && item.Val == nil {
+			items = items[:len(items)-1]
+			trailingComma = true
+		}
+
+		for _, item := range items {
+			t := ast.FirstToken(item)
+			if t == nil {
+				continue
+			}
+
+			reindent(item, unit)
+			f.breakBefore(t, inner)
+		}
+
+		if !trailingComma && f.style.TrailingComma && len(*l.separators) == len(items)-1 {
+			*l.separators = append(*l.separators, &token.Token{ID: ',', Value: []byte(",")})
+		}
+
+		f.breakBefore(l.close, indent)
+	case wrapChain:
+		for _, call := range chainCalls(c.node) {
+			v := reflect.ValueOf(call).Elem()
+			for _, name := range []string{"Method", "Args"} {
+				switch fld := v.FieldByName(name).Interface().(type) {
+				case ast.Vertex:
+					reindent(fld, unit)
+				case []ast.Vertex:
+					for _, arg := range fld {
+						reindent(arg, unit)
+					}
+				}
+			}
+			for _, name := range []string{"SeparatorTkns", "CloseParenthesisTkn"} {
+				switch fld := v.FieldByName(name).Interface().(type) {
+				case *token.Token:
+					reindentToken(fld, unit)
+				case []*token.Token:
+					for _, t := range fld {
+						reindentToken(t, unit)
+					}
+				}
+			}
+
+			f.breakBefore(v.FieldByName("ObjectOperatorTkn").Interface().(*token.Token), inner)
+		}
+	case wrapBoolean:
+		operands, operators := booleanOperands(c.node, nil, nil)
+		for i, op := range operators {
+			reindent(operands[i+1], unit)
+			f.breakBefore(op, inner)
+		}
+	}
+}
+
+// booleanOperands flattens the nested boolean operations
+func booleanOperands(n ast.Vertex, operands []ast.Vertex, operators []*token.Token) ([]ast.Vertex, []*token.Token) {
+	var left, right ast.Vertex
+	var op *token.Token
+
+	switch n := n.(type) {
+	case *ast.ExprBinaryBooleanAnd:
+		left, op, right = n.Left, n.OpTkn, n.Right
+	case *ast.ExprBinaryBooleanOr:
+		left, op, right = n.Left, n.OpTkn, n.Right
+	case *ast.ExprBinaryLogicalAnd:
+		left, op, right = n.Left, n.OpTkn, n.Right
+	case *ast.ExprBinaryLogicalOr:
+		left, op, right = n.Left, n.OpTkn, n.Right
+	default:
+		return append(operands, n), operators
+	}
+
+	operands, operators = booleanOperands(left, operands, operators)
+	operators = append(operators, op)
+
+	return booleanOperands(right, operands, operators)
+}
+
+// breakBefore replaces the whitespace in front of the token with the line break
+func (f *formatter) breakBefore(t *token.Token, indent []byte) {
+	if t == nil {
+		return
+	}
+
+	ff := []*token.Token{{ID: token.T_WHITESPACE, Value: append([]byte("\n"), indent...)}}
+	t.FreeFloating = f.withComments(ff, takeTokenComments(t))
+}
+
+// reindent adds the indentation after every line break in the whitespace of the subtree
+func reindent(n ast.Vertex, unit []byte) {
+	for _, t := range ast.Tokens(n) {
+		reindentToken(t, unit)
+	}
+
+	for _, c := range ast.Children(n) {
+		reindent(c.Node, unit)
+	}
+}
+
+func reindentToken(t *token.Token, unit []byte) {
+	if t == nil {
+		return
+	}
+
+	for _, ff := range t.FreeFloating {
+		if ff.ID == token.T_WHITESPACE {
+			ff.Value = bytes.ReplaceAll(ff.Value, []byte("\n"), append([]byte("\n"), unit...))
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package formatter_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/visitor/formatter"
+)
+
+const wrapSrc = `<?php
+class Foo {
+public function bar($first, $second, $third = null, array $options = []) {
+$result = $this->repository->findBy(['status' => 'active', 'type' => $type], ['created' => 'desc'], 10);
+$items = $query->where('a', 1)->orderBy('b')->limit(10)->get();
+if ($first !== null && $second !== null && $third !== null || $options['force'] === true) {
+return array_map(function ($item) use ($first) { return $item->value + $first; }, $result, [1, 2,]);
+}
+short($a, $b);
+}
+}
+`
+
+func TestWrap(t *testing.T) {
+	style := formatter.DefaultStyle()
+	style.LineWidth = 60
+
+	expected := `<?php
+
+class Foo {
+    public function bar(
+        $first,
+        $second,
+        $third = null,
+        array $options = array(),
+    ) {
+        $result = $this->repository->findBy(
+            array('status' => 'active', 'type' => $type),
+            array('created' => 'desc'),
+            10,
+        );
+        $items = $query
+            ->where('a', 1)
+            ->orderBy('b')
+            ->limit(10)
+            ->get();
+        if ($first !== null
+            && $second !== null
+            && $third !== null
+            || $options['force'] === true) {
+            return array_map(function($item) use($first) {
+                return $item->value + $first;
+            }, $result, array(1, 2, ));
+        }
+        short($a, $b);
+    }
+}
+`
+
+	assert.Equal(t, expected, format(t, wrapSrc, style))
+}
+
+func TestWrapNarrow(t *testing.T) {
+	style := formatter.DefaultStyle()
+	style.UseTabs = true
+	style.LineWidth = 40
+	style.TrailingComma = false
+
+	expected := "<?php\n\n" +
+		"class Foo {\n" +
+		"\tpublic function bar(\n" +
+		"\t\t$first,\n" +
+		"\t\t$second,\n" +
+		"\t\t$third = null,\n" +
+		"\t\tarray $options = array()\n" +
+		"\t) {\n" +
+		"\t\t$result = $this->repository->findBy(\n" +
+		"\t\t\tarray(\n" +
+		"\t\t\t\t'status' => 'active',\n" +
+		"\t\t\t\t'type' => $type\n" +
+		"\t\t\t),\n" +
+		"\t\t\tarray('created' => 'desc'),\n" +
+		"\t\t\t10\n" +
+		"\t\t);\n" +
+		"\t\t$items = $query\n" +
+		"\t\t\t->where('a', 1)\n" +
+		"\t\t\t->orderBy('b')\n" +
+		"\t\t\t->limit(10)\n" +
+		"\t\t\t->get();\n" +
+		"\t\tif ($first !== null\n" +
+		"\t\t\t&& $second !== null\n" +
+		"\t\t\t&& $third !== null\n" +
+		"\t\t\t|| $options['force'] === true) {\n" +
+		"\t\t\treturn array_map(\n" +
+		"\t\t\t\tfunction(\n" +
+		"\t\t\t\t\t$item\n" +
+		"\t\t\t\t) use($first) {\n" +
+		"\t\t\t\t\treturn $item->value + $first;\n" +
+		"\t\t\t\t},\n" +
+		"\t\t\t\t$result,\n" +
+		"\t\t\t\tarray(1, 2, )\n" +
+		"\t\t\t);\n" +
+		"\t\t}\n" +
+		"\t\tshort($a, $b);\n" +
+		"\t}\n" +
+		"}\n"
+
+	assert.Equal(t, expected, format(t, wrapSrc, style))
+}
+
+func TestWrapDisabled(t *testing.T) {
+	actual := format(t, wrapSrc, formatter.DefaultStyle())
+
+	assert.Assert(t, bytes.Contains([]byte(actual), []byte("$items = $query->where('a', 1)->orderBy('b')->limit(10)->get();")), actual)
+}
@@ -0,0 +1,230 @@
+package json
+
+import (
+	"bytes"
+	"encoding/base64"
+	stdjson "encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+var tokenIDs = map[string]token.ID{}
+
+func init() {
+	// the named tokens go in a row, String returns "ID(n)" after the last one
+	for id := token.T_INCLUDE; !strings.HasPrefix(id.String(), "ID("); id++ {
+		tokenIDs[id.String()] = id
+	}
+}
+
+type jsonToken struct {
+	ID           string             `json:"id"`
+	Value        *string            `json:"value"`
+	ValueBase64  *string            `json:"valueBase64"`
+	Position     *position.Position `json:"position"`
+	FreeFloating []*jsonToken       `json:"freeFloating"`
+}
+
+type Decoder struct {
+	decoder *stdjson.Decoder
+}
+
+// NewDecoder creates and returns new Decoder
+func NewDecoder(reader io.Reader) *Decoder {
+	return &Decoder{decoder: stdjson.NewDecoder(reader)}
+}
+
+// Decode reads the next JSON encoded node
+func (d *Decoder) Decode() (ast.Vertex, error) {
+	var raw stdjson.RawMessage
+	err := d.decoder.Decode(&raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeNode(raw)
+}
+
+// Unmarshal rebuilds the node from JSON produced by the Encoder
+func Unmarshal(data []byte) (ast.Vertex, error) {
+	return decodeNode(data)
+}
+
+func isNull(raw stdjson.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
+
+func decodeNode(raw stdjson.RawMessage) (ast.Vertex, error) {
+	if isNull(raw) {
+		return nil, nil
+	}
+
+	var obj map[string]stdjson.RawMessage
+	err := stdjson.Unmarshal(raw, &obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var kind string
+	err = stdjson.Unmarshal(obj["kind"], &kind)
+	if err != nil {
+		return nil, fmt.Errorf("node kind: %v", err)
+	}
+
+	n := ast.NewNode(kind)
+	if n == nil {
+		return nil, fmt.Errorf("unknown node kind %q", kind)
+	}
+
+	s := reflect.ValueOf(n).Elem()
+	for _, f := range ast.Fields(n) {
+		key := fieldKey(f.Name)
+
+		val, ok := obj[key]
+		if !ok && f.Kind == ast.FieldValue {
+			val, ok = obj[key+"Base64"]
+			key += "Base64"
+		}
+		if !ok || isNull(val) {
+			continue
+		}
+
+		v, err := decodeField(f.Kind, key, val)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", kind, f.Name, err)
+		}
+
+		s.FieldByName(f.Name).Set(reflect.ValueOf(v))
+	}
+
+	return n, nil
+}
+
+func decodeField(kind ast.FieldKind, key string, raw stdjson.RawMessage) (interface{}, error) {
+	switch kind {
+	case ast.FieldPosition:
+		p := &position.Position{}
+		err := stdjson.Unmarshal(raw, p)
+		return p, err
+	case ast.FieldToken:
+		var t *jsonToken
+		err := stdjson.Unmarshal(raw, &t)
+		if err != nil {
+			return nil, err
+		}
+		return decodeToken(t)
+	case ast.FieldTokenList:
+		var list []*jsonToken
+		err := stdjson.Unmarshal(raw, &list)
+		if err != nil {
+			return nil, err
+		}
+		return decodeTokenList(list)
+	case ast.FieldNode:
+		n, err := decodeNode(raw)
+		return n, err
+	case ast.FieldNodeList:
+		var list []stdjson.RawMessage
+		err := stdjson.Unmarshal(raw, &list)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes := make([]ast.Vertex, len(list))
+		for i, r := range list {
+			nodes[i], err = decodeNode(r)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nodes, nil
+	case ast.FieldValue:
+		var str string
+		err := stdjson.Unmarshal(raw, &str)
+		if err != nil {
+			return nil, err
+		}
+		return decodeBytes(str, strings.HasSuffix(key, "Base64"))
+	}
+
+	return nil, fmt.Errorf("unknown field kind %d", kind)
+}
+
+func decodeToken(t *jsonToken) (*token.Token, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	id, err := decodeTokenID(t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := &token.Token{
+		ID:       id,
+		Position: t.Position,
+	}
+
+	switch {
+	case t.Value != nil:
+		tkn.Value = []byte(*t.Value)
+	case t.ValueBase64 != nil:
+		tkn.Value, err = decodeBytes(*t.ValueBase64, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.FreeFloating != nil {
+		tkn.FreeFloating, err = decodeTokenList(t.FreeFloating)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tkn, nil
+}
+
+func decodeTokenList(list []*jsonToken) ([]*token.Token, error) {
+	tkns := make([]*token.Token, len(list))
+	for i, t := range list {
+		var err error
+		tkns[i], err = decodeToken(t)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tkns, nil
+}
+
+func decodeTokenID(name string) (token.ID, error) {
+	if name == "" {
+		return 0, nil
+	}
+
+	if id, ok := tokenIDs[name]; ok {
+		return id, nil
+	}
+
+	if r, size := utf8.DecodeRuneInString(name); size == len(name) && r != utf8.RuneError {
+		return token.ID(r), nil
+	}
+
+	return 0, fmt.Errorf("unknown token id %q", name)
+}
+
+func decodeBytes(s string, isBase64 bool) ([]byte, error) {
+	if isBase64 {
+		return base64.StdEncoding.DecodeString(s)
+	}
+
+	return []byte(s), nil
+}
@@ -0,0 +1,236 @@
+// Package json encodes the AST into JSON and decodes it back.
+//
+// A node is encoded as an object with the "kind" key holding the node type name
+// followed by the node fields. Field keys are the Go field names starting with
+// a lowercase letter, nil fields are omitted:
+//
+//	{"kind":"ExprVariable","position":{...},"name":{"kind":"Identifier",...}}
+//
+// A token is encoded as an object with the "id", "value", "position" and
+// "freeFloating" keys. The id is the token name like "T_VARIABLE", or the
+// character itself for the single character tokens. Values that are not valid
+// UTF-8 are encoded in base64 under the key with the "Base64" suffix, like "valueBase64".
+package json
+
+import (
+	"bytes"
+	"encoding/base64"
+	stdjson "encoding/json"
+	"io"
+	"reflect"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+type Encoder struct {
+	writer     io.Writer
+	withIndent bool
+	prefix     string
+	indent     string
+}
+
+// NewEncoder creates and returns new Encoder
+func NewEncoder(writer io.Writer) *Encoder {
+	return &Encoder{writer: writer}
+}
+
+// WithIndent makes the encoder produce indented JSON like json.Indent does
+func (e *Encoder) WithIndent(prefix, indent string) *Encoder {
+	e.withIndent = true
+	e.prefix = prefix
+	e.indent = indent
+	return e
+}
+
+// Encode writes JSON of the node followed by a newline
+func (e *Encoder) Encode(n ast.Vertex) error {
+	buf := &bytes.Buffer{}
+	encodeNode(buf, n)
+
+	out := buf.Bytes()
+	if e.withIndent {
+		indented := &bytes.Buffer{}
+		err := stdjson.Indent(indented, out, e.prefix, e.indent)
+		if err != nil {
+			return err
+		}
+		out = indented.Bytes()
+	}
+
+	_, err := e.writer.Write(append(out, '\n'))
+	return err
+}
+
+// Marshal returns JSON of the node
+func Marshal(n ast.Vertex) []byte {
+	buf := &bytes.Buffer{}
+	encodeNode(buf, n)
+
+	return buf.Bytes()
+}
+
+func fieldKey(name string) string {
+	return string(name[0]+'a'-'A') + name[1:]
+}
+
+func encodeNode(buf *bytes.Buffer, n ast.Vertex) {
+	if n == nil {
+		buf.WriteString("null")
+		return
+	}
+
+	buf.WriteString(`{"kind":`)
+	encodeString(buf, ast.Kind(n))
+
+	s := reflect.ValueOf(n).Elem()
+	for _, f := range ast.Fields(n) {
+		v := s.FieldByName(f.Name)
+		if v.IsNil() {
+			continue
+		}
+
+		key := fieldKey(f.Name)
+		if f.Kind == ast.FieldValue && !utf8.Valid(v.Bytes()) {
+			key += "Base64"
+		}
+
+		buf.WriteByte(',')
+		encodeString(buf, key)
+		buf.WriteByte(':')
+
+		switch f.Kind {
+		case ast.FieldPosition:
+			encodePosition(buf, v.Interface().(*position.Position))
+		case ast.FieldToken:
+			encodeToken(buf, v.Interface().(*token.Token))
+		case ast.FieldTokenList:
+			encodeTokenList(buf, v.Interface().([]*token.Token))
+		case ast.FieldNode:
+			encodeNode(buf, v.Interface().(ast.Vertex))
+		case ast.FieldNodeList:
+			buf.WriteByte('[')
+			for i, nn := range v.Interface().([]ast.Vertex) {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				encodeNode(buf, nn)
+			}
+			buf.WriteByte(']')
+		case ast.FieldValue:
+			encodeBytes(buf, v.Interface().([]byte))
+		}
+	}
+
+	buf.WriteByte('}')
+}
+
+func encodeToken(buf *bytes.Buffer, t *token.Token) {
+	if t == nil {
+		buf.WriteString("null")
+		return
+	}
+
+	buf.WriteString(`{"id":`)
+	encodeString(buf, tokenName(t.ID))
+
+	if t.Value != nil {
+		if utf8.Valid(t.Value) {
+			buf.WriteString(`,"value":`)
+		} else {
+			buf.WriteString(`,"valueBase64":`)
+		}
+		encodeBytes(buf, t.Value)
+	}
+
+	if t.Position != nil {
+		buf.WriteString(`,"position":`)
+		encodePosition(buf, t.Position)
+	}
+
+	if t.FreeFloating != nil {
+		buf.WriteString(`,"freeFloating":`)
+		encodeTokenList(buf, t.FreeFloating)
+	}
+
+	buf.WriteByte('}')
+}
+
+func encodeTokenList(buf *bytes.Buffer, list []*token.Token) {
+	buf.WriteByte('[')
+	for i, t := range list {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		encodeToken(buf, t)
+	}
+	buf.WriteByte(']')
+}
+
+// encodeBytes writes the value as a string, or as a base64 string if it is not valid UTF-8
+func encodeBytes(buf *bytes.Buffer, b []byte) {
+	if utf8.Valid(b) {
+		encodeString(buf, string(b))
+		return
+	}
+
+	encodeString(buf, base64.StdEncoding.EncodeToString(b))
+}
+
+func encodePosition(buf *bytes.Buffer, p *position.Position) {
+	if p == nil {
+		buf.WriteString("null")
+		return
+	}
+
+	fields := []struct {
+		key string
+		val int
+	}{
+		{"startLine", p.StartLine},
+		{"endLine", p.EndLine},
+		{"startPos", p.StartPos},
+		{"endPos", p.EndPos},
+		{"startCol", p.StartCol},
+		{"endCol", p.EndCol},
+		{"startColUTF16", p.StartColUTF16},
+		{"endColUTF16", p.EndColUTF16},
+	}
+
+	buf.WriteByte('{')
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		encodeString(buf, f.key)
+		buf.WriteByte(':')
+		buf.WriteString(strconv.Itoa(f.val))
+	}
+	buf.WriteByte('}')
+}
+
+func encodeString(buf *bytes.Buffer, s string) {
+	e := stdjson.NewEncoder(buf)
+	e.SetEscapeHTML(false)
+	_ = e.Encode(s)
+
+	// drop the newline added by the json encoder
+	buf.Truncate(buf.Len() - 1)
+}
+
+// tokenName returns the name of named tokens, the character of single character tokens
+// and an empty string for EOF
+func tokenName(id token.ID) string {
+	if id >= token.T_INCLUDE {
+		return id.String()
+	}
+
+	if id == 0 {
+		return ""
+	}
+
+	return string(rune(id))
+}
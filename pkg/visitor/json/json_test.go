@@ -0,0 +1,130 @@
+package json_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor/json"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+)
+
+func parse(t *testing.T, src []byte, ver string) ast.Vertex {
+	v, err := version.New(ver)
+	assert.NilError(t, err)
+
+	root, err := parser.Parse(src, conf.Config{Version: v})
+	assert.NilError(t, err)
+
+	return root
+}
+
+func printNode(n ast.Vertex) string {
+	o := bytes.NewBufferString("")
+	n.Accept(printer.NewPrinter(o))
+
+	return o.String()
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		file    string
+		version string
+	}{
+		{"../../../internal/php5/test.php", "5.6"},
+		{"../../../internal/php7/test.php", "7.4"},
+		{"../../../internal/php8/test.php", "8.3"},
+	}
+
+	for _, tt := range tests {
+		src, err := ioutil.ReadFile(tt.file)
+		assert.NilError(t, err)
+
+		root := parse(t, src, tt.version)
+
+		o := bytes.NewBufferString("")
+		err = json.NewEncoder(o).Encode(root)
+		assert.NilError(t, err)
+
+		decoded, err := json.NewDecoder(o).Decode()
+		assert.NilError(t, err)
+
+		assert.Assert(t, ast.Equal(root, decoded, ast.EqualOptions{}), tt.file)
+		assert.Equal(t, string(src), printNode(decoded))
+	}
+}
+
+func TestRoundTripInvalidUTF8(t *testing.T) {
+	src := []byte("\xff\xfe<?php echo \"\xc3\x28\";")
+	root := parse(t, src, "8.3")
+
+	decoded, err := json.Unmarshal(json.Marshal(root))
+	assert.NilError(t, err)
+
+	assert.Equal(t, string(src), printNode(decoded))
+}
+
+func TestMarshal(t *testing.T) {
+	n := &ast.StmtExpression{
+		Expr: &ast.ExprVariable{
+			Position: &position.Position{StartLine: 1, EndLine: 1, StartPos: 6, EndPos: 8, StartCol: 7, EndCol: 9, StartColUTF16: 7, EndColUTF16: 9},
+			Name: &ast.Identifier{
+				IdentifierTkn: &token.Token{
+					ID:    token.T_VARIABLE,
+					Value: []byte("$a"),
+					FreeFloating: []*token.Token{
+						{ID: token.T_OPEN_TAG, Value: []byte("<?php")},
+						{ID: token.T_WHITESPACE, Value: []byte(" ")},
+					},
+				},
+				Value: []byte("$a"),
+			},
+		},
+		SemiColonTkn: &token.Token{
+			ID:    ';',
+			Value: []byte(";"),
+		},
+	}
+
+	expected := `{"kind":"StmtExpression","expr":{"kind":"ExprVariable","position":{"startLine":1,"endLine":1,"startPos":6,"endPos":8,"startCol":7,"endCol":9,"startColUTF16":7,"endColUTF16":9},"name":{"kind":"Identifier","identifierTkn":{"id":"T_VARIABLE","value":"$a","freeFloating":[{"id":"T_OPEN_TAG","value":"<?php"},{"id":"T_WHITESPACE","value":" "}]},"value":"$a"}},"semiColonTkn":{"id":";","value":";"}}`
+
+	assert.Equal(t, expected, string(json.Marshal(n)))
+
+	decoded, err := json.Unmarshal([]byte(expected))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, n, decoded)
+}
+
+func TestUnmarshalTokenIDs(t *testing.T) {
+	for _, id := range []token.ID{token.T_INCLUDE, token.T_MATCH, token.T_FRAGMENT_CLASS_MEMBER} {
+		n := &ast.Root{EndTkn: &token.Token{ID: id}}
+
+		decoded, err := json.Unmarshal(json.Marshal(n))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, n, decoded)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	tests := []struct {
+		src string
+		err string
+	}{
+		{`{"kind":"Foo"}`, `unknown node kind "Foo"`},
+		{`{"kind":"StmtExpression","expr":{"kind":"Bar"}}`, `StmtExpression.Expr: unknown node kind "Bar"`},
+		{`{"kind":"StmtExpression","semiColonTkn":{"id":"T_FOO"}}`, `StmtExpression.SemiColonTkn: unknown token id "T_FOO"`},
+	}
+
+	for _, tt := range tests {
+		_, err := json.Unmarshal([]byte(tt.src))
+		assert.Error(t, err, tt.err)
+	}
+}
@@ -0,0 +1,511 @@
+// Package minver detects the minimum php version the source requires
+package minver
+
+import (
+	"bytes"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/position"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor"
+)
+
+// Usage is a construct that requires some php version
+type Usage struct {
+	Feature  version.Feature
+	Position *position.Position
+}
+
+// Detector visitor records the constructs tied to a php version,
+// use it with traverser.NewNodeTraverser
+type Detector struct {
+	visitor.Null
+	Usages []Usage
+
+	parent ast.Vertex
+	lists  map[ast.Vertex]bool
+
+	// heredocs are the closing labels at the line start, the code may follow them
+	heredocs []*token.Token
+}
+
+// NewDetector Detector type constructor
+func NewDetector() *Detector {
+	return &Detector{
+		lists: map[ast.Vertex]bool{},
+	}
+}
+
+// Min returns the first usage of the construct that requires the highest version,
+// nil if the source has no version dependent constructs
+func (d *Detector) Min() *Usage {
+	var min *Usage
+	for i, u := range d.Usages {
+		if min == nil || min.Feature.Since().Less(u.Feature.Since()) {
+			min = &d.Usages[i]
+		}
+	}
+
+	return min
+}
+
+// EnterNode records the constructs of the node
+func (d *Detector) EnterNode(n ast.Vertex, parent ast.Vertex, _ string) bool {
+	d.parent = parent
+	n.Accept(d)
+
+	return true
+}
+
+// LeaveNode checks the heredocs after the whole tree is traversed
+func (d *Detector) LeaveNode(n ast.Vertex, parent ast.Vertex, _ string) {
+	if parent == nil && len(d.heredocs) > 0 {
+		d.checkHeredocs(n)
+	}
+}
+
+func (d *Detector) add(f version.Feature, pos *position.Position) {
+	d.Usages = append(d.Usages, Usage{Feature: f, Position: pos})
+}
+
+func (d *Detector) list(n ast.Vertex) {
+	var items []ast.Vertex
+	switch l := n.(type) {
+	case *ast.ExprList:
+		if l.ListTkn == nil {
+			d.add(version.FeatureShortList, l.OpenBracketTkn.Position)
+		}
+		items = l.Items
+	case *ast.ExprArray:
+		d.add(version.FeatureShortList, l.OpenBracketTkn.Position)
+		items = l.Items
+	default:
+		return
+	}
+
+	d.lists[n] = true
+
+	for _, item := range items {
+		item, ok := item.(*ast.ExprArrayItem)
+		if !ok {
+			continue
+		}
+
+		d.lists[item] = true
+
+		if item.Key != nil {
+			d.add(version.FeatureKeyedList, item.Key.GetPosition())
+		}
+
+		if item.AmpersandTkn != nil {
+			d.add(version.FeatureListReference, item.AmpersandTkn.Position)
+		}
+
+		d.list(item.Val)
+	}
+}
+
+func (d *Detector) typ(n ast.Vertex, union bool) {
+	switch t := n.(type) {
+	case *ast.Nullable:
+		d.add(version.FeatureNullableType, t.QuestionTkn.Position)
+		d.typ(t.Expr, false)
+	case *ast.Union:
+		d.add(version.FeatureUnionType, t.Position)
+		for _, nn := range t.Types {
+			d.typ(nn, true)
+		}
+	case *ast.Intersection:
+		d.add(version.FeatureIntersectionType, t.Position)
+	case *ast.Identifier:
+		if bytes.EqualFold(t.Value, []byte("callable")) {
+			d.add(version.FeatureCallableType, t.Position)
+		}
+	case *ast.Name:
+		if len(t.Parts) != 1 {
+			return
+		}
+
+		switch string(bytes.ToLower(t.Parts[0].(*ast.NamePart).Value)) {
+		case "int", "float", "string", "bool":
+			d.add(version.FeatureScalarType, t.Position)
+		case "void":
+			d.add(version.FeatureVoidType, t.Position)
+		case "iterable":
+			d.add(version.FeatureIterableType, t.Position)
+		case "object":
+			d.add(version.FeatureObjectType, t.Position)
+		case "mixed":
+			d.add(version.FeatureMixedType, t.Position)
+		case "never":
+			d.add(version.FeatureNeverType, t.Position)
+		case "true":
+			d.add(version.FeatureStandaloneType, t.Position)
+		case "null", "false":
+			if !union {
+				d.add(version.FeatureStandaloneType, t.Position)
+			}
+		}
+	}
+}
+
+func (d *Detector) returnType(n ast.Vertex) {
+	if n == nil {
+		return
+	}
+
+	d.add(version.FeatureReturnType, n.GetPosition())
+
+	if id, ok := n.(*ast.Identifier); ok && bytes.EqualFold(id.Value, []byte("static")) {
+		d.add(version.FeatureStaticReturnType, n.GetPosition())
+	}
+
+	d.typ(n, false)
+}
+
+func (d *Detector) modifiers(modifiers []ast.Vertex, f version.Feature) {
+	for _, m := range modifiers {
+		if bytes.EqualFold(m.(*ast.Identifier).Value, []byte("readonly")) {
+			d.add(f, m.GetPosition())
+		}
+	}
+}
+
+func (d *Detector) args(args []ast.Vertex, separatorTkns int) {
+	if len(args) > 0 && len(args) == separatorTkns {
+		d.add(version.FeatureCallTrailingComma, args[len(args)-1].GetPosition())
+	}
+}
+
+func (d *Detector) Parameter(n *ast.Parameter) {
+	if len(n.Modifiers) > 0 {
+		d.add(version.FeatureConstructorPromotion, n.Modifiers[0].GetPosition())
+		d.modifiers(n.Modifiers, version.FeatureReadonlyProperty)
+	}
+
+	if n.VariadicTkn != nil {
+		d.add(version.FeatureVariadic, n.VariadicTkn.Position)
+	}
+
+	d.typ(n.Type, false)
+}
+
+func (d *Detector) Argument(n *ast.Argument) {
+	if n.Name != nil {
+		d.add(version.FeatureNamedArgument, n.Name.GetPosition())
+	}
+
+	if n.VariadicTkn == nil {
+		return
+	}
+
+	if n.Expr == nil {
+		d.add(version.FeatureFirstClassCallable, n.VariadicTkn.Position)
+	} else {
+		d.add(version.FeatureArgumentUnpacking, n.VariadicTkn.Position)
+	}
+}
+
+func (d *Detector) AttributeGroup(n *ast.AttributeGroup) {
+	d.add(version.FeatureAttribute, n.Position)
+}
+
+func (d *Detector) ScalarDnumber(n *ast.ScalarDnumber) {
+	if bytes.IndexByte(n.Value, '_') >= 0 {
+		d.add(version.FeatureNumericLiteralSeparator, n.Position)
+	}
+}
+
+func (d *Detector) ScalarHeredoc(n *ast.ScalarHeredoc) {
+	if bytes.IndexByte(n.OpenHeredocTkn.Value, '\'') >= 0 {
+		d.add(version.FeatureNowdoc, n.OpenHeredocTkn.Position)
+	}
+
+	if n.CloseHeredocTkn.Position.StartCol > 1 {
+		d.add(version.FeatureFlexibleHeredoc, n.CloseHeredocTkn.Position)
+		return
+	}
+
+	d.heredocs = append(d.heredocs, n.CloseHeredocTkn)
+}
+
+// checkHeredocs reports the closing labels followed by the code on the same line,
+// before PHP 7.3 only the semicolon may follow the label
+func (d *Detector) checkHeredocs(root ast.Vertex) {
+	next := map[int]*token.Token{}
+	collectTokens(root, next)
+
+	for _, label := range d.heredocs {
+		t := next[label.Position.EndPos]
+		if t != nil && t.ID == ';' && len(t.FreeFloating) == 0 {
+			t = next[t.Position.EndPos]
+		}
+
+		if t == nil || startsLine(t) {
+			continue
+		}
+
+		// keep the usages in the source order
+		i := len(d.Usages)
+		for i > 0 && d.Usages[i-1].Position.StartPos > label.Position.StartPos {
+			i--
+		}
+
+		d.Usages = append(d.Usages, Usage{})
+		copy(d.Usages[i+1:], d.Usages[i:])
+		d.Usages[i] = Usage{Feature: version.FeatureFlexibleHeredoc, Position: label.Position}
+	}
+
+	d.heredocs = nil
+}
+
+// collectTokens maps the start of the tokens with their free floating to the tokens
+func collectTokens(n ast.Vertex, tokens map[int]*token.Token) {
+	for _, t := range ast.Tokens(n) {
+		switch {
+		case len(t.FreeFloating) > 0 && t.FreeFloating[0].Position != nil:
+			tokens[t.FreeFloating[0].Position.StartPos] = t
+		case t.Position != nil:
+			tokens[t.Position.StartPos] = t
+		}
+	}
+
+	for _, c := range ast.Children(n) {
+		collectTokens(c.Node, tokens)
+	}
+}
+
+// startsLine reports whether the token is preceded by a line break
+func startsLine(t *token.Token) bool {
+	if len(t.FreeFloating) == 0 {
+		return false
+	}
+
+	v := t.FreeFloating[0].Value
+	return t.FreeFloating[0].ID == token.T_WHITESPACE && len(v) > 0 && (v[0] == '\n' || v[0] == '\r')
+}
+
+func (d *Detector) ScalarLnumber(n *ast.ScalarLnumber) {
+	if len(n.Value) > 1 && n.Value[0] == '0' && (n.Value[1] == 'b' || n.Value[1] == 'B') {
+		d.add(version.FeatureBinaryLiteral, n.Position)
+	}
+
+	if bytes.IndexByte(n.Value, '_') >= 0 {
+		d.add(version.FeatureNumericLiteralSeparator, n.Position)
+	}
+}
+
+func (d *Detector) StmtCatch(n *ast.StmtCatch) {
+	if len(n.Types) > 1 {
+		d.add(version.FeatureMultiCatch, n.SeparatorTkns[0].Position)
+	}
+
+	if n.Var == nil {
+		d.add(version.FeatureCatchWithoutVariable, n.Position)
+	}
+}
+
+func (d *Detector) StmtClass(n *ast.StmtClass) {
+	d.modifiers(n.Modifiers, version.FeatureReadonlyClass)
+
+	if n.OpenParenthesisTkn != nil {
+		d.args(n.Args, len(n.SeparatorTkns))
+	}
+}
+
+func (d *Detector) StmtClassConstList(n *ast.StmtClassConstList) {
+	if len(n.Modifiers) > 0 {
+		d.add(version.FeatureClassConstVisibility, n.Modifiers[0].GetPosition())
+	}
+
+	if n.Type != nil {
+		d.add(version.FeatureTypedClassConstant, n.Type.GetPosition())
+		d.typ(n.Type, false)
+	}
+}
+
+func (d *Detector) StmtClassMethod(n *ast.StmtClassMethod) {
+	d.returnType(n.ReturnType)
+}
+
+func (d *Detector) StmtEnum(n *ast.StmtEnum) {
+	d.add(version.FeatureEnum, n.EnumTkn.Position)
+}
+
+func (d *Detector) StmtFinally(n *ast.StmtFinally) {
+	d.add(version.FeatureFinally, n.FinallyTkn.Position)
+}
+
+func (d *Detector) StmtForeach(n *ast.StmtForeach) {
+	d.list(n.Var)
+}
+
+func (d *Detector) StmtFunction(n *ast.StmtFunction) {
+	d.returnType(n.ReturnType)
+}
+
+func (d *Detector) StmtGoto(n *ast.StmtGoto) {
+	d.add(version.FeatureGoto, n.GotoTkn.Position)
+}
+
+func (d *Detector) StmtNamespace(n *ast.StmtNamespace) {
+	d.add(version.FeatureNamespace, n.NsTkn.Position)
+}
+
+func (d *Detector) StmtPropertyList(n *ast.StmtPropertyList) {
+	d.modifiers(n.Modifiers, version.FeatureReadonlyProperty)
+
+	if n.Type != nil {
+		d.add(version.FeatureTypedProperty, n.Type.GetPosition())
+		d.typ(n.Type, false)
+	}
+}
+
+func (d *Detector) StmtTrait(n *ast.StmtTrait) {
+	d.add(version.FeatureTrait, n.TraitTkn.Position)
+}
+
+func (d *Detector) StmtTraitUse(n *ast.StmtTraitUse) {
+	d.add(version.FeatureTrait, n.UseTkn.Position)
+}
+
+func (d *Detector) StmtUnset(n *ast.StmtUnset) {
+	d.args(n.Vars, len(n.SeparatorTkns))
+}
+
+func (d *Detector) StmtUse(n *ast.StmtUseList) {
+	d.add(version.FeatureNamespace, n.UseTkn.Position)
+
+	if n.Type != nil {
+		d.add(version.FeatureUseFunction, n.Type.GetPosition())
+	}
+}
+
+func (d *Detector) StmtGroupUse(n *ast.StmtGroupUseList) {
+	d.add(version.FeatureGroupUse, n.OpenCurlyBracketTkn.Position)
+
+	if n.Type != nil {
+		d.add(version.FeatureUseFunction, n.Type.GetPosition())
+	}
+
+	if len(n.Uses) == len(n.SeparatorTkns) {
+		d.add(version.FeatureGroupUseTrailingComma, n.SeparatorTkns[len(n.SeparatorTkns)-1].Position)
+	}
+}
+
+func (d *Detector) ExprArray(n *ast.ExprArray) {
+	if n.ArrayTkn == nil && !d.lists[n] {
+		d.add(version.FeatureShortArray, n.OpenBracketTkn.Position)
+	}
+}
+
+func (d *Detector) ExprArrayItem(n *ast.ExprArrayItem) {
+	if n.EllipsisTkn != nil && !d.lists[n] {
+		d.add(version.FeatureArraySpread, n.EllipsisTkn.Position)
+	}
+}
+
+func (d *Detector) ExprArrowFunction(n *ast.ExprArrowFunction) {
+	d.add(version.FeatureArrowFunction, n.FnTkn.Position)
+	d.returnType(n.ReturnType)
+}
+
+func (d *Detector) ExprClassConstFetch(n *ast.ExprClassConstFetch) {
+	if n.OpenCurlyBracketTkn != nil {
+		d.add(version.FeatureDynamicClassConstFetch, n.OpenCurlyBracketTkn.Position)
+	}
+}
+
+func (d *Detector) ExprClosure(n *ast.ExprClosure) {
+	d.add(version.FeatureClosure, n.FunctionTkn.Position)
+	d.returnType(n.ReturnType)
+}
+
+func (d *Detector) ExprFunctionCall(n *ast.ExprFunctionCall) {
+	d.args(n.Args, len(n.SeparatorTkns))
+}
+
+func (d *Detector) ExprIsset(n *ast.ExprIsset) {
+	d.args(n.Vars, len(n.SeparatorTkns))
+}
+
+func (d *Detector) ExprList(n *ast.ExprList) {
+	if !d.lists[n] {
+		d.list(n)
+	}
+}
+
+func (d *Detector) ExprMatch(n *ast.ExprMatch) {
+	d.add(version.FeatureMatch, n.MatchTkn.Position)
+}
+
+func (d *Detector) ExprMethodCall(n *ast.ExprMethodCall) {
+	d.args(n.Args, len(n.SeparatorTkns))
+}
+
+func (d *Detector) ExprNew(n *ast.ExprNew) {
+	if _, ok := n.Class.(*ast.StmtClass); ok {
+		d.add(version.FeatureAnonymousClass, n.NewTkn.Position)
+		return
+	}
+
+	d.args(n.Args, len(n.SeparatorTkns))
+}
+
+func (d *Detector) ExprNullsafeMethodCall(n *ast.ExprNullsafeMethodCall) {
+	d.add(version.FeatureNullsafeOperator, n.ObjectOperatorTkn.Position)
+	d.args(n.Args, len(n.SeparatorTkns))
+}
+
+func (d *Detector) ExprNullsafePropertyFetch(n *ast.ExprNullsafePropertyFetch) {
+	d.add(version.FeatureNullsafeOperator, n.ObjectOperatorTkn.Position)
+}
+
+func (d *Detector) ExprStaticCall(n *ast.ExprStaticCall) {
+	d.args(n.Args, len(n.SeparatorTkns))
+}
+
+func (d *Detector) ExprTernary(n *ast.ExprTernary) {
+	if n.IfTrue == nil {
+		d.add(version.FeatureShortTernary, n.QuestionTkn.Position)
+	}
+}
+
+func (d *Detector) ExprThrow(n *ast.ExprThrow) {
+	if _, ok := d.parent.(*ast.StmtExpression); !ok {
+		d.add(version.FeatureThrowExpression, n.ThrowTkn.Position)
+	}
+}
+
+func (d *Detector) ExprYield(n *ast.ExprYield) {
+	d.add(version.FeatureGenerator, n.YieldTkn.Position)
+}
+
+func (d *Detector) ExprYieldFrom(n *ast.ExprYieldFrom) {
+	d.add(version.FeatureYieldFrom, n.YieldFromTkn.Position)
+}
+
+func (d *Detector) ExprAssign(n *ast.ExprAssign) {
+	d.list(n.Var)
+}
+
+func (d *Detector) ExprAssignCoalesce(n *ast.ExprAssignCoalesce) {
+	d.add(version.FeatureNullCoalescingAssignment, n.EqualTkn.Position)
+}
+
+func (d *Detector) ExprAssignPow(n *ast.ExprAssignPow) {
+	d.add(version.FeatureExponentiation, n.EqualTkn.Position)
+}
+
+func (d *Detector) ExprBinaryCoalesce(n *ast.ExprBinaryCoalesce) {
+	d.add(version.FeatureNullCoalescing, n.OpTkn.Position)
+}
+
+func (d *Detector) ExprBinaryPow(n *ast.ExprBinaryPow) {
+	d.add(version.FeatureExponentiation, n.OpTkn.Position)
+}
+
+func (d *Detector) ExprBinarySpaceship(n *ast.ExprBinarySpaceship) {
+	d.add(version.FeatureSpaceship, n.OpTkn.Position)
+}
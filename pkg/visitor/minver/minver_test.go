@@ -0,0 +1,112 @@
+package minver_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/parser"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor/minver"
+	"github.com/z7zmey/php-parser/pkg/visitor/traverser"
+)
+
+func detect(t *testing.T, src string, ver string) *minver.Detector {
+	v, err := version.New(ver)
+	assert.NilError(t, err)
+
+	root, err := parser.Parse([]byte(src), conf.Config{Version: v})
+	assert.NilError(t, err, src)
+
+	d := minver.NewDetector()
+	traverser.NewNodeTraverser(d).Traverse(root)
+
+	return d
+}
+
+func TestMin(t *testing.T) {
+	tests := []struct {
+		src      string
+		expected version.Feature
+	}{
+		{"<?php $a = [1];", version.FeatureShortArray},
+		{"<?php $a = 0b11;", version.FeatureBinaryLiteral},
+		{"<?php $a ?: $b;", version.FeatureShortTernary},
+		{"<?php function f() { yield; }", version.FeatureGenerator},
+		{"<?php $a = 2 ** 3;", version.FeatureExponentiation},
+		{"<?php f(...$a);", version.FeatureArgumentUnpacking},
+		{"<?php $a ?? $b;", version.FeatureNullCoalescing},
+		{"<?php function f(int $a) {}", version.FeatureScalarType},
+		{"<?php function f(): array {}", version.FeatureReturnType},
+		{"<?php new class {};", version.FeatureAnonymousClass},
+		{"<?php function f(?A $a) {}", version.FeatureNullableType},
+		{"<?php [$a, $b] = $c;", version.FeatureShortList},
+		{"<?php list('a' => $a) = $c;", version.FeatureKeyedList},
+		{"<?php function f(): void {}", version.FeatureVoidType},
+		{"<?php function f(object $a) {}", version.FeatureObjectType},
+		{"<?php $a = <<<A\n  a\n  A;", version.FeatureFlexibleHeredoc},
+		{"<?php foo(<<<A\na\nA, $b);", version.FeatureFlexibleHeredoc},
+		{"<?php f($a,);", version.FeatureCallTrailingComma},
+		{"<?php [$a, &$b] = $c;", version.FeatureListReference},
+		{"<?php fn() => 1;", version.FeatureArrowFunction},
+		{"<?php class A { public int $a; }", version.FeatureTypedProperty},
+		{"<?php $a = [...$b];", version.FeatureArraySpread},
+		{"<?php function f(): static {}", version.FeatureStaticReturnType},
+		{"<?php f(a: 1);", version.FeatureNamedArgument},
+		{"<?php $a?->b;", version.FeatureNullsafeOperator},
+		{"<?php $a = $b ?? throw $e;", version.FeatureThrowExpression},
+		{"<?php function f(int|string $a) {}", version.FeatureUnionType},
+		{"<?php enum A {}", version.FeatureEnum},
+		{"<?php strlen(...);", version.FeatureFirstClassCallable},
+		{"<?php function f(): never {}", version.FeatureNeverType},
+		{"<?php readonly class A {}", version.FeatureReadonlyClass},
+		{"<?php function f(null $a) {}", version.FeatureStandaloneType},
+		{"<?php class A { const int B = 1; }", version.FeatureTypedClassConstant},
+		{"<?php A::{$b};", version.FeatureDynamicClassConstFetch},
+	}
+
+	for _, tt := range tests {
+		min := detect(t, tt.src, "8.3").Min()
+		assert.Assert(t, min != nil, tt.src)
+		assert.Equal(t, tt.expected, min.Feature, tt.src)
+	}
+}
+
+func TestMinPosition(t *testing.T) {
+	src := "<?php\n$a = [1];\n$b = fn() => 1;\n$c ??= 1;\n$d = fn() => 2;\n"
+
+	min := detect(t, src, "7.4").Min()
+
+	assert.Equal(t, version.FeatureArrowFunction, min.Feature)
+	assert.Equal(t, 3, min.Position.StartLine)
+	assert.Equal(t, "7.4", min.Feature.Since().String())
+}
+
+func TestMinNone(t *testing.T) {
+	d := detect(t, "<?php echo array(1); throw $e;", "8.3")
+
+	assert.Assert(t, d.Min() == nil)
+	assert.Equal(t, 0, len(d.Usages))
+}
+
+func TestListIsNotArray(t *testing.T) {
+	d := detect(t, "<?php foreach ($a as [$b, [$c]]) {}", "7.4")
+
+	for _, u := range d.Usages {
+		assert.Equal(t, version.FeatureShortList, u.Feature)
+	}
+	assert.Equal(t, 2, len(d.Usages))
+}
+
+func TestHeredocBeforeFlexible(t *testing.T) {
+	tests := []string{
+		"<?php $a = <<<A\na\nA;\n",
+		"<?php $a = <<<A\na\nA\n;",
+		"<?php $a = <<<A\na\nA;",
+	}
+
+	for _, src := range tests {
+		assert.Assert(t, detect(t, src, "8.3").Min() == nil, src)
+	}
+}
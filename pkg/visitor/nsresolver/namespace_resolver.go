@@ -26,7 +26,8 @@ func NewNamespaceResolver() *NamespaceResolver {
 	}
 }
 
-func (nsr *NamespaceResolver) EnterNode(n ast.Vertex) bool {
+// EnterNode resolves names of the node, the children of use statements are skipped
+func (nsr *NamespaceResolver) EnterNode(n ast.Vertex, _ ast.Vertex, _ string) bool {
 	n.Accept(nsr)
 
 	if !nsr.goDeep {
@@ -102,6 +103,16 @@ func (nsr *NamespaceResolver) StmtTrait(n *ast.StmtTrait) {
 	nsr.AddNamespacedName(n, string(n.Name.(*ast.Identifier).Value))
 }
 
+func (nsr *NamespaceResolver) StmtEnum(n *ast.StmtEnum) {
+	if n.Implements != nil {
+		for _, interfaceName := range n.Implements {
+			nsr.ResolveName(interfaceName, "")
+		}
+	}
+
+	nsr.AddNamespacedName(n, string(n.Name.(*ast.Identifier).Value))
+}
+
 func (nsr *NamespaceResolver) StmtFunction(n *ast.StmtFunction) {
 	nsr.AddNamespacedName(n, string(n.Name.(*ast.Identifier).Value))
 
@@ -140,12 +151,22 @@ func (nsr *NamespaceResolver) StmtPropertyList(n *ast.StmtPropertyList) {
 	}
 }
 
+func (nsr *NamespaceResolver) StmtClassConstList(n *ast.StmtClassConstList) {
+	if n.Type != nil {
+		nsr.ResolveType(n.Type)
+	}
+}
+
 func (nsr *NamespaceResolver) StmtConstList(n *ast.StmtConstList) {
 	for _, constant := range n.Consts {
 		nsr.AddNamespacedName(constant, string(constant.(*ast.StmtConstant).Name.(*ast.Identifier).Value))
 	}
 }
 
+func (nsr *NamespaceResolver) Attribute(n *ast.Attribute) {
+	nsr.ResolveName(n.Name, "")
+}
+
 func (nsr *NamespaceResolver) ExprStaticCall(n *ast.ExprStaticCall) {
 	nsr.ResolveName(n.Class, "")
 }
@@ -206,7 +227,7 @@ func (nsr *NamespaceResolver) StmtTraitUse(n *ast.StmtTraitUse) {
 }
 
 // LeaveNode is invoked after node process
-func (nsr *NamespaceResolver) LeaveNode(n ast.Vertex) {
+func (nsr *NamespaceResolver) LeaveNode(n ast.Vertex, _ ast.Vertex, _ string) {
 	switch nn := n.(type) {
 	case *ast.StmtNamespace:
 		if nn.Stmts != nil {
@@ -257,6 +278,14 @@ func (nsr *NamespaceResolver) ResolveType(n ast.Vertex) {
 	switch nn := n.(type) {
 	case *ast.Nullable:
 		nsr.ResolveType(nn.Expr)
+	case *ast.Union:
+		for _, t := range nn.Types {
+			nsr.ResolveType(t)
+		}
+	case *ast.Intersection:
+		for _, t := range nn.Types {
+			nsr.ResolveType(t)
+		}
 	case *ast.Name:
 		nsr.ResolveName(n, "")
 	case *ast.NameRelative:
@@ -339,6 +368,16 @@ func (ns *Namespace) ResolveName(nameNode ast.Vertex, aliasType string) (string,
 			case "iterable":
 				fallthrough
 			case "object":
+				fallthrough
+			case "mixed":
+				fallthrough
+			case "false":
+				fallthrough
+			case "null":
+				fallthrough
+			case "never":
+				fallthrough
+			case "true":
 				return part, nil
 			}
 		}
@@ -662,6 +662,46 @@ func TestResolveNamespaces(t *testing.T) {
 	assert.DeepEqual(t, expected, nsResolver.ResolvedNames)
 }
 
+func TestResolveNamespaceResetAfterBracedNamespace(t *testing.T) {
+	namespaceA := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("A")}}}
+	nameBC := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("B")}, &ast.NamePart{Value: []byte("C")}}}
+	nameC := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("C")}}}
+	nameD := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("D")}}}
+
+	stxTree := &ast.StmtStmtList{
+		Stmts: []ast.Vertex{
+			&ast.StmtNamespace{
+				Name: namespaceA,
+				Stmts: []ast.Vertex{
+					&ast.StmtUseList{
+						Uses: []ast.Vertex{
+							&ast.StmtUse{
+								Use: nameBC,
+							},
+						},
+					},
+					&ast.ExprNew{
+						Class: nameD,
+					},
+				},
+			},
+			&ast.ExprNew{
+				Class: nameC,
+			},
+		},
+	}
+
+	expected := map[ast.Vertex]string{
+		nameD: "A\\D",
+		nameC: "C",
+	}
+
+	nsResolver := nsresolver.NewNamespaceResolver()
+	traverser.NewNodeTraverser(nsResolver).Traverse(stxTree)
+
+	assert.DeepEqual(t, expected, nsResolver.ResolvedNames)
+}
+
 func TestResolveStaticCallDinamicClassName(t *testing.T) {
 	stxTree := &ast.StmtStmtList{
 		Stmts: []ast.Vertex{
@@ -978,3 +1018,122 @@ func TestResolvePropertyTypeName(t *testing.T) {
 
 	assert.DeepEqual(t, expected, nsResolver.ResolvedNames)
 }
+
+func TestResolveUnionTypesAndAttributes(t *testing.T) {
+	nameA := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("A")}}}
+	nameB := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("B")}}}
+	nameNull := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("null")}}}
+	nameMixed := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("mixed")}}}
+	nameAttr := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("Attr")}}}
+
+	functionNode := &ast.StmtFunction{
+		AttrGroups: []ast.Vertex{
+			&ast.AttributeGroup{
+				Attrs: []ast.Vertex{
+					&ast.Attribute{Name: nameAttr},
+				},
+			},
+		},
+		Name: &ast.Identifier{Value: []byte("f")},
+		Params: []ast.Vertex{
+			&ast.Parameter{
+				Type: &ast.Union{
+					Types: []ast.Vertex{nameA, nameB, nameNull},
+				},
+				Var: &ast.ExprVariable{Name: &ast.Identifier{Value: []byte("$a")}},
+			},
+		},
+		ReturnType: nameMixed,
+	}
+
+	stmts := &ast.StmtStmtList{
+		Stmts: []ast.Vertex{
+			&ast.StmtNamespace{
+				Name: &ast.Name{
+					Parts: []ast.Vertex{
+						&ast.NamePart{Value: []byte("Foo")},
+					},
+				},
+			},
+			functionNode,
+		},
+	}
+
+	expected := map[ast.Vertex]string{
+		nameA:        "Foo\\A",
+		nameB:        "Foo\\B",
+		nameNull:     "null",
+		nameMixed:    "mixed",
+		nameAttr:     "Foo\\Attr",
+		functionNode: "Foo\\f",
+	}
+
+	nsResolver := nsresolver.NewNamespaceResolver()
+	traverser.NewTraverser(nsResolver).Traverse(stmts)
+
+	assert.DeepEqual(t, expected, nsResolver.ResolvedNames)
+}
+
+func TestResolveEnumAndIntersectionTypes(t *testing.T) {
+	nameA := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("A")}}}
+	nameB := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("B")}}}
+	nameI := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("I")}}}
+	nameC := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("C")}}}
+	nameNever := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("never")}}}
+
+	enumNode := &ast.StmtEnum{
+		Name:       &ast.Identifier{Value: []byte("E")},
+		Implements: []ast.Vertex{nameI},
+		Stmts: []ast.Vertex{
+			&ast.StmtClassConstList{
+				Type: nameC,
+				Consts: []ast.Vertex{
+					&ast.StmtConstant{
+						Name: &ast.Identifier{Value: []byte("X")},
+						Expr: &ast.ScalarLnumber{Value: []byte("1")},
+					},
+				},
+			},
+			&ast.StmtClassMethod{
+				Name: &ast.Identifier{Value: []byte("f")},
+				Params: []ast.Vertex{
+					&ast.Parameter{
+						Type: &ast.Intersection{
+							Types: []ast.Vertex{nameA, nameB},
+						},
+						Var: &ast.ExprVariable{Name: &ast.Identifier{Value: []byte("$a")}},
+					},
+				},
+				ReturnType: nameNever,
+				Stmt:       &ast.StmtStmtList{},
+			},
+		},
+	}
+
+	stmts := &ast.StmtStmtList{
+		Stmts: []ast.Vertex{
+			&ast.StmtNamespace{
+				Name: &ast.Name{
+					Parts: []ast.Vertex{
+						&ast.NamePart{Value: []byte("Foo")},
+					},
+				},
+			},
+			enumNode,
+		},
+	}
+
+	expected := map[ast.Vertex]string{
+		enumNode:  "Foo\\E",
+		nameI:     "Foo\\I",
+		nameC:     "Foo\\C",
+		nameA:     "Foo\\A",
+		nameB:     "Foo\\B",
+		nameNever: "never",
+	}
+
+	nsResolver := nsresolver.NewNamespaceResolver()
+	traverser.NewTraverser(nsResolver).Traverse(stmts)
+
+	assert.DeepEqual(t, expected, nsResolver.ResolvedNames)
+}
@@ -14,11 +14,11 @@ func (v *Null) Leave(_ string, _ bool) {
 	// do nothing
 }
 
-func (v *Null) EnterNode(_ ast.Vertex) bool {
+func (v *Null) EnterNode(_ ast.Vertex, _ ast.Vertex, _ string) bool {
 	return true
 }
 
-func (v *Null) LeaveNode(_ ast.Vertex) {
+func (v *Null) LeaveNode(_ ast.Vertex, _ ast.Vertex, _ string) {
 	// do nothing
 }
 
@@ -42,6 +42,30 @@ func (v *Null) Argument(_ *ast.Argument) {
 	// do nothing
 }
 
+func (v *Null) Attribute(_ *ast.Attribute) {
+	// do nothing
+}
+
+func (v *Null) AttributeGroup(_ *ast.AttributeGroup) {
+	// do nothing
+}
+
+func (v *Null) Union(_ *ast.Union) {
+	// do nothing
+}
+
+func (v *Null) Intersection(_ *ast.Intersection) {
+	// do nothing
+}
+
+func (v *Null) MatchArm(_ *ast.MatchArm) {
+	// do nothing
+}
+
+func (v *Null) BadStmt(_ *ast.BadStmt) {
+	// do nothing
+}
+
 func (v *Null) StmtBreak(_ *ast.StmtBreak) {
 	// do nothing
 }
@@ -102,6 +126,14 @@ func (v *Null) StmtElseIf(_ *ast.StmtElseIf) {
 	// do nothing
 }
 
+func (v *Null) StmtEnum(_ *ast.StmtEnum) {
+	// do nothing
+}
+
+func (v *Null) StmtEnumCase(_ *ast.StmtEnumCase) {
+	// do nothing
+}
+
 func (v *Null) StmtExpression(_ *ast.StmtExpression) {
 	// do nothing
 }
@@ -230,6 +262,10 @@ func (v *Null) StmtWhile(_ *ast.StmtWhile) {
 	// do nothing
 }
 
+func (v *Null) BadExpr(_ *ast.BadExpr) {
+	// do nothing
+}
+
 func (v *Null) ExprArray(_ *ast.ExprArray) {
 	// do nothing
 }
@@ -318,6 +354,10 @@ func (v *Null) ExprList(_ *ast.ExprList) {
 	// do nothing
 }
 
+func (v *Null) ExprMatch(_ *ast.ExprMatch) {
+	// do nothing
+}
+
 func (v *Null) ExprMethodCall(_ *ast.ExprMethodCall) {
 	// do nothing
 }
@@ -326,6 +366,14 @@ func (v *Null) ExprNew(_ *ast.ExprNew) {
 	// do nothing
 }
 
+func (v *Null) ExprNullsafeMethodCall(_ *ast.ExprNullsafeMethodCall) {
+	// do nothing
+}
+
+func (v *Null) ExprNullsafePropertyFetch(_ *ast.ExprNullsafePropertyFetch) {
+	// do nothing
+}
+
 func (v *Null) ExprPostDec(_ *ast.ExprPostDec) {
 	// do nothing
 }
@@ -374,6 +422,10 @@ func (v *Null) ExprTernary(_ *ast.ExprTernary) {
 	// do nothing
 }
 
+func (v *Null) ExprThrow(_ *ast.ExprThrow) {
+	// do nothing
+}
+
 func (v *Null) ExprUnaryMinus(_ *ast.ExprUnaryMinus) {
 	// do nothing
 }
@@ -0,0 +1,261 @@
+package printer
+
+import (
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/token"
+)
+
+// the operator precedence levels follow the precedence declarations of the grammars,
+// the higher level binds tighter
+const (
+	precThrow = iota + 1
+	precInclude
+	precLogicalOr
+	precLogicalXor
+	precLogicalAnd
+	precPrint
+	precYield
+	precArrowFunction
+	precYieldFrom
+	precAssign
+	precTernary
+	precCoalesce
+	precBooleanOr
+	precBooleanAnd
+	precBitwiseOr
+	precBitwiseXor
+	precBitwiseAnd
+	precEquality
+	precComparison
+	precShift
+	precAdditive
+	precMultiplicative
+	precBooleanNot
+	precInstanceOf
+	precUnary
+	precPow
+	precNew
+)
+
+type assoc int
+
+const (
+	assocLeft assoc = iota
+	assocRight
+	assocNone
+)
+
+type operator struct {
+	prec  int
+	assoc assoc
+}
+
+// precedence returns the operator of the expression node,
+// ok is false for the nodes that are not operators
+func precedence(n ast.Vertex) (op operator, ok bool) {
+	switch n.(type) {
+	case *ast.ExprThrow:
+		return operator{precThrow, assocRight}, true
+	case *ast.ExprInclude, *ast.ExprIncludeOnce, *ast.ExprRequire, *ast.ExprRequireOnce:
+		return operator{precInclude, assocRight}, true
+	case *ast.ExprBinaryLogicalOr:
+		return operator{precLogicalOr, assocLeft}, true
+	case *ast.ExprBinaryLogicalXor:
+		return operator{precLogicalXor, assocLeft}, true
+	case *ast.ExprBinaryLogicalAnd:
+		return operator{precLogicalAnd, assocLeft}, true
+	case *ast.ExprPrint:
+		return operator{precPrint, assocRight}, true
+	case *ast.ExprYield:
+		return operator{precYield, assocRight}, true
+	case *ast.ExprArrowFunction:
+		return operator{precArrowFunction, assocRight}, true
+	case *ast.ExprYieldFrom:
+		return operator{precYieldFrom, assocRight}, true
+	case *ast.ExprAssign, *ast.ExprAssignReference, *ast.ExprAssignBitwiseAnd, *ast.ExprAssignBitwiseOr,
+		*ast.ExprAssignBitwiseXor, *ast.ExprAssignCoalesce, *ast.ExprAssignConcat, *ast.ExprAssignDiv,
+		*ast.ExprAssignMinus, *ast.ExprAssignMod, *ast.ExprAssignMul, *ast.ExprAssignPlus, *ast.ExprAssignPow,
+		*ast.ExprAssignShiftLeft, *ast.ExprAssignShiftRight:
+		return operator{precAssign, assocRight}, true
+	case *ast.ExprTernary:
+		// php 8 does not allow the nested ternary operators without parentheses
+		return operator{precTernary, assocNone}, true
+	case *ast.ExprBinaryCoalesce:
+		return operator{precCoalesce, assocRight}, true
+	case *ast.ExprBinaryBooleanOr:
+		return operator{precBooleanOr, assocLeft}, true
+	case *ast.ExprBinaryBooleanAnd:
+		return operator{precBooleanAnd, assocLeft}, true
+	case *ast.ExprBinaryBitwiseOr:
+		return operator{precBitwiseOr, assocLeft}, true
+	case *ast.ExprBinaryBitwiseXor:
+		return operator{precBitwiseXor, assocLeft}, true
+	case *ast.ExprBinaryBitwiseAnd:
+		return operator{precBitwiseAnd, assocLeft}, true
+	case *ast.ExprBinaryEqual, *ast.ExprBinaryNotEqual, *ast.ExprBinaryIdentical, *ast.ExprBinaryNotIdentical,
+		*ast.ExprBinarySpaceship:
+		return operator{precEquality, assocNone}, true
+	case *ast.ExprBinarySmaller, *ast.ExprBinarySmallerOrEqual, *ast.ExprBinaryGreater, *ast.ExprBinaryGreaterOrEqual:
+		return operator{precComparison, assocNone}, true
+	case *ast.ExprBinaryShiftLeft, *ast.ExprBinaryShiftRight:
+		return operator{precShift, assocLeft}, true
+	case *ast.ExprBinaryPlus, *ast.ExprBinaryMinus, *ast.ExprBinaryConcat:
+		return operator{precAdditive, assocLeft}, true
+	case *ast.ExprBinaryMul, *ast.ExprBinaryDiv, *ast.ExprBinaryMod:
+		return operator{precMultiplicative, assocLeft}, true
+	case *ast.ExprBooleanNot:
+		return operator{precBooleanNot, assocRight}, true
+	case *ast.ExprInstanceOf:
+		return operator{precInstanceOf, assocNone}, true
+	case *ast.ExprBitwiseNot, *ast.ExprUnaryMinus, *ast.ExprUnaryPlus, *ast.ExprErrorSuppress,
+		*ast.ExprPreInc, *ast.ExprPreDec, *ast.ExprCastArray, *ast.ExprCastBool, *ast.ExprCastDouble,
+		*ast.ExprCastInt, *ast.ExprCastObject, *ast.ExprCastString, *ast.ExprCastUnset:
+		return operator{precUnary, assocRight}, true
+	case *ast.ExprBinaryPow:
+		return operator{precPow, assocRight}, true
+	case *ast.ExprNew, *ast.ExprClone:
+		return operator{precNew, assocNone}, true
+	}
+
+	return operator{}, false
+}
+
+// side is the place of the operand relative to the operator
+type side int
+
+const (
+	sideLeft side = iota
+	sideRight
+	// sideDeref is the dereferenced operand that can not be an operator
+	sideDeref
+)
+
+// needParens reports whether the child has to be wrapped into the parentheses
+// to keep the meaning of the tree, followed is whether any operator is printed
+// after the child.
+//
+// The right operand starting with the prefix operator, like !$a = 1 or $a ?? throw $e,
+// is parsed as a whole whatever its precedence is, so it needs no parentheses
+// unless it would take the following operators into its own operand.
+func needParens(parent, child ast.Vertex, s side, followed bool) bool {
+	c, isOp := precedence(child)
+
+	if s == sideDeref {
+		_, isClosure := child.(*ast.ExprClosure)
+		return isOp || isClosure
+	}
+
+	if !isOp {
+		return false
+	}
+
+	if isAmbiguous(parent, child, s) {
+		return true
+	}
+
+	if s == sideRight && !followed && isPrefix(child) {
+		return false
+	}
+
+	if isLegacyTernary(parent, child) {
+		return false
+	}
+
+	p, _ := precedence(parent)
+
+	switch {
+	case c.prec != p.prec:
+		return c.prec < p.prec
+	case s == sideLeft:
+		return p.assoc != assocLeft
+	default:
+		return p.assoc != assocRight
+	}
+}
+
+// isPrefix reports whether the operator node starts with its operator,
+// the assignment is the same as the variable can not take the operator before it
+func isPrefix(n ast.Vertex) bool {
+	op, _ := precedence(n)
+
+	switch op.prec {
+	case precThrow, precInclude, precPrint, precYield, precArrowFunction, precYieldFrom, precAssign,
+		precBooleanNot, precUnary, precNew:
+		return true
+	}
+
+	return false
+}
+
+// isLegacyTernary reports whether the ternary condition is the ternary parsed
+// without parentheses by php 7, that treats the nested ternary as left associative.
+// The condition has to end right before the question mark of the parent.
+func isLegacyTernary(parent, child ast.Vertex) bool {
+	p, ok := parent.(*ast.ExprTernary)
+	if !ok || p.Cond != child || p.QuestionTkn == nil || child.GetPosition() == nil {
+		return false
+	}
+
+	if _, ok := child.(*ast.ExprTernary); !ok {
+		return false
+	}
+
+	start := p.QuestionTkn.Position
+	if len(p.QuestionTkn.FreeFloating) > 0 {
+		start = p.QuestionTkn.FreeFloating[0].Position
+	}
+
+	return start != nil && start.StartPos == child.GetPosition().EndPos
+}
+
+// isAmbiguous reports whether the operators are parsed differently by php 7 and php 8,
+// or would be glued into another operator like - -$a into --$a
+func isAmbiguous(parent, child ast.Vertex, s side) bool {
+	switch parent.(type) {
+	case *ast.ExprBinaryConcat:
+		switch child.(type) {
+		case *ast.ExprBinaryPlus, *ast.ExprBinaryMinus, *ast.ExprBinaryShiftLeft, *ast.ExprBinaryShiftRight:
+			return true
+		}
+	case *ast.ExprBinaryPlus, *ast.ExprBinaryMinus, *ast.ExprBinaryShiftLeft, *ast.ExprBinaryShiftRight:
+		if _, ok := child.(*ast.ExprBinaryConcat); ok {
+			return true
+		}
+	}
+
+	if s != sideRight || separated(child) {
+		return false
+	}
+
+	switch parent.(type) {
+	case *ast.ExprUnaryMinus, *ast.ExprBinaryMinus:
+		switch child.(type) {
+		case *ast.ExprUnaryMinus, *ast.ExprPreDec:
+			return true
+		}
+	case *ast.ExprUnaryPlus, *ast.ExprBinaryPlus:
+		switch child.(type) {
+		case *ast.ExprUnaryPlus, *ast.ExprPreInc:
+			return true
+		}
+	}
+
+	return false
+}
+
+// separated reports whether the whitespace is printed before the operator of the child
+func separated(n ast.Vertex) bool {
+	var t *token.Token
+	switch n := n.(type) {
+	case *ast.ExprUnaryMinus:
+		t = n.MinusTkn
+	case *ast.ExprUnaryPlus:
+		t = n.PlusTkn
+	case *ast.ExprPreDec:
+		t = n.DecTkn
+	case *ast.ExprPreInc:
+		t = n.IncTkn
+	}
+
+	return t != nil && len(t.FreeFloating) > 0
+}
@@ -0,0 +1,105 @@
+package printer_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+)
+
+func printPHP(n ast.Vertex) string {
+	o := bytes.NewBufferString("")
+	n.Accept(printer.NewPrinter(o).WithState(printer.PrinterStatePHP))
+
+	return o.String()
+}
+
+func v(name string) *ast.ExprVariable {
+	return &ast.ExprVariable{Name: &ast.Identifier{Value: []byte("$" + name)}}
+}
+
+func TestPrinterPrecedence(t *testing.T) {
+	a, b, c := v("a"), v("b"), v("c")
+	foo := &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("Foo")}}}
+
+	tests := []struct {
+		node     ast.Vertex
+		expected string
+	}{
+		{&ast.ExprBinaryMul{Left: &ast.ExprBinaryPlus{Left: a, Right: b}, Right: c}, "($a+$b)*$c"},
+		{&ast.ExprBinaryMul{Left: a, Right: &ast.ExprBinaryPlus{Left: b, Right: c}}, "$a*($b+$c)"},
+		{&ast.ExprBinaryPlus{Left: &ast.ExprBinaryMul{Left: a, Right: b}, Right: c}, "$a*$b+$c"},
+		{&ast.ExprBinaryMinus{Left: &ast.ExprBinaryMinus{Left: a, Right: b}, Right: c}, "$a-$b-$c"},
+		{&ast.ExprBinaryMinus{Left: a, Right: &ast.ExprBinaryMinus{Left: b, Right: c}}, "$a-($b-$c)"},
+		{&ast.ExprBinaryPow{Left: &ast.ExprBinaryPow{Left: a, Right: b}, Right: c}, "($a**$b)**$c"},
+		{&ast.ExprBinaryPow{Left: a, Right: &ast.ExprBinaryPow{Left: b, Right: c}}, "$a**$b**$c"},
+		{&ast.ExprBinaryPow{Left: &ast.ExprUnaryMinus{Expr: a}, Right: b}, "(-$a)**$b"},
+		{&ast.ExprBinaryCoalesce{Left: &ast.ExprBinaryCoalesce{Left: a, Right: b}, Right: c}, "($a??$b)??$c"},
+		{&ast.ExprBinaryCoalesce{Left: a, Right: &ast.ExprBinaryCoalesce{Left: b, Right: c}}, "$a??$b??$c"},
+		{&ast.ExprBinarySpaceship{Left: &ast.ExprBinarySpaceship{Left: a, Right: b}, Right: c}, "($a<=>$b)<=>$c"},
+		{&ast.ExprBinaryBooleanAnd{Left: &ast.ExprBinaryLogicalOr{Left: a, Right: b}, Right: c}, "($a or$b)&&$c"},
+		{&ast.ExprAssign{Var: a, Expr: &ast.ExprBinaryLogicalAnd{Left: b, Right: c}}, "$a=($b and$c)"},
+		{&ast.ExprAssign{Var: a, Expr: &ast.ExprAssignCoalesce{Var: b, Expr: c}}, "$a=$b??=$c"},
+		{&ast.ExprBooleanNot{Expr: &ast.ExprInstanceOf{Expr: a, Class: foo}}, "!$a instanceof Foo"},
+		{&ast.ExprInstanceOf{Expr: &ast.ExprBooleanNot{Expr: a}, Class: foo}, "(!$a)instanceof Foo"},
+		{&ast.ExprUnaryMinus{Expr: &ast.ExprUnaryMinus{Expr: a}}, "-(-$a)"},
+		{&ast.ExprBinaryMinus{Left: a, Right: &ast.ExprPreDec{Var: b}}, "$a-(--$b)"},
+		{&ast.ExprCastInt{Expr: &ast.ExprBinaryPlus{Left: a, Right: b}}, "(int)($a+$b)"},
+		{&ast.ExprBinaryConcat{Left: a, Right: &ast.ExprBinaryPlus{Left: b, Right: c}}, "$a.($b+$c)"},
+		{&ast.ExprBinaryPlus{Left: &ast.ExprBinaryConcat{Left: a, Right: b}, Right: c}, "($a.$b)+$c"},
+		{&ast.ExprTernary{Cond: &ast.ExprTernary{Cond: a, IfTrue: b, IfFalse: c}, IfTrue: b, IfFalse: c}, "($a?$b:$c)?$b:$c"},
+		{&ast.ExprTernary{Cond: a, IfTrue: &ast.ExprTernary{Cond: a, IfTrue: b, IfFalse: c}, IfFalse: c}, "$a?$a?$b:$c:$c"},
+		{&ast.ExprTernary{Cond: a, IfTrue: b, IfFalse: &ast.ExprTernary{Cond: a, IfTrue: b, IfFalse: c}}, "$a?$b:($a?$b:$c)"},
+		{&ast.ExprMethodCall{Var: &ast.ExprNew{Class: foo}, Method: &ast.Identifier{Value: []byte("bar")}}, "(new Foo)->bar()"},
+		{&ast.ExprPropertyFetch{Var: &ast.ExprAssign{Var: a, Expr: b}, Prop: &ast.Identifier{Value: []byte("p")}}, "($a=$b)->p"},
+		{&ast.ExprArrayDimFetch{Var: &ast.ExprBinaryCoalesce{Left: a, Right: b}, Dim: c}, "($a??$b)[$c]"},
+		{&ast.ExprFunctionCall{Function: &ast.ExprClosure{}}, "(function(){})()"},
+		{&ast.ExprBooleanNot{Expr: &ast.ExprAssign{Var: a, Expr: b}}, "!$a=$b"},
+		{&ast.ExprBinaryPlus{Left: &ast.ExprBooleanNot{Expr: &ast.ExprAssign{Var: a, Expr: b}}, Right: c}, "!($a=$b)+$c"},
+		{&ast.ExprBinaryPlus{Left: &ast.ExprBinaryMul{Left: a, Right: &ast.ExprThrow{Expr: b}}, Right: c}, "$a*(throw$b)+$c"},
+		{&ast.ExprBinaryCoalesce{Left: a, Right: &ast.ExprThrow{Expr: b}}, "$a??throw$b"},
+		{&ast.ExprBinaryMul{Left: &ast.ExprBrackets{Expr: &ast.ExprBinaryPlus{Left: a, Right: b}}, Right: c}, "($a+$b)*$c"},
+		{&ast.ExprFunctionCall{
+			Function: &ast.Name{Parts: []ast.Vertex{&ast.NamePart{Value: []byte("f")}}},
+			Args:     []ast.Vertex{&ast.Argument{Expr: &ast.ExprBinaryLogicalOr{Left: a, Right: b}}},
+		}, "f($a or$b)"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, printPHP(tt.node))
+	}
+}
+
+func TestPrinterPrecedenceParsed(t *testing.T) {
+	src := `<?php
+	!$a = 1;
+	$b = $a and $c;
+	($a + $b) * $c;
+	$a ? $b : ($c ? 1 : 2);
+	`
+
+	assert.Equal(t, src, print(parse(src)))
+}
+
+func TestPrinterPrecedenceMovedNode(t *testing.T) {
+	root := parse("<?php $c * $d;$a + $b;").(*ast.Root)
+
+	mul := root.Stmts[0].(*ast.StmtExpression).Expr.(*ast.ExprBinaryMul)
+	mul.Right = root.Stmts[1].(*ast.StmtExpression).Expr
+	root.Stmts = root.Stmts[:1]
+
+	// the free floating of the operand follows the added parenthesis
+	assert.Equal(t, "<?php $c *($a + $b);", print(root))
+}
+
+func TestPrinterPrecedenceUnwrappedBrackets(t *testing.T) {
+	root := parse("<?php $a * ($b + $c);").(*ast.Root)
+
+	mul := root.Stmts[0].(*ast.StmtExpression).Expr.(*ast.ExprBinaryMul)
+	mul.Right = mul.Right.(*ast.ExprBrackets).Expr
+
+	assert.Equal(t, "<?php $a *($b + $c);", print(root))
+}
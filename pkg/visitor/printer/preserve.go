@@ -0,0 +1,280 @@
+package printer
+
+import (
+	"bytes"
+
+	"github.com/z7zmey/php-parser/internal/indentation"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/visitor/formatter"
+)
+
+// WithSource enables the format preserving printing of the tree parsed from src.
+// The unchanged subtrees are copied from the source as is. The changed node
+// is printed with its own tokens and the nodes without position, that are built
+// or marked as changed by setting the position to nil, are formatted to match
+// the indentation around them. The source keeps its own open tags,
+// so the printer starts in the php state.
+func (p *printer) WithSource(src []byte) *printer {
+	p.state = PrinterStatePHP
+	p.src = src
+	p.pristines = map[ast.Vertex]bool{}
+	return p
+}
+
+// printPreserved prints the node in the format preserving mode
+func (p *printer) printPreserved(n ast.Vertex) {
+	parent := p.parent
+	p.parent = n
+	defer func() { p.parent = parent }()
+
+	orig := n
+	if p.origins != nil {
+		orig = p.origins[n]
+	}
+
+	switch {
+	case orig != nil && p.pristine(orig):
+		pos := orig.GetPosition()
+		if t := ast.FirstToken(n); t != nil {
+			for _, ff := range t.FreeFloating {
+				p.write(ff.Value)
+			}
+		}
+		p.write(p.src[pos.StartPos:pos.EndPos])
+	case p.origins != nil || n.GetPosition() != nil:
+		n.Accept(p)
+	default:
+		p.printGenerated(parent, n)
+	}
+}
+
+// printGenerated formats the copy of the node without position and prints it
+// after the whitespace of the node, the original subtrees inside the node
+// are still copied from the source
+func (p *printer) printGenerated(parent, n ast.Vertex) {
+	var leading []*token.Token
+	if t := ast.FirstToken(n); t != nil && len(t.FreeFloating) > 0 {
+		leading = t.FreeFloating
+	} else {
+		leading = p.leading(parent, n)
+	}
+
+	c := ast.Clone(n)
+
+	p.origins = map[ast.Vertex]ast.Vertex{}
+	defer func() { p.origins = nil }()
+	mapOrigins(p.origins, c, n)
+
+	c.Accept(formatter.NewFormatter().WithState(formatter.FormatterStatePHP))
+
+	indent := lastLine(leading)
+	if indent == nil && parent != nil && parent.GetPosition() != nil {
+		indent = indentation.Line(p.src, parent.GetPosition().StartPos)
+	}
+
+	indentation.Reindent(c, indent)
+	if t := ast.FirstToken(c); t != nil {
+		t.FreeFloating = leading
+	}
+
+	c.Accept(p)
+}
+
+// mapOrigins maps the nodes of the clone to the nodes it is cloned from
+func mapOrigins(m map[ast.Vertex]ast.Vertex, clone, orig ast.Vertex) {
+	m[clone] = orig
+
+	cc, oc := ast.Children(clone), ast.Children(orig)
+	for i := range cc {
+		mapOrigins(m, cc[i].Node, oc[i].Node)
+	}
+}
+
+// leading returns the whitespace in front of the node that has none of its own.
+// The node in a list is spaced like its siblings, otherwise the spacing
+// is taken from the formatted copy of the parent.
+func (p *printer) leading(parent, n ast.Vertex) []*token.Token {
+	if parent == nil || parent.GetPosition() == nil {
+		return nil
+	}
+
+	children := ast.Children(parent)
+
+	var child ast.Child
+	for _, c := range children {
+		if c.Node == n {
+			child = c
+			break
+		}
+	}
+
+	if child.Index >= 0 {
+		var sibling ast.Vertex
+		distance := -1
+		for _, c := range children {
+			if c.Field != child.Field || c.Node == n || c.Node.GetPosition() == nil {
+				continue
+			}
+
+			// the first item follows the opening bracket
+			d := abs(c.Index - child.Index)
+			if c.Index == 0 {
+				d += len(children)
+			}
+
+			if distance < 0 || d < distance {
+				sibling, distance = c.Node, d
+			}
+		}
+
+		if sibling != nil {
+			return whitespace(ast.FirstToken(sibling))
+		}
+	}
+
+	pc := ast.Clone(parent)
+	pc.Accept(formatter.NewFormatter().WithState(formatter.FormatterStatePHP))
+
+	for _, c := range ast.Children(pc) {
+		if c.Field != child.Field || c.Index != child.Index {
+			continue
+		}
+
+		t := ast.FirstToken(c.Node)
+		if t == nil {
+			return nil
+		}
+
+		indent := indentation.Line(p.src, parent.GetPosition().StartPos)
+		leading := make([]*token.Token, len(t.FreeFloating))
+		for i, ff := range t.FreeFloating {
+			leading[i] = &token.Token{ID: ff.ID, Value: bytes.ReplaceAll(ff.Value, []byte("\n"), append([]byte("\n"), indent...))}
+		}
+
+		return leading
+	}
+
+	return nil
+}
+
+// whitespace returns the last whitespace in front of the token
+func whitespace(t *token.Token) []*token.Token {
+	if t == nil {
+		return nil
+	}
+
+	for i := len(t.FreeFloating) - 1; i >= 0; i-- {
+		if ff := t.FreeFloating[i]; ff.ID == token.T_WHITESPACE {
+			return []*token.Token{{ID: token.T_WHITESPACE, Value: ff.Value}}
+		}
+	}
+
+	return nil
+}
+
+// lastLine returns the whitespace after the last line break,
+// nil if there is no line break
+func lastLine(ff []*token.Token) []byte {
+	for i := len(ff) - 1; i >= 0; i-- {
+		if n := bytes.LastIndexByte(ff[i].Value, '\n'); n >= 0 {
+			return ff[i].Value[n+1:]
+		}
+	}
+
+	return nil
+}
+
+// pristine reports whether the node is printed as it was parsed
+func (p *printer) pristine(n ast.Vertex) bool {
+	ok, seen := p.pristines[n]
+	if !seen {
+		ok = p.checkPristine(n)
+		p.pristines[n] = ok
+	}
+
+	return ok
+}
+
+type part struct {
+	start, end   int
+	freeFloating []*token.Token
+}
+
+// checkPristine reports whether the tokens and the children of the node,
+// together with the whitespace in front of them, cover the node source exactly.
+// The tokens and the children are taken in the order of the node fields and lists,
+// the moved child is out of the source order and makes the node changed.
+func (p *printer) checkPristine(n ast.Vertex) bool {
+	pos := n.GetPosition()
+	if pos == nil || pos.StartPos < 0 || pos.StartPos > pos.EndPos || pos.EndPos > len(p.src) {
+		return false
+	}
+
+	var tokens []part
+	for _, t := range ast.Tokens(n) {
+		tp := t.Position
+		if tp == nil || tp.StartPos < pos.StartPos || tp.EndPos > pos.EndPos || tp.StartPos > tp.EndPos {
+			return false
+		}
+		if !bytes.Equal(t.Value, p.src[tp.StartPos:tp.EndPos]) {
+			return false
+		}
+		tokens = append(tokens, part{tp.StartPos, tp.EndPos, t.FreeFloating})
+	}
+
+	var children []part
+	for _, c := range ast.Children(n) {
+		if !p.pristine(c.Node) {
+			return false
+		}
+
+		cp := c.Node.GetPosition()
+		if cp.StartPos < pos.StartPos || cp.EndPos > pos.EndPos {
+			return false
+		}
+
+		var ff []*token.Token
+		if t := ast.FirstToken(c.Node); t != nil {
+			ff = t.FreeFloating
+		}
+		children = append(children, part{cp.StartPos, cp.EndPos, ff})
+	}
+
+	cursor := pos.StartPos
+	for i := 0; len(tokens) > 0 || len(children) > 0; i++ {
+		var s part
+		if len(children) == 0 || len(tokens) > 0 && tokens[0].start < children[0].start {
+			s, tokens = tokens[0], tokens[1:]
+		} else {
+			s, children = children[0], children[1:]
+		}
+
+		if s.start < cursor {
+			return false
+		}
+
+		// the whitespace of the first part is in front of the node
+		if i > 0 || s.start > cursor {
+			var gap []byte
+			for _, ff := range s.freeFloating {
+				gap = append(gap, ff.Value...)
+			}
+			if !bytes.Equal(gap, p.src[cursor:s.start]) {
+				return false
+			}
+		}
+
+		cursor = s.end
+	}
+
+	return cursor == pos.EndPos
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+
+	return i
+}
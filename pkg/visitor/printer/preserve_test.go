@@ -0,0 +1,201 @@
+package printer_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/ast/builder"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+)
+
+func printPreserved(src string, n ast.Vertex) string {
+	o := bytes.NewBufferString("")
+	n.Accept(printer.NewPrinter(o).WithSource([]byte(src)))
+
+	return o.String()
+}
+
+const preservedSrc = `<?php
+namespace App;
+
+class Foo extends Bar
+{
+	// the items
+	private $items = [1,2,  3];
+
+	public function add($item)  {
+		$this->items[] = $item; // append
+		return $this;
+	}
+
+	public function count(): int
+	{
+		return count( $this->items );
+	}
+}
+`
+
+func preservedClass(root ast.Vertex) *ast.StmtClass {
+	return root.(*ast.Root).Stmts[1].(*ast.StmtClass)
+}
+
+func TestPreservedUnchanged(t *testing.T) {
+	root := parsePhp8(preservedSrc)
+	assert.Equal(t, preservedSrc, printPreserved(preservedSrc, root))
+
+	src := `<div><?php
+	class Foo {
+		use T { a as protected b; }
+		public function f() {
+			$h = <<<EOT
+	  hi $x
+	  EOT;
+			switch ($a) { case 1: break; default: }
+			list(, $q) = $a;
+		}
+	}
+	?>html<?= $a ?>
+	`
+	assert.Equal(t, src, printPreserved(src, parsePhp8(src)))
+}
+
+func TestPreservedReplaceMethod(t *testing.T) {
+	root := parsePhp8(preservedSrc)
+	class := preservedClass(root)
+
+	class.Stmts[1] = builder.Method("add").Public().Param(builder.Param("item").Type("int").Node()).Body(
+		builder.If(builder.Var("item"), builder.Stmt(builder.Assign(builder.Index(builder.Prop(builder.Var("this"), "items"), nil), builder.Var("item")))),
+		builder.Return(builder.Var("this")),
+	).Node()
+
+	expected := `<?php
+namespace App;
+
+class Foo extends Bar
+{
+	// the items
+	private $items = [1,2,  3];
+
+	public function add(int $item) {
+	    if ($item) {
+	        $this->items[] = $item;
+	    }
+	    return $this;
+	}
+
+	public function count(): int
+	{
+		return count( $this->items );
+	}
+}
+`
+	assert.Equal(t, expected, printPreserved(preservedSrc, root))
+}
+
+func TestPreservedInsertAndRemove(t *testing.T) {
+	root := parsePhp8(preservedSrc)
+	class := preservedClass(root)
+
+	add := class.Stmts[1].(*ast.StmtClassMethod)
+	body := add.Stmt.(*ast.StmtStmtList)
+	body.Stmts = append([]ast.Vertex{builder.Stmt(builder.Call("assert", builder.Var("item")))}, body.Stmts...)
+
+	class.Stmts = class.Stmts[:2]
+
+	expected := `<?php
+namespace App;
+
+class Foo extends Bar
+{
+	// the items
+	private $items = [1,2,  3];
+
+	public function add($item)  {
+		assert($item);
+		$this->items[] = $item; // append
+		return $this;
+	}
+}
+`
+	assert.Equal(t, expected, printPreserved(preservedSrc, root))
+}
+
+func TestPreservedReplaceExpr(t *testing.T) {
+	root := parsePhp8(preservedSrc)
+	class := preservedClass(root)
+
+	count := class.Stmts[2].(*ast.StmtClassMethod)
+	count.Name = builder.Id("size")
+	ret := count.Stmt.(*ast.StmtStmtList).Stmts[0].(*ast.StmtReturn)
+	ret.Expr = &ast.ExprBinaryMul{Left: ret.Expr, Right: builder.Int(2)}
+
+	list := class.Stmts[0].(*ast.StmtPropertyList).Props[0].(*ast.StmtProperty).Expr.(*ast.ExprArray)
+	list.Items = append(list.Items, builder.Item(nil, builder.Int(4)))
+
+	expected := `<?php
+namespace App;
+
+class Foo extends Bar
+{
+	// the items
+	private $items = [1,2,  3,  4];
+
+	public function add($item)  {
+		$this->items[] = $item; // append
+		return $this;
+	}
+
+	public function size(): int
+	{
+		return count( $this->items ) * 2;
+	}
+}
+`
+	assert.Equal(t, expected, printPreserved(preservedSrc, root))
+}
+
+func TestPreservedDirtyMark(t *testing.T) {
+	src := "<?php\nif ($a)  {\n  foo( $a ,$b );\n}\n"
+	root := parsePhp8(src)
+
+	stmt := root.(*ast.Root).Stmts[0].(*ast.StmtIf).Stmt.(*ast.StmtStmtList).Stmts[0].(*ast.StmtExpression)
+	stmt.Expr.(*ast.ExprFunctionCall).Position = nil
+
+	expected := "<?php\nif ($a)  {\n  foo($a, $b);\n}\n"
+	assert.Equal(t, expected, printPreserved(src, root))
+}
+
+func TestPreservedReorder(t *testing.T) {
+	root := parsePhp8(preservedSrc)
+	class := preservedClass(root)
+
+	body := class.Stmts[1].(*ast.StmtClassMethod).Stmt.(*ast.StmtStmtList)
+	body.Stmts[0], body.Stmts[1] = body.Stmts[1], body.Stmts[0]
+
+	class.Stmts[1], class.Stmts[2] = class.Stmts[2], class.Stmts[1]
+
+	// the trailing comment is in front of the next statement and moves with it
+	expected := `<?php
+namespace App;
+
+class Foo extends Bar
+{
+	// the items
+	private $items = [1,2,  3];
+
+	public function count(): int
+	{
+		return count( $this->items );
+	}
+
+	public function add($item)  { // append
+		return $this;
+		$this->items[] = $item;
+	}
+}
+`
+	assert.Equal(t, expected, printPreserved(preservedSrc, root))
+}
@@ -18,8 +18,20 @@ type printer struct {
 	output io.Writer
 	state  printerState
 	last   []byte
+
+	src       []byte
+	pristines map[ast.Vertex]bool
+	origins   map[ast.Vertex]ast.Vertex
+	parent    ast.Vertex
+
+	// followed is true while the printed operand is followed by the operators
+	// of its ancestors, see needParens
+	followed bool
 }
 
+// Printer is the printer returned by NewPrinter
+type Printer = printer
+
 func NewPrinter(output io.Writer) *printer {
 	return &printer{
 		output: output,
@@ -56,9 +68,38 @@ func (p *printer) write(b []byte) {
 }
 
 func (p *printer) printNode(n ast.Vertex) {
-	if n != nil {
-		n.Accept(p)
+	p.printExpr(n, false)
+}
+
+// printExpr prints the node, followed is whether the operators are printed after it
+func (p *printer) printExpr(n ast.Vertex, followed bool) {
+	if n == nil {
+		return
+	}
+
+	defer func(f bool) { p.followed = f }(p.followed)
+	p.followed = followed
+
+	if p.src != nil {
+		p.printPreserved(n)
+		return
 	}
+
+	n.Accept(p)
+}
+
+// printOperand prints the operand of the operator node n,
+// the parentheses are added if they are required by the precedence
+func (p *printer) printOperand(n ast.Vertex, operand ast.Vertex, s side) {
+	followed := p.followed || s != sideRight
+	if operand == nil || !needParens(n, operand, s, followed) {
+		p.printExpr(operand, followed)
+		return
+	}
+
+	p.write([]byte("("))
+	p.printNode(operand)
+	p.write([]byte(")"))
 }
 
 func (p *printer) printList(list []ast.Vertex) {
@@ -135,6 +176,8 @@ func (p *printer) ifNotToken(t *token.Token, val []byte) []byte {
 }
 
 func (p *printer) Root(n *ast.Root) {
+	// the root is accepted directly, the statements are spaced in its context
+	p.parent = n
 	p.printList(n.Stmts)
 	p.printToken(n.EndTkn, nil)
 }
@@ -145,6 +188,8 @@ func (p *printer) Nullable(n *ast.Nullable) {
 }
 
 func (p *printer) Parameter(n *ast.Parameter) {
+	p.printList(n.AttrGroups)
+	p.printList(n.Modifiers)
 	p.printNode(n.Type)
 	p.printToken(n.AmpersandTkn, nil)
 	p.printToken(n.VariadicTkn, nil)
@@ -158,11 +203,48 @@ func (p *printer) Identifier(n *ast.Identifier) {
 }
 
 func (p *printer) Argument(n *ast.Argument) {
+	p.printNode(n.Name)
+	p.printToken(n.ColonTkn, p.ifNode(n.Name, []byte(":")))
 	p.printToken(n.VariadicTkn, nil)
 	p.printToken(n.AmpersandTkn, nil)
 	p.printNode(n.Expr)
 }
 
+func (p *printer) Attribute(n *ast.Attribute) {
+	p.printNode(n.Name)
+	p.printToken(n.OpenParenthesisTkn, p.ifNodeList(n.Args, []byte("(")))
+	p.printSeparatedList(n.Args, n.SeparatorTkns, []byte(","))
+	p.printToken(n.CloseParenthesisTkn, p.ifNodeList(n.Args, []byte(")")))
+}
+
+func (p *printer) AttributeGroup(n *ast.AttributeGroup) {
+	p.printToken(n.OpenAttributeTkn, []byte("#["))
+	p.printSeparatedList(n.Attrs, n.SeparatorTkns, []byte(","))
+	p.printToken(n.CloseAttributeTkn, []byte("]"))
+}
+
+func (p *printer) Union(n *ast.Union) {
+	p.printSeparatedList(n.Types, n.SeparatorTkns, []byte("|"))
+}
+
+func (p *printer) Intersection(n *ast.Intersection) {
+	p.printSeparatedList(n.Types, n.SeparatorTkns, []byte("&"))
+}
+
+func (p *printer) MatchArm(n *ast.MatchArm) {
+	p.printToken(n.DefaultTkn, nil)
+	p.printToken(n.DefaultCommaTkn, nil)
+	p.printSeparatedList(n.Exprs, n.SeparatorTkns, []byte(","))
+	p.printToken(n.DoubleArrowTkn, []byte("=>"))
+	p.printNode(n.ReturnExpr)
+}
+
+func (p *printer) BadStmt(n *ast.BadStmt) {
+	for _, t := range n.SkippedTkns {
+		p.printToken(t, nil)
+	}
+}
+
 func (p *printer) StmtBreak(n *ast.StmtBreak) {
 	p.printToken(n.BreakTkn, []byte("break"))
 	p.printNode(n.Expr)
@@ -188,6 +270,7 @@ func (p *printer) StmtCatch(n *ast.StmtCatch) {
 }
 
 func (p *printer) StmtClass(n *ast.StmtClass) {
+	p.printList(n.AttrGroups)
 	p.printList(n.Modifiers)
 	p.printToken(n.ClassTkn, []byte("class"))
 	p.printNode(n.Name)
@@ -204,13 +287,16 @@ func (p *printer) StmtClass(n *ast.StmtClass) {
 }
 
 func (p *printer) StmtClassConstList(n *ast.StmtClassConstList) {
+	p.printList(n.AttrGroups)
 	p.printList(n.Modifiers)
 	p.printToken(n.ConstTkn, []byte("const"))
+	p.printNode(n.Type)
 	p.printSeparatedList(n.Consts, n.SeparatorTkns, []byte(","))
 	p.printToken(n.SemiColonTkn, []byte(";"))
 }
 
 func (p *printer) StmtClassMethod(n *ast.StmtClassMethod) {
+	p.printList(n.AttrGroups)
 	p.printList(n.Modifiers)
 	p.printToken(n.FunctionTkn, []byte("function"))
 	p.printToken(n.AmpersandTkn, nil)
@@ -308,6 +394,28 @@ func (p *printer) StmtElseIf(n *ast.StmtElseIf) {
 	}
 }
 
+func (p *printer) StmtEnum(n *ast.StmtEnum) {
+	p.printList(n.AttrGroups)
+	p.printToken(n.EnumTkn, []byte("enum"))
+	p.printNode(n.Name)
+	p.printToken(n.ColonTkn, p.ifNode(n.Type, []byte(":")))
+	p.printNode(n.Type)
+	p.printToken(n.ImplementsTkn, p.ifNodeList(n.Implements, []byte("implements")))
+	p.printSeparatedList(n.Implements, n.ImplementsSeparatorTkns, []byte(","))
+	p.printToken(n.OpenCurlyBracketTkn, []byte("{"))
+	p.printList(n.Stmts)
+	p.printToken(n.CloseCurlyBracketTkn, []byte("}"))
+}
+
+func (p *printer) StmtEnumCase(n *ast.StmtEnumCase) {
+	p.printList(n.AttrGroups)
+	p.printToken(n.CaseTkn, []byte("case"))
+	p.printNode(n.Name)
+	p.printToken(n.EqualTkn, p.ifNode(n.Expr, []byte("=")))
+	p.printNode(n.Expr)
+	p.printToken(n.SemiColonTkn, []byte(";"))
+}
+
 func (p *printer) StmtExpression(n *ast.StmtExpression) {
 	p.printNode(n.Expr)
 	p.printToken(n.SemiColonTkn, []byte(";"))
@@ -364,6 +472,7 @@ func (p *printer) StmtForeach(n *ast.StmtForeach) {
 }
 
 func (p *printer) StmtFunction(n *ast.StmtFunction) {
+	p.printList(n.AttrGroups)
 	p.printToken(n.FunctionTkn, []byte("function"))
 	p.printToken(n.AmpersandTkn, nil)
 	p.printNode(n.Name)
@@ -426,6 +535,7 @@ func (p *printer) StmtInlineHtml(n *ast.StmtInlineHtml) {
 }
 
 func (p *printer) StmtInterface(n *ast.StmtInterface) {
+	p.printList(n.AttrGroups)
 	p.printToken(n.InterfaceTkn, []byte("interface"))
 	p.printNode(n.Name)
 	p.printToken(n.ExtendsTkn, p.ifNodeList(n.Extends, []byte("extends")))
@@ -460,6 +570,7 @@ func (p *printer) StmtProperty(n *ast.StmtProperty) {
 }
 
 func (p *printer) StmtPropertyList(n *ast.StmtPropertyList) {
+	p.printList(n.AttrGroups)
 	p.printList(n.Modifiers)
 	p.printNode(n.Type)
 	p.printSeparatedList(n.Props, n.SeparatorTkns, []byte(","))
@@ -511,6 +622,7 @@ func (p *printer) StmtThrow(n *ast.StmtThrow) {
 }
 
 func (p *printer) StmtTrait(n *ast.StmtTrait) {
+	p.printList(n.AttrGroups)
 	p.printToken(n.TraitTkn, []byte("trait"))
 	p.printNode(n.Name)
 	p.printToken(n.OpenCurlyBracketTkn, []byte("{"))
@@ -607,6 +719,12 @@ func (p *printer) StmtWhile(n *ast.StmtWhile) {
 	p.printToken(n.SemiColonTkn, p.ifToken(n.ColonTkn, []byte(";"), nil))
 }
 
+func (p *printer) BadExpr(n *ast.BadExpr) {
+	for _, t := range n.SkippedTkns {
+		p.printToken(t, nil)
+	}
+}
+
 func (p *printer) ExprArray(n *ast.ExprArray) {
 	p.printToken(n.ArrayTkn, nil)
 	p.printToken(n.OpenBracketTkn, p.ifToken(n.ArrayTkn, []byte("("), []byte("[")))
@@ -615,7 +733,7 @@ func (p *printer) ExprArray(n *ast.ExprArray) {
 }
 
 func (p *printer) ExprArrayDimFetch(n *ast.ExprArrayDimFetch) {
-	p.printNode(n.Var)
+	p.printOperand(n, n.Var, sideDeref)
 	p.printToken(n.OpenBracketTkn, []byte("["))
 	p.printNode(n.Dim)
 	p.printToken(n.CloseBracketTkn, []byte("]"))
@@ -630,6 +748,7 @@ func (p *printer) ExprArrayItem(n *ast.ExprArrayItem) {
 }
 
 func (p *printer) ExprArrowFunction(n *ast.ExprArrowFunction) {
+	p.printList(n.AttrGroups)
 	p.printToken(n.StaticTkn, nil)
 	p.printToken(n.FnTkn, []byte("fn"))
 	p.printToken(n.AmpersandTkn, nil)
@@ -639,37 +758,46 @@ func (p *printer) ExprArrowFunction(n *ast.ExprArrowFunction) {
 	p.printToken(n.ColonTkn, p.ifNode(n.ReturnType, []byte(":")))
 	p.printNode(n.ReturnType)
 	p.printToken(n.DoubleArrowTkn, []byte("=>"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprBitwiseNot(n *ast.ExprBitwiseNot) {
 	p.printToken(n.TildaTkn, []byte("~"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprBooleanNot(n *ast.ExprBooleanNot) {
 	p.printToken(n.ExclamationTkn, []byte("!"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprBrackets(n *ast.ExprBrackets) {
-	p.printToken(n.OpenParenthesisTkn, nil)
+	p.printToken(n.OpenParenthesisTkn, []byte("("))
 	p.printNode(n.Expr)
-	p.printToken(n.CloseParenthesisTkn, nil)
+	p.printToken(n.CloseParenthesisTkn, []byte(")"))
 }
 
 func (p *printer) ExprClassConstFetch(n *ast.ExprClassConstFetch) {
-	p.printNode(n.Class)
+	p.printOperand(n, n.Class, sideDeref)
 	p.printToken(n.DoubleColonTkn, []byte("::"))
+
+	if _, ok := n.Const.(*ast.Identifier); ok {
+		p.printNode(n.Const)
+		return
+	}
+
+	p.printToken(n.OpenCurlyBracketTkn, []byte("{"))
 	p.printNode(n.Const)
+	p.printToken(n.CloseCurlyBracketTkn, []byte("}"))
 }
 
 func (p *printer) ExprClone(n *ast.ExprClone) {
 	p.printToken(n.CloneTkn, []byte("clone"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprClosure(n *ast.ExprClosure) {
+	p.printList(n.AttrGroups)
 	p.printToken(n.StaticTkn, nil)
 	p.printToken(n.FunctionTkn, []byte("function"))
 	p.printToken(n.AmpersandTkn, nil)
@@ -705,7 +833,7 @@ func (p *printer) ExprEmpty(n *ast.ExprEmpty) {
 
 func (p *printer) ExprErrorSuppress(n *ast.ExprErrorSuppress) {
 	p.printToken(n.AtTkn, []byte("@"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprEval(n *ast.ExprEval) {
@@ -723,7 +851,7 @@ func (p *printer) ExprExit(n *ast.ExprExit) {
 }
 
 func (p *printer) ExprFunctionCall(n *ast.ExprFunctionCall) {
-	p.printNode(n.Function)
+	p.printOperand(n, n.Function, sideDeref)
 	p.printToken(n.OpenParenthesisTkn, []byte("("))
 	p.printSeparatedList(n.Args, n.SeparatorTkns, []byte(","))
 	p.printToken(n.CloseParenthesisTkn, []byte(")"))
@@ -731,18 +859,18 @@ func (p *printer) ExprFunctionCall(n *ast.ExprFunctionCall) {
 
 func (p *printer) ExprInclude(n *ast.ExprInclude) {
 	p.printToken(n.IncludeTkn, []byte("include"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprIncludeOnce(n *ast.ExprIncludeOnce) {
 	p.printToken(n.IncludeOnceTkn, []byte("include_once"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprInstanceOf(n *ast.ExprInstanceOf) {
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideLeft)
 	p.printToken(n.InstanceOfTkn, []byte("instanceof"))
-	p.printNode(n.Class)
+	p.printOperand(n, n.Class, sideDeref)
 }
 
 func (p *printer) ExprIsset(n *ast.ExprIsset) {
@@ -759,8 +887,18 @@ func (p *printer) ExprList(n *ast.ExprList) {
 	p.printToken(n.CloseBracketTkn, []byte(")"))
 }
 
+func (p *printer) ExprMatch(n *ast.ExprMatch) {
+	p.printToken(n.MatchTkn, []byte("match"))
+	p.printToken(n.OpenParenthesisTkn, []byte("("))
+	p.printNode(n.Expr)
+	p.printToken(n.CloseParenthesisTkn, []byte(")"))
+	p.printToken(n.OpenCurlyBracketTkn, []byte("{"))
+	p.printSeparatedList(n.Arms, n.SeparatorTkns, []byte(","))
+	p.printToken(n.CloseCurlyBracketTkn, []byte("}"))
+}
+
 func (p *printer) ExprMethodCall(n *ast.ExprMethodCall) {
-	p.printNode(n.Var)
+	p.printOperand(n, n.Var, sideDeref)
 	p.printToken(n.ObjectOperatorTkn, []byte("->"))
 	p.printToken(n.OpenCurlyBracketTkn, nil)
 	p.printNode(n.Method)
@@ -772,39 +910,58 @@ func (p *printer) ExprMethodCall(n *ast.ExprMethodCall) {
 
 func (p *printer) ExprNew(n *ast.ExprNew) {
 	p.printToken(n.NewTkn, []byte("new"))
-	p.printNode(n.Class)
+	p.printOperand(n, n.Class, sideDeref)
 	p.printToken(n.OpenParenthesisTkn, p.ifNodeList(n.Args, []byte("(")))
 	p.printSeparatedList(n.Args, n.SeparatorTkns, []byte(","))
 	p.printToken(n.CloseParenthesisTkn, p.ifNodeList(n.Args, []byte(")")))
 }
 
+func (p *printer) ExprNullsafeMethodCall(n *ast.ExprNullsafeMethodCall) {
+	p.printOperand(n, n.Var, sideDeref)
+	p.printToken(n.ObjectOperatorTkn, []byte("?->"))
+	p.printToken(n.OpenCurlyBracketTkn, nil)
+	p.printNode(n.Method)
+	p.printToken(n.CloseCurlyBracketTkn, nil)
+	p.printToken(n.OpenParenthesisTkn, []byte("("))
+	p.printSeparatedList(n.Args, n.SeparatorTkns, []byte(","))
+	p.printToken(n.CloseParenthesisTkn, []byte(")"))
+}
+
+func (p *printer) ExprNullsafePropertyFetch(n *ast.ExprNullsafePropertyFetch) {
+	p.printOperand(n, n.Var, sideDeref)
+	p.printToken(n.ObjectOperatorTkn, []byte("?->"))
+	p.printToken(n.OpenCurlyBracketTkn, nil)
+	p.printNode(n.Prop)
+	p.printToken(n.CloseCurlyBracketTkn, nil)
+}
+
 func (p *printer) ExprPostDec(n *ast.ExprPostDec) {
-	p.printNode(n.Var)
+	p.printOperand(n, n.Var, sideDeref)
 	p.printToken(n.DecTkn, []byte("--"))
 }
 
 func (p *printer) ExprPostInc(n *ast.ExprPostInc) {
-	p.printNode(n.Var)
+	p.printOperand(n, n.Var, sideDeref)
 	p.printToken(n.IncTkn, []byte("++"))
 }
 
 func (p *printer) ExprPreDec(n *ast.ExprPreDec) {
 	p.printToken(n.DecTkn, []byte("--"))
-	p.printNode(n.Var)
+	p.printOperand(n, n.Var, sideDeref)
 }
 
 func (p *printer) ExprPreInc(n *ast.ExprPreInc) {
 	p.printToken(n.IncTkn, []byte("++"))
-	p.printNode(n.Var)
+	p.printOperand(n, n.Var, sideDeref)
 }
 
 func (p *printer) ExprPrint(n *ast.ExprPrint) {
 	p.printToken(n.PrintTkn, []byte("print"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprPropertyFetch(n *ast.ExprPropertyFetch) {
-	p.printNode(n.Var)
+	p.printOperand(n, n.Var, sideDeref)
 	p.printToken(n.ObjectOperatorTkn, []byte("->"))
 	p.printToken(n.OpenCurlyBracketTkn, nil)
 	p.printNode(n.Prop)
@@ -813,12 +970,12 @@ func (p *printer) ExprPropertyFetch(n *ast.ExprPropertyFetch) {
 
 func (p *printer) ExprRequire(n *ast.ExprRequire) {
 	p.printToken(n.RequireTkn, []byte("require"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprRequireOnce(n *ast.ExprRequireOnce) {
 	p.printToken(n.RequireOnceTkn, []byte("require_once"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprShellExec(n *ast.ExprShellExec) {
@@ -828,7 +985,7 @@ func (p *printer) ExprShellExec(n *ast.ExprShellExec) {
 }
 
 func (p *printer) ExprStaticCall(n *ast.ExprStaticCall) {
-	p.printNode(n.Class)
+	p.printOperand(n, n.Class, sideDeref)
 	p.printToken(n.DoubleColonTkn, []byte("::"))
 	p.printToken(n.OpenCurlyBracketTkn, nil)
 	p.printNode(n.Call)
@@ -839,27 +996,32 @@ func (p *printer) ExprStaticCall(n *ast.ExprStaticCall) {
 }
 
 func (p *printer) ExprStaticPropertyFetch(n *ast.ExprStaticPropertyFetch) {
-	p.printNode(n.Class)
+	p.printOperand(n, n.Class, sideDeref)
 	p.printToken(n.DoubleColonTkn, []byte("::"))
 	p.printNode(n.Prop)
 }
 
 func (p *printer) ExprTernary(n *ast.ExprTernary) {
-	p.printNode(n.Cond)
+	p.printOperand(n, n.Cond, sideLeft)
 	p.printToken(n.QuestionTkn, []byte("?"))
 	p.printNode(n.IfTrue)
 	p.printToken(n.ColonTkn, []byte(":"))
-	p.printNode(n.IfFalse)
+	p.printOperand(n, n.IfFalse, sideRight)
+}
+
+func (p *printer) ExprThrow(n *ast.ExprThrow) {
+	p.printToken(n.ThrowTkn, []byte("throw"))
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprUnaryMinus(n *ast.ExprUnaryMinus) {
 	p.printToken(n.MinusTkn, []byte("-"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprUnaryPlus(n *ast.ExprUnaryPlus) {
 	p.printToken(n.PlusTkn, []byte("+"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprVariable(n *ast.ExprVariable) {
@@ -871,302 +1033,302 @@ func (p *printer) ExprVariable(n *ast.ExprVariable) {
 
 func (p *printer) ExprYield(n *ast.ExprYield) {
 	p.printToken(n.YieldTkn, []byte("yield"))
-	p.printNode(n.Key)
+	p.printOperand(n, n.Key, sideRight)
 	p.printToken(n.DoubleArrowTkn, p.ifNode(n.Key, []byte("=>")))
-	p.printNode(n.Val)
+	p.printOperand(n, n.Val, sideRight)
 }
 
 func (p *printer) ExprYieldFrom(n *ast.ExprYieldFrom) {
 	p.printToken(n.YieldFromTkn, []byte("yield from"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssign(n *ast.ExprAssign) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignReference(n *ast.ExprAssignReference) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("="))
 	p.printToken(n.AmpersandTkn, []byte("&"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignBitwiseAnd(n *ast.ExprAssignBitwiseAnd) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("&="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignBitwiseOr(n *ast.ExprAssignBitwiseOr) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("|="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignBitwiseXor(n *ast.ExprAssignBitwiseXor) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("^="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignCoalesce(n *ast.ExprAssignCoalesce) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("??="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignConcat(n *ast.ExprAssignConcat) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte(".="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignDiv(n *ast.ExprAssignDiv) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("/="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignMinus(n *ast.ExprAssignMinus) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("-="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignMod(n *ast.ExprAssignMod) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("%="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignMul(n *ast.ExprAssignMul) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("*="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignPlus(n *ast.ExprAssignPlus) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("+="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignPow(n *ast.ExprAssignPow) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("**="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignShiftLeft(n *ast.ExprAssignShiftLeft) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte("<<="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprAssignShiftRight(n *ast.ExprAssignShiftRight) {
 	p.printNode(n.Var)
 	p.printToken(n.EqualTkn, []byte(">>="))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprBinaryBitwiseAnd(n *ast.ExprBinaryBitwiseAnd) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("&"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryBitwiseOr(n *ast.ExprBinaryBitwiseOr) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("|"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryBitwiseXor(n *ast.ExprBinaryBitwiseXor) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("^"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryBooleanAnd(n *ast.ExprBinaryBooleanAnd) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("&&"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryBooleanOr(n *ast.ExprBinaryBooleanOr) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("||"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryCoalesce(n *ast.ExprBinaryCoalesce) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("??"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryConcat(n *ast.ExprBinaryConcat) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("."))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryDiv(n *ast.ExprBinaryDiv) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("/"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryEqual(n *ast.ExprBinaryEqual) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("=="))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryGreater(n *ast.ExprBinaryGreater) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte(">"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryGreaterOrEqual(n *ast.ExprBinaryGreaterOrEqual) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte(">="))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryIdentical(n *ast.ExprBinaryIdentical) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("==="))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryLogicalAnd(n *ast.ExprBinaryLogicalAnd) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("and"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryLogicalOr(n *ast.ExprBinaryLogicalOr) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("or"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryLogicalXor(n *ast.ExprBinaryLogicalXor) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("xor"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryMinus(n *ast.ExprBinaryMinus) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("-"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryMod(n *ast.ExprBinaryMod) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("%"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryMul(n *ast.ExprBinaryMul) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("*"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryNotEqual(n *ast.ExprBinaryNotEqual) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("!="))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryNotIdentical(n *ast.ExprBinaryNotIdentical) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("!=="))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryPlus(n *ast.ExprBinaryPlus) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("+"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryPow(n *ast.ExprBinaryPow) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("**"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryShiftLeft(n *ast.ExprBinaryShiftLeft) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("<<"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinaryShiftRight(n *ast.ExprBinaryShiftRight) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte(">>"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinarySmaller(n *ast.ExprBinarySmaller) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("<"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinarySmallerOrEqual(n *ast.ExprBinarySmallerOrEqual) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("<="))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprBinarySpaceship(n *ast.ExprBinarySpaceship) {
-	p.printNode(n.Left)
+	p.printOperand(n, n.Left, sideLeft)
 	p.printToken(n.OpTkn, []byte("<=>"))
-	p.printNode(n.Right)
+	p.printOperand(n, n.Right, sideRight)
 }
 
 func (p *printer) ExprCastArray(n *ast.ExprCastArray) {
 	p.printToken(n.CastTkn, []byte("(array)"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprCastBool(n *ast.ExprCastBool) {
 	p.printToken(n.CastTkn, []byte("(bool)"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprCastDouble(n *ast.ExprCastDouble) {
 	p.printToken(n.CastTkn, []byte("(float)"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprCastInt(n *ast.ExprCastInt) {
 	p.printToken(n.CastTkn, []byte("(int)"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprCastObject(n *ast.ExprCastObject) {
 	p.printToken(n.CastTkn, []byte("(object)"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprCastString(n *ast.ExprCastString) {
 	p.printToken(n.CastTkn, []byte("(string)"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ExprCastUnset(n *ast.ExprCastUnset) {
 	p.printToken(n.CastTkn, []byte("(unset)"))
-	p.printNode(n.Expr)
+	p.printOperand(n, n.Expr, sideRight)
 }
 
 func (p *printer) ScalarDnumber(n *ast.ScalarDnumber) {
@@ -1437,3 +1437,23 @@ func TestParseAndPrintPhp5ComplexString4(t *testing.T) {
 		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
 	}
 }
+
+func TestParseAndPrintPhp5SyntaxErrors(t *testing.T) {
+	src := `<?php
+	$a = ;
+	}
+	foo ( 1 , , 2 ) ;
+	class Foo {
+		pub $a ;
+		public function bar ( ) { $b = 1 }
+	}
+	function baz ( ) {
+		if ( $c ) {
+	`
+
+	actual := printPhp5(parsePhp5(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
@@ -1582,3 +1582,23 @@ func TestParseAndPrintComplexString4(t *testing.T) {
 		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
 	}
 }
+
+func TestParseAndPrintSyntaxErrors(t *testing.T) {
+	src := `<?php
+	$a = ;
+	}
+	foo ( 1 , , 2 ) ;
+	class Foo {
+		pub $a ;
+		public function bar ( ) { $b = 1 }
+	}
+	function baz ( ) {
+		if ( $c ) {
+	`
+
+	actual := print(parse(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
@@ -0,0 +1,246 @@
+package printer_test
+
+import (
+	"testing"
+
+	"github.com/z7zmey/php-parser/internal/php8"
+	"github.com/z7zmey/php-parser/internal/scanner"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/version"
+)
+
+func parsePhp8(src string) ast.Vertex {
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+
+	return php8parser.GetRootNode()
+}
+
+func TestParseAndPrintPhp8Attributes(t *testing.T) {
+	src := `<?php
+	#[ A , B ( 1 , name : 'x' ) , ]
+	#[ \C\D ]
+	final class Foo {
+		#[ E ] public int $a ;
+		#[ F ] const X = 1 ;
+		#[ G ] public function m ( #[ H ] $p ) { }
+	}
+	#[ I ] function f ( ) { }
+	$a = #[ J ] fn ( ) => 1 ;
+	$b = #[ K ] static function ( ) { } ;
+	$c = new #[ L ] class { } ;
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8Types(t *testing.T) {
+	src := `<?php
+	class Foo {
+		public int | string | null $a ;
+		public function __construct ( public ? int $a , protected A | B $b = null , ) { }
+		public function f ( mixed $m ) : static { }
+	}
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8NamedArguments(t *testing.T) {
+	src := `<?php
+	foo ( a : 1 , b : $b , ... $c ) ;
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8Nullsafe(t *testing.T) {
+	src := `<?php
+	$a ?-> b ?-> c ( 1 ) ?-> { $d } ;
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8Match(t *testing.T) {
+	src := `<?php
+	$a = match ( $b ) {
+		1 , 2 , => 'a' ,
+		default , => throw new E ( ) ,
+	} ;
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8Throw(t *testing.T) {
+	src := `<?php
+	throw $e ;
+	$a ?? throw $e ;
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8Enum(t *testing.T) {
+	src := `<?php
+	enum Suit : string implements HasLabel , JsonSerializable {
+		case Hearts = 'H' ;
+		#[ A ] case Spades = 'S' ;
+		const Wild = self :: Spades ;
+		public function label ( ) : string { }
+	}
+	enum Status { case Active ; case Inactive ; }
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8Readonly(t *testing.T) {
+	src := `<?php
+	final readonly class Point {
+		public function __construct ( public readonly int $x , readonly int $y ) { }
+	}
+	class User {
+		public readonly string $name ;
+		readonly protected ? int $age ;
+	}
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8FirstClassCallable(t *testing.T) {
+	src := `<?php
+	$f = strlen ( ... ) ;
+	$m = $obj -> method ( ... ) ;
+	$s = Foo :: bar ( ... ) ;
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8IntersectionTypes(t *testing.T) {
+	src := `<?php
+	function f ( A & B $a , C & D & $b , E & ... $c ) : X & Y { }
+	class Foo { public Countable & Iterator $it ; }
+	$a = $b & $c ;
+	$d = $e & FOO ;
+	function & g ( ) { }
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8NewInInitializers(t *testing.T) {
+	src := `<?php
+	function f ( Logger $l = new NullLogger ( ) ) { static $x = new Foo ; }
+	const C = new Bar ( 1 ) ;
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8TypedClassConstants(t *testing.T) {
+	src := `<?php
+	interface I {
+		const string A = 'a' ;
+		public const int | float B = 1 , C = 2 ;
+		const ? Foo D = null ;
+		const E = 1 ;
+		const array = [ ] ;
+	}
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8DynamicClassConstFetch(t *testing.T) {
+	src := `<?php
+	$a = Foo :: { $name } ;
+	$b = $obj :: { 'BAR' } ;
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
+
+func TestParseAndPrintPhp8SyntaxErrors(t *testing.T) {
+	src := `<?php
+	$a = ;
+	}
+	foo ( 1 , , 2 ) ;
+	enum Foo {
+		case ;
+		pub $a ;
+		public function bar ( ) { $b = 1 }
+	}
+	function baz ( ) {
+		if ( $c ) {
+	`
+
+	actual := print(parsePhp8(src))
+
+	if src != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", src, actual)
+	}
+}
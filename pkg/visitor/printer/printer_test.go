@@ -202,6 +202,26 @@ func TestPrinterPrintArgument(t *testing.T) {
 		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
 	}
 }
+func TestPrinterPrintArgumentNamed(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.Argument{
+		Name: &ast.Identifier{Value: []byte("foo")},
+		Expr: &ast.ExprVariable{
+			Name: &ast.Identifier{Value: []byte("$var")},
+		},
+	}
+	n.Accept(p)
+
+	expected := `foo:$var`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestPrinterPrintArgumentByRef(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -224,8 +244,134 @@ func TestPrinterPrintArgumentByRef(t *testing.T) {
 	}
 }
 
+func TestPrinterPrintArgumentVariadicPlaceholder(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.Argument{
+		VariadicTkn: &token.Token{
+			Value: []byte("..."),
+		},
+	}
+	n.Accept(p)
+
+	expected := `...`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 // name
 
+func TestPrinterPrintAttributeGroup(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.AttributeGroup{
+		Attrs: []ast.Vertex{
+			&ast.Attribute{
+				Name: &ast.Name{
+					Parts: []ast.Vertex{
+						&ast.NamePart{
+							Value: []byte("Foo"),
+						},
+					},
+				},
+			},
+			&ast.Attribute{
+				Name: &ast.Name{
+					Parts: []ast.Vertex{
+						&ast.NamePart{
+							Value: []byte("Bar"),
+						},
+					},
+				},
+				Args: []ast.Vertex{
+					&ast.Argument{
+						Expr: &ast.ExprVariable{
+							Name: &ast.Identifier{Value: []byte("$a")},
+						},
+					},
+				},
+			},
+		},
+	}
+	n.Accept(p)
+
+	expected := `#[Foo,Bar($a)]`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestPrinterPrintUnion(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.Union{
+		Types: []ast.Vertex{
+			&ast.Name{
+				Parts: []ast.Vertex{
+					&ast.NamePart{
+						Value: []byte("int"),
+					},
+				},
+			},
+			&ast.Name{
+				Parts: []ast.Vertex{
+					&ast.NamePart{
+						Value: []byte("null"),
+					},
+				},
+			},
+		},
+	}
+	n.Accept(p)
+
+	expected := `int|null`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestPrinterPrintIntersection(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.Intersection{
+		Types: []ast.Vertex{
+			&ast.Name{
+				Parts: []ast.Vertex{
+					&ast.NamePart{
+						Value: []byte("Countable"),
+					},
+				},
+			},
+			&ast.Name{
+				Parts: []ast.Vertex{
+					&ast.NamePart{
+						Value: []byte("Iterator"),
+					},
+				},
+			},
+		},
+	}
+	n.Accept(p)
+
+	expected := `Countable&Iterator`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestPrinterPrintNameNamePart(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -1615,6 +1761,46 @@ func TestPrinterPrintExprArrayItemUnpack(t *testing.T) {
 	}
 }
 
+func TestPrinterPrintBadExpr(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.ExprFunctionCall{
+		Function: &ast.Name{
+			Parts: []ast.Vertex{
+				&ast.NamePart{
+					Value: []byte("foo"),
+				},
+			},
+		},
+		Args: []ast.Vertex{
+			&ast.BadExpr{
+				SkippedTkns: []*token.Token{
+					{
+						Value: []byte("$a"),
+					},
+					{
+						Value: []byte("$b"),
+						FreeFloating: []*token.Token{
+							{
+								Value: []byte(" "),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	n.Accept(p)
+
+	expected := "foo($a $b)"
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestPrinterPrintExprArray(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -1735,6 +1921,28 @@ func TestPrinterPrintExprClassConstFetch(t *testing.T) {
 	}
 }
 
+func TestPrinterPrintExprClassConstFetchDynamic(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.ExprClassConstFetch{
+		Class: &ast.ExprVariable{
+			Name: &ast.Identifier{Value: []byte("$var")},
+		},
+		Const: &ast.ExprVariable{
+			Name: &ast.Identifier{Value: []byte("$name")},
+		},
+	}
+	n.Accept(p)
+
+	expected := `$var::{$name}`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestPrinterPrintExprClone(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -2193,6 +2401,53 @@ func TestPrinterPrintMethodCall(t *testing.T) {
 	}
 }
 
+func TestPrinterPrintNullsafeMethodCall(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.ExprNullsafeMethodCall{
+		Var: &ast.ExprVariable{
+			Name: &ast.Identifier{Value: []byte("$foo")},
+		},
+		Method: &ast.Identifier{Value: []byte("bar")},
+		Args: []ast.Vertex{
+			&ast.Argument{
+				Expr: &ast.ExprVariable{
+					Name: &ast.Identifier{Value: []byte("$a")},
+				},
+			},
+		},
+	}
+	n.Accept(p)
+
+	expected := `$foo?->bar($a)`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestPrinterPrintNullsafePropertyFetch(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.ExprNullsafePropertyFetch{
+		Var: &ast.ExprVariable{
+			Name: &ast.Identifier{Value: []byte("$foo")},
+		},
+		Prop: &ast.Identifier{Value: []byte("bar")},
+	}
+	n.Accept(p)
+
+	expected := `$foo?->bar`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestPrinterPrintNew(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -2533,6 +2788,44 @@ func TestPrinterPrintStaticPropertyFetch(t *testing.T) {
 	}
 }
 
+func TestPrinterPrintMatch(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.ExprMatch{
+		Expr: &ast.ExprVariable{
+			Name: &ast.Identifier{Value: []byte("$a")},
+		},
+		Arms: []ast.Vertex{
+			&ast.MatchArm{
+				Exprs: []ast.Vertex{
+					&ast.ScalarLnumber{Value: []byte("1")},
+					&ast.ScalarLnumber{Value: []byte("2")},
+				},
+				ReturnExpr: &ast.ExprVariable{
+					Name: &ast.Identifier{Value: []byte("$b")},
+				},
+			},
+			&ast.MatchArm{
+				DefaultTkn: &token.Token{
+					Value: []byte("default"),
+				},
+				ReturnExpr: &ast.ExprVariable{
+					Name: &ast.Identifier{Value: []byte("$c")},
+				},
+			},
+		},
+	}
+	n.Accept(p)
+
+	expected := `match($a){1,2=>$b,default=>$c}`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestPrinterPrintTernary(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -2580,6 +2873,25 @@ func TestPrinterPrintTernaryFull(t *testing.T) {
 	}
 }
 
+func TestPrinterPrintThrow(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.ExprThrow{
+		Expr: &ast.ExprVariable{
+			Name: &ast.Identifier{Value: []byte("$e")},
+		},
+	}
+	n.Accept(p)
+
+	expected := `throw$e`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestPrinterPrintUnaryMinus(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -3056,6 +3368,35 @@ func TestPrinterPrintAltWhile(t *testing.T) {
 	}
 }
 
+func TestPrinterPrintBadStmt(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.BadStmt{
+		SkippedTkns: []*token.Token{
+			{
+				Value: []byte("$a"),
+			},
+			{
+				Value: []byte("="),
+				FreeFloating: []*token.Token{
+					{
+						Value: []byte(" "),
+					},
+				},
+			},
+		},
+	}
+	n.Accept(p)
+
+	expected := "$a ="
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestPrinterPrintStmtBreak(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -3355,6 +3696,36 @@ func TestPrinterPrintStmtClassConstList(t *testing.T) {
 	}
 }
 
+func TestPrinterPrintStmtClassConstListTyped(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.StmtClassConstList{
+		Modifiers: []ast.Vertex{&ast.Identifier{Value: []byte("public")}},
+		Type: &ast.Name{
+			Parts: []ast.Vertex{
+				&ast.NamePart{
+					Value: []byte("string"),
+				},
+			},
+		},
+		Consts: []ast.Vertex{
+			&ast.StmtConstant{
+				Name: &ast.Identifier{Value: []byte("FOO")},
+				Expr: &ast.ScalarString{Value: []byte("'a'")},
+			},
+		},
+	}
+	n.Accept(p)
+
+	expected := `public const string FOO='a';`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestPrinterPrintStmtConstList(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -3691,6 +4062,69 @@ func TestPrinterPrintStmtElseIfNop(t *testing.T) {
 	}
 }
 
+func TestPrinterPrintStmtEnum(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.StmtEnum{
+		Name: &ast.Identifier{Value: []byte("Suit")},
+		Type: &ast.Name{
+			Parts: []ast.Vertex{
+				&ast.NamePart{
+					Value: []byte("string"),
+				},
+			},
+		},
+		Implements: []ast.Vertex{
+			&ast.Name{
+				Parts: []ast.Vertex{
+					&ast.NamePart{
+						Value: []byte("A"),
+					},
+				},
+			},
+			&ast.Name{
+				Parts: []ast.Vertex{
+					&ast.NamePart{
+						Value: []byte("B"),
+					},
+				},
+			},
+		},
+		Stmts: []ast.Vertex{
+			&ast.StmtEnumCase{
+				Name: &ast.Identifier{Value: []byte("Hearts")},
+				Expr: &ast.ScalarString{Value: []byte("'H'")},
+			},
+		},
+	}
+	n.Accept(p)
+
+	expected := `enum Suit:string implements A,B{case Hearts='H';}`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
+func TestPrinterPrintStmtEnumCase(t *testing.T) {
+	o := bytes.NewBufferString("")
+
+	p := printer.NewPrinter(o).WithState(printer.PrinterStatePHP)
+	n := &ast.StmtEnumCase{
+		Name: &ast.Identifier{Value: []byte("Active")},
+	}
+	n.Accept(p)
+
+	expected := `case Active;`
+	actual := o.String()
+
+	if expected != actual {
+		t.Errorf("\nexpected: %s\ngot: %s\n", expected, actual)
+	}
+}
+
 func TestPrinterPrintStmtElseStmts(t *testing.T) {
 	o := bytes.NewBufferString("")
 
@@ -0,0 +1,336 @@
+package rewriter
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/token"
+	"github.com/z7zmey/php-parser/pkg/visitor/traverser"
+)
+
+var (
+	vertexType     = reflect.TypeOf((*ast.Vertex)(nil)).Elem()
+	vertexListType = reflect.TypeOf([]ast.Vertex(nil))
+)
+
+// Visitor is used by the Rewriter
+type Visitor interface {
+	// EnterNode is called before the node children are traversed,
+	// the children are skipped when it returns false
+	EnterNode(n ast.Vertex, parent ast.Vertex, field string) bool
+
+	// LeaveNode is called after the node children are traversed and rewritten.
+	// It returns the nodes n is replaced with: []ast.Vertex{n} keeps the node,
+	// nil deletes it and several nodes are spliced into the list field.
+	LeaveNode(n ast.Vertex, parent ast.Vertex, field string) []ast.Vertex
+}
+
+type frame struct {
+	node    ast.Vertex
+	index   int
+	counts  map[string]int
+	changes map[string]map[int][]ast.Vertex
+}
+
+// Rewriter traverses the tree and replaces, deletes or splices nodes
+// returned by the Visitor. Separator tokens of the changed lists are updated,
+// so the printer still produces valid code.
+type Rewriter struct {
+	v      Visitor
+	t      *traverser.Traverser
+	frames []*frame
+	result []ast.Vertex
+	err    error
+}
+
+// NewRewriter creates and returns new Rewriter
+func NewRewriter(v Visitor) *Rewriter {
+	r := &Rewriter{
+		v: v,
+	}
+	r.t = traverser.NewNodeTraverser(r)
+
+	return r
+}
+
+// Rewrite rewrites the tree in place and returns the nodes n is replaced with.
+// If the returned nodes can not be put into the field, the traversal stops
+// with the error, the changes made before are kept.
+func (r *Rewriter) Rewrite(n ast.Vertex) ([]ast.Vertex, error) {
+	r.frames = nil
+	r.result = []ast.Vertex{n}
+	r.err = nil
+
+	r.t.Traverse(n)
+
+	// the traversal was aborted, apply the changes collected so far
+	for len(r.frames) > 0 {
+		f := r.pop()
+		r.apply(f.node, f.changes)
+	}
+
+	return r.result, r.err
+}
+
+// Abort stops the traversal, the changes made before are kept
+func (r *Rewriter) Abort() {
+	r.t.Abort()
+}
+
+func (r *Rewriter) EnterNode(n ast.Vertex, parent ast.Vertex, field string) bool {
+	f := &frame{
+		node: n,
+	}
+
+	if len(r.frames) > 0 {
+		p := r.frames[len(r.frames)-1]
+		if p.counts == nil {
+			p.counts = map[string]int{}
+		}
+
+		f.index = p.counts[field]
+		p.counts[field]++
+	}
+
+	r.frames = append(r.frames, f)
+
+	return r.v.EnterNode(n, parent, field)
+}
+
+func (r *Rewriter) LeaveNode(n ast.Vertex, parent ast.Vertex, field string) {
+	f := r.pop()
+	if !r.apply(n, f.changes) {
+		return
+	}
+
+	nodes := r.v.LeaveNode(n, parent, field)
+	if len(nodes) == 1 && nodes[0] == n {
+		return
+	}
+
+	if len(r.frames) == 0 {
+		r.result = nodes
+		return
+	}
+
+	p := r.frames[len(r.frames)-1]
+	if p.changes == nil {
+		p.changes = map[string]map[int][]ast.Vertex{}
+	}
+	if p.changes[field] == nil {
+		p.changes[field] = map[int][]ast.Vertex{}
+	}
+
+	p.changes[field][f.index] = nodes
+}
+
+func (r *Rewriter) pop() *frame {
+	f := r.frames[len(r.frames)-1]
+	r.frames = r.frames[:len(r.frames)-1]
+
+	return f
+}
+
+// apply applies the changes to the fields of the node,
+// it aborts the traversal and returns false if the changes are invalid
+func (r *Rewriter) apply(n ast.Vertex, changes map[string]map[int][]ast.Vertex) bool {
+	if len(changes) == 0 {
+		return true
+	}
+
+	s := reflect.ValueOf(n).Elem()
+
+	for field, nodes := range changes {
+		f := s.FieldByName(field)
+
+		switch f.Type() {
+		case vertexType:
+			switch len(nodes[0]) {
+			case 0:
+				f.Set(reflect.Zero(vertexType))
+			case 1:
+				f.Set(reflect.ValueOf(&nodes[0][0]).Elem())
+			default:
+				if r.err == nil {
+					r.err = fmt.Errorf("rewriter: can not splice %d nodes into %T.%s", len(nodes[0]), n, field)
+				}
+				r.t.Abort()
+				return false
+			}
+		case vertexListType:
+			list := f.Interface().([]ast.Vertex)
+
+			tkns := separatorTkns(n, field)
+			if tkns == nil {
+				list, _ = splice(list, nil, nodes)
+			} else {
+				list, *tkns = splice(list, *tkns, nodes)
+			}
+
+			f.Set(reflect.ValueOf(list))
+		}
+	}
+
+	return true
+}
+
+// splice returns the list with the changes applied. Every separator token
+// stays after the node it followed, separators are added as nil tokens
+// that the printer replaces with the default ones.
+func splice(list []ast.Vertex, seps []*token.Token, changes map[int][]ast.Vertex) ([]ast.Vertex, []*token.Token) {
+	trailing := len(list) > 0 && len(seps) >= len(list)
+
+	var nodes []ast.Vertex
+	var tkns []*token.Token
+
+	for i, n := range list {
+		replacement, ok := changes[i]
+		if !ok {
+			replacement = []ast.Vertex{n}
+		}
+
+		for j, nn := range replacement {
+			nodes = append(nodes, nn)
+
+			var sep *token.Token
+			if j == len(replacement)-1 && i < len(seps) {
+				sep = seps[i]
+			}
+			tkns = append(tkns, sep)
+		}
+	}
+
+	if seps == nil {
+		return nodes, nil
+	}
+
+	l := len(nodes) - 1
+	if trailing {
+		l = len(nodes)
+	}
+	if l <= 0 {
+		return nodes, nil
+	}
+
+	return nodes, tkns[:l]
+}
+
+// separatorTkns returns the separator tokens of the list field
+func separatorTkns(n ast.Vertex, field string) *[]*token.Token {
+	switch n := n.(type) {
+	case *ast.Attribute:
+		return &n.SeparatorTkns
+	case *ast.AttributeGroup:
+		return &n.SeparatorTkns
+	case *ast.Union:
+		return &n.SeparatorTkns
+	case *ast.Intersection:
+		return &n.SeparatorTkns
+	case *ast.MatchArm:
+		return &n.SeparatorTkns
+	case *ast.StmtCatch:
+		if field == "Types" {
+			return &n.SeparatorTkns
+		}
+	case *ast.StmtClass:
+		switch field {
+		case "Args":
+			return &n.SeparatorTkns
+		case "Implements":
+			return &n.ImplementsSeparatorTkns
+		}
+	case *ast.StmtClassConstList:
+		if field == "Consts" {
+			return &n.SeparatorTkns
+		}
+	case *ast.StmtClassMethod:
+		if field == "Params" {
+			return &n.SeparatorTkns
+		}
+	case *ast.StmtConstList:
+		return &n.SeparatorTkns
+	case *ast.StmtDeclare:
+		return &n.SeparatorTkns
+	case *ast.StmtEcho:
+		return &n.SeparatorTkns
+	case *ast.StmtEnum:
+		if field == "Implements" {
+			return &n.ImplementsSeparatorTkns
+		}
+	case *ast.StmtFor:
+		switch field {
+		case "Init":
+			return &n.InitSeparatorTkns
+		case "Cond":
+			return &n.CondSeparatorTkns
+		case "Loop":
+			return &n.LoopSeparatorTkns
+		}
+	case *ast.StmtFunction:
+		if field == "Params" {
+			return &n.SeparatorTkns
+		}
+	case *ast.StmtGlobal:
+		return &n.SeparatorTkns
+	case *ast.StmtInterface:
+		if field == "Extends" {
+			return &n.ExtendsSeparatorTkns
+		}
+	case *ast.StmtPropertyList:
+		if field == "Props" {
+			return &n.SeparatorTkns
+		}
+	case *ast.StmtStatic:
+		return &n.SeparatorTkns
+	case *ast.StmtTraitUse:
+		if field == "Traits" {
+			return &n.SeparatorTkns
+		}
+	case *ast.StmtTraitUsePrecedence:
+		return &n.SeparatorTkns
+	case *ast.StmtUnset:
+		return &n.SeparatorTkns
+	case *ast.StmtUseList:
+		return &n.SeparatorTkns
+	case *ast.StmtGroupUseList:
+		return &n.SeparatorTkns
+	case *ast.ExprArray:
+		return &n.SeparatorTkns
+	case *ast.ExprArrowFunction:
+		if field == "Params" {
+			return &n.SeparatorTkns
+		}
+	case *ast.ExprClosure:
+		switch field {
+		case "Params":
+			return &n.SeparatorTkns
+		case "Uses":
+			return &n.UseSeparatorTkns
+		}
+	case *ast.ExprFunctionCall:
+		return &n.SeparatorTkns
+	case *ast.ExprIsset:
+		return &n.SeparatorTkns
+	case *ast.ExprList:
+		return &n.SeparatorTkns
+	case *ast.ExprMatch:
+		return &n.SeparatorTkns
+	case *ast.ExprMethodCall:
+		return &n.SeparatorTkns
+	case *ast.ExprNew:
+		return &n.SeparatorTkns
+	case *ast.ExprNullsafeMethodCall:
+		return &n.SeparatorTkns
+	case *ast.ExprStaticCall:
+		return &n.SeparatorTkns
+	case *ast.Name:
+		return &n.SeparatorTkns
+	case *ast.NameFullyQualified:
+		return &n.SeparatorTkns
+	case *ast.NameRelative:
+		return &n.SeparatorTkns
+	}
+
+	return nil
+}
@@ -0,0 +1,317 @@
+package rewriter_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/internal/php8"
+	"github.com/z7zmey/php-parser/internal/scanner"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor/printer"
+	"github.com/z7zmey/php-parser/pkg/visitor/rewriter"
+)
+
+type visitor struct {
+	enter func(n ast.Vertex) bool
+	leave func(n ast.Vertex, parent ast.Vertex, field string) []ast.Vertex
+}
+
+func (v *visitor) EnterNode(n ast.Vertex, _ ast.Vertex, _ string) bool {
+	if v.enter == nil {
+		return true
+	}
+
+	return v.enter(n)
+}
+
+func (v *visitor) LeaveNode(n ast.Vertex, parent ast.Vertex, field string) []ast.Vertex {
+	return v.leave(n, parent, field)
+}
+
+func parse(src string) ast.Vertex {
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+	lexer := scanner.NewLexer([]byte(src), config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+
+	return php8parser.GetRootNode()
+}
+
+func printNode(n ast.Vertex) string {
+	o := bytes.NewBufferString("")
+	n.Accept(printer.NewPrinter(o))
+
+	return o.String()
+}
+
+func isVar(n ast.Vertex, name string) bool {
+	arg, ok := n.(*ast.Argument)
+	if !ok {
+		return false
+	}
+
+	v, ok := arg.Expr.(*ast.ExprVariable)
+	if !ok {
+		return false
+	}
+
+	return string(v.Name.(*ast.Identifier).Value) == name
+}
+
+func newArg(name string) ast.Vertex {
+	return &ast.Argument{
+		Expr: &ast.ExprVariable{
+			Name: &ast.Identifier{Value: []byte(name)},
+		},
+	}
+}
+
+func TestRewriterReplace(t *testing.T) {
+	root := parse(`<?php foo($a); bar($b);`)
+
+	v := &visitor{
+		leave: func(n ast.Vertex, _ ast.Vertex, _ string) []ast.Vertex {
+			if nn, ok := n.(*ast.NamePart); ok && string(nn.Value) == "foo" {
+				return []ast.Vertex{&ast.NamePart{Value: []byte("baz")}}
+			}
+			return []ast.Vertex{n}
+		},
+	}
+	rewriter.NewRewriter(v).Rewrite(root)
+
+	assert.Equal(t, `<?php baz($a); bar($b);`, printNode(root))
+}
+
+func TestRewriterDeleteArgument(t *testing.T) {
+	tests := []struct {
+		src      string
+		del      string
+		expected string
+	}{
+		{`<?php f($a, $b, $c);`, "$a", `<?php f( $b, $c);`},
+		{`<?php f($a, $b, $c);`, "$b", `<?php f($a, $c);`},
+		{`<?php f($a, $b, $c);`, "$c", `<?php f($a, $b);`},
+		{`<?php f($a, $b,);`, "$b", `<?php f($a,);`},
+		{`<?php f($a,);`, "$a", `<?php f();`},
+		{`<?php f($a);`, "$a", `<?php f();`},
+	}
+
+	for _, tt := range tests {
+		root := parse(tt.src)
+
+		v := &visitor{
+			leave: func(n ast.Vertex, _ ast.Vertex, _ string) []ast.Vertex {
+				if isVar(n, tt.del) {
+					return nil
+				}
+				return []ast.Vertex{n}
+			},
+		}
+		rewriter.NewRewriter(v).Rewrite(root)
+
+		assert.Equal(t, tt.expected, printNode(root))
+	}
+}
+
+func TestRewriterSpliceArguments(t *testing.T) {
+	tests := []struct {
+		src      string
+		expected string
+	}{
+		{`<?php f($a);`, `<?php f($x,$a,$y);`},
+		{`<?php f($a, $b);`, `<?php f($x,$a,$y, $b);`},
+		{`<?php f($b, $a);`, `<?php f($b,$x, $a,$y);`},
+		{`<?php f($b, $a,);`, `<?php f($b,$x, $a,$y,);`},
+	}
+
+	for _, tt := range tests {
+		root := parse(tt.src)
+
+		v := &visitor{
+			leave: func(n ast.Vertex, _ ast.Vertex, _ string) []ast.Vertex {
+				if isVar(n, "$a") {
+					return []ast.Vertex{newArg("$x"), n, newArg("$y")}
+				}
+				return []ast.Vertex{n}
+			},
+		}
+		rewriter.NewRewriter(v).Rewrite(root)
+
+		assert.Equal(t, tt.expected, printNode(root))
+	}
+}
+
+func TestRewriterStatements(t *testing.T) {
+	root := parse(`<?php
+function f() {
+	echo 1;
+	echo 2;
+	return 3;
+}`)
+
+	v := &visitor{
+		leave: func(n ast.Vertex, parent ast.Vertex, field string) []ast.Vertex {
+			nn, ok := n.(*ast.StmtEcho)
+			if !ok {
+				return []ast.Vertex{n}
+			}
+
+			if _, ok := parent.(*ast.StmtFunction); !ok || field != "Stmts" {
+				t.Errorf("unexpected parent %T and field %s", parent, field)
+			}
+
+			if string(nn.Exprs[0].(*ast.ScalarLnumber).Value) == "1" {
+				return nil
+			}
+
+			return []ast.Vertex{n, n}
+		},
+	}
+	rewriter.NewRewriter(v).Rewrite(root)
+
+	expected := `<?php
+function f() {
+	echo 2;
+	echo 2;
+	return 3;
+}`
+
+	assert.Equal(t, expected, printNode(root))
+}
+
+func TestRewriterSeparatedLists(t *testing.T) {
+	root := parse(`<?php
+use A, B, C;
+class Foo implements A, B, C {}
+$f = function ($a, $b) use ($a, $b) {};
+echo [1, 2, 3], 4;
+`)
+
+	v := &visitor{
+		leave: func(n ast.Vertex, _ ast.Vertex, field string) []ast.Vertex {
+			switch nn := n.(type) {
+			case *ast.StmtUse:
+				if string(nn.Use.(*ast.Name).Parts[0].(*ast.NamePart).Value) == "B" {
+					return nil
+				}
+			case *ast.Name:
+				if field == "Implements" && string(nn.Parts[0].(*ast.NamePart).Value) == "C" {
+					return nil
+				}
+			case *ast.Parameter:
+				if string(nn.Var.(*ast.ExprVariable).Name.(*ast.Identifier).Value) == "$a" {
+					return nil
+				}
+			case *ast.ExprClosureUse:
+				if string(nn.Var.(*ast.ExprVariable).Name.(*ast.Identifier).Value) == "$b" {
+					return nil
+				}
+			case *ast.ExprArrayItem:
+				if string(nn.Val.(*ast.ScalarLnumber).Value) == "2" {
+					return nil
+				}
+			case *ast.ScalarLnumber:
+				if string(nn.Value) == "4" {
+					return nil
+				}
+			}
+			return []ast.Vertex{n}
+		},
+	}
+	rewriter.NewRewriter(v).Rewrite(root)
+
+	expected := `<?php
+use A, C;
+class Foo implements A, B {}
+$f = function ( $b) use ($a) {};
+echo [1, 3];
+`
+
+	assert.Equal(t, expected, printNode(root))
+}
+
+func TestRewriterSkipChildren(t *testing.T) {
+	root := parse(`<?php f($a); function g() { f($a); }`)
+
+	v := &visitor{
+		enter: func(n ast.Vertex) bool {
+			_, ok := n.(*ast.StmtFunction)
+			return !ok
+		},
+		leave: func(n ast.Vertex, _ ast.Vertex, _ string) []ast.Vertex {
+			if isVar(n, "$a") {
+				return []ast.Vertex{newArg("$b")}
+			}
+			return []ast.Vertex{n}
+		},
+	}
+	rewriter.NewRewriter(v).Rewrite(root)
+
+	assert.Equal(t, `<?php f($b); function g() { f($a); }`, printNode(root))
+}
+
+func TestRewriterAbort(t *testing.T) {
+	root := parse(`<?php f($a); f($a);`)
+
+	var r *rewriter.Rewriter
+	v := &visitor{
+		leave: func(n ast.Vertex, _ ast.Vertex, _ string) []ast.Vertex {
+			if isVar(n, "$a") {
+				r.Abort()
+				return []ast.Vertex{newArg("$b")}
+			}
+			return []ast.Vertex{n}
+		},
+	}
+	r = rewriter.NewRewriter(v)
+	r.Rewrite(root)
+
+	assert.Equal(t, `<?php f($b); f($a);`, printNode(root))
+}
+
+func TestRewriterReplaceRoot(t *testing.T) {
+	root := parse(`<?php $a;`)
+	replacement := &ast.Root{}
+
+	v := &visitor{
+		leave: func(n ast.Vertex, _ ast.Vertex, _ string) []ast.Vertex {
+			if n == root {
+				return []ast.Vertex{replacement}
+			}
+			return []ast.Vertex{n}
+		},
+	}
+	result, err := rewriter.NewRewriter(v).Rewrite(root)
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, []ast.Vertex{replacement}, result)
+}
+
+func TestRewriterSpliceIntoNodeField(t *testing.T) {
+	root := parse(`<?php $a = $b;`)
+
+	v := &visitor{
+		leave: func(n ast.Vertex, _ ast.Vertex, field string) []ast.Vertex {
+			if field == "Expr" {
+				if _, ok := n.(*ast.ExprVariable); ok {
+					return []ast.Vertex{n, n}
+				}
+			}
+			return []ast.Vertex{n}
+		},
+	}
+
+	_, err := rewriter.NewRewriter(v).Rewrite(root)
+
+	assert.Error(t, err, "rewriter: can not splice 2 nodes into *ast.ExprAssign.Expr")
+	assert.Equal(t, `<?php $a = $b;`, printNode(root))
+}
@@ -4,1161 +4,1892 @@ import (
 	"github.com/z7zmey/php-parser/pkg/ast"
 )
 
+// NodeVisitor is notified when the traverser enters and leaves a node.
+// parent is nil and field is empty for the node passed to Traverse,
+// list elements get the name of the slice field.
+type NodeVisitor interface {
+	// EnterNode is called before the node children are traversed,
+	// the children are skipped when it returns false
+	EnterNode(n ast.Vertex, parent ast.Vertex, field string) bool
+
+	// LeaveNode is called after the node children are traversed,
+	// it is called even if the children were skipped
+	LeaveNode(n ast.Vertex, parent ast.Vertex, field string)
+}
+
 type Traverser struct {
-	v ast.Visitor
+	v  ast.Visitor
+	nv NodeVisitor
+
+	parent  ast.Vertex
+	field   string
+	aborted bool
 }
 
+// NewTraverser creates traverser that calls the node method of v
+// before traversing the node children
 func NewTraverser(v ast.Visitor) *Traverser {
 	return &Traverser{
 		v: v,
 	}
 }
 
+// NewNodeTraverser creates traverser that calls EnterNode and LeaveNode of v
+func NewNodeTraverser(v NodeVisitor) *Traverser {
+	return &Traverser{
+		nv: v,
+	}
+}
+
 func (t *Traverser) Traverse(n ast.Vertex) {
-	if n != nil {
-		n.Accept(t)
+	t.aborted = false
+	t.traverse(nil, "", n)
+}
+
+// Abort stops the traversal, no EnterNode and LeaveNode calls are made after it
+func (t *Traverser) Abort() {
+	t.aborted = true
+}
+
+func (t *Traverser) traverse(parent ast.Vertex, field string, n ast.Vertex) {
+	if n == nil || t.aborted {
+		return
 	}
+
+	p, f := t.parent, t.field
+	t.parent, t.field = parent, field
+
+	n.Accept(t)
+
+	t.parent, t.field = p, f
 }
 
-func (t *Traverser) Root(n *ast.Root) {
-	n.Accept(t.v)
+func (t *Traverser) traverseList(parent ast.Vertex, field string, list []ast.Vertex) {
+	for _, n := range list {
+		t.traverse(parent, field, n)
+	}
+}
+
+func (t *Traverser) enter(n ast.Vertex) bool {
+	if t.nv == nil {
+		n.Accept(t.v)
+		return true
+	}
+
+	if t.aborted {
+		return false
+	}
 
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+	if !t.nv.EnterNode(n, t.parent, t.field) {
+		t.leave(n)
+		return false
 	}
+
+	return !t.aborted
+}
+
+func (t *Traverser) leave(n ast.Vertex) {
+	if t.nv == nil || t.aborted {
+		return
+	}
+
+	t.nv.LeaveNode(n, t.parent, t.field)
+}
+
+func (t *Traverser) Root(n *ast.Root) {
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) Nullable(n *ast.Nullable) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) Parameter(n *ast.Parameter) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Type)
-	t.Traverse(n.Var)
-	t.Traverse(n.DefaultValue)
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverseList(n, "Modifiers", n.Modifiers)
+	t.traverse(n, "Type", n.Type)
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "DefaultValue", n.DefaultValue)
+
+	t.leave(n)
 }
 
 func (t *Traverser) Identifier(n *ast.Identifier) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
 }
 
 func (t *Traverser) Argument(n *ast.Argument) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Name", n.Name)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
-func (t *Traverser) StmtBreak(n *ast.StmtBreak) {
-	n.Accept(t.v)
+func (t *Traverser) Attribute(n *ast.Attribute) {
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
-}
+	t.traverse(n, "Name", n.Name)
+	t.traverseList(n, "Args", n.Args)
 
-func (t *Traverser) StmtCase(n *ast.StmtCase) {
-	n.Accept(t.v)
+	t.leave(n)
+}
 
-	t.Traverse(n.Cond)
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+func (t *Traverser) AttributeGroup(n *ast.AttributeGroup) {
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Attrs", n.Attrs)
+
+	t.leave(n)
 }
 
-func (t *Traverser) StmtCatch(n *ast.StmtCatch) {
-	n.Accept(t.v)
+func (t *Traverser) Union(n *ast.Union) {
+	if !t.enter(n) {
+		return
+	}
 
-	for _, nn := range n.Types {
-		nn.Accept(t)
+	t.traverseList(n, "Types", n.Types)
+
+	t.leave(n)
+}
+
+func (t *Traverser) Intersection(n *ast.Intersection) {
+	if !t.enter(n) {
+		return
 	}
-	t.Traverse(n.Var)
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+
+	t.traverseList(n, "Types", n.Types)
+
+	t.leave(n)
+}
+
+func (t *Traverser) MatchArm(n *ast.MatchArm) {
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Exprs", n.Exprs)
+	t.traverse(n, "ReturnExpr", n.ReturnExpr)
+
+	t.leave(n)
 }
 
-func (t *Traverser) StmtClass(n *ast.StmtClass) {
-	n.Accept(t.v)
+func (t *Traverser) BadStmt(n *ast.BadStmt) {
+	if !t.enter(n) {
+		return
+	}
 
-	for _, nn := range n.Modifiers {
-		nn.Accept(t)
+	t.leave(n)
+}
+
+func (t *Traverser) StmtBreak(n *ast.StmtBreak) {
+	if !t.enter(n) {
+		return
 	}
-	t.Traverse(n.Name)
-	for _, nn := range n.Args {
-		nn.Accept(t)
+
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
+}
+
+func (t *Traverser) StmtCase(n *ast.StmtCase) {
+	if !t.enter(n) {
+		return
 	}
-	t.Traverse(n.Extends)
-	for _, nn := range n.Implements {
-		nn.Accept(t)
+
+	t.traverse(n, "Cond", n.Cond)
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
+}
+
+func (t *Traverser) StmtCatch(n *ast.StmtCatch) {
+	if !t.enter(n) {
+		return
 	}
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+
+	t.traverseList(n, "Types", n.Types)
+	t.traverse(n, "Var", n.Var)
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
+}
+
+func (t *Traverser) StmtClass(n *ast.StmtClass) {
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverseList(n, "Modifiers", n.Modifiers)
+	t.traverse(n, "Name", n.Name)
+	t.traverseList(n, "Args", n.Args)
+	t.traverse(n, "Extends", n.Extends)
+	t.traverseList(n, "Implements", n.Implements)
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtClassConstList(n *ast.StmtClassConstList) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Modifiers {
-		nn.Accept(t)
-	}
-	for _, nn := range n.Consts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverseList(n, "Modifiers", n.Modifiers)
+	t.traverse(n, "Type", n.Type)
+	t.traverseList(n, "Consts", n.Consts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtClassMethod(n *ast.StmtClassMethod) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Modifiers {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
-	t.Traverse(n.Name)
-	for _, nn := range n.Params {
-		nn.Accept(t)
-	}
-	t.Traverse(n.ReturnType)
-	t.Traverse(n.Stmt)
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverseList(n, "Modifiers", n.Modifiers)
+	t.traverse(n, "Name", n.Name)
+	t.traverseList(n, "Params", n.Params)
+	t.traverse(n, "ReturnType", n.ReturnType)
+	t.traverse(n, "Stmt", n.Stmt)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtConstList(n *ast.StmtConstList) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Consts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Consts", n.Consts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtConstant(n *ast.StmtConstant) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Name)
-	t.Traverse(n.Expr)
+	t.traverse(n, "Name", n.Name)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtContinue(n *ast.StmtContinue) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtDeclare(n *ast.StmtDeclare) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Consts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
-	t.Traverse(n.Stmt)
+
+	t.traverseList(n, "Consts", n.Consts)
+	t.traverse(n, "Stmt", n.Stmt)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtDefault(n *ast.StmtDefault) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtDo(n *ast.StmtDo) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Stmt", n.Stmt)
+	t.traverse(n, "Cond", n.Cond)
 
-	t.Traverse(n.Stmt)
-	t.Traverse(n.Cond)
+	t.leave(n)
 }
 
 func (t *Traverser) StmtEcho(n *ast.StmtEcho) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Exprs {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Exprs", n.Exprs)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtElse(n *ast.StmtElse) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Stmt", n.Stmt)
 
-	t.Traverse(n.Stmt)
+	t.leave(n)
 }
 
 func (t *Traverser) StmtElseIf(n *ast.StmtElseIf) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Cond", n.Cond)
+	t.traverse(n, "Stmt", n.Stmt)
 
-	t.Traverse(n.Cond)
-	t.Traverse(n.Stmt)
+	t.leave(n)
+}
+
+func (t *Traverser) StmtEnum(n *ast.StmtEnum) {
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverse(n, "Name", n.Name)
+	t.traverse(n, "Type", n.Type)
+	t.traverseList(n, "Implements", n.Implements)
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
+}
+
+func (t *Traverser) StmtEnumCase(n *ast.StmtEnumCase) {
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverse(n, "Name", n.Name)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtExpression(n *ast.StmtExpression) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtFinally(n *ast.StmtFinally) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtFor(n *ast.StmtFor) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Init {
-		nn.Accept(t)
-	}
-	for _, nn := range n.Cond {
-		nn.Accept(t)
-	}
-	for _, nn := range n.Loop {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
-	t.Traverse(n.Stmt)
+
+	t.traverseList(n, "Init", n.Init)
+	t.traverseList(n, "Cond", n.Cond)
+	t.traverseList(n, "Loop", n.Loop)
+	t.traverse(n, "Stmt", n.Stmt)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtForeach(n *ast.StmtForeach) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
+	t.traverse(n, "Key", n.Key)
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Stmt", n.Stmt)
 
-	t.Traverse(n.Expr)
-	t.Traverse(n.Key)
-	t.Traverse(n.Var)
-	t.Traverse(n.Stmt)
+	t.leave(n)
 }
 
 func (t *Traverser) StmtFunction(n *ast.StmtFunction) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Name)
-	for _, nn := range n.Params {
-		nn.Accept(t)
-	}
-	t.Traverse(n.ReturnType)
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverse(n, "Name", n.Name)
+	t.traverseList(n, "Params", n.Params)
+	t.traverse(n, "ReturnType", n.ReturnType)
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtGlobal(n *ast.StmtGlobal) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Vars {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Vars", n.Vars)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtGoto(n *ast.StmtGoto) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Label", n.Label)
 
-	t.Traverse(n.Label)
+	t.leave(n)
 }
 
 func (t *Traverser) StmtHaltCompiler(n *ast.StmtHaltCompiler) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtIf(n *ast.StmtIf) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Cond)
-	t.Traverse(n.Stmt)
-	for _, nn := range n.ElseIf {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
-	t.Traverse(n.Else)
+
+	t.traverse(n, "Cond", n.Cond)
+	t.traverse(n, "Stmt", n.Stmt)
+	t.traverseList(n, "ElseIf", n.ElseIf)
+	t.traverse(n, "Else", n.Else)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtInlineHtml(n *ast.StmtInlineHtml) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtInterface(n *ast.StmtInterface) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Name)
-	for _, nn := range n.Extends {
-		nn.Accept(t)
-	}
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverse(n, "Name", n.Name)
+	t.traverseList(n, "Extends", n.Extends)
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtLabel(n *ast.StmtLabel) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Name)
+	t.traverse(n, "Name", n.Name)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtNamespace(n *ast.StmtNamespace) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Name)
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverse(n, "Name", n.Name)
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtNop(n *ast.StmtNop) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtProperty(n *ast.StmtProperty) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtPropertyList(n *ast.StmtPropertyList) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Modifiers {
-		nn.Accept(t)
-	}
-	t.Traverse(n.Type)
-	for _, nn := range n.Props {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverseList(n, "Modifiers", n.Modifiers)
+	t.traverse(n, "Type", n.Type)
+	t.traverseList(n, "Props", n.Props)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtReturn(n *ast.StmtReturn) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) StmtStatic(n *ast.StmtStatic) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Vars {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Vars", n.Vars)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtStaticVar(n *ast.StmtStaticVar) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) StmtStmtList(n *ast.StmtStmtList) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtSwitch(n *ast.StmtSwitch) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Cond)
-	for _, nn := range n.Cases {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverse(n, "Cond", n.Cond)
+	t.traverseList(n, "Cases", n.Cases)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtThrow(n *ast.StmtThrow) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtTrait(n *ast.StmtTrait) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Name)
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverse(n, "Name", n.Name)
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtTraitUse(n *ast.StmtTraitUse) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Traits {
-		nn.Accept(t)
-	}
-	for _, nn := range n.Adaptations {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Traits", n.Traits)
+	t.traverseList(n, "Adaptations", n.Adaptations)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtTraitUseAlias(n *ast.StmtTraitUseAlias) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Trait)
-	t.Traverse(n.Method)
-	t.Traverse(n.Modifier)
-	t.Traverse(n.Alias)
+	t.traverse(n, "Trait", n.Trait)
+	t.traverse(n, "Method", n.Method)
+	t.traverse(n, "Modifier", n.Modifier)
+	t.traverse(n, "Alias", n.Alias)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtTraitUsePrecedence(n *ast.StmtTraitUsePrecedence) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Trait)
-	t.Traverse(n.Method)
-	for _, nn := range n.Insteadof {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverse(n, "Trait", n.Trait)
+	t.traverse(n, "Method", n.Method)
+	t.traverseList(n, "Insteadof", n.Insteadof)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtTry(n *ast.StmtTry) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
-	for _, nn := range n.Catches {
-		nn.Accept(t)
-	}
-	t.Traverse(n.Finally)
+
+	t.traverseList(n, "Stmts", n.Stmts)
+	t.traverseList(n, "Catches", n.Catches)
+	t.traverse(n, "Finally", n.Finally)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtUnset(n *ast.StmtUnset) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Vars {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Vars", n.Vars)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtUse(n *ast.StmtUseList) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Type)
-	for _, nn := range n.Uses {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverse(n, "Type", n.Type)
+	t.traverseList(n, "Uses", n.Uses)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtGroupUse(n *ast.StmtGroupUseList) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Type)
-	t.Traverse(n.Prefix)
-	for _, nn := range n.Uses {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverse(n, "Type", n.Type)
+	t.traverse(n, "Prefix", n.Prefix)
+	t.traverseList(n, "Uses", n.Uses)
+
+	t.leave(n)
 }
 
 func (t *Traverser) StmtUseDeclaration(n *ast.StmtUse) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Type", n.Type)
+	t.traverse(n, "Use", n.Use)
+	t.traverse(n, "Alias", n.Alias)
 
-	t.Traverse(n.Type)
-	t.Traverse(n.Use)
-	t.Traverse(n.Alias)
+	t.leave(n)
 }
 
 func (t *Traverser) StmtWhile(n *ast.StmtWhile) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Cond", n.Cond)
+	t.traverse(n, "Stmt", n.Stmt)
 
-	t.Traverse(n.Cond)
-	t.Traverse(n.Stmt)
+	t.leave(n)
 }
 
-func (t *Traverser) ExprArray(n *ast.ExprArray) {
-	n.Accept(t.v)
+func (t *Traverser) BadExpr(n *ast.BadExpr) {
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
+}
 
-	for _, nn := range n.Items {
-		nn.Accept(t)
+func (t *Traverser) ExprArray(n *ast.ExprArray) {
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Items", n.Items)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprArrayDimFetch(n *ast.ExprArrayDimFetch) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Dim", n.Dim)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Dim)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprArrayItem(n *ast.ExprArrayItem) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Key)
-	t.Traverse(n.Val)
+	t.traverse(n, "Key", n.Key)
+	t.traverse(n, "Val", n.Val)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprArrowFunction(n *ast.ExprArrowFunction) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Params {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
-	t.Traverse(n.ReturnType)
-	t.Traverse(n.Expr)
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverseList(n, "Params", n.Params)
+	t.traverse(n, "ReturnType", n.ReturnType)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBitwiseNot(n *ast.ExprBitwiseNot) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBooleanNot(n *ast.ExprBooleanNot) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBrackets(n *ast.ExprBrackets) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprClassConstFetch(n *ast.ExprClassConstFetch) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Class)
-	t.Traverse(n.Const)
+	t.traverse(n, "Class", n.Class)
+	t.traverse(n, "Const", n.Const)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprClone(n *ast.ExprClone) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprClosure(n *ast.ExprClosure) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Params {
-		nn.Accept(t)
-	}
-	for _, nn := range n.Uses {
-		nn.Accept(t)
-	}
-	t.Traverse(n.ReturnType)
-	for _, nn := range n.Stmts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "AttrGroups", n.AttrGroups)
+	t.traverseList(n, "Params", n.Params)
+	t.traverseList(n, "Uses", n.Uses)
+	t.traverse(n, "ReturnType", n.ReturnType)
+	t.traverseList(n, "Stmts", n.Stmts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprClosureUse(n *ast.ExprClosureUse) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
 
-	t.Traverse(n.Var)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprConstFetch(n *ast.ExprConstFetch) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Const)
+	t.traverse(n, "Const", n.Const)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprEmpty(n *ast.ExprEmpty) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprErrorSuppress(n *ast.ExprErrorSuppress) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprEval(n *ast.ExprEval) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprExit(n *ast.ExprExit) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprFunctionCall(n *ast.ExprFunctionCall) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Function)
-	for _, nn := range n.Args {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverse(n, "Function", n.Function)
+	t.traverseList(n, "Args", n.Args)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprInclude(n *ast.ExprInclude) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprIncludeOnce(n *ast.ExprIncludeOnce) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprInstanceOf(n *ast.ExprInstanceOf) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
+	t.traverse(n, "Class", n.Class)
 
-	t.Traverse(n.Expr)
-	t.Traverse(n.Class)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprIsset(n *ast.ExprIsset) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Vars {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Vars", n.Vars)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprList(n *ast.ExprList) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverseList(n, "Items", n.Items)
+
+	t.leave(n)
+}
 
-	for _, nn := range n.Items {
-		nn.Accept(t)
+func (t *Traverser) ExprMatch(n *ast.ExprMatch) {
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverse(n, "Expr", n.Expr)
+	t.traverseList(n, "Arms", n.Arms)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprMethodCall(n *ast.ExprMethodCall) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Var)
-	t.Traverse(n.Method)
-	for _, nn := range n.Args {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Method", n.Method)
+	t.traverseList(n, "Args", n.Args)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprNew(n *ast.ExprNew) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Class", n.Class)
+	t.traverseList(n, "Args", n.Args)
+
+	t.leave(n)
+}
+
+func (t *Traverser) ExprNullsafeMethodCall(n *ast.ExprNullsafeMethodCall) {
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Method", n.Method)
+	t.traverseList(n, "Args", n.Args)
+
+	t.leave(n)
+}
 
-	t.Traverse(n.Class)
-	for _, nn := range n.Args {
-		nn.Accept(t)
+func (t *Traverser) ExprNullsafePropertyFetch(n *ast.ExprNullsafePropertyFetch) {
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Prop", n.Prop)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprPostDec(n *ast.ExprPostDec) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Var)
+	t.traverse(n, "Var", n.Var)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprPostInc(n *ast.ExprPostInc) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Var)
+	t.traverse(n, "Var", n.Var)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprPreDec(n *ast.ExprPreDec) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Var)
+	t.traverse(n, "Var", n.Var)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprPreInc(n *ast.ExprPreInc) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
 
-	t.Traverse(n.Var)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprPrint(n *ast.ExprPrint) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprPropertyFetch(n *ast.ExprPropertyFetch) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Prop", n.Prop)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Prop)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprRequire(n *ast.ExprRequire) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprRequireOnce(n *ast.ExprRequireOnce) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprShellExec(n *ast.ExprShellExec) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Parts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Parts", n.Parts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprStaticCall(n *ast.ExprStaticCall) {
-	n.Accept(t.v)
-
-	t.Traverse(n.Class)
-	t.Traverse(n.Call)
-	for _, nn := range n.Args {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverse(n, "Class", n.Class)
+	t.traverse(n, "Call", n.Call)
+	t.traverseList(n, "Args", n.Args)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprStaticPropertyFetch(n *ast.ExprStaticPropertyFetch) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Class", n.Class)
+	t.traverse(n, "Prop", n.Prop)
 
-	t.Traverse(n.Class)
-	t.Traverse(n.Prop)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprTernary(n *ast.ExprTernary) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Cond", n.Cond)
+	t.traverse(n, "IfTrue", n.IfTrue)
+	t.traverse(n, "IfFalse", n.IfFalse)
+
+	t.leave(n)
+}
+
+func (t *Traverser) ExprThrow(n *ast.ExprThrow) {
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Cond)
-	t.Traverse(n.IfTrue)
-	t.Traverse(n.IfFalse)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprUnaryMinus(n *ast.ExprUnaryMinus) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprUnaryPlus(n *ast.ExprUnaryPlus) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprVariable(n *ast.ExprVariable) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Name)
+	t.traverse(n, "Name", n.Name)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprYield(n *ast.ExprYield) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Key)
-	t.Traverse(n.Val)
+	t.traverse(n, "Key", n.Key)
+	t.traverse(n, "Val", n.Val)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprYieldFrom(n *ast.ExprYieldFrom) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssign(n *ast.ExprAssign) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignReference(n *ast.ExprAssignReference) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignBitwiseAnd(n *ast.ExprAssignBitwiseAnd) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignBitwiseOr(n *ast.ExprAssignBitwiseOr) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignBitwiseXor(n *ast.ExprAssignBitwiseXor) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignCoalesce(n *ast.ExprAssignCoalesce) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignConcat(n *ast.ExprAssignConcat) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignDiv(n *ast.ExprAssignDiv) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignMinus(n *ast.ExprAssignMinus) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignMod(n *ast.ExprAssignMod) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignMul(n *ast.ExprAssignMul) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignPlus(n *ast.ExprAssignPlus) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignPow(n *ast.ExprAssignPow) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignShiftLeft(n *ast.ExprAssignShiftLeft) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprAssignShiftRight(n *ast.ExprAssignShiftRight) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Var", n.Var)
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Var)
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryBitwiseAnd(n *ast.ExprBinaryBitwiseAnd) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryBitwiseOr(n *ast.ExprBinaryBitwiseOr) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryBitwiseXor(n *ast.ExprBinaryBitwiseXor) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryBooleanAnd(n *ast.ExprBinaryBooleanAnd) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryBooleanOr(n *ast.ExprBinaryBooleanOr) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryCoalesce(n *ast.ExprBinaryCoalesce) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryConcat(n *ast.ExprBinaryConcat) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryDiv(n *ast.ExprBinaryDiv) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryEqual(n *ast.ExprBinaryEqual) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryGreater(n *ast.ExprBinaryGreater) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryGreaterOrEqual(n *ast.ExprBinaryGreaterOrEqual) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryIdentical(n *ast.ExprBinaryIdentical) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryLogicalAnd(n *ast.ExprBinaryLogicalAnd) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryLogicalOr(n *ast.ExprBinaryLogicalOr) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryLogicalXor(n *ast.ExprBinaryLogicalXor) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryMinus(n *ast.ExprBinaryMinus) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryMod(n *ast.ExprBinaryMod) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryMul(n *ast.ExprBinaryMul) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryNotEqual(n *ast.ExprBinaryNotEqual) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryNotIdentical(n *ast.ExprBinaryNotIdentical) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryPlus(n *ast.ExprBinaryPlus) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryPow(n *ast.ExprBinaryPow) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryShiftLeft(n *ast.ExprBinaryShiftLeft) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinaryShiftRight(n *ast.ExprBinaryShiftRight) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinarySmaller(n *ast.ExprBinarySmaller) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinarySmallerOrEqual(n *ast.ExprBinarySmallerOrEqual) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprBinarySpaceship(n *ast.ExprBinarySpaceship) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Left", n.Left)
+	t.traverse(n, "Right", n.Right)
 
-	t.Traverse(n.Left)
-	t.Traverse(n.Right)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprCastArray(n *ast.ExprCastArray) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprCastBool(n *ast.ExprCastBool) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprCastDouble(n *ast.ExprCastDouble) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprCastInt(n *ast.ExprCastInt) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Expr)
+	t.traverse(n, "Expr", n.Expr)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ExprCastObject(n *ast.ExprCastObject) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprCastString(n *ast.ExprCastString) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ExprCastUnset(n *ast.ExprCastUnset) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Expr", n.Expr)
 
-	t.Traverse(n.Expr)
+	t.leave(n)
 }
 
 func (t *Traverser) ScalarDnumber(n *ast.ScalarDnumber) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
 }
 
 func (t *Traverser) ScalarEncapsed(n *ast.ScalarEncapsed) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Parts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Parts", n.Parts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ScalarEncapsedStringPart(n *ast.ScalarEncapsedStringPart) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
 }
 
 func (t *Traverser) ScalarEncapsedStringVar(n *ast.ScalarEncapsedStringVar) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.traverse(n, "Name", n.Name)
+	t.traverse(n, "Dim", n.Dim)
 
-	t.Traverse(n.Name)
-	t.Traverse(n.Dim)
+	t.leave(n)
 }
 
 func (t *Traverser) ScalarEncapsedStringBrackets(n *ast.ScalarEncapsedStringBrackets) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
 
-	t.Traverse(n.Var)
+	t.traverse(n, "Var", n.Var)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ScalarHeredoc(n *ast.ScalarHeredoc) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Parts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Parts", n.Parts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) ScalarLnumber(n *ast.ScalarLnumber) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
 }
 
 func (t *Traverser) ScalarMagicConstant(n *ast.ScalarMagicConstant) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
 }
 
 func (t *Traverser) ScalarString(n *ast.ScalarString) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
 }
 
 func (t *Traverser) NameName(n *ast.Name) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Parts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Parts", n.Parts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) NameFullyQualified(n *ast.NameFullyQualified) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Parts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Parts", n.Parts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) NameRelative(n *ast.NameRelative) {
-	n.Accept(t.v)
-
-	for _, nn := range n.Parts {
-		nn.Accept(t)
+	if !t.enter(n) {
+		return
 	}
+
+	t.traverseList(n, "Parts", n.Parts)
+
+	t.leave(n)
 }
 
 func (t *Traverser) NameNamePart(n *ast.NamePart) {
-	n.Accept(t.v)
+	if !t.enter(n) {
+		return
+	}
+
+	t.leave(n)
 }
@@ -0,0 +1,176 @@
+package traverser_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/z7zmey/php-parser/internal/php8"
+	"github.com/z7zmey/php-parser/internal/scanner"
+	"github.com/z7zmey/php-parser/pkg/ast"
+	"github.com/z7zmey/php-parser/pkg/conf"
+	"github.com/z7zmey/php-parser/pkg/version"
+	"github.com/z7zmey/php-parser/pkg/visitor/traverser"
+)
+
+type recorder struct {
+	t     *traverser.Traverser
+	log   []string
+	skip  ast.Vertex
+	abort ast.Vertex
+}
+
+func (r *recorder) EnterNode(n ast.Vertex, parent ast.Vertex, field string) bool {
+	r.log = append(r.log, fmt.Sprintf("enter %T %T %s", n, parent, field))
+
+	if n == r.abort {
+		r.t.Abort()
+	}
+
+	return n != r.skip
+}
+
+func (r *recorder) LeaveNode(n ast.Vertex, parent ast.Vertex, field string) {
+	r.log = append(r.log, fmt.Sprintf("leave %T %T %s", n, parent, field))
+}
+
+func newTree() (*ast.Root, *ast.StmtExpression, *ast.ExprAssign) {
+	assign := &ast.ExprAssign{
+		Var:  &ast.ExprVariable{Name: &ast.Identifier{Value: []byte("$a")}},
+		Expr: &ast.ScalarLnumber{Value: []byte("1")},
+	}
+	stmt := &ast.StmtExpression{Expr: assign}
+	root := &ast.Root{
+		Stmts: []ast.Vertex{
+			stmt,
+			&ast.StmtNop{},
+		},
+	}
+
+	return root, stmt, assign
+}
+
+func TestNodeTraverser(t *testing.T) {
+	root, _, _ := newTree()
+
+	r := &recorder{}
+	r.t = traverser.NewNodeTraverser(r)
+	r.t.Traverse(root)
+
+	expected := []string{
+		"enter *ast.Root <nil> ",
+		"enter *ast.StmtExpression *ast.Root Stmts",
+		"enter *ast.ExprAssign *ast.StmtExpression Expr",
+		"enter *ast.ExprVariable *ast.ExprAssign Var",
+		"enter *ast.Identifier *ast.ExprVariable Name",
+		"leave *ast.Identifier *ast.ExprVariable Name",
+		"leave *ast.ExprVariable *ast.ExprAssign Var",
+		"enter *ast.ScalarLnumber *ast.ExprAssign Expr",
+		"leave *ast.ScalarLnumber *ast.ExprAssign Expr",
+		"leave *ast.ExprAssign *ast.StmtExpression Expr",
+		"leave *ast.StmtExpression *ast.Root Stmts",
+		"enter *ast.StmtNop *ast.Root Stmts",
+		"leave *ast.StmtNop *ast.Root Stmts",
+		"leave *ast.Root <nil> ",
+	}
+
+	assert.DeepEqual(t, expected, r.log)
+}
+
+func TestNodeTraverserSkipChildren(t *testing.T) {
+	root, _, assign := newTree()
+
+	r := &recorder{skip: assign}
+	r.t = traverser.NewNodeTraverser(r)
+	r.t.Traverse(root)
+
+	expected := []string{
+		"enter *ast.Root <nil> ",
+		"enter *ast.StmtExpression *ast.Root Stmts",
+		"enter *ast.ExprAssign *ast.StmtExpression Expr",
+		"leave *ast.ExprAssign *ast.StmtExpression Expr",
+		"leave *ast.StmtExpression *ast.Root Stmts",
+		"enter *ast.StmtNop *ast.Root Stmts",
+		"leave *ast.StmtNop *ast.Root Stmts",
+		"leave *ast.Root <nil> ",
+	}
+
+	assert.DeepEqual(t, expected, r.log)
+}
+
+func TestNodeTraverserAbort(t *testing.T) {
+	root, _, assign := newTree()
+
+	r := &recorder{abort: assign}
+	r.t = traverser.NewNodeTraverser(r)
+	r.t.Traverse(root)
+
+	expected := []string{
+		"enter *ast.Root <nil> ",
+		"enter *ast.StmtExpression *ast.Root Stmts",
+		"enter *ast.ExprAssign *ast.StmtExpression Expr",
+	}
+
+	assert.DeepEqual(t, expected, r.log)
+
+	r.log = nil
+	r.abort = nil
+	r.t.Traverse(assign)
+
+	expected = []string{
+		"enter *ast.ExprAssign <nil> ",
+		"enter *ast.ExprVariable *ast.ExprAssign Var",
+		"enter *ast.Identifier *ast.ExprVariable Name",
+		"leave *ast.Identifier *ast.ExprVariable Name",
+		"leave *ast.ExprVariable *ast.ExprAssign Var",
+		"enter *ast.ScalarLnumber *ast.ExprAssign Expr",
+		"leave *ast.ScalarLnumber *ast.ExprAssign Expr",
+		"leave *ast.ExprAssign <nil> ",
+	}
+
+	assert.DeepEqual(t, expected, r.log)
+}
+
+type childRecorder struct {
+	log []string
+}
+
+func (r *childRecorder) EnterNode(n ast.Vertex, parent ast.Vertex, field string) bool {
+	r.log = append(r.log, fmt.Sprintf("%p %p %s", n, parent, field))
+	return true
+}
+
+func (r *childRecorder) LeaveNode(_ ast.Vertex, _ ast.Vertex, _ string) {}
+
+func walkChildren(log []string, n ast.Vertex, parent ast.Vertex, field string) []string {
+	log = append(log, fmt.Sprintf("%p %p %s", n, parent, field))
+
+	for _, c := range ast.Children(n) {
+		log = walkChildren(log, c.Node, n, c.Field)
+	}
+
+	return log
+}
+
+func TestNodeTraverserMatchesChildren(t *testing.T) {
+	src, err := ioutil.ReadFile("../../../internal/php8/test.php")
+	assert.NilError(t, err)
+
+	config := conf.Config{
+		Version: &version.Version{
+			Major: 8,
+			Minor: 3,
+		},
+	}
+	lexer := scanner.NewLexer(src, config)
+	php8parser := php8.NewParser(lexer, config)
+	php8parser.Parse()
+	root := php8parser.GetRootNode()
+
+	r := &childRecorder{}
+	traverser.NewNodeTraverser(r).Traverse(root)
+
+	assert.DeepEqual(t, walkChildren(nil, root, nil, ""), r.log)
+}